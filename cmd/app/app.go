@@ -9,30 +9,94 @@ import (
 	"auth/internal/usecases"
 	"auth/pkg"
 	"auth/pkg/logger"
+	"context"
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"log"
 	"net/http"
+	"time"
 )
 
 var (
 	l              logger.Logger
 	postgresClient *postgres.Client
 
-	bcryptHashService pkg.HashService
-	sessionService    pkg.SessionService
-	cookieService     pkg.CookieService
+	hashService             pkg.HashService
+	sessionService          pkg.SessionService
+	cookieService           pkg.CookieService
+	breachedPasswordService pkg.BreachedPasswordService
+	randomService           pkg.RandomService
+	mailService             pkg.MailService
+	smsSender               pkg.SMSSender
+	mfaService              pkg.MFAService
+	encryptionService       pkg.EncryptionService
+	webAuthnService         pkg.WebAuthnService
+	auditLogger             pkg.AuditLogger
+	webhookSender           pkg.WebhookSender
+	webhookSignatureService pkg.WebhookSignatureService
+	eventPublisher          pkg.EventPublisher
+	geoIPService            pkg.GeoIPService
 
-	userRepository    repositories.UserRepository
-	sessionRepository repositories.SessionRepository
+	userRepository          repositories.UserRepository
+	sessionRepository       repositories.SessionRepository
+	roleRepository          repositories.RoleRepository
+	permissionRepository    repositories.PermissionRepository
+	organizationRepository  repositories.OrganizationRepository
+	invitationRepository    repositories.InvitationRepository
+	loginAttemptRepository  repositories.LoginAttemptRepository
+	rateLimitRepository     repositories.RateLimitRepository
+	mfaRepository           repositories.MFARepository
+	webAuthnRepository      repositories.WebAuthnRepository
+	passwordlessRepository  repositories.PasswordlessRepository
+	trustedDeviceRepository repositories.TrustedDeviceRepository
+	smsCodeRepository       repositories.SMSCodeRepository
+	auditEventRepository    repositories.AuditEventRepository
+	webhookRepository       repositories.WebhookRepository
+	webhookAlertRepository  repositories.WebhookAlertRepository
+	webhookNonceRepository  repositories.WebhookNonceRepository
+	domainEventRepository   repositories.DomainEventRepository
+	loginHistoryRepository  repositories.LoginHistoryRepository
 
-	signInUseCase         usecases.SignInUseCase
-	signUpUseCase         usecases.SignUpUseCase
-	generateTokensUseCase usecases.GenerateTokensUseCase
-	refreshSessionUseCase usecases.RefreshSessionUseCase
-	getUserUseCase        usecases.GetUserUseCase
-	logoutUserUseCase     usecases.LogoutUseCase
+	signInUseCase               usecases.SignInUseCase
+	verifyMFAUseCase            usecases.VerifyMFAUseCase
+	webAuthnLoginUseCase        usecases.WebAuthnLoginUseCase
+	passwordlessUseCase         usecases.PasswordlessUseCase
+	signUpUseCase               usecases.SignUpUseCase
+	generateTokensUseCase       usecases.GenerateTokensUseCase
+	refreshSessionUseCase       usecases.RefreshSessionUseCase
+	getUserUseCase              usecases.GetUserUseCase
+	logoutUserUseCase           usecases.LogoutUseCase
+	listUsersUseCase            usecases.ListUsersUseCase
+	updateUserUseCase           usecases.UpdateUserUseCase
+	deleteUserUseCase           usecases.DeleteUserUseCase
+	revokeSessionsUseCase       usecases.RevokeSessionsUseCase
+	listRolesUseCase            usecases.ListRolesUseCase
+	createRoleUseCase           usecases.CreateRoleUseCase
+	updateRoleUseCase           usecases.UpdateRoleUseCase
+	deleteRoleUseCase           usecases.DeleteRoleUseCase
+	listPermissionsUseCase      usecases.ListPermissionsUseCase
+	createPermissionUseCase     usecases.CreatePermissionUseCase
+	deletePermissionUseCase     usecases.DeletePermissionUseCase
+	setUserRolesUseCase         usecases.SetUserRolesUseCase
+	createUserInvitationUseCase usecases.CreateUserInvitationUseCase
+	createOrganizationUseCase   usecases.CreateOrganizationUseCase
+	listMembersUseCase          usecases.ListMembersUseCase
+	removeMemberUseCase         usecases.RemoveMemberUseCase
+	setOrgPasswordPolicyUseCase usecases.SetOrganizationPasswordPolicyUseCase
+	createInvitationUseCase     usecases.CreateInvitationUseCase
+	acceptInvitationUseCase     usecases.AcceptInvitationUseCase
+	getPasswordPolicyUseCase    usecases.GetPasswordPolicyUseCase
+	changePasswordUseCase       usecases.ChangePasswordUseCase
+	enrollTOTPUseCase           usecases.EnrollTOTPUseCase
+	registerWebAuthnUseCase     usecases.RegisterWebAuthnUseCase
+	trustedDevicesUseCase       usecases.TrustedDevicesUseCase
+	verifyPhoneUseCase          usecases.VerifyPhoneUseCase
+	securityEventsUseCase       usecases.SecurityEventsUseCase
+	webhooksUseCase             usecases.WebhooksUseCase
+	webhookEndpointsUseCase     usecases.WebhookEndpointsUseCase
+	webhookAlertsUseCase        usecases.WebhookAlertsUseCase
+	domainEventsUseCase         usecases.DomainEventsUseCase
 )
 
 func Run() {
@@ -43,10 +107,14 @@ func Run() {
 
 	initPackages(cfg)
 	initService(cfg)
-	initRepository()
-	initUseCases()
+	initRepository(cfg)
+	initUseCases(cfg)
 
 	defer postgresClient.Close()
+	defer eventPublisher.Close()
+	defer geoIPService.Close()
+	runWebhooks(cfg)
+	runEvents(cfg)
 	runHTTP(cfg)
 }
 
@@ -72,78 +140,520 @@ func initPackages(cfg *config.Config) {
 }
 
 func initService(cfg *config.Config) {
-	bcryptHashService = pkg.NewBcryptHashService()
+	hashService = pkg.NewHashService(cfg.PasswordHashing)
 
 	accessTokenService := pkg.NewTokenService([]byte(cfg.SecretKey))
 	refreshTokenService := pkg.NewTokenService([]byte(cfg.SecretKey))
 	sessionService = pkg.NewSessionService(cfg.TokenConfiguration, accessTokenService, refreshTokenService)
 
 	cookieService = pkg.NewCookieService(cfg.Cookie)
+	randomService = pkg.NewRandomService()
+	mailService = pkg.NewMailService(cfg.Mail, l)
+	mfaService = pkg.NewMFAService(cfg.MFA)
+
+	var err error
+	encryptionService, err = pkg.NewEncryptionService(cfg.MFA.EncryptionKey)
+	if err != nil {
+		l.Fatal().Msgf("invalid mfa encryption key: %s", err.Error())
+	}
+
+	webAuthnService, err = pkg.NewWebAuthnService(cfg.WebAuthn)
+	if err != nil {
+		l.Fatal().Msgf("invalid webauthn configuration: %s", err.Error())
+	}
+
+	breachedPasswordService, err = pkg.NewBreachedPasswordService(cfg.BreachedPasswords)
+	if err != nil {
+		l.Fatal().Msgf("failed to load breached passwords: %s", err.Error())
+	}
+
+	smsSender, err = pkg.NewSMSSender(cfg.SMS, l)
+	if err != nil {
+		l.Fatal().Msgf("invalid sms configuration: %s", err.Error())
+	}
+
+	webhookSender = pkg.NewWebhookSender(cfg.Webhooks)
+	webhookSignatureService = pkg.NewWebhookSignatureService()
+
+	eventPublisher, err = pkg.NewEventPublisher(cfg.Events, postgresClient.Pool, l)
+	if err != nil {
+		l.Fatal().Msgf("invalid events configuration: %s", err.Error())
+	}
+
+	geoIPService, err = pkg.NewGeoIPService(cfg.Risk.GeoIPDatabase)
+	if err != nil {
+		l.Fatal().Msgf("failed to open geoip database: %s", err.Error())
+	}
 }
 
-func initRepository() {
+func initRepository(cfg *config.Config) {
 	userRepository = CreatePGUserRepo(postgresClient)
 	sessionRepository = CreateSessionRepo(postgresClient)
+	roleRepository = CreateRoleRepo(postgresClient)
+	permissionRepository = CreatePermissionRepo(postgresClient)
+	organizationRepository = CreateOrganizationRepo(postgresClient)
+	invitationRepository = CreateInvitationRepo(postgresClient)
+	mfaRepository = CreateMFARepo(postgresClient)
+	webAuthnRepository = CreateWebAuthnRepo(postgresClient)
+	passwordlessRepository = CreatePasswordlessRepo(postgresClient)
+	trustedDeviceRepository = CreateTrustedDeviceRepo(postgresClient)
+	smsCodeRepository = CreateSMSCodeRepo(postgresClient)
+	auditEventRepository = CreateAuditEventRepo(postgresClient)
+	webhookRepository = CreateWebhookRepo(postgresClient)
+	webhookAlertRepository = CreateWebhookAlertRepo(postgresClient)
+	domainEventRepository = CreateDomainEventRepo(postgresClient)
+	loginHistoryRepository = CreateLoginHistoryRepo(postgresClient)
+	auditLogger = pkg.NewAuditLogger(auditEventRepository, l)
+
+	var err error
+	loginAttemptRepository, err = CreateLoginAttemptRepo(cfg.BruteForce.Storage, postgresClient)
+	if err != nil {
+		l.Fatal().Msgf("failed to create login attempts storage: %s", err.Error())
+	}
+
+	rateLimitRepository, err = CreateRateLimitRepo(cfg.RateLimit.Storage, postgresClient)
+	if err != nil {
+		l.Fatal().Msgf("failed to create rate limit storage: %s", err.Error())
+	}
+
+	webhookNonceRepository, err = CreateWebhookNonceRepo(cfg.InboundWebhook.NonceStorage, postgresClient)
+	if err != nil {
+		l.Fatal().Msgf("failed to create webhook nonce storage: %s", err.Error())
+	}
 }
 
-func initUseCases() {
+func initUseCases(cfg *config.Config) {
+	passwordPolicy := CreatePasswordPolicy(cfg.PasswordPolicy, cfg.PasswordHashing)
+	smsPolicy := CreateSMSPolicy(cfg.SMS)
+	riskEngine := usecases.NewRiskEngine(
+		loginHistoryRepository,
+		geoIPService,
+		mailService,
+		CreateRiskPolicy(cfg.Risk),
+		auditLogger,
+	)
+
 	signUpUseCase = usecases.NewSignUpUseCase(
 		userRepository,
+		invitationRepository,
 		sessionRepository,
 		sessionService,
-		bcryptHashService,
+		hashService,
+		breachedPasswordService,
 		cookieService,
+		mailService,
+		passwordPolicy,
+		cfg.SignUp.InviteOnly,
+		cfg.SignUp.UniformResponse,
+		auditLogger,
+	)
+
+	getPasswordPolicyUseCase = usecases.NewGetPasswordPolicyUseCase(passwordPolicy)
+
+	changePasswordUseCase = usecases.NewChangePasswordUseCase(
+		userRepository,
+		sessionRepository,
+		loginAttemptRepository,
+		hashService,
+		breachedPasswordService,
+		passwordPolicy,
+		auditLogger,
 	)
 
 	signInUseCase = usecases.NewSignInUseCase(
 		userRepository,
 		sessionRepository,
-		bcryptHashService,
+		organizationRepository,
+		loginAttemptRepository,
+		mfaRepository,
+		webAuthnRepository,
+		trustedDeviceRepository,
+		hashService,
+		sessionService,
+		cookieService,
+		passwordPolicy.MaxAge,
+		CreateLockoutPolicy(cfg.BruteForce),
+		cfg.MFA.TrustedDeviceTTL,
+		riskEngine,
+		auditLogger,
+	)
+
+	verifyMFAUseCase = usecases.NewVerifyMFAUseCase(
+		userRepository,
+		sessionRepository,
+		organizationRepository,
+		loginAttemptRepository,
+		mfaRepository,
+		webAuthnRepository,
+		trustedDeviceRepository,
+		smsCodeRepository,
+		rateLimitRepository,
+		hashService,
+		sessionService,
+		cookieService,
+		mfaService,
+		encryptionService,
+		randomService,
+		smsSender,
+		passwordPolicy.MaxAge,
+		CreateLockoutPolicy(cfg.BruteForce),
+		cfg.MFA.TrustedDeviceTTL,
+		smsPolicy,
+		riskEngine,
+		auditLogger,
+	)
+
+	webAuthnLoginUseCase = usecases.NewWebAuthnLoginUseCase(
+		userRepository,
+		sessionRepository,
+		organizationRepository,
+		loginAttemptRepository,
+		mfaRepository,
+		webAuthnRepository,
+		trustedDeviceRepository,
+		hashService,
 		sessionService,
 		cookieService,
+		webAuthnService,
+		passwordPolicy.MaxAge,
+		CreateLockoutPolicy(cfg.BruteForce),
+		cfg.WebAuthn.Passwordless,
+		cfg.MFA.TrustedDeviceTTL,
+		riskEngine,
+		auditLogger,
+	)
+
+	passwordlessUseCase = usecases.NewPasswordlessUseCase(
+		userRepository,
+		sessionRepository,
+		organizationRepository,
+		loginAttemptRepository,
+		mfaRepository,
+		webAuthnRepository,
+		passwordlessRepository,
+		trustedDeviceRepository,
+		hashService,
+		sessionService,
+		cookieService,
+		mailService,
+		randomService,
+		CreateLockoutPolicy(cfg.BruteForce),
+		CreatePasswordlessPolicy(cfg.Passwordless, cfg.SignUp),
+		cfg.MFA.TrustedDeviceTTL,
+		riskEngine,
+		auditLogger,
+	)
+
+	enrollTOTPUseCase = usecases.NewEnrollTOTPUseCase(
+		userRepository,
+		mfaRepository,
+		mfaService,
+		encryptionService,
+		auditLogger,
+	)
+
+	registerWebAuthnUseCase = usecases.NewRegisterWebAuthnUseCase(
+		userRepository,
+		webAuthnRepository,
+		webAuthnService,
+		auditLogger,
+	)
+
+	trustedDevicesUseCase = usecases.NewTrustedDevicesUseCase(
+		trustedDeviceRepository,
+		auditLogger,
+	)
+
+	verifyPhoneUseCase = usecases.NewVerifyPhoneUseCase(
+		userRepository,
+		smsCodeRepository,
+		rateLimitRepository,
+		hashService,
+		randomService,
+		smsSender,
+		smsPolicy,
+		auditLogger,
 	)
 
 	generateTokensUseCase = usecases.NewGenerateTokensUseCase(
 		userRepository,
 		sessionRepository,
-		bcryptHashService,
+		hashService,
 		cookieService,
 		sessionService,
+		auditLogger,
 	)
 
 	refreshSessionUseCase = usecases.NewRefreshSessionUseCase(
 		userRepository,
 		sessionRepository,
+		organizationRepository,
 		sessionService,
 		cookieService,
-		bcryptHashService,
+		hashService,
+		riskEngine,
+		auditLogger,
 	)
 
-	getUserUseCase = usecases.NewGetUserUseCase(userRepository)
+	getUserUseCase = usecases.NewGetUserUseCase(
+		userRepository,
+		organizationRepository,
+		auditLogger,
+	)
 
 	logoutUserUseCase = usecases.NewLogoutUseCase(
 		sessionRepository,
 		cookieService,
+		auditLogger,
+	)
+
+	listUsersUseCase = usecases.NewListUsersUseCase(userRepository, auditLogger)
+
+	updateUserUseCase = usecases.NewUpdateUserUseCase(
+		userRepository,
+		sessionRepository,
+		auditLogger,
+	)
+
+	deleteUserUseCase = usecases.NewDeleteUserUseCase(
+		userRepository,
+		auditLogger,
+	)
+
+	revokeSessionsUseCase = usecases.NewRevokeSessionsUseCase(
+		userRepository,
+		sessionRepository,
+		auditLogger,
+	)
+
+	listRolesUseCase = usecases.NewListRolesUseCase(roleRepository, auditLogger)
+
+	createRoleUseCase = usecases.NewCreateRoleUseCase(
+		roleRepository,
+		permissionRepository,
+		auditLogger,
+	)
+
+	updateRoleUseCase = usecases.NewUpdateRoleUseCase(
+		roleRepository,
+		permissionRepository,
+		sessionRepository,
+		auditLogger,
+	)
+
+	deleteRoleUseCase = usecases.NewDeleteRoleUseCase(
+		roleRepository,
+		sessionRepository,
+		auditLogger,
+	)
+
+	listPermissionsUseCase = usecases.NewListPermissionsUseCase(permissionRepository, auditLogger)
+
+	createPermissionUseCase = usecases.NewCreatePermissionUseCase(
+		permissionRepository,
+		auditLogger,
+	)
+
+	deletePermissionUseCase = usecases.NewDeletePermissionUseCase(
+		permissionRepository,
+		auditLogger,
+	)
+
+	setUserRolesUseCase = usecases.NewSetUserRolesUseCase(
+		userRepository,
+		roleRepository,
+		sessionRepository,
+		auditLogger,
+	)
+
+	createUserInvitationUseCase = usecases.NewCreateUserInvitationUseCase(
+		userRepository,
+		roleRepository,
+		invitationRepository,
+		hashService,
+		randomService,
+		cfg.SignUp.InvitationTTL,
+		auditLogger,
+	)
+
+	createOrganizationUseCase = usecases.NewCreateOrganizationUseCase(
+		organizationRepository,
+		auditLogger,
+	)
+
+	listMembersUseCase = usecases.NewListMembersUseCase(organizationRepository, auditLogger)
+
+	removeMemberUseCase = usecases.NewRemoveMemberUseCase(
+		organizationRepository,
+		auditLogger,
+	)
+
+	setOrgPasswordPolicyUseCase = usecases.NewSetOrganizationPasswordPolicyUseCase(
+		organizationRepository,
+		auditLogger,
+	)
+
+	createInvitationUseCase = usecases.NewCreateInvitationUseCase(
+		organizationRepository,
+		invitationRepository,
+		hashService,
+		randomService,
+		cfg.SignUp.InvitationTTL,
+		auditLogger,
+	)
+
+	acceptInvitationUseCase = usecases.NewAcceptInvitationUseCase(
+		userRepository,
+		organizationRepository,
+		invitationRepository,
+		hashService,
+		auditLogger,
+	)
+
+	securityEventsUseCase = usecases.NewSecurityEventsUseCase(auditEventRepository, auditLogger)
+
+	webhooksUseCase = usecases.NewWebhooksUseCase(
+		webhookRepository,
+		encryptionService,
+		webhookSender,
+		CreateWebhookPolicy(cfg.Webhooks),
+		auditLogger,
+	)
+
+	webhookEndpointsUseCase = usecases.NewWebhookEndpointsUseCase(
+		webhookRepository,
+		encryptionService,
+		randomService,
+		auditLogger,
+	)
+
+	webhookAlertsUseCase = usecases.NewWebhookAlertsUseCase(
+		webhookAlertRepository,
+		webhookNonceRepository,
+		webhookSignatureService,
+		CreateInboundWebhookPolicy(cfg.InboundWebhook),
+		auditLogger,
+	)
+
+	domainEventsUseCase = usecases.NewDomainEventsUseCase(
+		domainEventRepository,
+		eventPublisher,
+		CreateDomainEventPolicy(cfg.Events),
+		auditLogger,
 	)
 }
 
+// runWebhooks saves the configured endpoints and starts the worker sending
+// the queued events. A full batch is followed by the next one right away.
+func runWebhooks(cfg *config.Config) {
+	ctx := context.Background()
+	err := webhooksUseCase.Configure(ctx, CreateWebhookEndpoints(cfg.Webhooks))
+	if err != nil {
+		l.Fatal().Msgf("invalid webhooks configuration: %s", err.Error())
+	}
+
+	go func() {
+		ticker := time.NewTicker(cfg.Webhooks.PollInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			for {
+				count, err := webhooksUseCase.DeliverDue(ctx)
+				if err != nil {
+					l.Error().Msgf("failed to deliver webhooks: %s", err.Error())
+				}
+				if err != nil || count < cfg.Webhooks.BatchSize {
+					break
+				}
+			}
+		}
+	}()
+}
+
+// runEvents starts the worker relaying the domain events from the outbox to
+// the broker. A full batch is followed by the next one right away.
+func runEvents(cfg *config.Config) {
+	ctx := context.Background()
+	go func() {
+		ticker := time.NewTicker(cfg.Events.PollInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			for {
+				count, err := domainEventsUseCase.PublishDue(ctx)
+				if err != nil {
+					l.Error().Msgf("failed to publish domain events: %s", err.Error())
+				}
+				if err != nil || count < cfg.Events.BatchSize {
+					break
+				}
+			}
+		}
+	}()
+}
+
 func runHTTP(cfg *config.Config) {
 	router := gin.Default()
 	router.HandleMethodNotAllowed = true
 
-	mw := middleware.NewMiddleware(sessionService, l)
+	rateLimitPolicy, err := CreateRateLimitPolicy(cfg.RateLimit)
+	if err != nil {
+		l.Fatal().Msgf("invalid rate limits: %s", err.Error())
+	}
+
+	stepUpPolicy, err := CreateStepUpPolicy(cfg.StepUp)
+	if err != nil {
+		l.Fatal().Msgf("invalid step-up policy: %s", err.Error())
+	}
+
+	mw := middleware.NewMiddleware(sessionService, userRepository, sessionRepository, rateLimitRepository, rateLimitPolicy, stepUpPolicy, l)
 	http2.InitServiceMiddleware(router)
+	router.Use(mw.RateLimit)
 	http2.NewSignUpController(router, signUpUseCase, mw, l)
 	http2.NewSignInController(router, signInUseCase, mw, l)
+	http2.NewVerifyMFAController(router, verifyMFAUseCase, mw, l)
+	http2.NewWebAuthnLoginController(router, webAuthnLoginUseCase, mw, l)
+	http2.NewPasswordlessController(router, passwordlessUseCase, mw, l)
+	http2.NewGetPasswordPolicyController(router, getPasswordPolicyUseCase, mw, l)
+	http2.NewChangePasswordController(router, changePasswordUseCase, mw, l)
+	http2.NewEnrollTOTPController(router, enrollTOTPUseCase, mw, l)
+	http2.NewRegisterWebAuthnController(router, registerWebAuthnUseCase, mw, l)
+	http2.NewTrustedDevicesController(router, trustedDevicesUseCase, mw, l)
+	http2.NewVerifyPhoneController(router, verifyPhoneUseCase, mw, l)
+	http2.NewSecurityEventsController(router, securityEventsUseCase, mw, l)
 	http2.NewGenerateTokensController(router, generateTokensUseCase, mw, l)
 	http2.NewRefreshSessionController(router, refreshSessionUseCase, mw, l)
 	http2.NewGetUserController(router, getUserUseCase, mw, l)
 	http2.NewLogoutController(router, logoutUserUseCase, mw, l)
-	http2.NewWebhookController(router, mw, l)
+	http2.NewWebhookController(router, webhookAlertsUseCase, mw, l)
+
+	http2.NewAdminListUsersController(router, listUsersUseCase, mw, l)
+	http2.NewAdminGetUserController(router, getUserUseCase, mw, l)
+	http2.NewAdminUpdateUserController(router, updateUserUseCase, mw, l)
+	http2.NewAdminDeleteUserController(router, deleteUserUseCase, mw, l)
+	http2.NewAdminRevokeSessionsController(router, revokeSessionsUseCase, mw, l)
+	http2.NewAdminSetUserRolesController(router, setUserRolesUseCase, mw, l)
+	http2.NewAdminCreateInvitationController(router, createUserInvitationUseCase, mw, l)
+	http2.NewAdminSecurityEventsController(router, securityEventsUseCase, mw, l)
+	http2.NewAdminWebhooksController(router, webhookEndpointsUseCase, mw, l)
+	http2.NewAdminWebhookAlertsController(router, webhookAlertsUseCase, mw, l)
+
+	http2.NewAdminListRolesController(router, listRolesUseCase, mw, l)
+	http2.NewAdminCreateRoleController(router, createRoleUseCase, mw, l)
+	http2.NewAdminUpdateRoleController(router, updateRoleUseCase, mw, l)
+	http2.NewAdminDeleteRoleController(router, deleteRoleUseCase, mw, l)
+	http2.NewAdminListPermissionsController(router, listPermissionsUseCase, mw, l)
+	http2.NewAdminCreatePermissionController(router, createPermissionUseCase, mw, l)
+	http2.NewAdminDeletePermissionController(router, deletePermissionUseCase, mw, l)
+
+	http2.NewCreateOrganizationController(router, createOrganizationUseCase, mw, l)
+	http2.NewListMembersController(router, listMembersUseCase, mw, l)
+	http2.NewRemoveMemberController(router, removeMemberUseCase, mw, l)
+	http2.NewSetOrganizationPasswordPolicyController(router, setOrgPasswordPolicyUseCase, mw, l)
+	http2.NewCreateInvitationController(router, createInvitationUseCase, mw, l)
+	http2.NewAcceptInvitationController(router, acceptInvitationUseCase, mw, l)
 
 	address := fmt.Sprintf("%s:%s", cfg.HTTP.Host, cfg.HTTP.Port)
 	l.Info().Msgf("starting HTTP server on %s", address)
-	err := http.ListenAndServe(address, router)
+	err = http.ListenAndServe(address, router)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -1,33 +1,499 @@
 package app
 
 import (
+	"auth/config"
+	"auth/infrastructure/memory"
 	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands/alerts"
+	"auth/infrastructure/postgres/commands/attempts"
+	"auth/infrastructure/postgres/commands/audit"
+	"auth/infrastructure/postgres/commands/devices"
+	"auth/infrastructure/postgres/commands/events"
+	"auth/infrastructure/postgres/commands/invitations"
+	"auth/infrastructure/postgres/commands/logins"
+	"auth/infrastructure/postgres/commands/mfa"
+	"auth/infrastructure/postgres/commands/nonces"
+	"auth/infrastructure/postgres/commands/organizations"
+	"auth/infrastructure/postgres/commands/passwordless"
+	"auth/infrastructure/postgres/commands/permissions"
+	"auth/infrastructure/postgres/commands/ratelimits"
+	"auth/infrastructure/postgres/commands/roles"
 	"auth/infrastructure/postgres/commands/sessions"
+	"auth/infrastructure/postgres/commands/sms"
 	"auth/infrastructure/postgres/commands/users"
+	"auth/infrastructure/postgres/commands/webauthn"
+	"auth/infrastructure/postgres/commands/webhooks"
+	"auth/internal/entities"
 	"auth/internal/repositories"
+	"auth/pkg"
+	"fmt"
+	"os"
 )
 
 func CreatePGUserRepo(client *postgres.Client) repositories.UserRepository {
 	selectAccountByIdCommand := users.NewSelectUserByIdCommand(client)
 	selectAccountByEmailCommand := users.NewSelectUserByEmailCommand(client)
+	selectAccountListCommand := users.NewSelectUserListCommand(client)
+	countAccountsCommand := users.NewCountUsersCommand(client)
 	insertAccountCommand := users.NewInsertUserPGCommand(client)
+	updateAccountCommand := users.NewUpdateUserCommand(client)
+	deleteAccountCommand := users.NewDeleteUserCommand(client)
+	updateAccountRolesCommand := users.NewUpdateUserRolesCommand(client)
+	updateAccountPasswordCommand := users.NewUpdateUserPasswordCommand(client)
+	changeAccountPasswordCommand := users.NewChangeUserPasswordCommand(client)
+	selectPasswordHistoryCommand := users.NewSelectPasswordHistoryCommand(client)
+	updateAccountPhoneCommand := users.NewUpdateUserPhoneCommand(client)
 
 	return repositories.NewUserRepository(
 		selectAccountByIdCommand,
 		selectAccountByEmailCommand,
-		insertAccountCommand)
+		selectAccountListCommand,
+		countAccountsCommand,
+		insertAccountCommand,
+		updateAccountCommand,
+		deleteAccountCommand,
+		updateAccountRolesCommand,
+		updateAccountPasswordCommand,
+		changeAccountPasswordCommand,
+		selectPasswordHistoryCommand,
+		updateAccountPhoneCommand)
 }
 
 func CreateSessionRepo(client *postgres.Client) repositories.SessionRepository {
 	selectSessionByUserIdCommand := sessions.NewSelectByUserIdCommand(client)
 	deleteSessionByUserId := sessions.NewDeleteByUserIdCommand(client)
+	deleteSessionByRoleId := sessions.NewDeleteByRoleIdCommand(client)
 	insertSessionCommand := sessions.NewInsertSessionCommand(client)
 	updateSessionCommand := sessions.NewUpdateSessionCommand(client)
+	updateSessionWithEventCommand := sessions.NewUpdateSessionWithEventCommand(client)
 
 	return repositories.NewSessionRepository(
 		insertSessionCommand,
 		selectSessionByUserIdCommand,
 		updateSessionCommand,
+		updateSessionWithEventCommand,
 		deleteSessionByUserId,
+		deleteSessionByRoleId,
 	)
 }
+
+func CreateRoleRepo(client *postgres.Client) repositories.RoleRepository {
+	selectAllRolesCommand := roles.NewSelectAllRolesCommand(client)
+	selectRoleByIdCommand := roles.NewSelectRoleByIdCommand(client)
+	selectRolesByNamesCommand := roles.NewSelectRolesByNamesCommand(client)
+	insertRoleCommand := roles.NewInsertRoleCommand(client)
+	updateRoleCommand := roles.NewUpdateRoleCommand(client)
+	deleteRoleCommand := roles.NewDeleteRoleCommand(client)
+
+	return repositories.NewRoleRepository(
+		selectAllRolesCommand,
+		selectRoleByIdCommand,
+		selectRolesByNamesCommand,
+		insertRoleCommand,
+		updateRoleCommand,
+		deleteRoleCommand,
+	)
+}
+
+func CreatePermissionRepo(client *postgres.Client) repositories.PermissionRepository {
+	selectAllPermissionsCommand := permissions.NewSelectAllPermissionsCommand(client)
+	selectPermissionsByNamesCommand := permissions.NewSelectPermissionsByNamesCommand(client)
+	insertPermissionCommand := permissions.NewInsertPermissionCommand(client)
+	deletePermissionCommand := permissions.NewDeletePermissionCommand(client)
+
+	return repositories.NewPermissionRepository(
+		selectAllPermissionsCommand,
+		selectPermissionsByNamesCommand,
+		insertPermissionCommand,
+		deletePermissionCommand,
+	)
+}
+
+func CreateOrganizationRepo(client *postgres.Client) repositories.OrganizationRepository {
+	insertOrganizationCommand := organizations.NewInsertOrganizationCommand(client)
+	selectMemberCommand := organizations.NewSelectMemberCommand(client)
+	selectMembersCommand := organizations.NewSelectMembersCommand(client)
+	selectMembershipsByUserIdCommand := organizations.NewSelectMembershipsByUserIdCommand(client)
+	deleteMemberCommand := organizations.NewDeleteMemberCommand(client)
+	updatePasswordMaxAgeCommand := organizations.NewUpdatePasswordMaxAgeCommand(client)
+
+	return repositories.NewOrganizationRepository(
+		insertOrganizationCommand,
+		selectMemberCommand,
+		selectMembersCommand,
+		selectMembershipsByUserIdCommand,
+		deleteMemberCommand,
+		updatePasswordMaxAgeCommand,
+	)
+}
+
+func CreateInvitationRepo(client *postgres.Client) repositories.InvitationRepository {
+	insertInvitationCommand := invitations.NewInsertInvitationCommand(client)
+	selectInvitationByIdCommand := invitations.NewSelectInvitationByIdCommand(client)
+	acceptInvitationCommand := invitations.NewAcceptInvitationCommand(client)
+	signUpByInvitationCommand := invitations.NewSignUpByInvitationCommand(client)
+
+	return repositories.NewInvitationRepository(
+		insertInvitationCommand,
+		selectInvitationByIdCommand,
+		acceptInvitationCommand,
+		signUpByInvitationCommand,
+	)
+}
+
+func CreateMFARepo(client *postgres.Client) repositories.MFARepository {
+	selectTOTPCommand := mfa.NewSelectTOTPCommand(client)
+	saveTOTPCommand := mfa.NewSaveTOTPCommand(client)
+	confirmTOTPCommand := mfa.NewConfirmTOTPCommand(client)
+	useTOTPStepCommand := mfa.NewUseTOTPStepCommand(client)
+	useRecoveryCodeCommand := mfa.NewUseRecoveryCodeCommand(client)
+
+	return repositories.NewMFARepository(
+		selectTOTPCommand,
+		saveTOTPCommand,
+		confirmTOTPCommand,
+		useTOTPStepCommand,
+		useRecoveryCodeCommand,
+	)
+}
+
+func CreateWebAuthnRepo(client *postgres.Client) repositories.WebAuthnRepository {
+	selectCredentialsCommand := webauthn.NewSelectCredentialsCommand(client)
+	insertCredentialCommand := webauthn.NewInsertCredentialCommand(client)
+	updateCredentialUsageCommand := webauthn.NewUpdateCredentialUsageCommand(client)
+	insertSessionCommand := webauthn.NewInsertSessionCommand(client)
+	takeSessionCommand := webauthn.NewTakeSessionCommand(client)
+
+	return repositories.NewWebAuthnRepository(
+		selectCredentialsCommand,
+		insertCredentialCommand,
+		updateCredentialUsageCommand,
+		insertSessionCommand,
+		takeSessionCommand,
+	)
+}
+
+func CreatePasswordlessRepo(client *postgres.Client) repositories.PasswordlessRepository {
+	insertTokenCommand := passwordless.NewInsertTokenCommand(client)
+	selectTokenByDeviceCommand := passwordless.NewSelectTokenByDeviceCommand(client)
+	registerTokenFailureCommand := passwordless.NewRegisterTokenFailureCommand(client)
+	deleteTokenCommand := passwordless.NewDeleteTokenCommand(client)
+
+	return repositories.NewPasswordlessRepository(
+		insertTokenCommand,
+		selectTokenByDeviceCommand,
+		registerTokenFailureCommand,
+		deleteTokenCommand,
+	)
+}
+
+func CreateTrustedDeviceRepo(client *postgres.Client) repositories.TrustedDeviceRepository {
+	insertCommand := devices.NewInsertDeviceCommand(client)
+	selectCommand := devices.NewSelectDeviceCommand(client)
+	selectByUserIdCommand := devices.NewSelectDevicesCommand(client)
+	updateUsageCommand := devices.NewUpdateDeviceUsageCommand(client)
+	deleteCommand := devices.NewDeleteDeviceCommand(client)
+	deleteByUserIdCommand := devices.NewDeleteDevicesCommand(client)
+
+	return repositories.NewTrustedDeviceRepository(
+		insertCommand,
+		selectCommand,
+		selectByUserIdCommand,
+		updateUsageCommand,
+		deleteCommand,
+		deleteByUserIdCommand,
+	)
+}
+
+func CreateSMSCodeRepo(client *postgres.Client) repositories.SMSCodeRepository {
+	saveCodeCommand := sms.NewSaveCodeCommand(client)
+	selectCodeCommand := sms.NewSelectCodeCommand(client)
+	registerCodeFailureCommand := sms.NewRegisterCodeFailureCommand(client)
+	deleteCodeCommand := sms.NewDeleteCodeCommand(client)
+
+	return repositories.NewSMSCodeRepository(
+		saveCodeCommand,
+		selectCodeCommand,
+		registerCodeFailureCommand,
+		deleteCodeCommand,
+	)
+}
+
+func CreateAuditEventRepo(client *postgres.Client) repositories.AuditEventRepository {
+	insertEventCommand := audit.NewInsertEventCommand(client)
+	selectEventsCommand := audit.NewSelectEventsCommand(client)
+	countEventsCommand := audit.NewCountEventsCommand(client)
+
+	return repositories.NewAuditEventRepository(
+		insertEventCommand,
+		selectEventsCommand,
+		countEventsCommand,
+	)
+}
+
+// CreateLoginAttemptRepo picks the storage of failed sign in attempts. The
+// in-memory one is only suitable for a single instance.
+func CreateLoginAttemptRepo(storage string, client *postgres.Client) (repositories.LoginAttemptRepository, error) {
+	switch storage {
+	case "", "postgres":
+		selectLoginAttemptsCommand := attempts.NewSelectLoginAttemptsCommand(client)
+		registerLoginFailureCommand := attempts.NewRegisterLoginFailureCommand(client)
+		lockLoginAttemptsCommand := attempts.NewLockLoginAttemptsCommand(client)
+		resetLoginAttemptsCommand := attempts.NewResetLoginAttemptsCommand(client)
+
+		return repositories.NewLoginAttemptRepository(
+			selectLoginAttemptsCommand,
+			registerLoginFailureCommand,
+			lockLoginAttemptsCommand,
+			resetLoginAttemptsCommand,
+		), nil
+	case "memory":
+		return memory.NewLoginAttemptRepository(), nil
+	}
+	return nil, fmt.Errorf("unknown login attempts storage %q", storage)
+}
+
+// CreateRateLimitRepo picks the storage of the rate limit buckets. Postgres
+// shares the limits between replicas, the in-memory one counts per replica.
+func CreateRateLimitRepo(storage string, client *postgres.Client) (repositories.RateLimitRepository, error) {
+	switch storage {
+	case "", "memory":
+		return memory.NewRateLimitRepository(), nil
+	case "postgres":
+		takeRateLimitTokenCommand := ratelimits.NewTakeRateLimitTokenCommand(client)
+
+		return repositories.NewRateLimitRepository(takeRateLimitTokenCommand), nil
+	}
+	return nil, fmt.Errorf("unknown rate limit storage %q", storage)
+}
+
+func CreateRateLimitPolicy(cfg config.RateLimit) (entities.RateLimitPolicy, error) {
+	defaultLimit, err := createRateLimit(cfg.Default)
+	if err != nil {
+		return entities.RateLimitPolicy{}, err
+	}
+
+	routes := make(map[string]entities.RateLimit, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		limit, err := createRateLimit(route)
+		if err != nil {
+			return entities.RateLimitPolicy{}, fmt.Errorf("route %s: %w", route.Route, err)
+		}
+		routes[route.Route] = limit
+	}
+
+	return entities.RateLimitPolicy{
+		Default:        defaultLimit,
+		Routes:         routes,
+		ClientIdHeader: cfg.ClientIdHeader,
+	}, nil
+}
+
+func CreateStepUpPolicy(cfg config.StepUp) (entities.StepUpPolicy, error) {
+	if cfg.ACR != "" && !entities.IsKnownACR(cfg.ACR) {
+		return entities.StepUpPolicy{}, fmt.Errorf("unknown acr %q", cfg.ACR)
+	}
+	return entities.StepUpPolicy{MaxAge: cfg.MaxAge, ACR: cfg.ACR}, nil
+}
+
+func createRateLimit(cfg config.RouteRateLimit) (entities.RateLimit, error) {
+	switch cfg.Key {
+	case "":
+		cfg.Key = entities.RateLimitKeyIP
+	case entities.RateLimitKeyIP, entities.RateLimitKeyUser, entities.RateLimitKeyClient:
+	default:
+		return entities.RateLimit{}, fmt.Errorf("unknown rate limit key %q", cfg.Key)
+	}
+
+	return entities.RateLimit{
+		Limit:  cfg.Limit,
+		Period: cfg.Period,
+		Key:    cfg.Key,
+	}, nil
+}
+
+func CreateLockoutPolicy(cfg config.BruteForce) entities.LockoutPolicy {
+	return entities.LockoutPolicy{
+		Window:             cfg.Window,
+		FreeAttempts:       cfg.FreeAttempts,
+		BaseDelay:          cfg.BaseDelay,
+		MaxDelay:           cfg.MaxDelay,
+		AccountMaxFailures: cfg.AccountMaxFailures,
+		IPMaxFailures:      cfg.IPMaxFailures,
+		LockoutDuration:    cfg.LockoutDuration,
+	}
+}
+
+// CreatePasswordlessPolicy allows creating accounts on the first passwordless
+// sign in only when both the passwordless and the sign up settings allow it.
+func CreatePasswordlessPolicy(cfg config.Passwordless, signUp config.SignUp) entities.PasswordlessPolicy {
+	return entities.PasswordlessPolicy{
+		Enabled:     cfg.Enabled,
+		CodeTTL:     cfg.CodeTTL,
+		LinkTTL:     cfg.LinkTTL,
+		MaxAttempts: cfg.MaxAttempts,
+		LinkURL:     cfg.LinkURL,
+		SignUp:      cfg.SignUp && !signUp.InviteOnly,
+	}
+}
+
+// CreateSMSPolicy limits the codes sent to one phone number regardless of the
+// user who requested them.
+func CreateSMSPolicy(cfg config.SMS) entities.SMSPolicy {
+	return entities.SMSPolicy{
+		CodeTTL:     cfg.CodeTTL,
+		MaxAttempts: cfg.MaxAttempts,
+		NumberLimit: entities.RateLimit{
+			Limit:  cfg.NumberLimit,
+			Period: cfg.NumberPeriod,
+		},
+	}
+}
+
+func CreateWebhookRepo(client *postgres.Client) repositories.WebhookRepository {
+	insertEndpointCommand := webhooks.NewInsertEndpointCommand(client)
+	selectEndpointsCommand := webhooks.NewSelectEndpointsCommand(client)
+	selectEndpointCommand := webhooks.NewSelectEndpointCommand(client)
+	updateEndpointCommand := webhooks.NewUpdateEndpointCommand(client)
+	deleteEndpointCommand := webhooks.NewDeleteEndpointCommand(client)
+	claimDeliveriesCommand := webhooks.NewClaimDeliveriesCommand(client)
+	updateDeliveryCommand := webhooks.NewUpdateDeliveryCommand(client)
+	selectDeliveriesCommand := webhooks.NewSelectDeliveriesCommand(client)
+	replayDeliveryCommand := webhooks.NewReplayDeliveryCommand(client)
+
+	return repositories.NewWebhookRepository(
+		insertEndpointCommand,
+		selectEndpointsCommand,
+		selectEndpointCommand,
+		updateEndpointCommand,
+		deleteEndpointCommand,
+		claimDeliveriesCommand,
+		updateDeliveryCommand,
+		selectDeliveriesCommand,
+		replayDeliveryCommand,
+	)
+}
+
+func CreateWebhookPolicy(cfg config.Webhooks) entities.WebhookPolicy {
+	return entities.WebhookPolicy{
+		BatchSize:   cfg.BatchSize,
+		MaxAttempts: cfg.MaxAttempts,
+		BaseDelay:   cfg.BaseDelay,
+		MaxDelay:    cfg.MaxDelay,
+		Lease:       cfg.Lease,
+	}
+}
+
+// CreateWebhookEndpoints reads the configured endpoints. The environment
+// variables are expanded here since the config only expands the top level
+// values, the endpoints with an empty URL are skipped.
+func CreateWebhookEndpoints(cfg config.Webhooks) []entities.WebhookEndpoint {
+	endpoints := make([]entities.WebhookEndpoint, 0, len(cfg.Endpoints))
+	for _, endpoint := range cfg.Endpoints {
+		url := os.ExpandEnv(endpoint.URL)
+		if url == "" {
+			continue
+		}
+		endpoints = append(endpoints, entities.WebhookEndpoint{
+			URL:        url,
+			Secret:     os.ExpandEnv(endpoint.Secret),
+			EventTypes: endpoint.Events,
+			Active:     true,
+		})
+	}
+	return endpoints
+}
+
+func CreateWebhookAlertRepo(client *postgres.Client) repositories.WebhookAlertRepository {
+	insertAlertCommand := alerts.NewInsertAlertCommand(client)
+	selectAlertsCommand := alerts.NewSelectAlertsCommand(client)
+	countAlertsCommand := alerts.NewCountAlertsCommand(client)
+
+	return repositories.NewWebhookAlertRepository(
+		insertAlertCommand,
+		selectAlertsCommand,
+		countAlertsCommand,
+	)
+}
+
+// CreateWebhookNonceRepo picks the storage of the inbound webhook nonces.
+// Behind several replicas only postgres rejects a replay sent to another one.
+func CreateWebhookNonceRepo(storage string, client *postgres.Client) (repositories.WebhookNonceRepository, error) {
+	switch storage {
+	case "", "memory":
+		return memory.NewWebhookNonceRepository(), nil
+	case "postgres":
+		insertNonceCommand := nonces.NewInsertNonceCommand(client)
+
+		return repositories.NewWebhookNonceRepository(insertNonceCommand), nil
+	}
+	return nil, fmt.Errorf("unknown webhook nonce storage %q", storage)
+}
+
+func CreateInboundWebhookPolicy(cfg config.InboundWebhook) entities.InboundWebhookPolicy {
+	return entities.InboundWebhookPolicy{
+		Secret:    cfg.Secret,
+		Tolerance: cfg.Tolerance,
+	}
+}
+
+func CreateDomainEventRepo(client *postgres.Client) repositories.DomainEventRepository {
+	relayEventsCommand := events.NewRelayEventsCommand(client)
+	purgeEventsCommand := events.NewPurgeEventsCommand(client)
+
+	return repositories.NewDomainEventRepository(relayEventsCommand, purgeEventsCommand)
+}
+
+func CreateDomainEventPolicy(cfg config.Events) entities.DomainEventPolicy {
+	return entities.DomainEventPolicy{
+		BatchSize: cfg.BatchSize,
+		Retention: cfg.Retention,
+	}
+}
+
+func CreateLoginHistoryRepo(client *postgres.Client) repositories.LoginHistoryRepository {
+	selectHistoryCommand := logins.NewSelectHistoryCommand(client)
+	insertLoginCommand := logins.NewInsertLoginCommand(client)
+
+	return repositories.NewLoginHistoryRepository(selectHistoryCommand, insertLoginCommand)
+}
+
+func CreateRiskPolicy(cfg config.Risk) entities.RiskPolicy {
+	return entities.RiskPolicy{
+		NewDeviceScore:          cfg.NewDeviceScore,
+		NewCountryScore:         cfg.NewCountryScore,
+		ImpossibleTravelScore:   cfg.ImpossibleTravelScore,
+		ManyAccountsFromIPScore: cfg.ManyAccountsFromIPScore,
+		MaxTravelSpeed:          cfg.MaxTravelSpeed,
+		AccountsPerIP:           cfg.AccountsPerIP,
+		AccountsPerIPWindow:     cfg.AccountsPerIPWindow,
+		HistoryRetention:        cfg.HistoryRetention,
+		NotifyThreshold:         cfg.NotifyThreshold,
+		ChallengeThreshold:      cfg.ChallengeThreshold,
+		BlockThreshold:          cfg.BlockThreshold,
+	}
+}
+
+// CreatePasswordPolicy limits the passwords to the 72 bytes bcrypt takes into
+// account, unless the pepper turns them into short HMACs first.
+func CreatePasswordPolicy(cfg config.PasswordPolicy, hashing config.PasswordHashing) entities.PasswordPolicy {
+	maxBytes := 0
+	if hashing.Algorithm == pkg.HashAlgorithmBcrypt && hashing.Pepper.CurrentVersion() == 0 {
+		maxBytes = pkg.BcryptMaxPasswordBytes
+	}
+
+	return entities.PasswordPolicy{
+		MinLength:        cfg.MinLength,
+		MaxLength:        cfg.MaxLength,
+		MaxBytes:         maxBytes,
+		RequireLetter:    cfg.RequireLetter,
+		RequireLowercase: cfg.RequireLowercase,
+		RequireUppercase: cfg.RequireUppercase,
+		RequireDigit:     cfg.RequireDigit,
+		RequireSymbol:    cfg.RequireSymbol,
+		AllowUnicode:     cfg.AllowUnicode,
+		ForbidEmail:      cfg.ForbidEmail,
+		HistorySize:      cfg.HistorySize,
+		MaxAge:           cfg.MaxAge,
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"auth/pkg"
+	"flag"
+	"log"
+	"os"
+)
+
+// Converts a HIBP SHA-1 dump ordered by hash or a common passwords list into
+// the sorted binary corpus used by the breached passwords check:
+//
+//	go run ./cmd/breached -format sha1 -in pwned-passwords-sha1-ordered-by-hash.txt -out config/breached.bin
+//	go run ./cmd/breached -format passwords -in common-passwords.txt -out config/common.bin
+func main() {
+	in := flag.String("in", "", "text corpus to convert")
+	out := flag.String("out", "", "binary corpus to write, should have the .bin extension")
+	format := flag.String("format", pkg.BreachedFormatSHA1,
+		"format of the text corpus: sha1 for HIBP records ordered by hash, passwords for one password per line")
+	flag.Parse()
+
+	if *in == "" || *out == "" || (*format != pkg.BreachedFormatSHA1 && *format != pkg.BreachedFormatPasswords) {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	source, err := os.Open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer source.Close()
+
+	target, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer target.Close()
+
+	// The HIBP dump is already sorted and too big for memory, it is streamed.
+	// The passwords list is hashed and has to be sorted.
+	count := 0
+	if *format == pkg.BreachedFormatSHA1 {
+		count, err = pkg.ConvertBreachedDigests(source, target)
+	} else {
+		var digests [][20]byte
+		digests, err = pkg.ReadBreachedPasswords(source, *format)
+		if err == nil {
+			count = len(digests)
+			err = pkg.WriteBreachedPasswords(target, digests)
+		}
+	}
+	if err != nil {
+		target.Close()
+		os.Remove(*out)
+		log.Fatal(err)
+	}
+	log.Printf("wrote %d passwords to %s", count, *out)
+}
@@ -17,6 +17,22 @@ type (
 		JWT                `mapstructure:"jwt"`
 		PG                 pg.Config `mapstructure:"pg"`
 		Cookie             `mapstructure:"cookie"`
+		SignUp             `mapstructure:"sign_up"`
+		PasswordHashing    `mapstructure:"password_hashing"`
+		PasswordPolicy     `mapstructure:"password_policy"`
+		BreachedPasswords  `mapstructure:"breached_passwords"`
+		BruteForce         `mapstructure:"brute_force"`
+		RateLimit          `mapstructure:"rate_limit"`
+		Mail               `mapstructure:"mail"`
+		MFA                `mapstructure:"mfa"`
+		WebAuthn           `mapstructure:"webauthn"`
+		Passwordless       `mapstructure:"passwordless"`
+		StepUp             `mapstructure:"step_up"`
+		SMS                `mapstructure:"sms"`
+		Webhooks           `mapstructure:"webhooks"`
+		InboundWebhook     `mapstructure:"inbound_webhook"`
+		Events             `mapstructure:"events"`
+		Risk               `mapstructure:"risk"`
 	}
 
 	App struct {
@@ -26,8 +42,9 @@ type (
 	}
 
 	TokenConfiguration struct {
-		AccessTokenDuration  time.Duration `mapstructure:"access_token_duration"`
-		RefreshTokenDuration time.Duration `mapstructure:"refresh_token_duration"`
+		AccessTokenDuration     time.Duration `mapstructure:"access_token_duration"`
+		RefreshTokenDuration    time.Duration `mapstructure:"refresh_token_duration"`
+		RestrictedTokenDuration time.Duration `mapstructure:"restricted_token_duration"`
 	}
 
 	HTTP struct {
@@ -46,6 +63,185 @@ type (
 		HttpOnly bool   `mapstructure:"http_only"`
 		SameSite string `mapstructure:"same_site"`
 	}
+
+	SignUp struct {
+		InviteOnly      bool          `mapstructure:"invite_only"`
+		InvitationTTL   time.Duration `mapstructure:"invitation_ttl"`
+		UniformResponse bool          `mapstructure:"uniform_response"`
+	}
+
+	Mail struct {
+		Host     string `mapstructure:"host"`
+		Port     string `mapstructure:"port"`
+		Username string `mapstructure:"username"`
+		Password string `mapstructure:"password"`
+		From     string `mapstructure:"from"`
+	}
+
+	SMS struct {
+		Provider     string        `mapstructure:"provider"`
+		File         string        `mapstructure:"file"`
+		URL          string        `mapstructure:"url"`
+		Token        string        `mapstructure:"token"`
+		From         string        `mapstructure:"from"`
+		Timeout      time.Duration `mapstructure:"timeout"`
+		CodeTTL      time.Duration `mapstructure:"code_ttl"`
+		MaxAttempts  int           `mapstructure:"max_attempts"`
+		NumberLimit  int           `mapstructure:"number_limit"`
+		NumberPeriod time.Duration `mapstructure:"number_period"`
+	}
+
+	Webhooks struct {
+		PollInterval time.Duration     `mapstructure:"poll_interval"`
+		BatchSize    int               `mapstructure:"batch_size"`
+		Timeout      time.Duration     `mapstructure:"timeout"`
+		MaxAttempts  int               `mapstructure:"max_attempts"`
+		BaseDelay    time.Duration     `mapstructure:"base_delay"`
+		MaxDelay     time.Duration     `mapstructure:"max_delay"`
+		Lease        time.Duration     `mapstructure:"lease"`
+		Endpoints    []WebhookEndpoint `mapstructure:"endpoints"`
+	}
+
+	WebhookEndpoint struct {
+		URL    string   `mapstructure:"url"`
+		Secret string   `mapstructure:"secret"`
+		Events []string `mapstructure:"events"`
+	}
+
+	InboundWebhook struct {
+		Secret       string        `mapstructure:"secret"`
+		Tolerance    time.Duration `mapstructure:"tolerance"`
+		NonceStorage string        `mapstructure:"nonce_storage"`
+	}
+
+	Events struct {
+		Transport    string        `mapstructure:"transport"`
+		Source       string        `mapstructure:"source"`
+		PollInterval time.Duration `mapstructure:"poll_interval"`
+		BatchSize    int           `mapstructure:"batch_size"`
+		Timeout      time.Duration `mapstructure:"timeout"`
+		Retention    time.Duration `mapstructure:"retention"`
+		Notify       NotifyEvents  `mapstructure:"notify"`
+		NATS         NATSEvents    `mapstructure:"nats"`
+		Kafka        KafkaEvents   `mapstructure:"kafka"`
+	}
+
+	NotifyEvents struct {
+		Channel string `mapstructure:"channel"`
+	}
+
+	NATSEvents struct {
+		URL     string `mapstructure:"url"`
+		Subject string `mapstructure:"subject"`
+	}
+
+	KafkaEvents struct {
+		Brokers []string `mapstructure:"brokers"`
+		Topic   string   `mapstructure:"topic"`
+	}
+
+	Risk struct {
+		GeoIPDatabase           string        `mapstructure:"geoip_database"`
+		NewDeviceScore          int           `mapstructure:"new_device_score"`
+		NewCountryScore         int           `mapstructure:"new_country_score"`
+		ImpossibleTravelScore   int           `mapstructure:"impossible_travel_score"`
+		ManyAccountsFromIPScore int           `mapstructure:"many_accounts_from_ip_score"`
+		MaxTravelSpeed          float64       `mapstructure:"max_travel_speed"`
+		AccountsPerIP           int           `mapstructure:"accounts_per_ip"`
+		AccountsPerIPWindow     time.Duration `mapstructure:"accounts_per_ip_window"`
+		HistoryRetention        time.Duration `mapstructure:"history_retention"`
+		NotifyThreshold         int           `mapstructure:"notify_threshold"`
+		ChallengeThreshold      int           `mapstructure:"challenge_threshold"`
+		BlockThreshold          int           `mapstructure:"block_threshold"`
+	}
+
+	PasswordHashing struct {
+		Algorithm  string   `mapstructure:"algorithm"`
+		BcryptCost int      `mapstructure:"bcrypt_cost"`
+		Argon2id   Argon2id `mapstructure:"argon2id"`
+		Pepper     Pepper   `mapstructure:"-"`
+	}
+
+	PasswordPolicy struct {
+		MinLength        int           `mapstructure:"min_length"`
+		MaxLength        int           `mapstructure:"max_length"`
+		RequireLetter    bool          `mapstructure:"require_letter"`
+		RequireLowercase bool          `mapstructure:"require_lowercase"`
+		RequireUppercase bool          `mapstructure:"require_uppercase"`
+		RequireDigit     bool          `mapstructure:"require_digit"`
+		RequireSymbol    bool          `mapstructure:"require_symbol"`
+		AllowUnicode     bool          `mapstructure:"allow_unicode"`
+		ForbidEmail      bool          `mapstructure:"forbid_email"`
+		HistorySize      int           `mapstructure:"history_size"`
+		MaxAge           time.Duration `mapstructure:"max_age"`
+	}
+
+	BreachedPasswords struct {
+		Path   string `mapstructure:"path"`
+		Format string `mapstructure:"format"`
+	}
+
+	BruteForce struct {
+		Storage            string        `mapstructure:"storage"`
+		Window             time.Duration `mapstructure:"window"`
+		FreeAttempts       int           `mapstructure:"free_attempts"`
+		BaseDelay          time.Duration `mapstructure:"base_delay"`
+		MaxDelay           time.Duration `mapstructure:"max_delay"`
+		AccountMaxFailures int           `mapstructure:"account_max_failures"`
+		IPMaxFailures      int           `mapstructure:"ip_max_failures"`
+		LockoutDuration    time.Duration `mapstructure:"lockout_duration"`
+	}
+
+	RateLimit struct {
+		Storage        string           `mapstructure:"storage"`
+		ClientIdHeader string           `mapstructure:"client_id_header"`
+		Default        RouteRateLimit   `mapstructure:"default"`
+		Routes         []RouteRateLimit `mapstructure:"routes"`
+	}
+
+	RouteRateLimit struct {
+		Route  string        `mapstructure:"route"`
+		Limit  int           `mapstructure:"limit"`
+		Period time.Duration `mapstructure:"period"`
+		Key    string        `mapstructure:"key"`
+	}
+
+	MFA struct {
+		Issuer           string        `mapstructure:"issuer"`
+		EncryptionKey    string        `mapstructure:"encryption_key"`
+		RecoveryCodes    int           `mapstructure:"recovery_codes"`
+		TrustedDeviceTTL time.Duration `mapstructure:"trusted_device_ttl"`
+	}
+
+	WebAuthn struct {
+		RPID          string        `mapstructure:"rp_id"`
+		RPDisplayName string        `mapstructure:"rp_display_name"`
+		RPOrigins     []string      `mapstructure:"rp_origins"`
+		Timeout       time.Duration `mapstructure:"timeout"`
+		Passwordless  bool          `mapstructure:"passwordless"`
+	}
+
+	Passwordless struct {
+		Enabled     bool          `mapstructure:"enabled"`
+		CodeTTL     time.Duration `mapstructure:"code_ttl"`
+		LinkTTL     time.Duration `mapstructure:"link_ttl"`
+		MaxAttempts int           `mapstructure:"max_attempts"`
+		LinkURL     string        `mapstructure:"link_url"`
+		SignUp      bool          `mapstructure:"sign_up"`
+	}
+
+	StepUp struct {
+		MaxAge time.Duration `mapstructure:"max_age"`
+		ACR    string        `mapstructure:"acr"`
+	}
+
+	Argon2id struct {
+		Memory      uint32 `mapstructure:"memory"`
+		Iterations  uint32 `mapstructure:"iterations"`
+		Parallelism uint8  `mapstructure:"parallelism"`
+		SaltLength  uint32 `mapstructure:"salt_length"`
+		KeyLength   uint32 `mapstructure:"key_length"`
+	}
 )
 
 func New() (*Config, error) {
@@ -76,5 +272,10 @@ func New() (*Config, error) {
 		panic(fmt.Errorf("fatal error unmarshalling file: %w", err))
 	}
 
+	cfg.PasswordHashing.Pepper, err = loadPepper()
+	if err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
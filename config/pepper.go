@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	pepperEnv     = "AUTH_PASSWORD_PEPPER"
+	pepperFileEnv = "AUTH_PASSWORD_PEPPER_FILE"
+)
+
+// Pepper holds the server keys applied to the passwords before hashing. It
+// is a secret, so it is never read from config.yaml.
+type Pepper struct {
+	Keys map[int][]byte
+}
+
+// CurrentVersion returns the highest key version, it is used for the new
+// hashes. Zero means that the pepper is disabled.
+func (p Pepper) CurrentVersion() int {
+	current := 0
+	for version := range p.Keys {
+		if version > current {
+			current = version
+		}
+	}
+	return current
+}
+
+// loadPepper reads the keys from the file named by AUTH_PASSWORD_PEPPER_FILE
+// or from AUTH_PASSWORD_PEPPER. Keys are "version:secret" pairs separated by
+// commas or new lines, e.g. "1:oldSecret,2:newSecret".
+func loadPepper() (Pepper, error) {
+	value := os.Getenv(pepperEnv)
+	if path := os.Getenv(pepperFileEnv); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return Pepper{}, fmt.Errorf("failed to read pepper file: %w", err)
+		}
+		value = string(content)
+	}
+
+	pepper := Pepper{Keys: make(map[int][]byte)}
+	entries := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r'
+	})
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		rawVersion, secret, found := strings.Cut(entry, ":")
+		version, err := strconv.Atoi(rawVersion)
+		if !found || err != nil || version <= 0 || secret == "" {
+			return Pepper{}, fmt.Errorf("pepper key must be a \"version:secret\" pair with a positive version")
+		}
+		if _, exists := pepper.Keys[version]; exists {
+			return Pepper{}, fmt.Errorf("duplicate pepper key version %d", version)
+		}
+		pepper.Keys[version] = []byte(secret)
+	}
+	return pepper, nil
+}
@@ -0,0 +1,7 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS status varchar(16) not null default 'active',
+    ADD COLUMN IF NOT EXISTS status_reason text not null default '',
+    ADD COLUMN IF NOT EXISTS locked_until timestamp;
+
+ALTER TABLE users
+    ADD CONSTRAINT users_status_check CHECK (status IN ('active', 'disabled', 'locked', 'banned'));
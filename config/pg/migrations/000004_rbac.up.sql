@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS roles
+(
+    id serial primary key,
+    name varchar(64) not null unique,
+    description text not null default '',
+    created_at timestamp not null default NOW()
+);
+
+CREATE TABLE IF NOT EXISTS permissions
+(
+    id serial primary key,
+    name varchar(64) not null unique,
+    description text not null default '',
+    created_at timestamp not null default NOW()
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions
+(
+    role_id int not null references roles(id) on delete cascade,
+    permission_id int not null references permissions(id) on delete cascade,
+    primary key (role_id, permission_id)
+);
+
+CREATE TABLE IF NOT EXISTS user_roles
+(
+    user_id UUID not null references users(id) on delete cascade,
+    role_id int not null references roles(id) on delete cascade,
+    primary key (user_id, role_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_roles_role_id ON user_roles(role_id);
+
+INSERT INTO roles (name, description) VALUES
+    ('user', 'default role of every registered user'),
+    ('admin', 'full access to the administration API')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO permissions (name, description) VALUES
+    ('users:read', 'list and view users'),
+    ('users:write', 'change users'),
+    ('users:delete', 'delete users'),
+    ('sessions:revoke', 'revoke sessions of any user'),
+    ('roles:manage', 'manage roles, permissions and their assignment')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p WHERE r.name = 'admin'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO user_roles (user_id, role_id)
+SELECT u.id, r.id FROM users u JOIN roles r ON r.name = 'user'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,11 @@
+ALTER TABLE sessions DROP COLUMN IF EXISTS organization_id;
+
+DROP INDEX IF EXISTS idx_organization_invitations_organization_id;
+
+DROP TABLE IF EXISTS organization_invitations;
+
+DROP INDEX IF EXISTS idx_organization_members_user_id;
+
+DROP TABLE IF EXISTS organization_members;
+
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS organizations
+(
+    id UUID default gen_random_uuid() primary key,
+    name varchar(100) not null,
+    created_at timestamp not null default NOW()
+);
+
+CREATE TABLE IF NOT EXISTS organization_members
+(
+    organization_id UUID not null references organizations(id) on delete cascade,
+    user_id UUID not null references users(id) on delete cascade,
+    role varchar(16) not null default 'member',
+    created_at timestamp not null default NOW(),
+    primary key (organization_id, user_id),
+    constraint organization_members_role_check check (role in ('owner', 'admin', 'member'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user_id ON organization_members(user_id);
+
+CREATE TABLE IF NOT EXISTS organization_invitations
+(
+    id UUID default gen_random_uuid() primary key,
+    organization_id UUID not null references organizations(id) on delete cascade,
+    email varchar(255) not null,
+    role varchar(16) not null default 'member',
+    token_hash text not null,
+    invited_by UUID references users(id) on delete set null,
+    created_at timestamp not null default NOW(),
+    expires_at timestamp not null,
+    accepted_at timestamp,
+    constraint organization_invitations_role_check check (role in ('admin', 'member'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_organization_id ON organization_invitations(organization_id);
+
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS organization_id UUID references organizations(id) on delete set null;
@@ -0,0 +1,20 @@
+DELETE FROM permissions WHERE name = 'users:invite';
+
+DELETE FROM invitations WHERE organization_id IS NULL;
+
+ALTER TABLE invitations DROP CONSTRAINT IF EXISTS invitations_role_check;
+
+UPDATE invitations SET role = 'member' WHERE role IS NULL;
+
+ALTER TABLE invitations
+    DROP COLUMN IF EXISTS user_role,
+    ALTER COLUMN role SET DEFAULT 'member',
+    ALTER COLUMN role SET NOT NULL,
+    ALTER COLUMN organization_id SET NOT NULL;
+
+ALTER TABLE invitations
+    ADD CONSTRAINT organization_invitations_role_check CHECK (role IN ('admin', 'member'));
+
+ALTER INDEX IF EXISTS idx_invitations_organization_id RENAME TO idx_organization_invitations_organization_id;
+
+ALTER TABLE invitations RENAME TO organization_invitations;
@@ -0,0 +1,24 @@
+ALTER TABLE organization_invitations RENAME TO invitations;
+
+ALTER INDEX IF EXISTS idx_organization_invitations_organization_id RENAME TO idx_invitations_organization_id;
+
+ALTER TABLE invitations DROP CONSTRAINT IF EXISTS organization_invitations_role_check;
+
+ALTER TABLE invitations
+    ALTER COLUMN organization_id DROP NOT NULL,
+    ALTER COLUMN role DROP NOT NULL,
+    ALTER COLUMN role DROP DEFAULT,
+    ADD COLUMN IF NOT EXISTS user_role varchar(64) references roles(name) on update cascade on delete set null;
+
+ALTER TABLE invitations
+    ADD CONSTRAINT invitations_role_check CHECK (
+        (organization_id IS NULL AND role IS NULL) OR role IN ('admin', 'member')
+    );
+
+INSERT INTO permissions (name, description) VALUES
+    ('users:invite', 'invite users to sign up with a role')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p WHERE r.name = 'admin' AND p.name = 'users:invite'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+-- users.password is left as text: the peppered hashes stored since don't fit
+-- into the former varchar(100).
@@ -0,0 +1,2 @@
+-- Peppered hashes carry the key version and don't fit into varchar(100).
+ALTER TABLE users ALTER COLUMN password TYPE text;
@@ -0,0 +1,11 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS password_changed_at timestamp not null default now();
+
+CREATE TABLE IF NOT EXISTS password_history (
+    id bigserial primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    password_hash text not null,
+    created_at timestamp not null default now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_password_history_user_id ON password_history(user_id, id DESC);
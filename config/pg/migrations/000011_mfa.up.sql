@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS user_totp (
+    user_id uuid primary key references users(id) on delete cascade,
+    secret text not null,
+    confirmed_at timestamp,
+    last_used_step bigint not null default 0,
+    created_at timestamp not null default now()
+);
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    id bigserial primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    code_hash text not null,
+    used_at timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_recovery_codes_user_id ON recovery_codes(user_id);
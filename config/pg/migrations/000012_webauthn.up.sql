@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS webauthn_credentials (
+    id bytea primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    name varchar(64) not null default '',
+    public_key bytea not null,
+    attestation_type varchar(32) not null default '',
+    aaguid bytea,
+    sign_count bigint not null default 0,
+    transports text[] not null default '{}',
+    backup_eligible boolean not null default false,
+    backup_state boolean not null default false,
+    created_at timestamp not null default now(),
+    last_used_at timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_webauthn_credentials_user_id ON webauthn_credentials(user_id);
+
+CREATE TABLE IF NOT EXISTS webauthn_sessions (
+    id uuid default gen_random_uuid() primary key,
+    user_id uuid references users(id) on delete cascade,
+    purpose varchar(16) not null,
+    data bytea not null,
+    expires_at timestamp not null
+);
+
+CREATE INDEX IF NOT EXISTS idx_webauthn_sessions_expires_at ON webauthn_sessions(expires_at);
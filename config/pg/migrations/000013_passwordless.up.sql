@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS passwordless_tokens (
+    id uuid default gen_random_uuid() primary key,
+    email varchar(254) not null,
+    method varchar(8) not null,
+    secret_hash varchar(255) not null,
+    device_hash varchar(64) not null unique,
+    attempts int not null default 0,
+    expires_at timestamp not null,
+    created_at timestamp not null default now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_passwordless_tokens_expires_at ON passwordless_tokens(expires_at);
@@ -0,0 +1,6 @@
+ALTER TABLE webauthn_sessions
+    DROP COLUMN IF EXISTS auth_methods;
+
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS auth_time,
+    DROP COLUMN IF EXISTS auth_methods;
@@ -0,0 +1,6 @@
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS auth_methods text[] not null default '{}',
+    ADD COLUMN IF NOT EXISTS auth_time timestamp;
+
+ALTER TABLE webauthn_sessions
+    ADD COLUMN IF NOT EXISTS auth_methods text[] not null default '{}';
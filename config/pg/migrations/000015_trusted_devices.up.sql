@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS trusted_devices (
+    id uuid default gen_random_uuid() primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    user_agent text not null default '',
+    ip_address text not null default '',
+    created_at timestamp not null default now(),
+    last_used_at timestamp,
+    expires_at timestamp not null
+);
+
+CREATE INDEX IF NOT EXISTS idx_trusted_devices_user_id ON trusted_devices(user_id);
@@ -0,0 +1,15 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS phone varchar(16) not null default '',
+    ADD COLUMN IF NOT EXISTS phone_verified_at timestamp;
+
+CREATE TABLE IF NOT EXISTS sms_codes (
+    id uuid default gen_random_uuid() primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    phone varchar(16) not null,
+    purpose varchar(32) not null,
+    code_hash varchar(255) not null,
+    attempts int not null default 0,
+    expires_at timestamp not null,
+    created_at timestamp not null default now(),
+    unique(user_id, purpose)
+);
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS audit_events (
+    id uuid default gen_random_uuid() primary key,
+    type varchar(64) not null,
+    actor_id varchar(64) not null default '',
+    subject_id text not null default '',
+    ip_address text not null default '',
+    user_agent text not null default '',
+    outcome varchar(16) not null,
+    reason text not null default '',
+    created_at timestamp not null default now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id ON audit_events(actor_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_subject_id ON audit_events(subject_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events(created_at);
+
+INSERT INTO permissions (name, description) VALUES
+    ('audit:read', 'view the security events of any user')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p WHERE r.name = 'admin' AND p.name = 'audit:read'
+ON CONFLICT DO NOTHING;
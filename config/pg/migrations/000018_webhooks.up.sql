@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id uuid default gen_random_uuid() primary key,
+    url text not null unique,
+    secret text not null,
+    event_types text[] not null default '{}',
+    active boolean not null default true,
+    created_at timestamp not null default now(),
+    updated_at timestamp not null default now()
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id uuid default gen_random_uuid() primary key,
+    endpoint_id uuid not null references webhook_endpoints(id) on delete cascade,
+    event_id uuid not null,
+    event_type varchar(64) not null,
+    payload jsonb not null,
+    status varchar(16) not null default 'pending',
+    attempts int not null default 0,
+    next_attempt_at timestamp not null default now(),
+    last_status_code int not null default 0,
+    last_error text not null default '',
+    created_at timestamp not null default now(),
+    delivered_at timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint_id ON webhook_deliveries(endpoint_id, created_at);
@@ -0,0 +1,7 @@
+INSERT INTO permissions (name, description) VALUES
+    ('webhooks:manage', 'manage the webhook endpoints and their deliveries')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p WHERE r.name = 'admin' AND p.name = 'webhooks:manage'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS webhook_nonces;
+DROP TABLE IF EXISTS webhook_alerts;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS webhook_alerts (
+    id uuid default gen_random_uuid() primary key,
+    event_id varchar(64) not null unique,
+    type varchar(64) not null,
+    user_id text not null,
+    ip_address text not null default '',
+    previous_ip_address text not null default '',
+    user_agent text not null default '',
+    occurred_at timestamp not null,
+    received_at timestamp not null default now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_alerts_user_id ON webhook_alerts(user_id, received_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_alerts_received_at ON webhook_alerts(received_at);
+
+CREATE TABLE IF NOT EXISTS webhook_nonces (
+    nonce varchar(128) primary key,
+    expires_at timestamp not null
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_nonces_expires_at ON webhook_nonces(expires_at);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS domain_events (
+    id uuid default gen_random_uuid() primary key,
+    sequence bigserial not null unique,
+    type varchar(64) not null,
+    user_id uuid not null,
+    data jsonb not null,
+    created_at timestamp not null default now(),
+    published_at timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_domain_events_pending ON domain_events(sequence) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_domain_events_published_at ON domain_events(published_at);
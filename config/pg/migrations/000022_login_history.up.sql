@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS login_history (
+    id uuid default gen_random_uuid() primary key,
+    user_id uuid not null references users(id) on delete cascade,
+    ip_address text not null default '',
+    user_agent text not null default '',
+    country varchar(2) not null default '',
+    latitude double precision not null default 0,
+    longitude double precision not null default 0,
+    created_at timestamp not null default now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_history_user_id ON login_history(user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_login_history_ip_address ON login_history(ip_address, created_at);
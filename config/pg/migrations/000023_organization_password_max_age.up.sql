@@ -0,0 +1,4 @@
+-- Seconds, NULL falls back to password_policy.max_age and 0 disables the
+-- expiry in the organization.
+ALTER TABLE organizations
+    ADD COLUMN IF NOT EXISTS password_max_age bigint;
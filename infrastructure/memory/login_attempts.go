@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"sync"
+	"time"
+)
+
+// loginAttemptRepository keeps the attempts in the process memory. It is
+// meant for a single instance deployment, the counters are lost on restart.
+type loginAttemptRepository struct {
+	mu        sync.Mutex
+	attempts  map[string]entities.LoginAttempts
+	lastPrune time.Time
+}
+
+func NewLoginAttemptRepository() repositories.LoginAttemptRepository {
+	return &loginAttemptRepository{
+		attempts: make(map[string]entities.LoginAttempts),
+	}
+}
+
+func (r *loginAttemptRepository) Select(_ context.Context, key string) (entities.LoginAttempts, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempts, ok := r.attempts[key]
+	if !ok {
+		return entities.LoginAttempts{Key: key}, nil
+	}
+	return attempts, nil
+}
+
+func (r *loginAttemptRepository) RegisterFailure(_ context.Context, key string, window time.Duration) (entities.LoginAttempts, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.prune(now, window)
+
+	attempts, ok := r.attempts[key]
+	if !ok || now.Sub(attempts.LastFailureAt) > window {
+		attempts = entities.LoginAttempts{Key: key, LockedUntil: attempts.LockedUntil}
+	}
+	attempts.Failures++
+	attempts.LastFailureAt = now
+	r.attempts[key] = attempts
+
+	return attempts, nil
+}
+
+func (r *loginAttemptRepository) Lock(_ context.Context, key string, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempts, ok := r.attempts[key]
+	if !ok {
+		attempts = entities.LoginAttempts{Key: key}
+	}
+	attempts.LockedUntil = until
+	r.attempts[key] = attempts
+	return nil
+}
+
+func (r *loginAttemptRepository) Reset(_ context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.attempts, key)
+	return nil
+}
+
+// prune drops the entries which are neither counted nor locked anymore, it
+// runs at most once per window.
+func (r *loginAttemptRepository) prune(now time.Time, window time.Duration) {
+	if now.Sub(r.lastPrune) < window {
+		return
+	}
+	r.lastPrune = now
+
+	for key, attempts := range r.attempts {
+		if now.Sub(attempts.LastFailureAt) > window && !now.Before(attempts.LockedUntil) {
+			delete(r.attempts, key)
+		}
+	}
+}
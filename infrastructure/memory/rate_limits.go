@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"sync"
+	"time"
+)
+
+// pruneInterval is how often the refilled buckets are dropped.
+const pruneInterval = time.Minute
+
+type rateLimitBucket struct {
+	bucket entities.TokenBucket
+	fullAt time.Time
+}
+
+// rateLimitRepository keeps the token buckets in the process memory, every
+// replica counts its own requests.
+type rateLimitRepository struct {
+	mu        sync.Mutex
+	buckets   map[string]rateLimitBucket
+	lastPrune time.Time
+}
+
+func NewRateLimitRepository() repositories.RateLimitRepository {
+	return &rateLimitRepository{
+		buckets: make(map[string]rateLimitBucket),
+	}
+}
+
+func (r *rateLimitRepository) Take(_ context.Context, key string, limit entities.RateLimit) (entities.RateLimitResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.prune(now)
+
+	bucket, result := r.buckets[key].bucket.Take(limit, now)
+	r.buckets[key] = rateLimitBucket{bucket: bucket, fullAt: bucket.FullAt(limit)}
+
+	return result, nil
+}
+
+func (r *rateLimitRepository) prune(now time.Time) {
+	if now.Sub(r.lastPrune) < pruneInterval {
+		return
+	}
+	r.lastPrune = now
+
+	for key, bucket := range r.buckets {
+		if !now.Before(bucket.fullAt) {
+			delete(r.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"auth/internal/repositories"
+	"context"
+	"sync"
+	"time"
+)
+
+// webhookNonceRepository keeps the nonces of the inbound webhooks in the
+// process memory, a request replayed to another replica isn't detected.
+type webhookNonceRepository struct {
+	mu        sync.Mutex
+	nonces    map[string]time.Time
+	lastPrune time.Time
+}
+
+func NewWebhookNonceRepository() repositories.WebhookNonceRepository {
+	return &webhookNonceRepository{
+		nonces: make(map[string]time.Time),
+	}
+}
+
+func (r *webhookNonceRepository) Insert(_ context.Context, nonce string, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.prune(now)
+
+	if seenUntil, ok := r.nonces[nonce]; ok && now.Before(seenUntil) {
+		return repositories.ErrEntityAlreadyExists
+	}
+	r.nonces[nonce] = expiresAt
+	return nil
+}
+
+func (r *webhookNonceRepository) prune(now time.Time) {
+	if now.Sub(r.lastPrune) < pruneInterval {
+		return
+	}
+	r.lastPrune = now
+
+	for nonce, expiresAt := range r.nonces {
+		if !now.Before(expiresAt) {
+			delete(r.nonces, nonce)
+		}
+	}
+}
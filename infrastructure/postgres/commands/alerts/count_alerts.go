@@ -0,0 +1,34 @@
+package alerts
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+)
+
+type countAlertsCommand struct {
+	client *postgres.Client
+}
+
+func NewCountAlertsCommand(client *postgres.Client) repositories.CountWebhookAlertsCommand {
+	return &countAlertsCommand{client: client}
+}
+
+func (c *countAlertsCommand) Execute(context context.Context, filter repositories.WebhookAlertFilter) (int, error) {
+	builder := c.client.Builder.
+		Select("COUNT(*)").
+		From(commands.WebhookAlertTable)
+
+	sql, args, err := applyAlertFilter(builder, filter).ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
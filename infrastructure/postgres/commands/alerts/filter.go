@@ -0,0 +1,54 @@
+package alerts
+
+import (
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+var alertFields = []string{
+	commands.WebhookAlertIdField,
+	commands.WebhookAlertEventIdField,
+	commands.WebhookAlertTypeField,
+	commands.WebhookAlertUserIdField,
+	commands.WebhookAlertIPField,
+	commands.WebhookAlertPreviousIPField,
+	commands.WebhookAlertUserAgentField,
+	commands.WebhookAlertOccurredAtField,
+	commands.WebhookAlertReceivedAtField,
+}
+
+func applyAlertFilter(builder sq.SelectBuilder, filter repositories.WebhookAlertFilter) sq.SelectBuilder {
+	if filter.UserId != "" {
+		builder = builder.Where(sq.Eq{commands.WebhookAlertUserIdField: filter.UserId})
+	}
+	if filter.Type != "" {
+		builder = builder.Where(sq.Eq{commands.WebhookAlertTypeField: filter.Type})
+	}
+	if !filter.From.IsZero() {
+		builder = builder.Where(sq.GtOrEq{commands.WebhookAlertReceivedAtField: filter.From.UTC()})
+	}
+	if !filter.To.IsZero() {
+		builder = builder.Where(sq.Lt{commands.WebhookAlertReceivedAtField: filter.To.UTC()})
+	}
+	return builder
+}
+
+// scanAlert reads the row selected with alertFields.
+func scanAlert(row pgx.Row) (entities.WebhookAlert, error) {
+	var alert entities.WebhookAlert
+	err := row.Scan(
+		&alert.Id,
+		&alert.EventId,
+		&alert.Type,
+		&alert.UserId,
+		&alert.IP,
+		&alert.PreviousIP,
+		&alert.UserAgent,
+		&alert.OccurredAt,
+		&alert.ReceivedAt,
+	)
+	return alert, err
+}
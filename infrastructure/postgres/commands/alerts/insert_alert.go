@@ -0,0 +1,50 @@
+package alerts
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type insertAlertCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertAlertCommand(client *postgres.Client) repositories.InsertWebhookAlertCommand {
+	return &insertAlertCommand{client: client}
+}
+
+// Execute stores the alert once, the event delivered again after a lost
+// response is ignored.
+func (c *insertAlertCommand) Execute(context context.Context, alert entities.WebhookAlert) error {
+	sql, args, err := c.client.Builder.
+		Insert(commands.WebhookAlertTable).
+		Columns(
+			commands.WebhookAlertEventIdField,
+			commands.WebhookAlertTypeField,
+			commands.WebhookAlertUserIdField,
+			commands.WebhookAlertIPField,
+			commands.WebhookAlertPreviousIPField,
+			commands.WebhookAlertUserAgentField,
+			commands.WebhookAlertOccurredAtField,
+		).
+		Values(
+			alert.EventId,
+			alert.Type,
+			alert.UserId,
+			alert.IP,
+			alert.PreviousIP,
+			alert.UserAgent,
+			alert.OccurredAt.UTC(),
+		).
+		Suffix("ON CONFLICT (" + commands.WebhookAlertEventIdField + ") DO NOTHING").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Pool.Exec(context, sql, args...)
+	return err
+}
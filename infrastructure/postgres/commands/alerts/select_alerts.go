@@ -0,0 +1,50 @@
+package alerts
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type selectAlertsCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectAlertsCommand(client *postgres.Client) repositories.SelectWebhookAlertsCommand {
+	return &selectAlertsCommand{client: client}
+}
+
+// Execute returns the matching alerts, the latest received first.
+func (c *selectAlertsCommand) Execute(context context.Context, filter repositories.WebhookAlertFilter) ([]entities.WebhookAlert, error) {
+	builder := c.client.Builder.
+		Select(alertFields...).
+		From(commands.WebhookAlertTable)
+
+	sql, args, err := applyAlertFilter(builder, filter).
+		OrderBy(commands.WebhookAlertReceivedAtField+" DESC", commands.WebhookAlertIdField).
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]entities.WebhookAlert, 0, filter.Limit)
+	for rows.Next() {
+		alert, err := scanAlert(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, alert)
+	}
+
+	return result, rows.Err()
+}
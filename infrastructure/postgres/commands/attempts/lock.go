@@ -0,0 +1,32 @@
+package attempts
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"time"
+)
+
+type lockLoginAttemptsCommand struct {
+	client *postgres.Client
+}
+
+func NewLockLoginAttemptsCommand(client *postgres.Client) repositories.LockLoginAttemptsCommand {
+	return &lockLoginAttemptsCommand{client: client}
+}
+
+func (c *lockLoginAttemptsCommand) Execute(context context.Context, key string, until time.Time) error {
+	sql, args, err := c.client.Builder.
+		Update(commands.LoginAttemptTable).
+		Set(commands.LoginAttemptLockedUntilField, until.UTC()).
+		Where(sq.Eq{commands.LoginAttemptKeyField: key}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Pool.Exec(context, sql, args...)
+	return err
+}
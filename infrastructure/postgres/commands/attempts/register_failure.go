@@ -0,0 +1,50 @@
+package attempts
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type registerLoginFailureCommand struct {
+	client *postgres.Client
+}
+
+func NewRegisterLoginFailureCommand(client *postgres.Client) repositories.RegisterLoginFailureCommand {
+	return &registerLoginFailureCommand{client: client}
+}
+
+// Execute increments the failures in one statement, so concurrent attempts
+// can't lose updates. The counter starts over when the previous failure is
+// older than the window.
+func (c *registerLoginFailureCommand) Execute(context context.Context, key string, window time.Duration) (entities.LoginAttempts, error) {
+	now := time.Now().UTC()
+
+	sql, args, err := c.client.Builder.
+		Insert(commands.LoginAttemptTable).
+		Columns(
+			commands.LoginAttemptKeyField,
+			commands.LoginAttemptFailuresField,
+			commands.LoginAttemptLastFailureAtField,
+		).
+		Values(key, 1, now).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%[2]s) DO UPDATE SET %[3]s = CASE WHEN %[1]s.%[4]s < ? THEN 1 ELSE %[1]s.%[3]s + 1 END, %[4]s = EXCLUDED.%[4]s",
+			commands.LoginAttemptTable,
+			commands.LoginAttemptKeyField,
+			commands.LoginAttemptFailuresField,
+			commands.LoginAttemptLastFailureAtField,
+		), now.Add(-window)).
+		Suffix("RETURNING " + strings.Join(attemptColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return entities.LoginAttempts{}, err
+	}
+
+	return scanAttempts(c.client.Pool.QueryRow(context, sql, args...))
+}
@@ -0,0 +1,30 @@
+package attempts
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type resetLoginAttemptsCommand struct {
+	client *postgres.Client
+}
+
+func NewResetLoginAttemptsCommand(client *postgres.Client) repositories.ResetLoginAttemptsCommand {
+	return &resetLoginAttemptsCommand{client: client}
+}
+
+func (c *resetLoginAttemptsCommand) Execute(context context.Context, key string) error {
+	sql, args, err := c.client.Builder.
+		Delete(commands.LoginAttemptTable).
+		Where(sq.Eq{commands.LoginAttemptKeyField: key}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Pool.Exec(context, sql, args...)
+	return err
+}
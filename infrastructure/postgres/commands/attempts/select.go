@@ -0,0 +1,66 @@
+package attempts
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+var attemptColumns = []string{
+	commands.LoginAttemptKeyField,
+	commands.LoginAttemptFailuresField,
+	commands.LoginAttemptLastFailureAtField,
+	commands.LoginAttemptLockedUntilField,
+}
+
+type selectLoginAttemptsCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectLoginAttemptsCommand(client *postgres.Client) repositories.SelectLoginAttemptsCommand {
+	return &selectLoginAttemptsCommand{client: client}
+}
+
+func (c *selectLoginAttemptsCommand) Execute(context context.Context, key string) (entities.LoginAttempts, error) {
+	sql, args, err := c.client.Builder.
+		Select(attemptColumns...).
+		From(commands.LoginAttemptTable).
+		Where(sq.Eq{commands.LoginAttemptKeyField: key}).
+		ToSql()
+	if err != nil {
+		return entities.LoginAttempts{}, err
+	}
+
+	attempts, err := scanAttempts(c.client.Pool.QueryRow(context, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.LoginAttempts{Key: key}, nil
+		}
+		return entities.LoginAttempts{}, err
+	}
+	return attempts, nil
+}
+
+func scanAttempts(row pgx.Row) (entities.LoginAttempts, error) {
+	var result entities.LoginAttempts
+	var lockedUntil *time.Time
+	err := row.Scan(
+		&result.Key,
+		&result.Failures,
+		&result.LastFailureAt,
+		&lockedUntil,
+	)
+	if err != nil {
+		return entities.LoginAttempts{}, err
+	}
+	if lockedUntil != nil {
+		result.LockedUntil = *lockedUntil
+	}
+	return result, nil
+}
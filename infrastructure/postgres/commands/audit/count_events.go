@@ -0,0 +1,34 @@
+package audit
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+)
+
+type countEventsCommand struct {
+	client *postgres.Client
+}
+
+func NewCountEventsCommand(client *postgres.Client) repositories.CountAuditEventsCommand {
+	return &countEventsCommand{client: client}
+}
+
+func (c *countEventsCommand) Execute(context context.Context, filter repositories.AuditEventFilter) (int, error) {
+	builder := c.client.Builder.
+		Select("COUNT(*)").
+		From(commands.AuditEventTable)
+
+	sql, args, err := applyEventFilter(builder, filter).ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	sq "github.com/Masterminds/squirrel"
+)
+
+var eventFields = []string{
+	commands.AuditEventIdField,
+	commands.AuditEventTypeField,
+	commands.AuditEventActorIdField,
+	commands.AuditEventSubjectIdField,
+	commands.AuditEventIPField,
+	commands.AuditEventUserAgentField,
+	commands.AuditEventOutcomeField,
+	commands.AuditEventReasonField,
+	commands.AuditEventCreatedAtField,
+}
+
+func applyEventFilter(builder sq.SelectBuilder, filter repositories.AuditEventFilter) sq.SelectBuilder {
+	if filter.UserId != "" {
+		builder = builder.Where(sq.Or{
+			sq.Eq{commands.AuditEventActorIdField: filter.UserId},
+			sq.Eq{commands.AuditEventSubjectIdField: filter.UserId},
+		})
+	}
+	if filter.ActorId != "" {
+		builder = builder.Where(sq.Eq{commands.AuditEventActorIdField: filter.ActorId})
+	}
+	if filter.SubjectId != "" {
+		builder = builder.Where(sq.Eq{commands.AuditEventSubjectIdField: filter.SubjectId})
+	}
+	if filter.Type != "" {
+		builder = builder.Where(sq.Eq{commands.AuditEventTypeField: filter.Type})
+	}
+	if filter.Outcome != "" {
+		builder = builder.Where(sq.Eq{commands.AuditEventOutcomeField: filter.Outcome})
+	}
+	if !filter.From.IsZero() {
+		builder = builder.Where(sq.GtOrEq{commands.AuditEventCreatedAtField: filter.From.UTC()})
+	}
+	if !filter.To.IsZero() {
+		builder = builder.Where(sq.Lt{commands.AuditEventCreatedAtField: filter.To.UTC()})
+	}
+	return builder
+}
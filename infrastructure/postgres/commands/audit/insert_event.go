@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type insertEventCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertEventCommand(client *postgres.Client) repositories.InsertAuditEventCommand {
+	return &insertEventCommand{client: client}
+}
+
+func (c *insertEventCommand) Execute(context context.Context, event entities.AuditEvent) error {
+	sql, args, err := c.client.Builder.
+		Insert(commands.AuditEventTable).
+		Columns(
+			commands.AuditEventTypeField,
+			commands.AuditEventActorIdField,
+			commands.AuditEventSubjectIdField,
+			commands.AuditEventIPField,
+			commands.AuditEventUserAgentField,
+			commands.AuditEventOutcomeField,
+			commands.AuditEventReasonField,
+		).
+		Values(
+			event.Type,
+			event.ActorId,
+			event.SubjectId,
+			event.IP,
+			event.UserAgent,
+			event.Outcome,
+			event.Reason,
+		).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Pool.Exec(context, sql, args...)
+	return err
+}
@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type selectEventsCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectEventsCommand(client *postgres.Client) repositories.SelectAuditEventsCommand {
+	return &selectEventsCommand{client: client}
+}
+
+// Execute returns the matching events, the latest first.
+func (c *selectEventsCommand) Execute(context context.Context, filter repositories.AuditEventFilter) ([]entities.AuditEvent, error) {
+	builder := c.client.Builder.
+		Select(eventFields...).
+		From(commands.AuditEventTable)
+
+	sql, args, err := applyEventFilter(builder, filter).
+		OrderBy(commands.AuditEventCreatedAtField+" DESC", commands.AuditEventIdField).
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]entities.AuditEvent, 0, filter.Limit)
+	for rows.Next() {
+		var event entities.AuditEvent
+		err = rows.Scan(
+			&event.Id,
+			&event.Type,
+			&event.ActorId,
+			&event.SubjectId,
+			&event.IP,
+			&event.UserAgent,
+			&event.Outcome,
+			&event.Reason,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+	}
+
+	return result, rows.Err()
+}
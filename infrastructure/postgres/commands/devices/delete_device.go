@@ -0,0 +1,44 @@
+package devices
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+type deleteDeviceCommand struct {
+	client *postgres.Client
+}
+
+func NewDeleteDeviceCommand(client *postgres.Client) repositories.DeleteTrustedDeviceCommand {
+	return &deleteDeviceCommand{client: client}
+}
+
+func (c *deleteDeviceCommand) Execute(context context.Context, userId, id string) error {
+	if uuid.Validate(userId) != nil || uuid.Validate(id) != nil {
+		return repositories.ErrEntityNotFound
+	}
+
+	sql, args, err := c.client.Builder.
+		Delete(commands.TrustedDeviceTable).
+		Where(sq.Eq{
+			commands.TrustedDeviceIdField:     id,
+			commands.TrustedDeviceUserIdField: userId,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
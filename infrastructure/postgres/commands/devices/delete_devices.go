@@ -0,0 +1,31 @@
+package devices
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type deleteDevicesCommand struct {
+	client *postgres.Client
+}
+
+func NewDeleteDevicesCommand(client *postgres.Client) repositories.DeleteTrustedDevicesCommand {
+	return &deleteDevicesCommand{client: client}
+}
+
+// Execute revokes all the trusted devices of the user.
+func (c *deleteDevicesCommand) Execute(context context.Context, userId string) error {
+	sql, args, err := c.client.Builder.
+		Delete(commands.TrustedDeviceTable).
+		Where(sq.Eq{commands.TrustedDeviceUserIdField: userId}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Pool.Exec(context, sql, args...)
+	return err
+}
@@ -0,0 +1,40 @@
+package devices
+
+import (
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+var deviceFields = []string{
+	commands.TrustedDeviceIdField,
+	commands.TrustedDeviceUserIdField,
+	commands.TrustedDeviceUserAgentField,
+	commands.TrustedDeviceIPField,
+	commands.TrustedDeviceCreatedAtField,
+	commands.TrustedDeviceLastUsedAtField,
+	commands.TrustedDeviceExpiresAtField,
+}
+
+// scanDevice reads the row selected with deviceFields.
+func scanDevice(row pgx.Row) (entities.TrustedDevice, error) {
+	var device entities.TrustedDevice
+	var lastUsedAt *time.Time
+	err := row.Scan(
+		&device.Id,
+		&device.UserId,
+		&device.UserAgent,
+		&device.IP,
+		&device.CreatedAt,
+		&lastUsedAt,
+		&device.ExpiresAt,
+	)
+	if err != nil {
+		return entities.TrustedDevice{}, err
+	}
+	if lastUsedAt != nil {
+		device.LastUsedAt = *lastUsedAt
+	}
+	return device, nil
+}
@@ -0,0 +1,43 @@
+package devices
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type insertDeviceCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertDeviceCommand(client *postgres.Client) repositories.InsertTrustedDeviceCommand {
+	return &insertDeviceCommand{client: client}
+}
+
+func (c *insertDeviceCommand) Execute(context context.Context, device entities.TrustedDevice) (string, error) {
+	sql, args, err := c.client.Builder.
+		Insert(commands.TrustedDeviceTable).
+		Columns(
+			commands.TrustedDeviceUserIdField,
+			commands.TrustedDeviceUserAgentField,
+			commands.TrustedDeviceIPField,
+			commands.TrustedDeviceExpiresAtField,
+		).
+		Values(
+			device.UserId,
+			device.UserAgent,
+			device.IP,
+			device.ExpiresAt.UTC(),
+		).
+		Suffix("RETURNING " + commands.TrustedDeviceIdField).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&id)
+	return id, err
+}
@@ -0,0 +1,52 @@
+package devices
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+type selectDeviceCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectDeviceCommand(client *postgres.Client) repositories.SelectTrustedDeviceCommand {
+	return &selectDeviceCommand{client: client}
+}
+
+// Execute returns the trusted device of the user, expired and revoked ones
+// yield ErrEntityNotFound.
+func (c *selectDeviceCommand) Execute(context context.Context, userId, id string) (entities.TrustedDevice, error) {
+	if uuid.Validate(userId) != nil || uuid.Validate(id) != nil {
+		return entities.TrustedDevice{}, repositories.ErrEntityNotFound
+	}
+
+	sql, args, err := c.client.Builder.
+		Select(deviceFields...).
+		From(commands.TrustedDeviceTable).
+		Where(sq.Eq{
+			commands.TrustedDeviceIdField:     id,
+			commands.TrustedDeviceUserIdField: userId,
+		}).
+		Where(sq.Gt{commands.TrustedDeviceExpiresAtField: time.Now().UTC()}).
+		ToSql()
+	if err != nil {
+		return entities.TrustedDevice{}, err
+	}
+
+	result, err := scanDevice(c.client.Pool.QueryRow(context, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.TrustedDevice{}, repositories.ErrEntityNotFound
+		}
+		return entities.TrustedDevice{}, err
+	}
+	return result, nil
+}
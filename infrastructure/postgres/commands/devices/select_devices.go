@@ -0,0 +1,49 @@
+package devices
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"time"
+)
+
+type selectDevicesCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectDevicesCommand(client *postgres.Client) repositories.SelectTrustedDevicesCommand {
+	return &selectDevicesCommand{client: client}
+}
+
+// Execute returns the trusted devices of the user that have not expired yet.
+func (c *selectDevicesCommand) Execute(context context.Context, userId string) ([]entities.TrustedDevice, error) {
+	sql, args, err := c.client.Builder.
+		Select(deviceFields...).
+		From(commands.TrustedDeviceTable).
+		Where(sq.Eq{commands.TrustedDeviceUserIdField: userId}).
+		Where(sq.Gt{commands.TrustedDeviceExpiresAtField: time.Now().UTC()}).
+		OrderBy(commands.TrustedDeviceCreatedAtField).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entities.TrustedDevice
+	for rows.Next() {
+		device, err := scanDevice(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, device)
+	}
+	return result, rows.Err()
+}
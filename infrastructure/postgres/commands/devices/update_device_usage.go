@@ -0,0 +1,38 @@
+package devices
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type updateDeviceUsageCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdateDeviceUsageCommand(client *postgres.Client) repositories.UpdateTrustedDeviceUsageCommand {
+	return &updateDeviceUsageCommand{client: client}
+}
+
+// Execute records the sign in that skipped the second factor on the device.
+func (c *updateDeviceUsageCommand) Execute(context context.Context, id string) error {
+	sql, args, err := c.client.Builder.
+		Update(commands.TrustedDeviceTable).
+		Set(commands.TrustedDeviceLastUsedAtField, sq.Expr("NOW()")).
+		Where(sq.Eq{commands.TrustedDeviceIdField: id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"auth/internal/entities"
+	"context"
+	"encoding/json"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// EnqueueDomainEvent writes the event to the outbox in the transaction of the
+// change that caused it. The transactions adding the events of one user are
+// serialized by an advisory lock held until the commit, so their sequence
+// numbers follow the order the changes are committed in.
+func EnqueueDomainEvent(context context.Context, tx pgx.Tx, builder sq.StatementBuilderType, event entities.DomainEvent) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(context, "SELECT pg_advisory_xact_lock(hashtext($1))", event.UserId)
+	if err != nil {
+		return err
+	}
+
+	sql, args, err := builder.
+		Insert(DomainEventTable).
+		Columns(
+			DomainEventTypeField,
+			DomainEventUserIdField,
+			DomainEventDataField,
+			DomainEventCreatedAtField,
+		).
+		Values(event.Type, event.UserId, string(data), event.CreatedAt).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(context, sql, args...)
+	return err
+}
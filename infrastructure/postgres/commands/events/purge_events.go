@@ -0,0 +1,33 @@
+package events
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"time"
+)
+
+type purgeEventsCommand struct {
+	client *postgres.Client
+}
+
+func NewPurgeEventsCommand(client *postgres.Client) repositories.PurgeDomainEventsCommand {
+	return &purgeEventsCommand{client: client}
+}
+
+// Execute deletes the events published before the given time, the pending
+// ones are kept however old they are.
+func (c *purgeEventsCommand) Execute(context context.Context, before time.Time) error {
+	sql, args, err := c.client.Builder.
+		Delete(commands.DomainEventTable).
+		Where(sq.Lt{commands.DomainEventPublishedAtField: before.UTC()}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Pool.Exec(context, sql, args...)
+	return err
+}
@@ -0,0 +1,109 @@
+package events
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+// relayLockKey is the advisory lock taken by the replica relaying the events,
+// one relay at a time keeps the events of a user in order.
+const relayLockKey = 7251403958126710021
+
+type relayEventsCommand struct {
+	client *postgres.Client
+}
+
+func NewRelayEventsCommand(client *postgres.Client) repositories.RelayDomainEventsCommand {
+	return &relayEventsCommand{client: client}
+}
+
+// Execute passes up to limit pending events to publish in the order of their
+// sequence and marks the ones published without an error. It returns the
+// number of the events read, none are read while another replica relays.
+func (c *relayEventsCommand) Execute(context context.Context, limit int, publish func(entities.DomainEvent) error) (int, error) {
+	selectSql, selectArgs, err := c.client.Builder.
+		Select(
+			commands.DomainEventIdField,
+			commands.DomainEventSequenceField,
+			commands.DomainEventTypeField,
+			commands.DomainEventUserIdField,
+			commands.DomainEventDataField,
+			commands.DomainEventCreatedAtField,
+		).
+		From(commands.DomainEventTable).
+		Where(sq.Eq{commands.DomainEventPublishedAtField: nil}).
+		OrderBy(commands.DomainEventSequenceField).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	err = pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		var locked bool
+		err := tx.QueryRow(context, "SELECT pg_try_advisory_xact_lock($1)", int64(relayLockKey)).Scan(&locked)
+		if err != nil || !locked {
+			return err
+		}
+
+		rows, err := tx.Query(context, selectSql, selectArgs...)
+		if err != nil {
+			return err
+		}
+		events := make([]entities.DomainEvent, 0, limit)
+		for rows.Next() {
+			var event entities.DomainEvent
+			err = rows.Scan(
+				&event.Id,
+				&event.Sequence,
+				&event.Type,
+				&event.UserId,
+				&event.Data,
+				&event.CreatedAt,
+			)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+			events = append(events, event)
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		count = len(events)
+
+		published := make([]string, 0, len(events))
+		for _, event := range events {
+			if publish(event) == nil {
+				published = append(published, event.Id)
+			}
+		}
+		if len(published) == 0 {
+			return nil
+		}
+
+		updateSql, updateArgs, err := c.client.Builder.
+			Update(commands.DomainEventTable).
+			Set(commands.DomainEventPublishedAtField, time.Now().UTC()).
+			Where(sq.Eq{commands.DomainEventIdField: published}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(context, updateSql, updateArgs...)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,57 @@
+package invitations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/infrastructure/postgres/commands/organizations"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type acceptInvitationCommand struct {
+	client *postgres.Client
+}
+
+func NewAcceptInvitationCommand(client *postgres.Client) repositories.AcceptInvitationCommand {
+	return &acceptInvitationCommand{client: client}
+}
+
+// Execute marks the invitation as used and adds the member in one
+// transaction.
+func (c *acceptInvitationCommand) Execute(context context.Context, invitation entities.Invitation, userId string) error {
+	return pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		err := markAccepted(context, c.client, tx, invitation)
+		if err != nil {
+			return err
+		}
+		return organizations.InsertMember(context, c.client, tx, invitation.Membership(userId))
+	})
+}
+
+// markAccepted only matches a not yet accepted invitation, so concurrent
+// acceptances of the same invitation can't both succeed.
+func markAccepted(context context.Context, client *postgres.Client, tx pgx.Tx, invitation entities.Invitation) error {
+	sql, args, err := client.Builder.
+		Update(commands.InvitationTable).
+		Set(commands.InvitationAcceptedAtField, sq.Expr("NOW()")).
+		Where(sq.Eq{
+			commands.InvitationIdField:         invitation.Id,
+			commands.InvitationAcceptedAtField: nil,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
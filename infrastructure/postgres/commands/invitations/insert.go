@@ -0,0 +1,49 @@
+package invitations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type insertInvitationCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertInvitationCommand(client *postgres.Client) repositories.InsertInvitationCommand {
+	return &insertInvitationCommand{client: client}
+}
+
+func (c *insertInvitationCommand) Execute(context context.Context, invitation entities.Invitation) (string, error) {
+	sql, args, err := c.client.Builder.
+		Insert(commands.InvitationTable).
+		Columns(
+			commands.InvitationOrganizationIdField,
+			commands.InvitationEmailField,
+			commands.InvitationRoleField,
+			commands.InvitationUserRoleField,
+			commands.InvitationTokenHashField,
+			commands.InvitationInvitedByField,
+			commands.InvitationExpiresAtField,
+		).
+		Values(
+			commands.NullIfEmpty(invitation.OrganizationId),
+			invitation.Email,
+			commands.NullIfEmpty(invitation.Role),
+			commands.NullIfEmpty(invitation.UserRole),
+			invitation.TokenHash,
+			commands.NullIfEmpty(invitation.InvitedBy),
+			invitation.ExpiresAt,
+		).
+		Suffix("RETURNING " + commands.InvitationIdField).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&id)
+	return id, err
+}
@@ -0,0 +1,85 @@
+package invitations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+type selectInvitationByIdCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectInvitationByIdCommand(client *postgres.Client) repositories.SelectInvitationByIdCommand {
+	return &selectInvitationByIdCommand{client: client}
+}
+
+func (c *selectInvitationByIdCommand) Execute(context context.Context, id string) (entities.Invitation, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return entities.Invitation{}, repositories.ErrEntityNotFound
+	}
+
+	sql, args, err := c.client.Builder.
+		Select(
+			commands.InvitationIdField,
+			commands.InvitationOrganizationIdField,
+			commands.InvitationEmailField,
+			commands.InvitationRoleField,
+			commands.InvitationUserRoleField,
+			commands.InvitationTokenHashField,
+			commands.InvitationInvitedByField,
+			commands.InvitationCreatedAtField,
+			commands.InvitationExpiresAtField,
+			commands.InvitationAcceptedAtField,
+		).
+		From(commands.InvitationTable).
+		Where(sq.Eq{commands.InvitationIdField: id}).
+		ToSql()
+	if err != nil {
+		return entities.Invitation{}, err
+	}
+
+	var result entities.Invitation
+	var organizationId, role, userRole, invitedBy *string
+	var acceptedAt *time.Time
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(
+		&result.Id,
+		&organizationId,
+		&result.Email,
+		&role,
+		&userRole,
+		&result.TokenHash,
+		&invitedBy,
+		&result.CreatedAt,
+		&result.ExpiresAt,
+		&acceptedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.Invitation{}, repositories.ErrEntityNotFound
+		}
+		return entities.Invitation{}, err
+	}
+	result.OrganizationId = valueOrEmpty(organizationId)
+	result.Role = valueOrEmpty(role)
+	result.UserRole = valueOrEmpty(userRole)
+	result.InvitedBy = valueOrEmpty(invitedBy)
+	if acceptedAt != nil {
+		result.AcceptedAt = *acceptedAt
+	}
+	return result, nil
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,45 @@
+package invitations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands/organizations"
+	"auth/infrastructure/postgres/commands/users"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"github.com/jackc/pgx/v5"
+)
+
+type signUpByInvitationCommand struct {
+	client *postgres.Client
+}
+
+func NewSignUpByInvitationCommand(client *postgres.Client) repositories.SignUpByInvitationCommand {
+	return &signUpByInvitationCommand{client: client}
+}
+
+// Execute marks the invitation as used, creates the user and, for
+// organization invitations, the membership in one transaction.
+func (c *signUpByInvitationCommand) Execute(context context.Context, invitation entities.Invitation, user entities.User) (string, error) {
+	var id string
+	err := pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		err := markAccepted(context, c.client, tx, invitation)
+		if err != nil {
+			return err
+		}
+
+		id, err = users.InsertUser(context, c.client, tx, user)
+		if err != nil {
+			return err
+		}
+
+		if !invitation.IsForOrganization() {
+			return nil
+		}
+		return organizations.InsertMember(context, c.client, tx, invitation.Membership(id))
+	})
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
@@ -0,0 +1,68 @@
+package logins
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+type insertLoginCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertLoginCommand(client *postgres.Client) repositories.InsertLoginCommand {
+	return &insertLoginCommand{client: client}
+}
+
+// Execute stores the login and forgets the logins of the user made before
+// keepSince.
+func (c *insertLoginCommand) Execute(context context.Context, login entities.Login, keepSince time.Time) error {
+	insertSql, insertArgs, err := c.client.Builder.
+		Insert(commands.LoginHistoryTable).
+		Columns(
+			commands.LoginHistoryUserIdField,
+			commands.LoginHistoryIPField,
+			commands.LoginHistoryUserAgentField,
+			commands.LoginHistoryCountryField,
+			commands.LoginHistoryLatitudeField,
+			commands.LoginHistoryLongitudeField,
+			commands.LoginHistoryCreatedAtField,
+		).
+		Values(
+			login.UserId,
+			login.IP,
+			login.UserAgent,
+			login.Location.Country,
+			login.Location.Latitude,
+			login.Location.Longitude,
+			login.CreatedAt.UTC(),
+		).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	deleteSql, deleteArgs, err := c.client.Builder.
+		Delete(commands.LoginHistoryTable).
+		Where(sq.Eq{commands.LoginHistoryUserIdField: login.UserId}).
+		Where(sq.Lt{commands.LoginHistoryCreatedAtField: keepSince.UTC()}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(context, insertSql, insertArgs...)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(context, deleteSql, deleteArgs...)
+		return err
+	})
+}
@@ -0,0 +1,94 @@
+package logins
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+type selectHistoryCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectHistoryCommand(client *postgres.Client) repositories.SelectLoginHistoryCommand {
+	return &selectHistoryCommand{client: client}
+}
+
+// Execute compares the login with the previous logins of the user and counts
+// the other users that logged in from its address since ipSince.
+func (c *selectHistoryCommand) Execute(context context.Context, login entities.Login, ipSince time.Time) (entities.LoginHistory, error) {
+	var history entities.LoginHistory
+
+	summarySql, summaryArgs, err := c.client.Builder.
+		Select("COUNT(*)").
+		Column(sq.Expr("COUNT(*) FILTER (WHERE "+commands.LoginHistoryUserAgentField+" = ?) > 0", login.UserAgent)).
+		Column(sq.Expr("COUNT(*) FILTER (WHERE "+commands.LoginHistoryCountryField+" = ?) > 0", login.Location.Country)).
+		From(commands.LoginHistoryTable).
+		Where(sq.Eq{commands.LoginHistoryUserIdField: login.UserId}).
+		ToSql()
+	if err != nil {
+		return history, err
+	}
+
+	err = c.client.Pool.QueryRow(context, summarySql, summaryArgs...).
+		Scan(&history.Logins, &history.KnownDevice, &history.KnownCountry)
+	if err != nil {
+		return history, err
+	}
+
+	lastSql, lastArgs, err := c.client.Builder.
+		Select(
+			commands.LoginHistoryUserIdField,
+			commands.LoginHistoryIPField,
+			commands.LoginHistoryUserAgentField,
+			commands.LoginHistoryCountryField,
+			commands.LoginHistoryLatitudeField,
+			commands.LoginHistoryLongitudeField,
+			commands.LoginHistoryCreatedAtField,
+		).
+		From(commands.LoginHistoryTable).
+		Where(sq.Eq{commands.LoginHistoryUserIdField: login.UserId}).
+		OrderBy(commands.LoginHistoryCreatedAtField + " DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return history, err
+	}
+
+	last := &history.Last
+	err = c.client.Pool.QueryRow(context, lastSql, lastArgs...).Scan(
+		&last.UserId,
+		&last.IP,
+		&last.UserAgent,
+		&last.Location.Country,
+		&last.Location.Latitude,
+		&last.Location.Longitude,
+		&last.CreatedAt,
+	)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return history, err
+	}
+
+	accountsSql, accountsArgs, err := c.client.Builder.
+		Select("COUNT(DISTINCT " + commands.LoginHistoryUserIdField + ")").
+		From(commands.LoginHistoryTable).
+		Where(sq.Eq{commands.LoginHistoryIPField: login.IP}).
+		Where(sq.NotEq{commands.LoginHistoryUserIdField: login.UserId}).
+		Where(sq.GtOrEq{commands.LoginHistoryCreatedAtField: ipSince.UTC()}).
+		ToSql()
+	if err != nil {
+		return history, err
+	}
+
+	err = c.client.Pool.QueryRow(context, accountsSql, accountsArgs...).Scan(&history.AccountsFromIP)
+	if err != nil {
+		return history, err
+	}
+	return history, nil
+}
@@ -0,0 +1,75 @@
+package mfa
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type confirmTOTPCommand struct {
+	client *postgres.Client
+}
+
+func NewConfirmTOTPCommand(client *postgres.Client) repositories.ConfirmTOTPCommand {
+	return &confirmTOTPCommand{client: client}
+}
+
+// Execute enables the enrolment and replaces the recovery codes of the user
+// in one transaction.
+func (c *confirmTOTPCommand) Execute(context context.Context, userId string, step int64, recoveryCodeHashes []string) error {
+	confirmSql, confirmArgs, err := c.client.Builder.
+		Update(commands.TOTPTable).
+		Set(commands.TOTPConfirmedAtField, sq.Expr("NOW()")).
+		Set(commands.TOTPLastUsedStepField, step).
+		Where(sq.Eq{commands.TOTPUserIdField: userId}).
+		Where(sq.Eq{commands.TOTPConfirmedAtField: nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(context, confirmSql, confirmArgs...)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return repositories.ErrEntityNotFound
+		}
+
+		return replaceRecoveryCodes(context, c.client, tx, userId, recoveryCodeHashes)
+	})
+}
+
+func replaceRecoveryCodes(context context.Context, client *postgres.Client, tx pgx.Tx, userId string, codeHashes []string) error {
+	deleteSql, deleteArgs, err := client.Builder.
+		Delete(commands.RecoveryCodeTable).
+		Where(sq.Eq{commands.RecoveryCodeUserIdField: userId}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(context, deleteSql, deleteArgs...)
+	if err != nil {
+		return err
+	}
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	insert := client.Builder.
+		Insert(commands.RecoveryCodeTable).
+		Columns(commands.RecoveryCodeUserIdField, commands.RecoveryCodeCodeHashField)
+	for _, hash := range codeHashes {
+		insert = insert.Values(userId, hash)
+	}
+	insertSql, insertArgs, err := insert.ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(context, insertSql, insertArgs...)
+	return err
+}
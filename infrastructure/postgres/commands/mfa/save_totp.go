@@ -0,0 +1,49 @@
+package mfa
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"fmt"
+)
+
+type saveTOTPCommand struct {
+	client *postgres.Client
+}
+
+func NewSaveTOTPCommand(client *postgres.Client) repositories.SaveTOTPCommand {
+	return &saveTOTPCommand{client: client}
+}
+
+// Execute starts a new enrolment or replaces the unconfirmed one, a confirmed
+// enrolment is left untouched.
+func (c *saveTOTPCommand) Execute(context context.Context, totp entities.TOTP) error {
+	sql, args, err := c.client.Builder.
+		Insert(commands.TOTPTable).
+		Columns(commands.TOTPUserIdField, commands.TOTPSecretField).
+		Values(totp.UserId, totp.Secret).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%[2]s) DO UPDATE SET %[3]s = EXCLUDED.%[3]s, %[4]s = 0, %[5]s = NOW() WHERE %[1]s.%[6]s IS NULL",
+			commands.TOTPTable,
+			commands.TOTPUserIdField,
+			commands.TOTPSecretField,
+			commands.TOTPLastUsedStepField,
+			commands.TOTPCreatedAtField,
+			commands.TOTPConfirmedAtField,
+		)).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityAlreadyExists
+	}
+	return nil
+}
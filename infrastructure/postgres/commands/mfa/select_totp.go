@@ -0,0 +1,56 @@
+package mfa
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+type selectTOTPCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectTOTPCommand(client *postgres.Client) repositories.SelectTOTPCommand {
+	return &selectTOTPCommand{client: client}
+}
+
+func (c *selectTOTPCommand) Execute(context context.Context, userId string) (entities.TOTP, error) {
+	sql, args, err := c.client.Builder.
+		Select(
+			commands.TOTPUserIdField,
+			commands.TOTPSecretField,
+			commands.TOTPConfirmedAtField,
+			commands.TOTPLastUsedStepField,
+		).
+		From(commands.TOTPTable).
+		Where(sq.Eq{commands.TOTPUserIdField: userId}).
+		ToSql()
+	if err != nil {
+		return entities.TOTP{}, err
+	}
+
+	var result entities.TOTP
+	var confirmedAt *time.Time
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(
+		&result.UserId,
+		&result.Secret,
+		&confirmedAt,
+		&result.LastUsedStep,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.TOTP{}, repositories.ErrEntityNotFound
+		}
+		return entities.TOTP{}, err
+	}
+	if confirmedAt != nil {
+		result.ConfirmedAt = *confirmedAt
+	}
+	return result, nil
+}
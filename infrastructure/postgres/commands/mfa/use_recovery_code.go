@@ -0,0 +1,41 @@
+package mfa
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type useRecoveryCodeCommand struct {
+	client *postgres.Client
+}
+
+func NewUseRecoveryCodeCommand(client *postgres.Client) repositories.UseRecoveryCodeCommand {
+	return &useRecoveryCodeCommand{client: client}
+}
+
+// Execute marks the code as used, an unknown or already used code yields
+// ErrEntityNotFound.
+func (c *useRecoveryCodeCommand) Execute(context context.Context, userId string, codeHash string) error {
+	sql, args, err := c.client.Builder.
+		Update(commands.RecoveryCodeTable).
+		Set(commands.RecoveryCodeUsedAtField, sq.Expr("NOW()")).
+		Where(sq.Eq{commands.RecoveryCodeUserIdField: userId}).
+		Where(sq.Eq{commands.RecoveryCodeCodeHashField: codeHash}).
+		Where(sq.Eq{commands.RecoveryCodeUsedAtField: nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
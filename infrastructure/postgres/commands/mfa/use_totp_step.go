@@ -0,0 +1,42 @@
+package mfa
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type useTOTPStepCommand struct {
+	client *postgres.Client
+}
+
+func NewUseTOTPStepCommand(client *postgres.Client) repositories.UseTOTPStepCommand {
+	return &useTOTPStepCommand{client: client}
+}
+
+// Execute records the time step of the accepted code. It fails when a code
+// of the same or a later step has already been used, which also covers two
+// concurrent requests with the same code.
+func (c *useTOTPStepCommand) Execute(context context.Context, userId string, step int64) error {
+	sql, args, err := c.client.Builder.
+		Update(commands.TOTPTable).
+		Set(commands.TOTPLastUsedStepField, step).
+		Where(sq.Eq{commands.TOTPUserIdField: userId}).
+		Where(sq.Lt{commands.TOTPLastUsedStepField: step}).
+		Where(sq.NotEq{commands.TOTPConfirmedAtField: nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package nonces
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+type insertNonceCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertNonceCommand(client *postgres.Client) repositories.InsertWebhookNonceCommand {
+	return &insertNonceCommand{client: client}
+}
+
+// Execute remembers the nonce until it expires, it yields
+// ErrEntityAlreadyExists if the nonce has been seen and hasn't expired yet.
+// The expired nonces are cleaned up on the way.
+func (c *insertNonceCommand) Execute(context context.Context, nonce string, expiresAt time.Time) error {
+	now := time.Now().UTC()
+
+	deleteSql, deleteArgs, err := c.client.Builder.
+		Delete(commands.WebhookNonceTable).
+		Where(sq.Lt{commands.WebhookNonceExpiresAtField: now}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	insertSql, insertArgs, err := c.client.Builder.
+		Insert(commands.WebhookNonceTable).
+		Columns(commands.WebhookNonceNonceField, commands.WebhookNonceExpiresAtField).
+		Values(nonce, expiresAt.UTC()).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%[1]s) DO UPDATE SET %[2]s = EXCLUDED.%[2]s WHERE %[3]s.%[2]s < ?",
+			commands.WebhookNonceNonceField,
+			commands.WebhookNonceExpiresAtField,
+			commands.WebhookNonceTable,
+		), now).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(context, deleteSql, deleteArgs...)
+		if err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(context, insertSql, insertArgs...)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return repositories.ErrEntityAlreadyExists
+		}
+		return nil
+	})
+}
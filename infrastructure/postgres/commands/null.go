@@ -0,0 +1,21 @@
+package commands
+
+import "time"
+
+// NullIfEmpty maps an empty string to NULL, it is used for optional foreign
+// keys such as sessions.organization_id.
+func NullIfEmpty(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// NullIfZero maps the zero time to NULL, it is used for optional timestamps
+// such as sessions.auth_time.
+func NullIfZero(value time.Time) any {
+	if value.IsZero() {
+		return nil
+	}
+	return value.UTC()
+}
@@ -0,0 +1,43 @@
+package organizations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type deleteMemberCommand struct {
+	client *postgres.Client
+}
+
+func NewDeleteMemberCommand(client *postgres.Client) repositories.DeleteMemberCommand {
+	return &deleteMemberCommand{client: client}
+}
+
+func (c *deleteMemberCommand) Execute(context context.Context, organizationId, userId string) error {
+	if !isUUID(organizationId) || !isUUID(userId) {
+		return repositories.ErrEntityNotFound
+	}
+
+	sql, args, err := c.client.Builder.
+		Delete(commands.MemberTable).
+		Where(sq.Eq{
+			commands.MemberOrganizationIdField: organizationId,
+			commands.MemberUserIdField:         userId,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
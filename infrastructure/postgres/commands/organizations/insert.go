@@ -0,0 +1,47 @@
+package organizations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"github.com/jackc/pgx/v5"
+)
+
+type insertOrganizationCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertOrganizationCommand(client *postgres.Client) repositories.InsertOrganizationCommand {
+	return &insertOrganizationCommand{client: client}
+}
+
+func (c *insertOrganizationCommand) Execute(context context.Context, organization entities.Organization, ownerId string) (string, error) {
+	sql, args, err := c.client.Builder.
+		Insert(commands.OrganizationTable).
+		Columns(commands.OrganizationNameField).
+		Values(organization.Name).
+		Suffix("RETURNING " + commands.OrganizationIdField).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	err = pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(context, sql, args...).Scan(&id)
+		if err != nil {
+			return err
+		}
+		return InsertMember(context, c.client, tx, entities.Membership{
+			OrganizationId: id,
+			UserId:         ownerId,
+			Role:           entities.OrganizationRoleOwner,
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
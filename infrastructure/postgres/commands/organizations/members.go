@@ -0,0 +1,99 @@
+package organizations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+// Members are always selected together with the organization name and the
+// user email, so every query goes through the same join.
+const membersFrom = commands.MemberTable + " m" +
+	" JOIN " + commands.OrganizationTable + " o ON o." + commands.OrganizationIdField + " = m." + commands.MemberOrganizationIdField +
+	" JOIN " + commands.UserTable + " u ON u." + commands.UserIdField + " = m." + commands.MemberUserIdField
+
+var memberColumns = []string{
+	"m." + commands.MemberOrganizationIdField,
+	"o." + commands.OrganizationNameField,
+	"m." + commands.MemberUserIdField,
+	"u." + commands.UserEmailField,
+	"m." + commands.MemberRoleField,
+	"o." + commands.OrganizationPasswordMaxAgeField,
+	"m." + commands.MemberCreatedAtField,
+}
+
+func scanMember(row pgx.Row) (entities.Membership, error) {
+	result := entities.Membership{}
+	var passwordMaxAge *int64
+	err := row.Scan(
+		&result.OrganizationId,
+		&result.OrganizationName,
+		&result.UserId,
+		&result.Email,
+		&result.Role,
+		&passwordMaxAge,
+		&result.JoinedAt,
+	)
+	if passwordMaxAge != nil {
+		maxAge := time.Duration(*passwordMaxAge) * time.Second
+		result.PasswordMaxAge = &maxAge
+	}
+	return result, err
+}
+
+func selectMembers(context context.Context, client *postgres.Client, where sq.Eq) ([]entities.Membership, error) {
+	sql, args, err := client.Builder.
+		Select(memberColumns...).
+		From(membersFrom).
+		Where(where).
+		OrderBy("m." + commands.MemberCreatedAtField).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]entities.Membership, 0)
+	for rows.Next() {
+		member, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, member)
+	}
+
+	return result, rows.Err()
+}
+
+// InsertMember adds a membership inside the given transaction, it is shared
+// with the invitation commands which create members on acceptance.
+func InsertMember(context context.Context, client *postgres.Client, tx pgx.Tx, member entities.Membership) error {
+	sql, args, err := client.Builder.
+		Insert(commands.MemberTable).
+		Columns(commands.MemberOrganizationIdField, commands.MemberUserIdField, commands.MemberRoleField).
+		Values(member.OrganizationId, member.UserId, member.Role).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(context, sql, args...)
+	return err
+}
+
+// isUUID filters out malformed ids coming from requests, they can't match any
+// row and would otherwise fail the query with a cast error.
+func isUUID(id string) bool {
+	_, err := uuid.Parse(id)
+	return err == nil
+}
@@ -0,0 +1,47 @@
+package organizations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type selectMemberCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectMemberCommand(client *postgres.Client) repositories.SelectMemberCommand {
+	return &selectMemberCommand{client: client}
+}
+
+func (c *selectMemberCommand) Execute(context context.Context, organizationId, userId string) (entities.Membership, error) {
+	if !isUUID(organizationId) || !isUUID(userId) {
+		return entities.Membership{}, repositories.ErrEntityNotFound
+	}
+
+	sql, args, err := c.client.Builder.
+		Select(memberColumns...).
+		From(membersFrom).
+		Where(sq.Eq{
+			"m." + commands.MemberOrganizationIdField: organizationId,
+			"m." + commands.MemberUserIdField:         userId,
+		}).
+		ToSql()
+	if err != nil {
+		return entities.Membership{}, err
+	}
+
+	member, err := scanMember(c.client.Pool.QueryRow(context, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.Membership{}, repositories.ErrEntityNotFound
+		}
+		return entities.Membership{}, err
+	}
+	return member, nil
+}
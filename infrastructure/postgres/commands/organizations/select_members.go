@@ -0,0 +1,22 @@
+package organizations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type selectMembersCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectMembersCommand(client *postgres.Client) repositories.SelectMembersCommand {
+	return &selectMembersCommand{client: client}
+}
+
+func (c *selectMembersCommand) Execute(context context.Context, organizationId string) ([]entities.Membership, error) {
+	return selectMembers(context, c.client, sq.Eq{"m." + commands.MemberOrganizationIdField: organizationId})
+}
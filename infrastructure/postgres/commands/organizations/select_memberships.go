@@ -0,0 +1,22 @@
+package organizations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type selectMembershipsByUserIdCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectMembershipsByUserIdCommand(client *postgres.Client) repositories.SelectMembershipsByUserIdCommand {
+	return &selectMembershipsByUserIdCommand{client: client}
+}
+
+func (c *selectMembershipsByUserIdCommand) Execute(context context.Context, userId string) ([]entities.Membership, error) {
+	return selectMembers(context, c.client, sq.Eq{"m." + commands.MemberUserIdField: userId})
+}
@@ -0,0 +1,49 @@
+package organizations
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"time"
+)
+
+type updatePasswordMaxAgeCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdatePasswordMaxAgeCommand(client *postgres.Client) repositories.UpdateOrganizationPasswordMaxAgeCommand {
+	return &updatePasswordMaxAgeCommand{client: client}
+}
+
+// Execute stores the override in seconds, nil clears it.
+func (c *updatePasswordMaxAgeCommand) Execute(context context.Context, organizationId string, maxAge *time.Duration) error {
+	if !isUUID(organizationId) {
+		return repositories.ErrEntityNotFound
+	}
+
+	var seconds *int64
+	if maxAge != nil {
+		value := int64(maxAge.Seconds())
+		seconds = &value
+	}
+
+	sql, args, err := c.client.Builder.
+		Update(commands.OrganizationTable).
+		Set(commands.OrganizationPasswordMaxAgeField, seconds).
+		Where(sq.Eq{commands.OrganizationIdField: organizationId}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
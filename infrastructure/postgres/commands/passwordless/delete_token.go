@@ -0,0 +1,59 @@
+package passwordless
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"time"
+)
+
+type deleteTokenCommand struct {
+	client *postgres.Client
+}
+
+func NewDeleteTokenCommand(client *postgres.Client) repositories.DeletePasswordlessTokenCommand {
+	return &deleteTokenCommand{client: client}
+}
+
+// Execute deletes the token so that it is used only once, expired tokens are
+// cleaned up on the way. ErrEntityNotFound means the token has already been
+// used by a concurrent request.
+func (c *deleteTokenCommand) Execute(context context.Context, id string) error {
+	sql, args, err := c.client.Builder.
+		Delete(commands.PasswordlessTokenTable).
+		Where(sq.Or{
+			sq.Eq{commands.PasswordlessTokenIdField: id},
+			sq.Lt{commands.PasswordlessTokenExpiresAtField: time.Now().UTC()},
+		}).
+		Suffix("RETURNING " + commands.PasswordlessTokenIdField).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := c.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	found := false
+	for rows.Next() {
+		var deletedId string
+		if err = rows.Scan(&deletedId); err != nil {
+			return err
+		}
+		if deletedId == id {
+			found = true
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	if !found {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
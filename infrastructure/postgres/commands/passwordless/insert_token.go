@@ -0,0 +1,45 @@
+package passwordless
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type insertTokenCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertTokenCommand(client *postgres.Client) repositories.InsertPasswordlessTokenCommand {
+	return &insertTokenCommand{client: client}
+}
+
+func (c *insertTokenCommand) Execute(context context.Context, token entities.PasswordlessToken) (string, error) {
+	sql, args, err := c.client.Builder.
+		Insert(commands.PasswordlessTokenTable).
+		Columns(
+			commands.PasswordlessTokenEmailField,
+			commands.PasswordlessTokenMethodField,
+			commands.PasswordlessTokenSecretHashField,
+			commands.PasswordlessTokenDeviceHashField,
+			commands.PasswordlessTokenExpiresAtField,
+		).
+		Values(
+			token.Email,
+			token.Method,
+			token.SecretHash,
+			token.DeviceHash,
+			token.ExpiresAt.UTC(),
+		).
+		Suffix("RETURNING " + commands.PasswordlessTokenIdField).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&id)
+	return id, err
+}
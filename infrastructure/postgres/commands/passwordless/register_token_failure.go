@@ -0,0 +1,43 @@
+package passwordless
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type registerTokenFailureCommand struct {
+	client *postgres.Client
+}
+
+func NewRegisterTokenFailureCommand(client *postgres.Client) repositories.RegisterPasswordlessTokenFailureCommand {
+	return &registerTokenFailureCommand{client: client}
+}
+
+// Execute counts a wrong secret entered for the sign in and returns the
+// number of wrong attempts so far.
+func (c *registerTokenFailureCommand) Execute(context context.Context, id string) (int, error) {
+	sql, args, err := c.client.Builder.
+		Update(commands.PasswordlessTokenTable).
+		Set(commands.PasswordlessTokenAttemptsField, sq.Expr(commands.PasswordlessTokenAttemptsField+" + 1")).
+		Where(sq.Eq{commands.PasswordlessTokenIdField: id}).
+		Suffix("RETURNING " + commands.PasswordlessTokenAttemptsField).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var attempts int
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&attempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repositories.ErrEntityNotFound
+		}
+		return 0, err
+	}
+	return attempts, nil
+}
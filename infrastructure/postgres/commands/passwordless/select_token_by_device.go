@@ -0,0 +1,63 @@
+package passwordless
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+type selectTokenByDeviceCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectTokenByDeviceCommand(client *postgres.Client) repositories.SelectPasswordlessTokenByDeviceCommand {
+	return &selectTokenByDeviceCommand{client: client}
+}
+
+// Execute returns the pending sign in started on the device, expired ones
+// yield ErrEntityNotFound.
+func (c *selectTokenByDeviceCommand) Execute(context context.Context, deviceHash string) (entities.PasswordlessToken, error) {
+	sql, args, err := c.client.Builder.
+		Select(
+			commands.PasswordlessTokenIdField,
+			commands.PasswordlessTokenEmailField,
+			commands.PasswordlessTokenMethodField,
+			commands.PasswordlessTokenSecretHashField,
+			commands.PasswordlessTokenDeviceHashField,
+			commands.PasswordlessTokenAttemptsField,
+			commands.PasswordlessTokenExpiresAtField,
+			commands.PasswordlessTokenCreatedAtField,
+		).
+		From(commands.PasswordlessTokenTable).
+		Where(sq.Eq{commands.PasswordlessTokenDeviceHashField: deviceHash}).
+		Where(sq.Gt{commands.PasswordlessTokenExpiresAtField: time.Now().UTC()}).
+		ToSql()
+	if err != nil {
+		return entities.PasswordlessToken{}, err
+	}
+
+	var result entities.PasswordlessToken
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(
+		&result.Id,
+		&result.Email,
+		&result.Method,
+		&result.SecretHash,
+		&result.DeviceHash,
+		&result.Attempts,
+		&result.ExpiresAt,
+		&result.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.PasswordlessToken{}, repositories.ErrEntityNotFound
+		}
+		return entities.PasswordlessToken{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package permissions
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type deletePermissionCommand struct {
+	client *postgres.Client
+}
+
+func NewDeletePermissionCommand(client *postgres.Client) repositories.DeletePermissionCommand {
+	return &deletePermissionCommand{client: client}
+}
+
+func (c *deletePermissionCommand) Execute(context context.Context, id int) error {
+	sql, args, err := c.client.Builder.
+		Delete(commands.PermissionTable).
+		Where(sq.Eq{commands.PermissionIdField: id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package permissions
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type insertPermissionCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertPermissionCommand(client *postgres.Client) repositories.InsertPermissionCommand {
+	return &insertPermissionCommand{client: client}
+}
+
+func (c *insertPermissionCommand) Execute(context context.Context, permission entities.Permission) (int, error) {
+	sql, args, err := c.client.Builder.
+		Insert(commands.PermissionTable).
+		Columns(commands.PermissionNameField, commands.PermissionDescriptionField).
+		Values(permission.Name, permission.Description).
+		Suffix("RETURNING " + commands.PermissionIdField).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var id int
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
@@ -0,0 +1,34 @@
+package permissions
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"context"
+)
+
+var permissionColumns = []string{
+	commands.PermissionIdField,
+	commands.PermissionNameField,
+	commands.PermissionDescriptionField,
+}
+
+func selectPermissions(context context.Context, client *postgres.Client, sql string, args []any) ([]entities.Permission, error) {
+	rows, err := client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]entities.Permission, 0)
+	for rows.Next() {
+		permission := entities.Permission{}
+		err = rows.Scan(&permission.Id, &permission.Name, &permission.Description)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, permission)
+	}
+
+	return result, rows.Err()
+}
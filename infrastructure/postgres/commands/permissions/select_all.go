@@ -0,0 +1,30 @@
+package permissions
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type selectAllPermissionsCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectAllPermissionsCommand(client *postgres.Client) repositories.SelectAllPermissionsCommand {
+	return &selectAllPermissionsCommand{client: client}
+}
+
+func (c *selectAllPermissionsCommand) Execute(context context.Context) ([]entities.Permission, error) {
+	sql, args, err := c.client.Builder.
+		Select(permissionColumns...).
+		From(commands.PermissionTable).
+		OrderBy(commands.PermissionNameField).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return selectPermissions(context, c.client, sql, args)
+}
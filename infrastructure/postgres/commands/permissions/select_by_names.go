@@ -0,0 +1,32 @@
+package permissions
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type selectPermissionsByNamesCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectPermissionsByNamesCommand(client *postgres.Client) repositories.SelectPermissionsByNamesCommand {
+	return &selectPermissionsByNamesCommand{client: client}
+}
+
+func (c *selectPermissionsByNamesCommand) Execute(context context.Context, names []string) ([]entities.Permission, error) {
+	sql, args, err := c.client.Builder.
+		Select(permissionColumns...).
+		From(commands.PermissionTable).
+		Where(sq.Eq{commands.PermissionNameField: names}).
+		OrderBy(commands.PermissionNameField).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return selectPermissions(context, c.client, sql, args)
+}
@@ -0,0 +1,141 @@
+package ratelimits
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"sync"
+	"time"
+)
+
+// cleanupInterval is how often each replica removes the refilled buckets.
+const cleanupInterval = time.Minute
+
+type takeRateLimitTokenCommand struct {
+	client *postgres.Client
+
+	mu          sync.Mutex
+	lastCleanup time.Time
+}
+
+func NewTakeRateLimitTokenCommand(client *postgres.Client) repositories.TakeRateLimitTokenCommand {
+	return &takeRateLimitTokenCommand{client: client}
+}
+
+// Execute locks the bucket row, so replicas sharing the database take tokens
+// one after another. The row of a new key is inserted as a full bucket first,
+// otherwise two first requests would find nothing to lock and both take from
+// a full bucket. Buckets which are full again are removed once a
+// cleanupInterval.
+func (c *takeRateLimitTokenCommand) Execute(context context.Context, key string, limit entities.RateLimit) (entities.RateLimitResult, error) {
+	now := time.Now().UTC()
+
+	insertSql, insertArgs, err := c.client.Builder.
+		Insert(commands.RateLimitBucketTable).
+		Columns(
+			commands.RateLimitBucketKeyField,
+			commands.RateLimitBucketTokensField,
+			commands.RateLimitBucketUpdatedAtField,
+			commands.RateLimitBucketFullAtField,
+		).
+		Values(key, float64(limit.Limit), now, now).
+		Suffix("ON CONFLICT (" + commands.RateLimitBucketKeyField + ") DO NOTHING").
+		ToSql()
+	if err != nil {
+		return entities.RateLimitResult{}, err
+	}
+
+	selectSql, selectArgs, err := c.client.Builder.
+		Select(commands.RateLimitBucketTokensField, commands.RateLimitBucketUpdatedAtField).
+		From(commands.RateLimitBucketTable).
+		Where(sq.Eq{commands.RateLimitBucketKeyField: key}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return entities.RateLimitResult{}, err
+	}
+
+	var result entities.RateLimitResult
+	err = pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(context, insertSql, insertArgs...)
+		if err != nil {
+			return err
+		}
+
+		// The refilled row may be cleaned up between the statements, the
+		// missing bucket is a full one and is written back by the upsert.
+		var bucket entities.TokenBucket
+		err = tx.QueryRow(context, selectSql, selectArgs...).Scan(&bucket.Tokens, &bucket.UpdatedAt)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		bucket, result = bucket.Take(limit, now)
+
+		upsertSql, upsertArgs, err := c.client.Builder.
+			Insert(commands.RateLimitBucketTable).
+			Columns(
+				commands.RateLimitBucketKeyField,
+				commands.RateLimitBucketTokensField,
+				commands.RateLimitBucketUpdatedAtField,
+				commands.RateLimitBucketFullAtField,
+			).
+			Values(key, bucket.Tokens, bucket.UpdatedAt, bucket.FullAt(limit)).
+			Suffix("ON CONFLICT (" + commands.RateLimitBucketKeyField + ") DO UPDATE SET " +
+				commands.RateLimitBucketTokensField + " = EXCLUDED." + commands.RateLimitBucketTokensField + ", " +
+				commands.RateLimitBucketUpdatedAtField + " = EXCLUDED." + commands.RateLimitBucketUpdatedAtField + ", " +
+				commands.RateLimitBucketFullAtField + " = EXCLUDED." + commands.RateLimitBucketFullAtField).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(context, upsertSql, upsertArgs...)
+		return err
+	})
+	if err != nil {
+		return entities.RateLimitResult{}, err
+	}
+
+	err = c.cleanup(context, now)
+	if err != nil {
+		return entities.RateLimitResult{}, err
+	}
+	return result, nil
+}
+
+// cleanup removes the refilled buckets, skipping the ones locked by
+// concurrent requests.
+func (c *takeRateLimitTokenCommand) cleanup(context context.Context, now time.Time) error {
+	c.mu.Lock()
+	if now.Sub(c.lastCleanup) < cleanupInterval {
+		c.mu.Unlock()
+		return nil
+	}
+	c.lastCleanup = now
+	c.mu.Unlock()
+
+	expired := sq.
+		Select(commands.RateLimitBucketKeyField).
+		From(commands.RateLimitBucketTable).
+		Where(sq.Lt{commands.RateLimitBucketFullAtField: now}).
+		Suffix("FOR UPDATE SKIP LOCKED")
+	expiredSql, expiredArgs, err := expired.ToSql()
+	if err != nil {
+		return err
+	}
+	sql, args, err := c.client.Builder.
+		Delete(commands.RateLimitBucketTable).
+		Where(sq.Expr(commands.RateLimitBucketKeyField+" IN ("+expiredSql+")", expiredArgs...)).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Pool.Exec(context, sql, args...)
+	return err
+}
@@ -0,0 +1,36 @@
+package roles
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type deleteRoleCommand struct {
+	client *postgres.Client
+}
+
+func NewDeleteRoleCommand(client *postgres.Client) repositories.DeleteRoleCommand {
+	return &deleteRoleCommand{client: client}
+}
+
+func (c *deleteRoleCommand) Execute(context context.Context, id int) error {
+	sql, args, err := c.client.Builder.
+		Delete(commands.RoleTable).
+		Where(sq.Eq{commands.RoleIdField: id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package roles
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"github.com/jackc/pgx/v5"
+)
+
+type insertRoleCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertRoleCommand(client *postgres.Client) repositories.InsertRoleCommand {
+	return &insertRoleCommand{client: client}
+}
+
+func (c *insertRoleCommand) Execute(context context.Context, role entities.Role) (int, error) {
+	sql, args, err := c.client.Builder.
+		Insert(commands.RoleTable).
+		Columns(commands.RoleNameField, commands.RoleDescriptionField).
+		Values(role.Name, role.Description).
+		Suffix("RETURNING " + commands.RoleIdField).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var id int
+	err = pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(context, sql, args...).Scan(&id)
+		if err != nil {
+			return err
+		}
+		return insertRolePermissions(context, c.client, tx, id, role.Permissions)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
@@ -0,0 +1,69 @@
+package roles
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+const rolePermissionsColumn = `ARRAY(SELECT p.name FROM role_permissions rp JOIN permissions p ON p.id = rp.permission_id
+	WHERE rp.role_id = roles.id ORDER BY p.name)`
+
+var roleColumns = []string{
+	commands.RoleIdField,
+	commands.RoleNameField,
+	commands.RoleDescriptionField,
+	rolePermissionsColumn,
+}
+
+func scanRole(row pgx.Row) (entities.Role, error) {
+	result := entities.Role{}
+	err := row.Scan(&result.Id, &result.Name, &result.Description, &result.Permissions)
+	return result, err
+}
+
+func selectRoles(context context.Context, client *postgres.Client, sql string, args []any) ([]entities.Role, error) {
+	rows, err := client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]entities.Role, 0)
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, role)
+	}
+
+	return result, rows.Err()
+}
+
+func insertRolePermissions(context context.Context, client *postgres.Client, tx pgx.Tx, roleId int, permissions []string) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	sql, args, err := client.Builder.
+		Insert(commands.RolePermissionTable).
+		Columns(commands.RolePermissionRoleIdField, commands.RolePermissionPermissionIdField).
+		Select(client.Builder.
+			Select().
+			Column(sq.Expr("?::int", roleId)).
+			Column(commands.PermissionIdField).
+			From(commands.PermissionTable).
+			Where(sq.Eq{commands.PermissionNameField: permissions})).
+		Suffix("ON CONFLICT DO NOTHING").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(context, sql, args...)
+	return err
+}
@@ -0,0 +1,30 @@
+package roles
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type selectAllRolesCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectAllRolesCommand(client *postgres.Client) repositories.SelectAllRolesCommand {
+	return &selectAllRolesCommand{client: client}
+}
+
+func (c *selectAllRolesCommand) Execute(context context.Context) ([]entities.Role, error) {
+	sql, args, err := c.client.Builder.
+		Select(roleColumns...).
+		From(commands.RoleTable).
+		OrderBy(commands.RoleNameField).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return selectRoles(context, c.client, sql, args)
+}
@@ -0,0 +1,40 @@
+package roles
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type selectRoleByIdCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectRoleByIdCommand(client *postgres.Client) repositories.SelectRoleByIdCommand {
+	return &selectRoleByIdCommand{client: client}
+}
+
+func (c *selectRoleByIdCommand) Execute(context context.Context, id int) (entities.Role, error) {
+	sql, args, err := c.client.Builder.
+		Select(roleColumns...).
+		From(commands.RoleTable).
+		Where(sq.Eq{commands.RoleIdField: id}).
+		ToSql()
+	if err != nil {
+		return entities.Role{}, err
+	}
+
+	role, err := scanRole(c.client.Pool.QueryRow(context, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.Role{}, repositories.ErrEntityNotFound
+		}
+		return entities.Role{}, err
+	}
+	return role, nil
+}
@@ -0,0 +1,32 @@
+package roles
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type selectRolesByNamesCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectRolesByNamesCommand(client *postgres.Client) repositories.SelectRolesByNamesCommand {
+	return &selectRolesByNamesCommand{client: client}
+}
+
+func (c *selectRolesByNamesCommand) Execute(context context.Context, names []string) ([]entities.Role, error) {
+	sql, args, err := c.client.Builder.
+		Select(roleColumns...).
+		From(commands.RoleTable).
+		Where(sq.Eq{commands.RoleNameField: names}).
+		OrderBy(commands.RoleNameField).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return selectRoles(context, c.client, sql, args)
+}
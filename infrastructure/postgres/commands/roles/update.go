@@ -0,0 +1,54 @@
+package roles
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type updateRoleCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdateRoleCommand(client *postgres.Client) repositories.UpdateRoleCommand {
+	return &updateRoleCommand{client: client}
+}
+
+func (c *updateRoleCommand) Execute(context context.Context, role entities.Role) error {
+	updateSql, updateArgs, err := c.client.Builder.
+		Update(commands.RoleTable).
+		Set(commands.RoleDescriptionField, role.Description).
+		Where(sq.Eq{commands.RoleIdField: role.Id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	deleteSql, deleteArgs, err := c.client.Builder.
+		Delete(commands.RolePermissionTable).
+		Where(sq.Eq{commands.RolePermissionRoleIdField: role.Id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(context, updateSql, updateArgs...)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return repositories.ErrEntityNotFound
+		}
+
+		_, err = tx.Exec(context, deleteSql, deleteArgs...)
+		if err != nil {
+			return err
+		}
+		return insertRolePermissions(context, c.client, tx, role.Id, role.Permissions)
+	})
+}
@@ -0,0 +1,67 @@
+package sessions
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type deleteByRoleIdCommand struct {
+	client *postgres.Client
+}
+
+func NewDeleteByRoleIdCommand(client *postgres.Client) repositories.DeleteByRoleIdCommand {
+	return &deleteByRoleIdCommand{client: client}
+}
+
+// Execute deletes the sessions of the holders of the role and queues a
+// session.revoked event for each of them in one transaction.
+func (c *deleteByRoleIdCommand) Execute(ctx context.Context, roleId int) error {
+	holders := c.client.Builder.
+		Select(commands.UserRoleUserIdField).
+		From(commands.UserRoleTable).
+		Where(sq.Eq{commands.UserRoleRoleIdField: roleId})
+
+	sql, args, err := c.client.Builder.
+		Delete(commands.SessionTable).
+		Where(sq.Expr(commands.SessionUserIdField+" IN (?)", holders)).
+		Suffix("RETURNING " + commands.SessionIdField + ", " + commands.SessionUserIdField).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(ctx, c.client.Pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+		var events []entities.DomainEvent
+		for rows.Next() {
+			var id int
+			var userId string
+			err = rows.Scan(&id, &userId)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+			events = append(events, entities.NewSessionRevokedEvent(userId, id))
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			err = commands.EnqueueDomainEvent(ctx, tx, c.client.Builder, event)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
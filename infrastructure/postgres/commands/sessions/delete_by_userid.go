@@ -3,8 +3,10 @@ package sessions
 import (
 	"auth/infrastructure/postgres"
 	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
 	"auth/internal/repositories"
 	"context"
+	"github.com/jackc/pgx/v5"
 )
 
 type deleteByUserIdCommand struct {
@@ -15,21 +17,47 @@ func NewDeleteByUserIdCommand(client *postgres.Client) repositories.DeleteByUser
 	return &deleteByUserIdCommand{client: client}
 }
 
+// Execute deletes the sessions of the user and queues a session.revoked
+// event for each of them in one transaction.
 func (c *deleteByUserIdCommand) Execute(ctx context.Context, userId string) error {
 	sql, args, err := c.client.Builder.
 		Delete(commands.SessionTable).
 		Where(commands.SessionUserIdField+" = ?", userId).
+		Suffix("RETURNING " + commands.SessionIdField).
 		ToSql()
 	if err != nil {
 		return err
 	}
 
-	tag, err := c.client.Pool.Exec(ctx, sql, args...)
-	if err != nil {
-		return err
-	}
-	if tag.RowsAffected() == 0 {
-		return repositories.ErrSessionNotFound
-	}
-	return err
+	return pgx.BeginFunc(ctx, c.client.Pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+		var ids []int
+		for rows.Next() {
+			var id int
+			err = rows.Scan(&id)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+			ids = append(ids, id)
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return repositories.ErrSessionNotFound
+		}
+
+		for _, id := range ids {
+			err = commands.EnqueueDomainEvent(ctx, tx, c.client.Builder, entities.NewSessionRevokedEvent(userId, id))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
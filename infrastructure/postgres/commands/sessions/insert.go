@@ -6,6 +6,7 @@ import (
 	"auth/internal/entities"
 	"auth/internal/repositories"
 	"context"
+	"github.com/jackc/pgx/v5"
 )
 
 type insertSessionCommand struct {
@@ -16,28 +17,47 @@ func NewInsertSessionCommand(client *postgres.Client) repositories.InsertSession
 	return &insertSessionCommand{client: client}
 }
 
+// Execute inserts the session and queues the session.created event in one
+// transaction.
 func (c *insertSessionCommand) Execute(ctx context.Context, session entities.Session) error {
+	authMethods := session.Authentication.Methods
+	if authMethods == nil {
+		authMethods = []string{}
+	}
+
 	sql, args, err := c.client.Builder.
 		Insert(commands.SessionTable).
 		Columns(
 			commands.SessionUserIdField,
+			commands.SessionOrganizationIdField,
 			commands.SessionRefreshTokenHash,
 			commands.SessionUserAgentField,
 			commands.SessionIPField,
 			commands.SessionExpiresAtField,
+			commands.SessionAuthMethodsField,
+			commands.SessionAuthTimeField,
 		).
 		Values(
 			session.UserId,
+			commands.NullIfEmpty(session.OrganizationId),
 			session.RefreshToken,
 			session.UserAgent,
 			session.IP,
 			session.ExpiresAt,
+			authMethods,
+			commands.NullIfZero(session.Authentication.Time),
 		).
+		Suffix("RETURNING " + commands.SessionIdField).
 		ToSql()
 	if err != nil {
 		return err
 	}
 
-	_, err = c.client.Pool.Exec(ctx, sql, args...)
-	return err
+	return pgx.BeginFunc(ctx, c.client.Pool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, sql, args...).Scan(&session.Id)
+		if err != nil {
+			return err
+		}
+		return commands.EnqueueDomainEvent(ctx, tx, c.client.Builder, entities.NewSessionCreatedEvent(session))
+	})
 }
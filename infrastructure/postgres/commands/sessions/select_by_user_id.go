@@ -7,6 +7,7 @@ import (
 	"auth/internal/repositories"
 	"context"
 	"github.com/google/uuid"
+	"time"
 )
 
 type selectByRefreshTokenCommand struct {
@@ -26,10 +27,13 @@ func (c *selectByRefreshTokenCommand) Execute(ctx context.Context, userId string
 		Select(
 			commands.SessionIdField,
 			commands.SessionUserIdField,
+			commands.SessionOrganizationIdField,
 			commands.SessionRefreshTokenHash,
 			commands.SessionUserAgentField,
 			commands.SessionIPField,
 			commands.SessionExpiresAtField,
+			commands.SessionAuthMethodsField,
+			commands.SessionAuthTimeField,
 		).
 		From(commands.SessionTable).
 		Where(commands.SessionUserIdField+" = ?", uuidUser).
@@ -39,14 +43,25 @@ func (c *selectByRefreshTokenCommand) Execute(ctx context.Context, userId string
 	}
 
 	var session entities.Session
+	var organizationId *string
+	var authTime *time.Time
 	err = c.client.Pool.QueryRow(ctx, sql, args...).Scan(
 		&session.Id,
 		&session.UserId,
+		&organizationId,
 		&session.RefreshToken,
 		&session.UserAgent,
 		&session.IP,
 		&session.ExpiresAt,
+		&session.Authentication.Methods,
+		&authTime,
 	)
+	if organizationId != nil {
+		session.OrganizationId = *organizationId
+	}
+	if authTime != nil {
+		session.Authentication.Time = *authTime
+	}
 
 	return session, err
 }
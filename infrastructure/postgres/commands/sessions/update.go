@@ -6,6 +6,7 @@ import (
 	"auth/internal/entities"
 	"auth/internal/repositories"
 	"context"
+	sq "github.com/Masterminds/squirrel"
 )
 
 type updateSessionCommand struct {
@@ -17,18 +18,30 @@ func NewUpdateSessionCommand(client *postgres.Client) repositories.UpdateSession
 }
 
 func (c *updateSessionCommand) Execute(ctx context.Context, session entities.Session) error {
-	sql, args, err := c.client.Builder.
+	sql, args, err := updateSessionSql(c.client.Builder, session)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Pool.Exec(ctx, sql, args...)
+	return err
+}
+
+func updateSessionSql(builder sq.StatementBuilderType, session entities.Session) (string, []any, error) {
+	authMethods := session.Authentication.Methods
+	if authMethods == nil {
+		authMethods = []string{}
+	}
+
+	return builder.
 		Update(commands.SessionTable).
+		Set(commands.SessionOrganizationIdField, commands.NullIfEmpty(session.OrganizationId)).
 		Set(commands.SessionRefreshTokenHash, session.RefreshToken).
 		Set(commands.SessionUserAgentField, session.UserAgent).
 		Set(commands.SessionIPField, session.IP).
 		Set(commands.SessionExpiresAtField, session.ExpiresAt).
+		Set(commands.SessionAuthMethodsField, authMethods).
+		Set(commands.SessionAuthTimeField, commands.NullIfZero(session.Authentication.Time)).
 		Where(commands.SessionUserIdField+" = ?", session.UserId).
 		ToSql()
-	if err != nil {
-		return err
-	}
-
-	_, err = c.client.Pool.Exec(ctx, sql, args...)
-	return err
 }
@@ -0,0 +1,35 @@
+package sessions
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"github.com/jackc/pgx/v5"
+)
+
+type updateSessionWithEventCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdateSessionWithEventCommand(client *postgres.Client) repositories.UpdateSessionWithEventCommand {
+	return &updateSessionWithEventCommand{client: client}
+}
+
+// Execute updates the session and queues the webhook event in one
+// transaction.
+func (c *updateSessionWithEventCommand) Execute(ctx context.Context, session entities.Session, event entities.WebhookEvent) error {
+	sql, args, err := updateSessionSql(c.client.Builder, session)
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(ctx, c.client.Pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+		return commands.EnqueueWebhookEvent(ctx, tx, c.client.Builder, event)
+	})
+}
@@ -0,0 +1,38 @@
+package sms
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type deleteCodeCommand struct {
+	client *postgres.Client
+}
+
+func NewDeleteCodeCommand(client *postgres.Client) repositories.DeleteSMSCodeCommand {
+	return &deleteCodeCommand{client: client}
+}
+
+// Execute deletes the code so that it is used only once. ErrEntityNotFound
+// means the code has already been used by a concurrent request.
+func (c *deleteCodeCommand) Execute(context context.Context, id string) error {
+	sql, args, err := c.client.Builder.
+		Delete(commands.SMSCodeTable).
+		Where(sq.Eq{commands.SMSCodeIdField: id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
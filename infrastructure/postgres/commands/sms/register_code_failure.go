@@ -0,0 +1,43 @@
+package sms
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type registerCodeFailureCommand struct {
+	client *postgres.Client
+}
+
+func NewRegisterCodeFailureCommand(client *postgres.Client) repositories.RegisterSMSCodeFailureCommand {
+	return &registerCodeFailureCommand{client: client}
+}
+
+// Execute counts a wrong code and returns the number of wrong attempts so
+// far.
+func (c *registerCodeFailureCommand) Execute(context context.Context, id string) (int, error) {
+	sql, args, err := c.client.Builder.
+		Update(commands.SMSCodeTable).
+		Set(commands.SMSCodeAttemptsField, sq.Expr(commands.SMSCodeAttemptsField+" + 1")).
+		Where(sq.Eq{commands.SMSCodeIdField: id}).
+		Suffix("RETURNING " + commands.SMSCodeAttemptsField).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var attempts int
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&attempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repositories.ErrEntityNotFound
+		}
+		return 0, err
+	}
+	return attempts, nil
+}
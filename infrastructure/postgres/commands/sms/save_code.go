@@ -0,0 +1,56 @@
+package sms
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"fmt"
+)
+
+type saveCodeCommand struct {
+	client *postgres.Client
+}
+
+func NewSaveCodeCommand(client *postgres.Client) repositories.SaveSMSCodeCommand {
+	return &saveCodeCommand{client: client}
+}
+
+// Execute stores the code, the pending code of the user for the same purpose
+// is replaced together with its wrong attempts.
+func (c *saveCodeCommand) Execute(context context.Context, code entities.SMSCode) error {
+	sql, args, err := c.client.Builder.
+		Insert(commands.SMSCodeTable).
+		Columns(
+			commands.SMSCodeUserIdField,
+			commands.SMSCodePhoneField,
+			commands.SMSCodePurposeField,
+			commands.SMSCodeHashField,
+			commands.SMSCodeExpiresAtField,
+		).
+		Values(
+			code.UserId,
+			string(code.Phone),
+			code.Purpose,
+			code.CodeHash,
+			code.ExpiresAt.UTC(),
+		).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%[1]s, %[2]s) DO UPDATE SET %[3]s = EXCLUDED.%[3]s, %[4]s = EXCLUDED.%[4]s, %[5]s = EXCLUDED.%[5]s, %[6]s = 0, %[7]s = NOW()",
+			commands.SMSCodeUserIdField,
+			commands.SMSCodePurposeField,
+			commands.SMSCodePhoneField,
+			commands.SMSCodeHashField,
+			commands.SMSCodeExpiresAtField,
+			commands.SMSCodeAttemptsField,
+			commands.SMSCodeCreatedAtField,
+		)).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Pool.Exec(context, sql, args...)
+	return err
+}
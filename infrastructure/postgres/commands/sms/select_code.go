@@ -0,0 +1,71 @@
+package sms
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+type selectCodeCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectCodeCommand(client *postgres.Client) repositories.SelectSMSCodeCommand {
+	return &selectCodeCommand{client: client}
+}
+
+// Execute returns the pending code of the user for the purpose, an expired
+// code yields ErrEntityNotFound.
+func (c *selectCodeCommand) Execute(context context.Context, userId, purpose string) (entities.SMSCode, error) {
+	if uuid.Validate(userId) != nil {
+		return entities.SMSCode{}, repositories.ErrEntityNotFound
+	}
+
+	sql, args, err := c.client.Builder.
+		Select(
+			commands.SMSCodeIdField,
+			commands.SMSCodeUserIdField,
+			commands.SMSCodePhoneField,
+			commands.SMSCodePurposeField,
+			commands.SMSCodeHashField,
+			commands.SMSCodeAttemptsField,
+			commands.SMSCodeExpiresAtField,
+			commands.SMSCodeCreatedAtField,
+		).
+		From(commands.SMSCodeTable).
+		Where(sq.Eq{
+			commands.SMSCodeUserIdField:  userId,
+			commands.SMSCodePurposeField: purpose,
+		}).
+		Where(sq.Gt{commands.SMSCodeExpiresAtField: time.Now().UTC()}).
+		ToSql()
+	if err != nil {
+		return entities.SMSCode{}, err
+	}
+
+	var code entities.SMSCode
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(
+		&code.Id,
+		&code.UserId,
+		&code.Phone,
+		&code.Purpose,
+		&code.CodeHash,
+		&code.Attempts,
+		&code.ExpiresAt,
+		&code.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.SMSCode{}, repositories.ErrEntityNotFound
+		}
+		return entities.SMSCode{}, err
+	}
+	return code, nil
+}
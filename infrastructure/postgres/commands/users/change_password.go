@@ -0,0 +1,89 @@
+package users
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type changeUserPasswordCommand struct {
+	client *postgres.Client
+}
+
+func NewChangeUserPasswordCommand(client *postgres.Client) repositories.ChangeUserPasswordCommand {
+	return &changeUserPasswordCommand{client: client}
+}
+
+// Execute moves the current hash to the history, sets the new password,
+// keeps only the last historySize entries and queues the webhook event in
+// one transaction.
+func (c *changeUserPasswordCommand) Execute(context context.Context, userId string, password entities.Password, historySize int, event entities.WebhookEvent) error {
+	archiveSql, archiveArgs, err := c.client.Builder.
+		Insert(commands.PasswordHistoryTable).
+		Columns(commands.PasswordHistoryUserIdField, commands.PasswordHistoryPasswordHash).
+		Select(sq.
+			Select(commands.UserIdField, commands.UserPasswordField).
+			From(commands.UserTable).
+			Where(sq.Eq{commands.UserIdField: userId})).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	updateSql, updateArgs, err := c.client.Builder.
+		Update(commands.UserTable).
+		Set(commands.UserPasswordField, password).
+		Set(commands.UserPasswordChangedAtField, sq.Expr("NOW()")).
+		Where(sq.Eq{commands.UserIdField: userId}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	// The sub-query is built with ? placeholders, they are numbered together
+	// with the ones of the outer query.
+	kept := sq.
+		Select(commands.PasswordHistoryIdField).
+		From(commands.PasswordHistoryTable).
+		Where(sq.Eq{commands.PasswordHistoryUserIdField: userId}).
+		OrderBy(commands.PasswordHistoryIdField + " DESC").
+		Limit(uint64(max(historySize, 0)))
+	keptSql, keptArgs, err := kept.ToSql()
+	if err != nil {
+		return err
+	}
+	trimSql, trimArgs, err := c.client.Builder.
+		Delete(commands.PasswordHistoryTable).
+		Where(sq.Eq{commands.PasswordHistoryUserIdField: userId}).
+		Where(sq.Expr(commands.PasswordHistoryIdField+" NOT IN ("+keptSql+")", keptArgs...)).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(context, archiveSql, archiveArgs...)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return repositories.ErrEntityNotFound
+		}
+
+		_, err = tx.Exec(context, updateSql, updateArgs...)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(context, trimSql, trimArgs...)
+		if err != nil {
+			return err
+		}
+
+		return commands.EnqueueWebhookEvent(context, tx, c.client.Builder, event)
+	})
+}
@@ -0,0 +1,34 @@
+package users
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+)
+
+type countUsersCommand struct {
+	client *postgres.Client
+}
+
+func NewCountUsersCommand(client *postgres.Client) repositories.CountUsersCommand {
+	return &countUsersCommand{client: client}
+}
+
+func (c *countUsersCommand) Execute(context context.Context, filter repositories.UserFilter) (int, error) {
+	builder := c.client.Builder.
+		Select("COUNT(*)").
+		From(commands.UserTable)
+
+	sql, args, err := applyUserFilter(builder, filter).ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
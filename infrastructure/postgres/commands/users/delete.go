@@ -0,0 +1,55 @@
+package users
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type deleteUserCommand struct {
+	client *postgres.Client
+}
+
+func NewDeleteUserCommand(client *postgres.Client) repositories.DeleteUserCommand {
+	return &deleteUserCommand{client: client}
+}
+
+// Execute deletes the user and queues the webhook and the domain events in
+// one transaction. The last holder of the admin role is not deleted.
+func (c *deleteUserCommand) Execute(context context.Context, id string, event entities.WebhookEvent) error {
+	sql, args, err := c.client.Builder.
+		Delete(commands.UserTable).
+		Where(sq.Eq{commands.UserIdField: id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		last, err := lastAdmin(context, c.client, tx, id)
+		if err != nil {
+			return err
+		}
+		if last {
+			return repositories.ErrLastAdmin
+		}
+
+		tag, err := tx.Exec(context, sql, args...)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return repositories.ErrEntityNotFound
+		}
+
+		err = commands.EnqueueWebhookEvent(context, tx, c.client.Builder, event)
+		if err != nil {
+			return err
+		}
+		return commands.EnqueueDomainEvent(context, tx, c.client.Builder, entities.NewUserDeletedEvent(id))
+	})
+}
@@ -0,0 +1,19 @@
+package users
+
+import (
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	sq "github.com/Masterminds/squirrel"
+	"strings"
+)
+
+func applyUserFilter(builder sq.SelectBuilder, filter repositories.UserFilter) sq.SelectBuilder {
+	if filter.Email != "" {
+		pattern := "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(filter.Email) + "%"
+		builder = builder.Where(sq.ILike{commands.UserEmailField: pattern})
+	}
+	if filter.Status != "" {
+		builder = builder.Where(sq.Eq{commands.UserStatusField: filter.Status})
+	}
+	return builder
+}
@@ -6,6 +6,7 @@ import (
 	"auth/internal/entities"
 	"auth/internal/repositories"
 	"context"
+	"github.com/jackc/pgx/v5"
 )
 
 type insertUserPGCommand struct {
@@ -17,11 +18,29 @@ func NewInsertUserPGCommand(client *postgres.Client) repositories.InsertUserComm
 }
 
 func (c *insertUserPGCommand) Execute(context context.Context, user entities.User) (string, error) {
-	sql, args, err := c.client.Builder.Insert(commands.UserTable).
+	var id string
+	err := pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		var err error
+		id, err = InsertUser(context, c.client, tx, user)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// InsertUser inserts the user together with the roles inside the given
+// transaction and queues the user.created event, it is shared with the
+// commands that create users as a part of a bigger change such as an
+// accepted invitation.
+func InsertUser(context context.Context, client *postgres.Client, tx pgx.Tx, user entities.User) (string, error) {
+	sql, args, err := client.Builder.Insert(commands.UserTable).
 		Columns(
 			commands.UserEmailField,
-			commands.UserPasswordField).
-		Values(user.Email, user.Password).
+			commands.UserPasswordField,
+			commands.UserStatusField).
+		Values(user.Email, user.Password, user.Status).
 		Suffix("RETURNING " + commands.UserIdField).
 		ToSql()
 	if err != nil {
@@ -29,9 +48,13 @@ func (c *insertUserPGCommand) Execute(context context.Context, user entities.Use
 	}
 
 	var id string
-	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&id)
+	err = tx.QueryRow(context, sql, args...).Scan(&id)
 	if err != nil {
 		return "", err
 	}
-	return id, nil
+	err = insertUserRoles(context, client, tx, id, user.Roles)
+	if err != nil {
+		return "", err
+	}
+	return id, commands.EnqueueDomainEvent(context, tx, client.Builder, entities.NewUserCreatedEvent(id, string(user.Email)))
 }
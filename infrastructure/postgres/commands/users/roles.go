@@ -0,0 +1,76 @@
+package users
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+func insertUserRoles(context context.Context, client *postgres.Client, tx pgx.Tx, userId string, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	sql, args, err := client.Builder.
+		Insert(commands.UserRoleTable).
+		Columns(commands.UserRoleUserIdField, commands.UserRoleRoleIdField).
+		Select(client.Builder.
+			Select().
+			Column(sq.Expr("?::uuid", userId)).
+			Column(commands.RoleIdField).
+			From(commands.RoleTable).
+			Where(sq.Eq{commands.RoleNameField: roles})).
+		Suffix("ON CONFLICT DO NOTHING").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(context, sql, args...)
+	return err
+}
+
+// lastAdmin locks the admin role and reports whether the user is its only
+// holder. The lock keeps two administrators from demoting each other at once.
+func lastAdmin(context context.Context, client *postgres.Client, tx pgx.Tx, userId string) (bool, error) {
+	sql, args, err := client.Builder.
+		Select(commands.RoleIdField).
+		From(commands.RoleTable).
+		Where(sq.Eq{commands.RoleNameField: entities.RoleAdmin}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var roleId int
+	err = tx.QueryRow(context, sql, args...).Scan(&roleId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	sql, args, err = client.Builder.
+		Select().
+		Column(sq.Expr("COUNT(*) FILTER (WHERE "+commands.UserRoleUserIdField+" = ?)", userId)).
+		Column("COUNT(*)").
+		From(commands.UserRoleTable).
+		Where(sq.Eq{commands.UserRoleRoleIdField: roleId}).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var held, holders int
+	err = tx.QueryRow(context, sql, args...).Scan(&held, &holders)
+	if err != nil {
+		return false, err
+	}
+	return held > 0 && holders == 1, nil
+}
@@ -2,17 +2,71 @@ package users
 
 import (
 	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
 	"auth/internal/entities"
 	"auth/internal/repositories"
 	"context"
 	"errors"
 	"github.com/jackc/pgx/v5"
+	"time"
 )
 
-func selectUser(context context.Context, client *postgres.Client, sql string, args []any) (entities.User, error) {
+var userColumns = []string{
+	commands.UserIdField,
+	commands.UserEmailField,
+	commands.UserPasswordField,
+	commands.UserCreatedAtField,
+	commands.UserStatusField,
+	commands.UserStatusReasonField,
+	commands.UserLockedUntilField,
+	commands.UserPasswordChangedAtField,
+	commands.UserPhoneField,
+	commands.UserPhoneVerifiedAtField,
+	userRolesColumn,
+	userPermissionsColumn,
+}
+
+const (
+	userRolesColumn = `ARRAY(SELECT r.name FROM user_roles ur JOIN roles r ON r.id = ur.role_id
+		WHERE ur.user_id = users.id ORDER BY r.name)`
+	userPermissionsColumn = `ARRAY(SELECT DISTINCT p.name FROM user_roles ur
+		JOIN role_permissions rp ON rp.role_id = ur.role_id
+		JOIN permissions p ON p.id = rp.permission_id
+		WHERE ur.user_id = users.id ORDER BY p.name)`
+)
+
+func scanUser(row pgx.Row) (entities.User, error) {
 	result := entities.User{}
+	var lockedUntil, phoneVerifiedAt *time.Time
+	err := row.Scan(
+		&result.Id,
+		&result.Email,
+		&result.Password,
+		&result.RegistrationDate,
+		&result.Status,
+		&result.StatusReason,
+		&lockedUntil,
+		&result.PasswordChangedAt,
+		&result.Phone,
+		&phoneVerifiedAt,
+		&result.Roles,
+		&result.Permissions,
+	)
+	if err != nil {
+		return entities.User{}, err
+	}
+	if lockedUntil != nil {
+		result.LockedUntil = *lockedUntil
+	}
+	if phoneVerifiedAt != nil {
+		result.PhoneVerifiedAt = *phoneVerifiedAt
+	}
+	return result, nil
+}
+
+func selectUser(context context.Context, client *postgres.Client, sql string, args []any) (entities.User, error) {
 	row := client.Pool.QueryRow(context, sql, args...)
-	err := row.Scan(&result.Id, &result.Email, &result.Password, &result.RegistrationDate)
+	result, err := scanUser(row)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return entities.User{}, repositories.ErrEntityNotFound
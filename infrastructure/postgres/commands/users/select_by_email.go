@@ -19,12 +19,7 @@ func NewSelectUserByEmailCommand(client *postgres.Client) repositories.SelectUse
 
 func (s *selectUserByEmailCommand) Execute(context context.Context, email entities.Email) (entities.User, error) {
 	sql, args, err := s.client.Builder.
-		Select(
-			commands.UserIdField,
-			commands.UserEmailField,
-			commands.UserPasswordField,
-			commands.UserCreatedAtField,
-		).
+		Select(userColumns...).
 		From(commands.UserTable).
 		Where(sq.Eq{commands.UserEmailField: email}).
 		ToSql()
@@ -18,11 +18,7 @@ func NewSelectUserByIdCommand(client *postgres.Client) repositories.SelectUserBy
 }
 
 func (s *selectUserByIdCommand) Execute(context context.Context, id string) (entities.User, error) {
-	sql, args, err := s.client.Builder.Select(
-		commands.UserIdField,
-		commands.UserEmailField,
-		commands.UserPasswordField,
-		commands.UserCreatedAtField).
+	sql, args, err := s.client.Builder.Select(userColumns...).
 		From(commands.UserTable).
 		Where(sq.Eq{commands.UserIdField: id}).
 		ToSql()
@@ -0,0 +1,49 @@
+package users
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type selectUserListCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectUserListCommand(client *postgres.Client) repositories.SelectUserListCommand {
+	return &selectUserListCommand{client: client}
+}
+
+func (s *selectUserListCommand) Execute(context context.Context, filter repositories.UserFilter) ([]entities.User, error) {
+	builder := s.client.Builder.
+		Select(userColumns...).
+		From(commands.UserTable)
+
+	sql, args, err := applyUserFilter(builder, filter).
+		OrderBy(commands.UserCreatedAtField+" DESC", commands.UserIdField).
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]entities.User, 0, filter.Limit)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, user)
+	}
+
+	return result, rows.Err()
+}
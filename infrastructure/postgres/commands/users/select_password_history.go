@@ -0,0 +1,53 @@
+package users
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type selectPasswordHistoryCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectPasswordHistoryCommand(client *postgres.Client) repositories.SelectPasswordHistoryCommand {
+	return &selectPasswordHistoryCommand{client: client}
+}
+
+// Execute returns up to limit previous password hashes, the newest first.
+func (c *selectPasswordHistoryCommand) Execute(context context.Context, userId string, limit int) ([]entities.Password, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	sql, args, err := c.client.Builder.
+		Select(commands.PasswordHistoryPasswordHash).
+		From(commands.PasswordHistoryTable).
+		Where(sq.Eq{commands.PasswordHistoryUserIdField: userId}).
+		OrderBy(commands.PasswordHistoryIdField + " DESC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entities.Password
+	for rows.Next() {
+		var password entities.Password
+		err = rows.Scan(&password)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, password)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,47 @@
+package users
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"time"
+)
+
+type updateUserCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdateUserCommand(client *postgres.Client) repositories.UpdateUserCommand {
+	return &updateUserCommand{client: client}
+}
+
+func (c *updateUserCommand) Execute(context context.Context, user entities.User) error {
+	var lockedUntil *time.Time
+	if !user.LockedUntil.IsZero() {
+		lockedUntil = &user.LockedUntil
+	}
+
+	sql, args, err := c.client.Builder.
+		Update(commands.UserTable).
+		Set(commands.UserEmailField, user.Email).
+		Set(commands.UserStatusField, user.Status).
+		Set(commands.UserStatusReasonField, user.StatusReason).
+		Set(commands.UserLockedUntilField, lockedUntil).
+		Where(sq.Eq{commands.UserIdField: user.Id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
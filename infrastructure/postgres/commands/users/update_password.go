@@ -0,0 +1,38 @@
+package users
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type updateUserPasswordCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdateUserPasswordCommand(client *postgres.Client) repositories.UpdateUserPasswordCommand {
+	return &updateUserPasswordCommand{client: client}
+}
+
+func (c *updateUserPasswordCommand) Execute(context context.Context, userId string, password entities.Password) error {
+	sql, args, err := c.client.Builder.
+		Update(commands.UserTable).
+		Set(commands.UserPasswordField, password).
+		Where(sq.Eq{commands.UserIdField: userId}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package users
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type updateUserPhoneCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdateUserPhoneCommand(client *postgres.Client) repositories.UpdateUserPhoneCommand {
+	return &updateUserPhoneCommand{client: client}
+}
+
+// Execute saves the phone the user has confirmed with a code.
+func (c *updateUserPhoneCommand) Execute(context context.Context, userId string, phone entities.Phone) error {
+	sql, args, err := c.client.Builder.
+		Update(commands.UserTable).
+		Set(commands.UserPhoneField, string(phone)).
+		Set(commands.UserPhoneVerifiedAtField, sq.Expr("NOW()")).
+		Where(sq.Eq{commands.UserIdField: userId}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
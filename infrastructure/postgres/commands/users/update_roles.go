@@ -0,0 +1,50 @@
+package users
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"slices"
+)
+
+type updateUserRolesCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdateUserRolesCommand(client *postgres.Client) repositories.UpdateUserRolesCommand {
+	return &updateUserRolesCommand{client: client}
+}
+
+// Execute replaces the roles of the user, taking the admin role away from its
+// last holder fails with ErrLastAdmin.
+func (c *updateUserRolesCommand) Execute(context context.Context, userId string, roles []string) error {
+	sql, args, err := c.client.Builder.
+		Delete(commands.UserRoleTable).
+		Where(sq.Eq{commands.UserRoleUserIdField: userId}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(context, c.client.Pool, func(tx pgx.Tx) error {
+		if !slices.Contains(roles, entities.RoleAdmin) {
+			last, err := lastAdmin(context, c.client, tx, userId)
+			if err != nil {
+				return err
+			}
+			if last {
+				return repositories.ErrLastAdmin
+			}
+		}
+
+		_, err := tx.Exec(context, sql, args...)
+		if err != nil {
+			return err
+		}
+		return insertUserRoles(context, c.client, tx, userId, roles)
+	})
+}
@@ -1,20 +1,268 @@
 package commands
 
 const (
-	UserTable          = "users"
-	UserIdField        = "id"
-	UserEmailField     = "email"
-	UserPasswordField  = "password"
-	UserCreatedAtField = "created_at"
-)
-
-const (
-	SessionTable            = "sessions"
-	SessionIdField          = "id"
-	SessionUserIdField      = "user_id"
-	SessionRefreshTokenHash = "refresh_token_hash"
-	SessionUserAgentField   = "user_agent"
-	SessionIPField          = "ip_address"
-	SessionCreatedAtField   = "created_at"
-	SessionExpiresAtField   = "expires_at"
+	UserTable                  = "users"
+	UserIdField                = "id"
+	UserEmailField             = "email"
+	UserPasswordField          = "password"
+	UserCreatedAtField         = "created_at"
+	UserStatusField            = "status"
+	UserStatusReasonField      = "status_reason"
+	UserLockedUntilField       = "locked_until"
+	UserPasswordChangedAtField = "password_changed_at"
+	UserPhoneField             = "phone"
+	UserPhoneVerifiedAtField   = "phone_verified_at"
+)
+
+const (
+	SessionTable               = "sessions"
+	SessionIdField             = "id"
+	SessionUserIdField         = "user_id"
+	SessionOrganizationIdField = "organization_id"
+	SessionRefreshTokenHash    = "refresh_token_hash"
+	SessionUserAgentField      = "user_agent"
+	SessionIPField             = "ip_address"
+	SessionCreatedAtField      = "created_at"
+	SessionExpiresAtField      = "expires_at"
+	SessionAuthMethodsField    = "auth_methods"
+	SessionAuthTimeField       = "auth_time"
+)
+
+const (
+	RoleTable            = "roles"
+	RoleIdField          = "id"
+	RoleNameField        = "name"
+	RoleDescriptionField = "description"
+)
+
+const (
+	PermissionTable            = "permissions"
+	PermissionIdField          = "id"
+	PermissionNameField        = "name"
+	PermissionDescriptionField = "description"
+)
+
+const (
+	RolePermissionTable             = "role_permissions"
+	RolePermissionRoleIdField       = "role_id"
+	RolePermissionPermissionIdField = "permission_id"
+)
+
+const (
+	UserRoleTable       = "user_roles"
+	UserRoleUserIdField = "user_id"
+	UserRoleRoleIdField = "role_id"
+)
+
+const (
+	OrganizationTable               = "organizations"
+	OrganizationIdField             = "id"
+	OrganizationNameField           = "name"
+	OrganizationCreatedAtField      = "created_at"
+	OrganizationPasswordMaxAgeField = "password_max_age"
+)
+
+const (
+	MemberTable               = "organization_members"
+	MemberOrganizationIdField = "organization_id"
+	MemberUserIdField         = "user_id"
+	MemberRoleField           = "role"
+	MemberCreatedAtField      = "created_at"
+)
+
+const (
+	InvitationTable               = "invitations"
+	InvitationIdField             = "id"
+	InvitationOrganizationIdField = "organization_id"
+	InvitationEmailField          = "email"
+	InvitationRoleField           = "role"
+	InvitationUserRoleField       = "user_role"
+	InvitationTokenHashField      = "token_hash"
+	InvitationInvitedByField      = "invited_by"
+	InvitationCreatedAtField      = "created_at"
+	InvitationExpiresAtField      = "expires_at"
+	InvitationAcceptedAtField     = "accepted_at"
+)
+
+const (
+	PasswordHistoryTable        = "password_history"
+	PasswordHistoryIdField      = "id"
+	PasswordHistoryUserIdField  = "user_id"
+	PasswordHistoryPasswordHash = "password_hash"
+)
+
+const (
+	LoginAttemptTable              = "login_attempts"
+	LoginAttemptKeyField           = "key"
+	LoginAttemptFailuresField      = "failures"
+	LoginAttemptLastFailureAtField = "last_failure_at"
+	LoginAttemptLockedUntilField   = "locked_until"
+)
+
+const (
+	RateLimitBucketTable          = "rate_limit_buckets"
+	RateLimitBucketKeyField       = "key"
+	RateLimitBucketTokensField    = "tokens"
+	RateLimitBucketUpdatedAtField = "updated_at"
+	RateLimitBucketFullAtField    = "full_at"
+)
+
+const (
+	TOTPTable             = "user_totp"
+	TOTPUserIdField       = "user_id"
+	TOTPSecretField       = "secret"
+	TOTPConfirmedAtField  = "confirmed_at"
+	TOTPLastUsedStepField = "last_used_step"
+	TOTPCreatedAtField    = "created_at"
+)
+
+const (
+	RecoveryCodeTable         = "recovery_codes"
+	RecoveryCodeIdField       = "id"
+	RecoveryCodeUserIdField   = "user_id"
+	RecoveryCodeCodeHashField = "code_hash"
+	RecoveryCodeUsedAtField   = "used_at"
+)
+
+const (
+	WebAuthnCredentialTable                = "webauthn_credentials"
+	WebAuthnCredentialIdField              = "id"
+	WebAuthnCredentialUserIdField          = "user_id"
+	WebAuthnCredentialNameField            = "name"
+	WebAuthnCredentialPublicKeyField       = "public_key"
+	WebAuthnCredentialAttestationTypeField = "attestation_type"
+	WebAuthnCredentialAAGUIDField          = "aaguid"
+	WebAuthnCredentialSignCountField       = "sign_count"
+	WebAuthnCredentialTransportsField      = "transports"
+	WebAuthnCredentialBackupEligibleField  = "backup_eligible"
+	WebAuthnCredentialBackupStateField     = "backup_state"
+	WebAuthnCredentialCreatedAtField       = "created_at"
+	WebAuthnCredentialLastUsedAtField      = "last_used_at"
+)
+
+const (
+	WebAuthnSessionTable            = "webauthn_sessions"
+	WebAuthnSessionIdField          = "id"
+	WebAuthnSessionUserIdField      = "user_id"
+	WebAuthnSessionPurposeField     = "purpose"
+	WebAuthnSessionDataField        = "data"
+	WebAuthnSessionAuthMethodsField = "auth_methods"
+	WebAuthnSessionExpiresAtField   = "expires_at"
+)
+
+const (
+	PasswordlessTokenTable           = "passwordless_tokens"
+	PasswordlessTokenIdField         = "id"
+	PasswordlessTokenEmailField      = "email"
+	PasswordlessTokenMethodField     = "method"
+	PasswordlessTokenSecretHashField = "secret_hash"
+	PasswordlessTokenDeviceHashField = "device_hash"
+	PasswordlessTokenAttemptsField   = "attempts"
+	PasswordlessTokenExpiresAtField  = "expires_at"
+	PasswordlessTokenCreatedAtField  = "created_at"
+)
+
+const (
+	TrustedDeviceTable           = "trusted_devices"
+	TrustedDeviceIdField         = "id"
+	TrustedDeviceUserIdField     = "user_id"
+	TrustedDeviceUserAgentField  = "user_agent"
+	TrustedDeviceIPField         = "ip_address"
+	TrustedDeviceCreatedAtField  = "created_at"
+	TrustedDeviceLastUsedAtField = "last_used_at"
+	TrustedDeviceExpiresAtField  = "expires_at"
+)
+
+const (
+	SMSCodeTable          = "sms_codes"
+	SMSCodeIdField        = "id"
+	SMSCodeUserIdField    = "user_id"
+	SMSCodePhoneField     = "phone"
+	SMSCodePurposeField   = "purpose"
+	SMSCodeHashField      = "code_hash"
+	SMSCodeAttemptsField  = "attempts"
+	SMSCodeExpiresAtField = "expires_at"
+	SMSCodeCreatedAtField = "created_at"
+)
+
+const (
+	AuditEventTable          = "audit_events"
+	AuditEventIdField        = "id"
+	AuditEventTypeField      = "type"
+	AuditEventActorIdField   = "actor_id"
+	AuditEventSubjectIdField = "subject_id"
+	AuditEventIPField        = "ip_address"
+	AuditEventUserAgentField = "user_agent"
+	AuditEventOutcomeField   = "outcome"
+	AuditEventReasonField    = "reason"
+	AuditEventCreatedAtField = "created_at"
+)
+
+const (
+	WebhookEndpointTable           = "webhook_endpoints"
+	WebhookEndpointIdField         = "id"
+	WebhookEndpointURLField        = "url"
+	WebhookEndpointSecretField     = "secret"
+	WebhookEndpointEventTypesField = "event_types"
+	WebhookEndpointActiveField     = "active"
+	WebhookEndpointCreatedAtField  = "created_at"
+	WebhookEndpointUpdatedAtField  = "updated_at"
+)
+
+const (
+	WebhookDeliveryTable               = "webhook_deliveries"
+	WebhookDeliveryIdField             = "id"
+	WebhookDeliveryEndpointIdField     = "endpoint_id"
+	WebhookDeliveryEventIdField        = "event_id"
+	WebhookDeliveryEventTypeField      = "event_type"
+	WebhookDeliveryPayloadField        = "payload"
+	WebhookDeliveryStatusField         = "status"
+	WebhookDeliveryAttemptsField       = "attempts"
+	WebhookDeliveryNextAttemptAtField  = "next_attempt_at"
+	WebhookDeliveryLastStatusCodeField = "last_status_code"
+	WebhookDeliveryLastErrorField      = "last_error"
+	WebhookDeliveryCreatedAtField      = "created_at"
+	WebhookDeliveryDeliveredAtField    = "delivered_at"
+)
+
+const (
+	WebhookAlertTable           = "webhook_alerts"
+	WebhookAlertIdField         = "id"
+	WebhookAlertEventIdField    = "event_id"
+	WebhookAlertTypeField       = "type"
+	WebhookAlertUserIdField     = "user_id"
+	WebhookAlertIPField         = "ip_address"
+	WebhookAlertPreviousIPField = "previous_ip_address"
+	WebhookAlertUserAgentField  = "user_agent"
+	WebhookAlertOccurredAtField = "occurred_at"
+	WebhookAlertReceivedAtField = "received_at"
+)
+
+const (
+	WebhookNonceTable          = "webhook_nonces"
+	WebhookNonceNonceField     = "nonce"
+	WebhookNonceExpiresAtField = "expires_at"
+)
+
+const (
+	DomainEventTable            = "domain_events"
+	DomainEventIdField          = "id"
+	DomainEventSequenceField    = "sequence"
+	DomainEventTypeField        = "type"
+	DomainEventUserIdField      = "user_id"
+	DomainEventDataField        = "data"
+	DomainEventCreatedAtField   = "created_at"
+	DomainEventPublishedAtField = "published_at"
+)
+
+const (
+	LoginHistoryTable          = "login_history"
+	LoginHistoryIdField        = "id"
+	LoginHistoryUserIdField    = "user_id"
+	LoginHistoryIPField        = "ip_address"
+	LoginHistoryUserAgentField = "user_agent"
+	LoginHistoryCountryField   = "country"
+	LoginHistoryLatitudeField  = "latitude"
+	LoginHistoryLongitudeField = "longitude"
+	LoginHistoryCreatedAtField = "created_at"
 )
@@ -0,0 +1,67 @@
+package webauthn
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type insertCredentialCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertCredentialCommand(client *postgres.Client) repositories.InsertWebAuthnCredentialCommand {
+	return &insertCredentialCommand{client: client}
+}
+
+// Execute yields ErrEntityAlreadyExists if the credential id has already been
+// registered, by this or by another user.
+func (c *insertCredentialCommand) Execute(context context.Context, credential entities.WebAuthnCredential) error {
+	transports := credential.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+
+	sql, args, err := c.client.Builder.
+		Insert(commands.WebAuthnCredentialTable).
+		Columns(
+			commands.WebAuthnCredentialIdField,
+			commands.WebAuthnCredentialUserIdField,
+			commands.WebAuthnCredentialNameField,
+			commands.WebAuthnCredentialPublicKeyField,
+			commands.WebAuthnCredentialAttestationTypeField,
+			commands.WebAuthnCredentialAAGUIDField,
+			commands.WebAuthnCredentialSignCountField,
+			commands.WebAuthnCredentialTransportsField,
+			commands.WebAuthnCredentialBackupEligibleField,
+			commands.WebAuthnCredentialBackupStateField,
+		).
+		Values(
+			credential.Id,
+			credential.UserId,
+			credential.Name,
+			credential.PublicKey,
+			credential.AttestationType,
+			credential.AAGUID,
+			int64(credential.SignCount),
+			transports,
+			credential.BackupEligible,
+			credential.BackupState,
+		).
+		Suffix("ON CONFLICT (" + commands.WebAuthnCredentialIdField + ") DO NOTHING").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityAlreadyExists
+	}
+	return nil
+}
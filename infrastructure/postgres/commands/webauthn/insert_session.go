@@ -0,0 +1,50 @@
+package webauthn
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type insertSessionCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertSessionCommand(client *postgres.Client) repositories.InsertWebAuthnSessionCommand {
+	return &insertSessionCommand{client: client}
+}
+
+func (c *insertSessionCommand) Execute(context context.Context, session entities.WebAuthnSession) (string, error) {
+	authMethods := session.AuthMethods
+	if authMethods == nil {
+		authMethods = []string{}
+	}
+
+	sql, args, err := c.client.Builder.
+		Insert(commands.WebAuthnSessionTable).
+		Columns(
+			commands.WebAuthnSessionUserIdField,
+			commands.WebAuthnSessionPurposeField,
+			commands.WebAuthnSessionDataField,
+			commands.WebAuthnSessionAuthMethodsField,
+			commands.WebAuthnSessionExpiresAtField,
+		).
+		Values(
+			commands.NullIfEmpty(session.UserId),
+			session.Purpose,
+			session.Data,
+			authMethods,
+			session.ExpiresAt.UTC(),
+		).
+		Suffix("RETURNING " + commands.WebAuthnSessionIdField).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	err = c.client.Pool.QueryRow(context, sql, args...).Scan(&id)
+	return id, err
+}
@@ -0,0 +1,80 @@
+package webauthn
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"time"
+)
+
+type selectCredentialsCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectCredentialsCommand(client *postgres.Client) repositories.SelectWebAuthnCredentialsCommand {
+	return &selectCredentialsCommand{client: client}
+}
+
+func (c *selectCredentialsCommand) Execute(context context.Context, userId string) ([]entities.WebAuthnCredential, error) {
+	sql, args, err := c.client.Builder.
+		Select(
+			commands.WebAuthnCredentialIdField,
+			commands.WebAuthnCredentialUserIdField,
+			commands.WebAuthnCredentialNameField,
+			commands.WebAuthnCredentialPublicKeyField,
+			commands.WebAuthnCredentialAttestationTypeField,
+			commands.WebAuthnCredentialAAGUIDField,
+			commands.WebAuthnCredentialSignCountField,
+			commands.WebAuthnCredentialTransportsField,
+			commands.WebAuthnCredentialBackupEligibleField,
+			commands.WebAuthnCredentialBackupStateField,
+			commands.WebAuthnCredentialCreatedAtField,
+			commands.WebAuthnCredentialLastUsedAtField,
+		).
+		From(commands.WebAuthnCredentialTable).
+		Where(sq.Eq{commands.WebAuthnCredentialUserIdField: userId}).
+		OrderBy(commands.WebAuthnCredentialCreatedAtField).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entities.WebAuthnCredential
+	for rows.Next() {
+		var credential entities.WebAuthnCredential
+		var signCount int64
+		var lastUsedAt *time.Time
+		err = rows.Scan(
+			&credential.Id,
+			&credential.UserId,
+			&credential.Name,
+			&credential.PublicKey,
+			&credential.AttestationType,
+			&credential.AAGUID,
+			&signCount,
+			&credential.Transports,
+			&credential.BackupEligible,
+			&credential.BackupState,
+			&credential.CreatedAt,
+			&lastUsedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		credential.SignCount = uint32(signCount)
+		if lastUsedAt != nil {
+			credential.LastUsedAt = *lastUsedAt
+		}
+		result = append(result, credential)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,77 @@
+package webauthn
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"time"
+)
+
+type takeSessionCommand struct {
+	client *postgres.Client
+}
+
+func NewTakeSessionCommand(client *postgres.Client) repositories.TakeWebAuthnSessionCommand {
+	return &takeSessionCommand{client: client}
+}
+
+// Execute deletes and returns the session so that every challenge is used
+// only once. Expired sessions are cleaned up on the way and yield
+// ErrEntityNotFound.
+func (c *takeSessionCommand) Execute(context context.Context, id string) (entities.WebAuthnSession, error) {
+	if uuid.Validate(id) != nil {
+		return entities.WebAuthnSession{}, repositories.ErrEntityNotFound
+	}
+
+	now := time.Now().UTC()
+	sql, args, err := c.client.Builder.
+		Delete(commands.WebAuthnSessionTable).
+		Where(sq.Or{
+			sq.Eq{commands.WebAuthnSessionIdField: id},
+			sq.Lt{commands.WebAuthnSessionExpiresAtField: now},
+		}).
+		Suffix(fmt.Sprintf(
+			"RETURNING %s, COALESCE(%s::text, ''), %s, %s, %s, %s",
+			commands.WebAuthnSessionIdField,
+			commands.WebAuthnSessionUserIdField,
+			commands.WebAuthnSessionPurposeField,
+			commands.WebAuthnSessionDataField,
+			commands.WebAuthnSessionAuthMethodsField,
+			commands.WebAuthnSessionExpiresAtField,
+		)).
+		ToSql()
+	if err != nil {
+		return entities.WebAuthnSession{}, err
+	}
+
+	rows, err := c.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return entities.WebAuthnSession{}, err
+	}
+	defer rows.Close()
+
+	var result entities.WebAuthnSession
+	found := false
+	for rows.Next() {
+		var session entities.WebAuthnSession
+		err = rows.Scan(&session.Id, &session.UserId, &session.Purpose, &session.Data, &session.AuthMethods, &session.ExpiresAt)
+		if err != nil {
+			return entities.WebAuthnSession{}, err
+		}
+		if session.Id == id && !session.IsExpired(now) {
+			result, found = session, true
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return entities.WebAuthnSession{}, err
+	}
+	if !found {
+		return entities.WebAuthnSession{}, repositories.ErrEntityNotFound
+	}
+	return result, nil
+}
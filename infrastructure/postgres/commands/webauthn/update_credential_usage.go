@@ -0,0 +1,43 @@
+package webauthn
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type updateCredentialUsageCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdateCredentialUsageCommand(client *postgres.Client) repositories.UpdateWebAuthnCredentialUsageCommand {
+	return &updateCredentialUsageCommand{client: client}
+}
+
+// Execute saves the signature counter and the backup state reported by the
+// authenticator at sign in.
+func (c *updateCredentialUsageCommand) Execute(context context.Context, credential entities.WebAuthnCredential) error {
+	sql, args, err := c.client.Builder.
+		Update(commands.WebAuthnCredentialTable).
+		Set(commands.WebAuthnCredentialSignCountField, int64(credential.SignCount)).
+		Set(commands.WebAuthnCredentialBackupStateField, credential.BackupState).
+		Set(commands.WebAuthnCredentialLastUsedAtField, sq.Expr("NOW()")).
+		Where(sq.Eq{commands.WebAuthnCredentialIdField: credential.Id}).
+		Where(sq.Eq{commands.WebAuthnCredentialUserIdField: credential.UserId}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
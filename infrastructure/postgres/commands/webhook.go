@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"auth/internal/entities"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// EnqueueWebhookEvent writes the event to the outbox as a pending delivery
+// for every active endpoint subscribed to it. It runs in the transaction of
+// the change that caused the event, so the event is queued if and only if
+// the change is committed.
+func EnqueueWebhookEvent(context context.Context, tx pgx.Tx, builder sq.StatementBuilderType, event entities.WebhookEvent) error {
+	if event.Id == "" {
+		event.Id = uuid.NewString()
+	}
+	payload, err := event.Payload()
+	if err != nil {
+		return err
+	}
+
+	endpoints := sq.
+		Select(WebhookEndpointIdField).
+		Column("?::uuid", event.Id).
+		Column("?", event.Type).
+		Column("?::jsonb", string(payload)).
+		From(WebhookEndpointTable).
+		Where(sq.Eq{WebhookEndpointActiveField: true}).
+		Where(sq.Or{
+			sq.Expr("cardinality(" + WebhookEndpointEventTypesField + ") = 0"),
+			sq.Expr("? = ANY("+WebhookEndpointEventTypesField+")", event.Type),
+		})
+
+	sql, args, err := builder.
+		Insert(WebhookDeliveryTable).
+		Columns(
+			WebhookDeliveryEndpointIdField,
+			WebhookDeliveryEventIdField,
+			WebhookDeliveryEventTypeField,
+			WebhookDeliveryPayloadField,
+		).
+		Select(endpoints).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(context, sql, args...)
+	return err
+}
@@ -0,0 +1,101 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"time"
+)
+
+type claimDeliveriesCommand struct {
+	client *postgres.Client
+}
+
+func NewClaimDeliveriesCommand(client *postgres.Client) repositories.ClaimWebhookDeliveriesCommand {
+	return &claimDeliveriesCommand{client: client}
+}
+
+// Execute takes up to limit pending deliveries that are due and postpones
+// them by the lease, so that the other workers skip them while they are
+// sent. The deliveries of paused endpoints are left in the queue. They are
+// filtered out before the limit is applied, otherwise a backlog of a paused
+// endpoint would fill every batch and starve the active ones.
+func (c *claimDeliveriesCommand) Execute(context context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error) {
+	now := time.Now().UTC()
+
+	// Only the delivery rows are locked, the endpoint rows stay available to
+	// the administrators and the other workers.
+	due := sq.
+		Select("dd." + commands.WebhookDeliveryIdField).
+		From(commands.WebhookDeliveryTable + " dd").
+		Join(fmt.Sprintf(
+			"%s de ON de.%s = dd.%s",
+			commands.WebhookEndpointTable,
+			commands.WebhookEndpointIdField,
+			commands.WebhookDeliveryEndpointIdField,
+		)).
+		Where(sq.Eq{"de." + commands.WebhookEndpointActiveField: true}).
+		Where(sq.Eq{"dd." + commands.WebhookDeliveryStatusField: entities.WebhookDeliveryPending}).
+		Where(sq.LtOrEq{"dd." + commands.WebhookDeliveryNextAttemptAtField: now}).
+		OrderBy("dd." + commands.WebhookDeliveryNextAttemptAtField).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE OF dd SKIP LOCKED")
+
+	sql, args, err := c.client.Builder.
+		Update(commands.WebhookDeliveryTable+" d").
+		Set(commands.WebhookDeliveryNextAttemptAtField, now.Add(lease)).
+		From(commands.WebhookEndpointTable + " e").
+		Where("e." + commands.WebhookEndpointIdField + " = d." + commands.WebhookDeliveryEndpointIdField).
+		Where(sq.Expr("d."+commands.WebhookDeliveryIdField+" IN (?)", due)).
+		Suffix(fmt.Sprintf(
+			"RETURNING d.%s, d.%s, d.%s, d.%s, d.%s, d.%s, d.%s, d.%s, e.%s, e.%s",
+			commands.WebhookDeliveryIdField,
+			commands.WebhookDeliveryEndpointIdField,
+			commands.WebhookDeliveryEventIdField,
+			commands.WebhookDeliveryEventTypeField,
+			commands.WebhookDeliveryPayloadField,
+			commands.WebhookDeliveryStatusField,
+			commands.WebhookDeliveryAttemptsField,
+			commands.WebhookDeliveryCreatedAtField,
+			commands.WebhookEndpointURLField,
+			commands.WebhookEndpointSecretField,
+		)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]entities.WebhookDelivery, 0, limit)
+	for rows.Next() {
+		var delivery entities.WebhookDelivery
+		err = rows.Scan(
+			&delivery.Id,
+			&delivery.EndpointId,
+			&delivery.EventId,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.CreatedAt,
+			&delivery.URL,
+			&delivery.Secret,
+		)
+		if err != nil {
+			return nil, err
+		}
+		delivery.NextAttemptAt = now.Add(lease)
+		result = append(result, delivery)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,42 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+type deleteEndpointCommand struct {
+	client *postgres.Client
+}
+
+func NewDeleteEndpointCommand(client *postgres.Client) repositories.DeleteWebhookEndpointCommand {
+	return &deleteEndpointCommand{client: client}
+}
+
+// Execute removes the endpoint together with its deliveries.
+func (c *deleteEndpointCommand) Execute(context context.Context, id string) error {
+	if uuid.Validate(id) != nil {
+		return repositories.ErrEntityNotFound
+	}
+
+	sql, args, err := c.client.Builder.
+		Delete(commands.WebhookEndpointTable).
+		Where(sq.Eq{commands.WebhookEndpointIdField: id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
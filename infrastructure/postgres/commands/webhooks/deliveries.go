@@ -0,0 +1,50 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+var deliveryFields = []string{
+	commands.WebhookDeliveryIdField,
+	commands.WebhookDeliveryEndpointIdField,
+	commands.WebhookDeliveryEventIdField,
+	commands.WebhookDeliveryEventTypeField,
+	commands.WebhookDeliveryPayloadField,
+	commands.WebhookDeliveryStatusField,
+	commands.WebhookDeliveryAttemptsField,
+	commands.WebhookDeliveryNextAttemptAtField,
+	commands.WebhookDeliveryLastStatusCodeField,
+	commands.WebhookDeliveryLastErrorField,
+	commands.WebhookDeliveryCreatedAtField,
+	commands.WebhookDeliveryDeliveredAtField,
+}
+
+// scanDelivery reads the row selected with deliveryFields.
+func scanDelivery(row pgx.Row) (entities.WebhookDelivery, error) {
+	var delivery entities.WebhookDelivery
+	var deliveredAt *time.Time
+	err := row.Scan(
+		&delivery.Id,
+		&delivery.EndpointId,
+		&delivery.EventId,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.LastStatusCode,
+		&delivery.LastError,
+		&delivery.CreatedAt,
+		&deliveredAt,
+	)
+	if err != nil {
+		return entities.WebhookDelivery{}, err
+	}
+	if deliveredAt != nil {
+		delivery.DeliveredAt = *deliveredAt
+	}
+	return delivery, nil
+}
@@ -0,0 +1,32 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"github.com/jackc/pgx/v5"
+)
+
+var endpointFields = []string{
+	commands.WebhookEndpointIdField,
+	commands.WebhookEndpointURLField,
+	commands.WebhookEndpointSecretField,
+	commands.WebhookEndpointEventTypesField,
+	commands.WebhookEndpointActiveField,
+	commands.WebhookEndpointCreatedAtField,
+	commands.WebhookEndpointUpdatedAtField,
+}
+
+// scanEndpoint reads the row selected with endpointFields.
+func scanEndpoint(row pgx.Row) (entities.WebhookEndpoint, error) {
+	var endpoint entities.WebhookEndpoint
+	err := row.Scan(
+		&endpoint.Id,
+		&endpoint.URL,
+		&endpoint.Secret,
+		&endpoint.EventTypes,
+		&endpoint.Active,
+		&endpoint.CreatedAt,
+		&endpoint.UpdatedAt,
+	)
+	return endpoint, err
+}
@@ -0,0 +1,59 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5"
+	"strings"
+)
+
+type insertEndpointCommand struct {
+	client *postgres.Client
+}
+
+func NewInsertEndpointCommand(client *postgres.Client) repositories.InsertWebhookEndpointCommand {
+	return &insertEndpointCommand{client: client}
+}
+
+// Execute returns the created endpoint, it yields ErrEntityAlreadyExists if
+// an endpoint with the URL exists.
+func (c *insertEndpointCommand) Execute(context context.Context, endpoint entities.WebhookEndpoint) (entities.WebhookEndpoint, error) {
+	eventTypes := endpoint.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	sql, args, err := c.client.Builder.
+		Insert(commands.WebhookEndpointTable).
+		Columns(
+			commands.WebhookEndpointURLField,
+			commands.WebhookEndpointSecretField,
+			commands.WebhookEndpointEventTypesField,
+			commands.WebhookEndpointActiveField,
+		).
+		Values(
+			endpoint.URL,
+			endpoint.Secret,
+			eventTypes,
+			endpoint.Active,
+		).
+		Suffix("ON CONFLICT (" + commands.WebhookEndpointURLField + ") DO NOTHING").
+		Suffix("RETURNING " + strings.Join(endpointFields, ", ")).
+		ToSql()
+	if err != nil {
+		return entities.WebhookEndpoint{}, err
+	}
+
+	result, err := scanEndpoint(c.client.Pool.QueryRow(context, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.WebhookEndpoint{}, repositories.ErrEntityAlreadyExists
+		}
+		return entities.WebhookEndpoint{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"strings"
+)
+
+type replayDeliveryCommand struct {
+	client *postgres.Client
+}
+
+func NewReplayDeliveryCommand(client *postgres.Client) repositories.ReplayWebhookDeliveryCommand {
+	return &replayDeliveryCommand{client: client}
+}
+
+// Execute queues the event of the delivery to the same endpoint once more as
+// a new pending delivery, the original one is kept as it is.
+func (c *replayDeliveryCommand) Execute(context context.Context, id string) (entities.WebhookDelivery, error) {
+	if uuid.Validate(id) != nil {
+		return entities.WebhookDelivery{}, repositories.ErrEntityNotFound
+	}
+
+	original := sq.
+		Select(
+			commands.WebhookDeliveryEndpointIdField,
+			commands.WebhookDeliveryEventIdField,
+			commands.WebhookDeliveryEventTypeField,
+			commands.WebhookDeliveryPayloadField,
+		).
+		From(commands.WebhookDeliveryTable).
+		Where(sq.Eq{commands.WebhookDeliveryIdField: id})
+
+	sql, args, err := c.client.Builder.
+		Insert(commands.WebhookDeliveryTable).
+		Columns(
+			commands.WebhookDeliveryEndpointIdField,
+			commands.WebhookDeliveryEventIdField,
+			commands.WebhookDeliveryEventTypeField,
+			commands.WebhookDeliveryPayloadField,
+		).
+		Select(original).
+		Suffix("RETURNING " + strings.Join(deliveryFields, ", ")).
+		ToSql()
+	if err != nil {
+		return entities.WebhookDelivery{}, err
+	}
+
+	result, err := scanDelivery(c.client.Pool.QueryRow(context, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.WebhookDelivery{}, repositories.ErrEntityNotFound
+		}
+		return entities.WebhookDelivery{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,54 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type selectDeliveriesCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectDeliveriesCommand(client *postgres.Client) repositories.SelectWebhookDeliveriesCommand {
+	return &selectDeliveriesCommand{client: client}
+}
+
+// Execute returns the latest deliveries of the endpoint.
+func (c *selectDeliveriesCommand) Execute(context context.Context, filter repositories.WebhookDeliveryFilter) ([]entities.WebhookDelivery, error) {
+	builder := c.client.Builder.
+		Select(deliveryFields...).
+		From(commands.WebhookDeliveryTable).
+		Where(sq.Eq{commands.WebhookDeliveryEndpointIdField: filter.EndpointId})
+	if filter.Status != "" {
+		builder = builder.Where(sq.Eq{commands.WebhookDeliveryStatusField: filter.Status})
+	}
+
+	sql, args, err := builder.
+		OrderBy(commands.WebhookDeliveryCreatedAtField+" DESC", commands.WebhookDeliveryIdField).
+		Limit(uint64(filter.Limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]entities.WebhookDelivery, 0, filter.Limit)
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, delivery)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,45 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type selectEndpointCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectEndpointCommand(client *postgres.Client) repositories.SelectWebhookEndpointCommand {
+	return &selectEndpointCommand{client: client}
+}
+
+func (c *selectEndpointCommand) Execute(context context.Context, id string) (entities.WebhookEndpoint, error) {
+	if uuid.Validate(id) != nil {
+		return entities.WebhookEndpoint{}, repositories.ErrEntityNotFound
+	}
+
+	sql, args, err := c.client.Builder.
+		Select(endpointFields...).
+		From(commands.WebhookEndpointTable).
+		Where(sq.Eq{commands.WebhookEndpointIdField: id}).
+		ToSql()
+	if err != nil {
+		return entities.WebhookEndpoint{}, err
+	}
+
+	result, err := scanEndpoint(c.client.Pool.QueryRow(context, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.WebhookEndpoint{}, repositories.ErrEntityNotFound
+		}
+		return entities.WebhookEndpoint{}, err
+	}
+	return result, nil
+}
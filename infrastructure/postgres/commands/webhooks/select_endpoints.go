@@ -0,0 +1,46 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+)
+
+type selectEndpointsCommand struct {
+	client *postgres.Client
+}
+
+func NewSelectEndpointsCommand(client *postgres.Client) repositories.SelectWebhookEndpointsCommand {
+	return &selectEndpointsCommand{client: client}
+}
+
+// Execute returns all the endpoints, the oldest first.
+func (c *selectEndpointsCommand) Execute(context context.Context) ([]entities.WebhookEndpoint, error) {
+	sql, args, err := c.client.Builder.
+		Select(endpointFields...).
+		From(commands.WebhookEndpointTable).
+		OrderBy(commands.WebhookEndpointCreatedAtField, commands.WebhookEndpointIdField).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.client.Pool.Query(context, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]entities.WebhookEndpoint, 0)
+	for rows.Next() {
+		endpoint, err := scanEndpoint(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, endpoint)
+	}
+
+	return result, rows.Err()
+}
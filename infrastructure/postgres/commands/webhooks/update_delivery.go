@@ -0,0 +1,40 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	sq "github.com/Masterminds/squirrel"
+)
+
+type updateDeliveryCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdateDeliveryCommand(client *postgres.Client) repositories.UpdateWebhookDeliveryCommand {
+	return &updateDeliveryCommand{client: client}
+}
+
+// Execute records the outcome of the delivery attempt.
+func (c *updateDeliveryCommand) Execute(context context.Context, delivery entities.WebhookDelivery) error {
+	sql, args, err := c.client.Builder.
+		Update(commands.WebhookDeliveryTable).
+		SetMap(map[string]any{
+			commands.WebhookDeliveryStatusField:         delivery.Status,
+			commands.WebhookDeliveryAttemptsField:       delivery.Attempts,
+			commands.WebhookDeliveryNextAttemptAtField:  delivery.NextAttemptAt.UTC(),
+			commands.WebhookDeliveryLastStatusCodeField: delivery.LastStatusCode,
+			commands.WebhookDeliveryLastErrorField:      delivery.LastError,
+			commands.WebhookDeliveryDeliveredAtField:    commands.NullIfZero(delivery.DeliveredAt),
+		}).
+		Where(sq.Eq{commands.WebhookDeliveryIdField: delivery.Id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Pool.Exec(context, sql, args...)
+	return err
+}
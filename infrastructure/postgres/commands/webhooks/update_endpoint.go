@@ -0,0 +1,64 @@
+package webhooks
+
+import (
+	"auth/infrastructure/postgres"
+	"auth/infrastructure/postgres/commands"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const uniqueViolationCode = "23505"
+
+type updateEndpointCommand struct {
+	client *postgres.Client
+}
+
+func NewUpdateEndpointCommand(client *postgres.Client) repositories.UpdateWebhookEndpointCommand {
+	return &updateEndpointCommand{client: client}
+}
+
+// Execute saves the URL, the secret, the event types and the state of the
+// endpoint. It yields ErrEntityAlreadyExists if another endpoint has the
+// URL.
+func (c *updateEndpointCommand) Execute(context context.Context, endpoint entities.WebhookEndpoint) error {
+	if uuid.Validate(endpoint.Id) != nil {
+		return repositories.ErrEntityNotFound
+	}
+	eventTypes := endpoint.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	sql, args, err := c.client.Builder.
+		Update(commands.WebhookEndpointTable).
+		SetMap(map[string]any{
+			commands.WebhookEndpointURLField:        endpoint.URL,
+			commands.WebhookEndpointSecretField:     endpoint.Secret,
+			commands.WebhookEndpointEventTypesField: eventTypes,
+			commands.WebhookEndpointActiveField:     endpoint.Active,
+			commands.WebhookEndpointUpdatedAtField:  sq.Expr("NOW()"),
+		}).
+		Where(sq.Eq{commands.WebhookEndpointIdField: endpoint.Id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := c.client.Pool.Exec(context, sql, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return repositories.ErrEntityAlreadyExists
+		}
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrEntityNotFound
+	}
+	return nil
+}
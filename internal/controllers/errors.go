@@ -1,8 +1,34 @@
 package controllers
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrDataBindError = errors.New("wrong data format")
 	ErrAuthRequired  = errors.New("auth is required")
+	ErrForbidden     = errors.New("access is forbidden")
+
+	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+	ErrOrganizationRequired = errors.New("active organization is required")
+
+	ErrReauthenticationRequired = errors.New("reauthentication required")
 )
+
+// ReauthenticationRequiredError tells the client which authentication the
+// operation needs, it is sent in the WWW-Authenticate header as described in
+// RFC 9470 and in the body.
+type ReauthenticationRequiredError struct {
+	MaxAge time.Duration
+	ACR    string
+}
+
+func (e *ReauthenticationRequiredError) Error() string {
+	return ErrReauthenticationRequired.Error()
+}
+
+func (e *ReauthenticationRequiredError) Unwrap() error {
+	return ErrReauthenticationRequired
+}
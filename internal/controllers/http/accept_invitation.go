@@ -0,0 +1,59 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type acceptInvitationController struct {
+	logger  logger.Logger
+	useCase usecases.AcceptInvitationUseCase
+}
+
+func NewAcceptInvitationController(
+	handler *gin.Engine,
+	useCase usecases.AcceptInvitationUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	o := &acceptInvitationController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/organizations/invitations/accept", middleware.Authenticate, o.AcceptInvitation, middleware.HandleErrors)
+}
+
+// AcceptInvitation godoc
+// @Summary      принятие приглашения в организацию
+// @Description  текущий пользователь становится участником организации с ролью из приглашения; email пользователя должен совпадать с email приглашения
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.AcceptInvitation true "структура запроса"
+// @Success 200 {object} responses.Membership
+// @Failure 400 {object} string "некорректное, просроченное или уже использованное приглашение"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 409 {object} string "пользователь уже состоит в организации"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /organizations/invitations/accept [post]
+func (o *acceptInvitationController) AcceptInvitation(c *gin.Context) {
+	var request requests.AcceptInvitation
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := o.useCase.AcceptInvitation(c, c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminCreateInvitationController struct {
+	logger  logger.Logger
+	useCase usecases.CreateUserInvitationUseCase
+}
+
+func NewAdminCreateInvitationController(
+	handler *gin.Engine,
+	useCase usecases.CreateUserInvitationUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminCreateInvitationController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/admin/invitations", middleware.Authenticate, middleware.RequirePermission(entities.PermissionUsersInvite), middleware.RequireStepUp, a.CreateInvitation, middleware.HandleErrors)
+}
+
+// CreateInvitation godoc
+// @Summary      приглашение на регистрацию
+// @Description  создание одноразового приглашения на регистрацию для указанного email с необязательной ролью; токен приглашения возвращается только в этом ответе
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.CreateUserInvitation true "структура запроса"
+// @Success 200 {object} responses.Invitation
+// @Failure 400 {object} string "некорректный формат запроса или неизвестная роль"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 409 {object} string "пользователь уже существует"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/invitations [post]
+func (a *adminCreateInvitationController) CreateInvitation(c *gin.Context) {
+	var request requests.CreateUserInvitation
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.CreateUserInvitation(c, c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
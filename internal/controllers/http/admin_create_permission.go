@@ -0,0 +1,61 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminCreatePermissionController struct {
+	logger  logger.Logger
+	useCase usecases.CreatePermissionUseCase
+}
+
+func NewAdminCreatePermissionController(
+	handler *gin.Engine,
+	useCase usecases.CreatePermissionUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminCreatePermissionController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/admin/permissions", middleware.Authenticate, middleware.RequirePermission(entities.PermissionRolesManage), middleware.RequireStepUp, a.CreatePermission, middleware.HandleErrors)
+}
+
+// CreatePermission godoc
+// @Summary      создание разрешения
+// @Description  создание разрешения, например для endpoint'ов сервисов, использующих токены auth
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.CreatePermission true "структура запроса"
+// @Success 200 {object} responses.Permission
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 409 {object} string "разрешение уже существует"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/permissions [post]
+func (a *adminCreatePermissionController) CreatePermission(c *gin.Context) {
+	var request requests.CreatePermission
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.CreatePermission(c, request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminCreateRoleController struct {
+	logger  logger.Logger
+	useCase usecases.CreateRoleUseCase
+}
+
+func NewAdminCreateRoleController(
+	handler *gin.Engine,
+	useCase usecases.CreateRoleUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminCreateRoleController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/admin/roles", middleware.Authenticate, middleware.RequirePermission(entities.PermissionRolesManage), middleware.RequireStepUp, a.CreateRole, middleware.HandleErrors)
+}
+
+// CreateRole godoc
+// @Summary      создание роли
+// @Description  создание роли с набором существующих разрешений
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.CreateRole true "структура запроса"
+// @Success 200 {object} responses.Role
+// @Failure 400 {object} string "некорректный формат запроса или неизвестное разрешение"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 409 {object} string "роль уже существует"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/roles [post]
+func (a *adminCreateRoleController) CreateRole(c *gin.Context) {
+	var request requests.CreateRole
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.CreateRole(c, request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+type adminDeletePermissionController struct {
+	logger  logger.Logger
+	useCase usecases.DeletePermissionUseCase
+}
+
+func NewAdminDeletePermissionController(
+	handler *gin.Engine,
+	useCase usecases.DeletePermissionUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminDeletePermissionController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.DELETE("/admin/permissions/:permission_id", middleware.Authenticate, middleware.RequirePermission(entities.PermissionRolesManage), middleware.RequireStepUp, a.DeletePermission, middleware.HandleErrors)
+}
+
+// DeletePermission godoc
+// @Summary      удаление разрешения
+// @Description  удаление разрешения вместе с его привязками к ролям
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        permission_id path int true "id разрешения"
+// @Success 200 "ok"
+// @Failure 400 {object} string "некорректный id"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "разрешение не найдено"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/permissions/{permission_id} [delete]
+func (a *adminDeletePermissionController) DeletePermission(c *gin.Context) {
+	permissionId, err := strconv.Atoi(c.Param("permission_id"))
+	if err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	err = a.useCase.DeletePermission(c, permissionId)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "permission deleted")
+}
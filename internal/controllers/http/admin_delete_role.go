@@ -0,0 +1,60 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+type adminDeleteRoleController struct {
+	logger  logger.Logger
+	useCase usecases.DeleteRoleUseCase
+}
+
+func NewAdminDeleteRoleController(
+	handler *gin.Engine,
+	useCase usecases.DeleteRoleUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminDeleteRoleController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.DELETE("/admin/roles/:role_id", middleware.Authenticate, middleware.RequirePermission(entities.PermissionRolesManage), middleware.RequireStepUp, a.DeleteRole, middleware.HandleErrors)
+}
+
+// DeleteRole godoc
+// @Summary      удаление роли
+// @Description  удаление роли; встроенные роли user и admin удалить нельзя
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        role_id path int true "id роли"
+// @Success 200 "ok"
+// @Failure 400 {object} string "некорректный id или встроенная роль"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "роль не найдена"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/roles/{role_id} [delete]
+func (a *adminDeleteRoleController) DeleteRole(c *gin.Context) {
+	roleId, err := strconv.Atoi(c.Param("role_id"))
+	if err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	err = a.useCase.DeleteRole(c, roleId)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "role deleted")
+}
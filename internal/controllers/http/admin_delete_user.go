@@ -0,0 +1,52 @@
+package http
+
+import (
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminDeleteUserController struct {
+	logger  logger.Logger
+	useCase usecases.DeleteUserUseCase
+}
+
+func NewAdminDeleteUserController(
+	handler *gin.Engine,
+	useCase usecases.DeleteUserUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminDeleteUserController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.DELETE("/admin/users/:user_id", middleware.Authenticate, middleware.RequirePermission(entities.PermissionUsersDelete), middleware.RequireStepUp, a.DeleteUser, middleware.HandleErrors)
+}
+
+// DeleteUser godoc
+// @Summary      удаление пользователя администратором
+// @Description  удаление пользователя вместе со всеми его сессиями
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        user_id path string true "id пользователя"
+// @Success 200 "ok"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "пользователь не найден"
+// @Failure 409 {object} string "удаление последнего администратора"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/users/{user_id} [delete]
+func (a *adminDeleteUserController) DeleteUser(c *gin.Context) {
+	err := a.useCase.DeleteUser(c, c.Param("user_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "user deleted")
+}
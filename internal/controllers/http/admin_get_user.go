@@ -0,0 +1,51 @@
+package http
+
+import (
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminGetUserController struct {
+	logger  logger.Logger
+	useCase usecases.GetUserUseCase
+}
+
+func NewAdminGetUserController(
+	handler *gin.Engine,
+	useCase usecases.GetUserUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminGetUserController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/admin/users/:user_id", middleware.Authenticate, middleware.RequirePermission(entities.PermissionUsersRead), a.GetUser, middleware.HandleErrors)
+}
+
+// GetUser godoc
+// @Summary      получение пользователя администратором
+// @Description  получение любого пользователя по его id, доступно только администраторам
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        user_id path string true "id пользователя"
+// @Success 200 {object} responses.User
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "пользователь не найден"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/users/{user_id} [get]
+func (a *adminGetUserController) GetUser(c *gin.Context) {
+	response, err := a.useCase.GetUserUseCase(c, c.Param("user_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminListPermissionsController struct {
+	logger  logger.Logger
+	useCase usecases.ListPermissionsUseCase
+}
+
+func NewAdminListPermissionsController(
+	handler *gin.Engine,
+	useCase usecases.ListPermissionsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminListPermissionsController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/admin/permissions", middleware.Authenticate, middleware.RequirePermission(entities.PermissionRolesManage), a.ListPermissions, middleware.HandleErrors)
+}
+
+// ListPermissions godoc
+// @Summary      список разрешений
+// @Description  список всех разрешений, которые можно выдать ролям
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Success 200 {array} responses.Permission
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/permissions [get]
+func (a *adminListPermissionsController) ListPermissions(c *gin.Context) {
+	response, err := a.useCase.ListPermissions(c)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
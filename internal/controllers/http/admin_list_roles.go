@@ -0,0 +1,49 @@
+package http
+
+import (
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminListRolesController struct {
+	logger  logger.Logger
+	useCase usecases.ListRolesUseCase
+}
+
+func NewAdminListRolesController(
+	handler *gin.Engine,
+	useCase usecases.ListRolesUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminListRolesController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/admin/roles", middleware.Authenticate, middleware.RequirePermission(entities.PermissionRolesManage), a.ListRoles, middleware.HandleErrors)
+}
+
+// ListRoles godoc
+// @Summary      список ролей
+// @Description  список всех ролей вместе с их разрешениями
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Success 200 {array} responses.Role
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/roles [get]
+func (a *adminListRolesController) ListRoles(c *gin.Context) {
+	response, err := a.useCase.ListRoles(c)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
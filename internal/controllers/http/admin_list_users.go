@@ -0,0 +1,62 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminListUsersController struct {
+	logger  logger.Logger
+	useCase usecases.ListUsersUseCase
+}
+
+func NewAdminListUsersController(
+	handler *gin.Engine,
+	useCase usecases.ListUsersUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminListUsersController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/admin/users", middleware.Authenticate, middleware.RequirePermission(entities.PermissionUsersRead), a.ListUsers, middleware.HandleErrors)
+}
+
+// ListUsers godoc
+// @Summary      список пользователей
+// @Description  постраничный список пользователей с поиском по email и фильтром по статусу, доступен только администраторам
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        page   query int    false "номер страницы, начиная с 1"
+// @Param        limit  query int    false "размер страницы, не больше 100"
+// @Param        email  query string false "подстрока email"
+// @Param        status query string false "статус пользователя" Enums(active, disabled, locked, banned)
+// @Success 200 {object} responses.UserList
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/users [get]
+func (a *adminListUsersController) ListUsers(c *gin.Context) {
+	var request requests.ListUsers
+	if err := c.ShouldBindQuery(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.ListUsers(c, request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminRevokeSessionsController struct {
+	logger  logger.Logger
+	useCase usecases.RevokeSessionsUseCase
+}
+
+func NewAdminRevokeSessionsController(
+	handler *gin.Engine,
+	useCase usecases.RevokeSessionsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminRevokeSessionsController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/admin/users/:user_id/sessions/revoke", middleware.Authenticate, middleware.RequirePermission(entities.PermissionSessionsRevoke), middleware.RequireStepUp, a.RevokeSessions, middleware.HandleErrors)
+}
+
+// RevokeSessions godoc
+// @Summary      закрытие сессий пользователя администратором
+// @Description  закрытие всех сессий пользователя по его id
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        user_id path string true "id пользователя"
+// @Success 200 "ok"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "пользователь не найден"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/users/{user_id}/sessions/revoke [post]
+func (a *adminRevokeSessionsController) RevokeSessions(c *gin.Context) {
+	err := a.useCase.RevokeSessions(c, c.Param("user_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "sessions revoked")
+}
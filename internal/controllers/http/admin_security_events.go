@@ -0,0 +1,67 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminSecurityEventsController struct {
+	logger  logger.Logger
+	useCase usecases.SecurityEventsUseCase
+}
+
+func NewAdminSecurityEventsController(
+	handler *gin.Engine,
+	useCase usecases.SecurityEventsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminSecurityEventsController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/admin/security-events", middleware.Authenticate, middleware.RequirePermission(entities.PermissionAuditRead), a.List, middleware.HandleErrors)
+}
+
+// List godoc
+// @Summary      журнал аудита
+// @Description  постраничный поиск по событиям безопасности всех пользователей, от новых к старым; доступен с правом audit:read
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        page      query int    false "номер страницы, начиная с 1"
+// @Param        limit     query int    false "размер страницы, не больше 100"
+// @Param        userId    query string false "пользователь — инициатор или объект действия"
+// @Param        actorId   query string false "инициатор действия"
+// @Param        subjectId query string false "объект действия: пользователь, роль, право или организация"
+// @Param        type      query string false "тип события" example(sign_in)
+// @Param        outcome   query string false "результат" Enums(success, failure)
+// @Param        from      query string false "начало периода в RFC 3339"
+// @Param        to        query string false "конец периода в RFC 3339"
+// @Success 200 {object} responses.AuditEventList
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/security-events [get]
+func (a *adminSecurityEventsController) List(c *gin.Context) {
+	var request requests.ListAuditEvents
+	if err := c.ShouldBindQuery(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.List(c, request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminSetUserRolesController struct {
+	logger  logger.Logger
+	useCase usecases.SetUserRolesUseCase
+}
+
+func NewAdminSetUserRolesController(
+	handler *gin.Engine,
+	useCase usecases.SetUserRolesUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminSetUserRolesController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.PUT("/admin/users/:user_id/roles", middleware.Authenticate, middleware.RequirePermission(entities.PermissionRolesManage), middleware.RequireStepUp, a.SetUserRoles, middleware.HandleErrors)
+}
+
+// SetUserRoles godoc
+// @Summary      назначение ролей пользователю
+// @Description  полностью заменяет набор ролей пользователя; новые роли попадут в access token при следующем обновлении сессии
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        user_id path string true "id пользователя"
+// @Param request body requests.SetUserRoles true "структура запроса"
+// @Success 200 {object} responses.User
+// @Failure 400 {object} string "некорректный формат запроса или неизвестная роль"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "пользователь не найден"
+// @Failure 409 {object} string "снятие роли admin с последнего администратора"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/users/{user_id}/roles [put]
+func (a *adminSetUserRolesController) SetUserRoles(c *gin.Context) {
+	var request requests.SetUserRoles
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.SetUserRoles(c, c.Param("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+type adminUpdateRoleController struct {
+	logger  logger.Logger
+	useCase usecases.UpdateRoleUseCase
+}
+
+func NewAdminUpdateRoleController(
+	handler *gin.Engine,
+	useCase usecases.UpdateRoleUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminUpdateRoleController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.PATCH("/admin/roles/:role_id", middleware.Authenticate, middleware.RequirePermission(entities.PermissionRolesManage), middleware.RequireStepUp, a.UpdateRole, middleware.HandleErrors)
+}
+
+// UpdateRole godoc
+// @Summary      изменение роли
+// @Description  изменение описания роли и замена её разрешений
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        role_id path int true "id роли"
+// @Param request body requests.UpdateRole true "структура запроса"
+// @Success 200 {object} responses.Role
+// @Failure 400 {object} string "некорректный формат запроса или неизвестное разрешение"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "роль не найдена"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/roles/{role_id} [patch]
+func (a *adminUpdateRoleController) UpdateRole(c *gin.Context) {
+	roleId, err := strconv.Atoi(c.Param("role_id"))
+	if err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	var request requests.UpdateRole
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.UpdateRole(c, roleId, request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
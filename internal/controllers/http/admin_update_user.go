@@ -0,0 +1,63 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminUpdateUserController struct {
+	logger  logger.Logger
+	useCase usecases.UpdateUserUseCase
+}
+
+func NewAdminUpdateUserController(
+	handler *gin.Engine,
+	useCase usecases.UpdateUserUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminUpdateUserController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.PATCH("/admin/users/:user_id", middleware.Authenticate, middleware.RequirePermission(entities.PermissionUsersWrite), middleware.RequireStepUp, a.UpdateUser, middleware.HandleErrors)
+}
+
+// UpdateUser godoc
+// @Summary      изменение пользователя администратором
+// @Description  изменение email, роли или статуса пользователя; при смене роли или неактивном статусе сессии пользователя закрываются
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        user_id path string true "id пользователя"
+// @Param request body requests.UpdateUser true "структура запроса"
+// @Success 200 {object} responses.User
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "пользователь не найден"
+// @Failure 409 {object} string "email уже занят"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/users/{user_id} [patch]
+func (a *adminUpdateUserController) UpdateUser(c *gin.Context) {
+	var request requests.UpdateUser
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.UpdateUser(c, c.Param("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
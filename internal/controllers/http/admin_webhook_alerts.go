@@ -0,0 +1,64 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminWebhookAlertsController struct {
+	logger  logger.Logger
+	useCase usecases.WebhookAlertsUseCase
+}
+
+func NewAdminWebhookAlertsController(
+	handler *gin.Engine,
+	useCase usecases.WebhookAlertsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminWebhookAlertsController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/admin/webhook-alerts", middleware.Authenticate, middleware.RequirePermission(entities.PermissionAuditRead), a.List, middleware.HandleErrors)
+}
+
+// List godoc
+// @Summary      принятые вебхуки
+// @Description  постраничный поиск по событиям, принятым входящим вебхуком, от новых к старым; доступен с правом audit:read
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        page   query int    false "номер страницы, начиная с 1"
+// @Param        limit  query int    false "размер страницы, не больше 100"
+// @Param        userId query string false "пользователь события"
+// @Param        type   query string false "тип события" example(session.ip_changed)
+// @Param        from   query string false "начало периода приёма в RFC 3339"
+// @Param        to     query string false "конец периода приёма в RFC 3339"
+// @Success 200 {object} responses.WebhookAlertList
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/webhook-alerts [get]
+func (a *adminWebhookAlertsController) List(c *gin.Context) {
+	var request requests.ListWebhookAlerts
+	if err := c.ShouldBindQuery(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.List(c, request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,260 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type adminWebhooksController struct {
+	logger  logger.Logger
+	useCase usecases.WebhookEndpointsUseCase
+}
+
+func NewAdminWebhooksController(
+	handler *gin.Engine,
+	useCase usecases.WebhookEndpointsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	a := &adminWebhooksController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/admin/webhooks", middleware.Authenticate, middleware.RequirePermission(entities.PermissionWebhooksManage), a.List, middleware.HandleErrors)
+	handler.POST("/admin/webhooks", middleware.Authenticate, middleware.RequirePermission(entities.PermissionWebhooksManage), middleware.RequireStepUp, a.Create, middleware.HandleErrors)
+	handler.PATCH("/admin/webhooks/:webhook_id", middleware.Authenticate, middleware.RequirePermission(entities.PermissionWebhooksManage), middleware.RequireStepUp, a.Update, middleware.HandleErrors)
+	handler.DELETE("/admin/webhooks/:webhook_id", middleware.Authenticate, middleware.RequirePermission(entities.PermissionWebhooksManage), middleware.RequireStepUp, a.Delete, middleware.HandleErrors)
+	handler.POST("/admin/webhooks/:webhook_id/pause", middleware.Authenticate, middleware.RequirePermission(entities.PermissionWebhooksManage), a.Pause, middleware.HandleErrors)
+	handler.POST("/admin/webhooks/:webhook_id/resume", middleware.Authenticate, middleware.RequirePermission(entities.PermissionWebhooksManage), a.Resume, middleware.HandleErrors)
+	handler.POST("/admin/webhooks/:webhook_id/secret", middleware.Authenticate, middleware.RequirePermission(entities.PermissionWebhooksManage), middleware.RequireStepUp, a.RotateSecret, middleware.HandleErrors)
+	handler.GET("/admin/webhooks/:webhook_id/deliveries", middleware.Authenticate, middleware.RequirePermission(entities.PermissionWebhooksManage), a.ListDeliveries, middleware.HandleErrors)
+	handler.POST("/admin/webhooks/deliveries/:delivery_id/replay", middleware.Authenticate, middleware.RequirePermission(entities.PermissionWebhooksManage), a.Replay, middleware.HandleErrors)
+}
+
+// List godoc
+// @Summary      список вебхуков
+// @Description  все endpoint'ы вебхуков; секреты не возвращаются
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Success 200 {array} responses.WebhookEndpoint
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/webhooks [get]
+func (a *adminWebhooksController) List(c *gin.Context) {
+	response, err := a.useCase.List(c)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary      создание вебхука
+// @Description  создание endpoint'а с подпиской на события (пустой список — все события); секрет подписи генерируется сервисом и возвращается только в этом ответе
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.CreateWebhookEndpoint true "структура запроса"
+// @Success 201 {object} responses.WebhookEndpoint
+// @Failure 400 {object} string "некорректный формат запроса, url или тип события"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 409 {object} string "endpoint с таким url уже существует"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/webhooks [post]
+func (a *adminWebhooksController) Create(c *gin.Context) {
+	var request requests.CreateWebhookEndpoint
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.Create(c, request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// Update godoc
+// @Summary      изменение вебхука
+// @Description  изменение url и списка событий endpoint'а; не переданные поля не меняются
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        webhook_id path string true "id вебхука"
+// @Param request body requests.UpdateWebhookEndpoint true "структура запроса"
+// @Success 200 {object} responses.WebhookEndpoint
+// @Failure 400 {object} string "некорректный формат запроса, url или тип события"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "вебхук не найден"
+// @Failure 409 {object} string "endpoint с таким url уже существует"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/webhooks/{webhook_id} [patch]
+func (a *adminWebhooksController) Update(c *gin.Context) {
+	var request requests.UpdateWebhookEndpoint
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.Update(c, c.Param("webhook_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Delete godoc
+// @Summary      удаление вебхука
+// @Description  удаление endpoint'а вместе с историей его доставок
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        webhook_id path string true "id вебхука"
+// @Success 200 "ok"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "вебхук не найден"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/webhooks/{webhook_id} [delete]
+func (a *adminWebhooksController) Delete(c *gin.Context) {
+	err := a.useCase.Delete(c, c.Param("webhook_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "webhook deleted")
+}
+
+// Pause godoc
+// @Summary      приостановка вебхука
+// @Description  события продолжают накапливаться и будут отправлены после возобновления
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        webhook_id path string true "id вебхука"
+// @Success 200 {object} responses.WebhookEndpoint
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "вебхук не найден"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/webhooks/{webhook_id}/pause [post]
+func (a *adminWebhooksController) Pause(c *gin.Context) {
+	a.setActive(c, false)
+}
+
+// Resume godoc
+// @Summary      возобновление вебхука
+// @Description  возобновление отправки событий приостановленному endpoint'у
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        webhook_id path string true "id вебхука"
+// @Success 200 {object} responses.WebhookEndpoint
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "вебхук не найден"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/webhooks/{webhook_id}/resume [post]
+func (a *adminWebhooksController) Resume(c *gin.Context) {
+	a.setActive(c, true)
+}
+
+func (a *adminWebhooksController) setActive(c *gin.Context, active bool) {
+	response, err := a.useCase.SetActive(c, c.Param("webhook_id"), active)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RotateSecret godoc
+// @Summary      смена секрета вебхука
+// @Description  новый секрет подписи действует сразу, в том числе для ожидающих доставок; возвращается только в этом ответе
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        webhook_id path string true "id вебхука"
+// @Success 200 {object} responses.WebhookEndpoint
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "вебхук не найден"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/webhooks/{webhook_id}/secret [post]
+func (a *adminWebhooksController) RotateSecret(c *gin.Context) {
+	response, err := a.useCase.RotateSecret(c, c.Param("webhook_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListDeliveries godoc
+// @Summary      доставки вебхука
+// @Description  последние доставки endpoint'а, от новых к старым, со статусом, числом попыток, кодом ответа и ошибкой последней попытки
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        webhook_id path  string true  "id вебхука"
+// @Param        status     query string false "статус доставки" Enums(pending, delivered, dead)
+// @Param        limit      query int    false "число доставок, не больше 100"
+// @Success 200 {array} responses.WebhookDelivery
+// @Failure 400 {object} string "некорректный формат запроса или статус"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "вебхук не найден"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/webhooks/{webhook_id}/deliveries [get]
+func (a *adminWebhooksController) ListDeliveries(c *gin.Context) {
+	var request requests.ListWebhookDeliveries
+	if err := c.ShouldBindQuery(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := a.useCase.ListDeliveries(c, c.Param("webhook_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Replay godoc
+// @Summary      повторная отправка события
+// @Description  событие доставки ставится в очередь тому же endpoint'у новой доставкой с тем же Webhook-Id; исходная доставка не меняется
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        delivery_id path string true "id доставки"
+// @Success 201 {object} responses.WebhookDelivery
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав"
+// @Failure 404 {object} string "доставка не найдена"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /admin/webhooks/deliveries/{delivery_id}/replay [post]
+func (a *adminWebhooksController) Replay(c *gin.Context) {
+	response, err := a.useCase.Replay(c, c.Param("delivery_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type changePasswordController struct {
+	logger  logger.Logger
+	useCase usecases.ChangePasswordUseCase
+}
+
+func NewChangePasswordController(
+	handler *gin.Engine,
+	useCase usecases.ChangePasswordUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	p := &changePasswordController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/auth/password/change", middleware.AuthenticatePasswordChange, p.ChangePassword, middleware.HandleErrors)
+}
+
+// ChangePassword godoc
+// @Summary      смена пароля
+// @Description  смена пароля пользователя; принимает обычный access token или ограниченный токен, выданный при входе с истёкшим паролем. Новый пароль не должен совпадать с последними паролями пользователя, после смены все сессии закрываются
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token или ограниченный токен"
+// @Param request body requests.ChangePassword true "структура запроса"
+// @Success 200 {object} string "password changed"
+// @Failure 400 {object} string "некорректный формат запроса или пароль не соответствует политике"
+// @Failure 401 {object} string "некорректный токен или неправильный текущий пароль"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/password/change [post]
+func (p *changePasswordController) ChangePassword(c *gin.Context) {
+	var request requests.ChangePassword
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	err := p.useCase.ChangePassword(c, c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "password changed")
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type createInvitationController struct {
+	logger  logger.Logger
+	useCase usecases.CreateInvitationUseCase
+}
+
+func NewCreateInvitationController(
+	handler *gin.Engine,
+	useCase usecases.CreateInvitationUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	o := &createInvitationController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/organizations/invitations", middleware.Authenticate, middleware.RequireOrganization, o.CreateInvitation, middleware.HandleErrors)
+}
+
+// CreateInvitation godoc
+// @Summary      приглашение в организацию
+// @Description  создание одноразового приглашения в активную организацию сессии для указанного email; токен приглашения возвращается только в этом ответе
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.CreateInvitation true "структура запроса"
+// @Success 200 {object} responses.Invitation
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав в организации"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /organizations/invitations [post]
+func (o *createInvitationController) CreateInvitation(c *gin.Context) {
+	var request requests.CreateInvitation
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := o.useCase.CreateInvitation(c, c.GetString("org_id"), c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
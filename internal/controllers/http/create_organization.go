@@ -0,0 +1,58 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type createOrganizationController struct {
+	logger  logger.Logger
+	useCase usecases.CreateOrganizationUseCase
+}
+
+func NewCreateOrganizationController(
+	handler *gin.Engine,
+	useCase usecases.CreateOrganizationUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	o := &createOrganizationController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/organizations", middleware.Authenticate, o.CreateOrganization, middleware.HandleErrors)
+}
+
+// CreateOrganization godoc
+// @Summary      создание организации
+// @Description  создание организации, текущий пользователь становится её владельцем; чтобы работать от имени организации, выберите её при входе или обновлении сессии
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.CreateOrganization true "структура запроса"
+// @Success 200 {object} responses.Organization
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /organizations [post]
+func (o *createOrganizationController) CreateOrganization(c *gin.Context) {
+	var request requests.CreateOrganization
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := o.useCase.CreateOrganization(c, c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type enrollTOTPController struct {
+	logger  logger.Logger
+	useCase usecases.EnrollTOTPUseCase
+}
+
+func NewEnrollTOTPController(
+	handler *gin.Engine,
+	useCase usecases.EnrollTOTPUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	e := &enrollTOTPController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/auth/mfa/totp", middleware.Authenticate, e.Enroll, middleware.HandleErrors)
+	handler.POST("/auth/mfa/totp/confirm", middleware.Authenticate, e.Confirm, middleware.HandleErrors)
+}
+
+// Enroll godoc
+// @Summary      подключение TOTP
+// @Description  создание секрета TOTP для приложения-аутентификатора; возвращает секрет и otpauth URI для QR-кода. Второй фактор начинает требоваться при входе только после подтверждения кодом
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Success 200 {object} responses.TOTPEnrollment
+// @Failure 401 {object} string "некорректный токен"
+// @Failure 409 {object} string "TOTP уже подключен"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/mfa/totp [post]
+func (e *enrollTOTPController) Enroll(c *gin.Context) {
+	response, err := e.useCase.Enroll(c, c.GetString("user_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Confirm godoc
+// @Summary      подтверждение TOTP
+// @Description  включение двухфакторной аутентификации кодом из приложения-аутентификатора; возвращает одноразовые коды восстановления, которые больше не показываются
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.ConfirmTOTP true "структура запроса"
+// @Success 200 {object} responses.RecoveryCodes
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный токен или неверный код"
+// @Failure 404 {object} string "TOTP не был создан"
+// @Failure 409 {object} string "TOTP уже подключен"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/mfa/totp/confirm [post]
+func (e *enrollTOTPController) Confirm(c *gin.Context) {
+	var request requests.ConfirmTOTP
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := e.useCase.Confirm(c, c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
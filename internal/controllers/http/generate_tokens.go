@@ -2,6 +2,7 @@ package http
 
 import (
 	"auth/internal/controllers/http/middleware"
+	"auth/internal/entities"
 	"auth/internal/usecases"
 	"auth/pkg/logger"
 	"github.com/gin-gonic/gin"
@@ -24,18 +25,22 @@ func NewGenerateTokensController(
 		useCase: useCase,
 	}
 
-	handler.GET("/auth/token/:user_id", u.GenerateTokens, middleware.HandleErrors)
+	handler.GET("/auth/token/:user_id", middleware.Authenticate, middleware.RequirePermission(entities.PermissionSessionsIssue), middleware.RequireStepUp, u.GenerateTokens, middleware.HandleErrors)
 }
 
 // GenerateTokens godoc
 // @Summary      создание токенов
-// @Description  создание токенов по id пользователя
+// @Description  создание токенов по id пользователя, доступно только с разрешением sessions:issue и после недавнего входа (step-up)
 // @Accept       json
 // @Produce      json
+// @Param Authorization header string true "access token"
 // @Param        user_id path string true "path format"
 // @Success      200  {object}  responses.Session
 // @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный access token или требуется повторный вход: insufficient_user_authentication, заголовок WWW-Authenticate"
+// @Failure 403 {object} string "недостаточно прав, пользователь отключен или заблокирован навсегда"
 // @Failure 404 {object} string "пользователь не найден"
+// @Failure 423 {object} string "пользователь временно заблокирован"
 // @Failure 500 {object} string "внутренняя ошибка сервера"
 // @Router       /auth/token/{user_id} [get]
 func (router *getTokensController) GenerateTokens(c *gin.Context) {
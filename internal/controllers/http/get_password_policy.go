@@ -0,0 +1,38 @@
+package http
+
+import (
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type getPasswordPolicyController struct {
+	logger  logger.Logger
+	useCase usecases.GetPasswordPolicyUseCase
+}
+
+func NewGetPasswordPolicyController(
+	handler *gin.Engine,
+	useCase usecases.GetPasswordPolicyUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	p := &getPasswordPolicyController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/auth/password/policy", p.GetPasswordPolicy, middleware.HandleErrors)
+}
+
+// GetPasswordPolicy godoc
+// @Summary      политика паролей
+// @Description  требования к новым паролям для подсказок на клиенте; длина считается в символах после нормализации NFKC
+// @Produce      json
+// @Success 200 {object} responses.PasswordPolicy
+// @Router       /auth/password/policy [get]
+func (p *getPasswordPolicyController) GetPasswordPolicy(c *gin.Context) {
+	c.JSON(http.StatusOK, p.useCase.GetPasswordPolicy())
+}
@@ -35,6 +35,8 @@ func NewGetUserController(
 // @Param Authorization header string true "access token"
 // @Success 200 {object} responses.User
 // @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "пользователь отключен или заблокирован навсегда"
+// @Failure 423 {object} string "пользователь временно заблокирован"
 // @Failure 500 {object} string "внутренняя ошибка сервера"
 // @Router       /auth/user [get]
 func (gc *getUserController) GetUser(c *gin.Context) {
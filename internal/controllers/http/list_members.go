@@ -0,0 +1,48 @@
+package http
+
+import (
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type listMembersController struct {
+	logger  logger.Logger
+	useCase usecases.ListMembersUseCase
+}
+
+func NewListMembersController(
+	handler *gin.Engine,
+	useCase usecases.ListMembersUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	o := &listMembersController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/organizations/members", middleware.Authenticate, middleware.RequireOrganization, o.ListMembers, middleware.HandleErrors)
+}
+
+// ListMembers godoc
+// @Summary      список участников организации
+// @Description  список участников активной организации сессии (claim org_id в access token)
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Success 200 {array} responses.Member
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "не выбрана организация или пользователь не состоит в ней"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /organizations/members [get]
+func (o *listMembersController) ListMembers(c *gin.Context) {
+	response, err := o.useCase.ListMembers(c, c.GetString("org_id"), c.GetString("user_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -1,12 +1,25 @@
 package middleware
 
 import (
+	"auth/internal/entities"
 	"auth/internal/usecases"
 	"github.com/gin-gonic/gin"
 	"net/http"
 )
 
 func (m *middleware) Authenticate(c *gin.Context) {
+	m.authenticate(c, "")
+}
+
+// AuthenticatePasswordChange also lets through the restricted token issued
+// at sign in when the password has expired.
+func (m *middleware) AuthenticatePasswordChange(c *gin.Context) {
+	m.authenticate(c, entities.ScopePasswordChange)
+}
+
+// authenticate accepts regular access tokens and restricted tokens with the
+// allowed scope.
+func (m *middleware) authenticate(c *gin.Context, allowedScope string) {
 	token := c.GetHeader("Authorization")
 
 	claims, err := m.manager.ParseToken(token)
@@ -16,5 +29,53 @@ func (m *middleware) Authenticate(c *gin.Context) {
 		return
 	}
 
+	if scope := claims.Scope(); scope != "" && scope != allowedScope {
+		switch scope {
+		case entities.ScopeMFA:
+			AddGinError(c, usecases.ErrMFARequired)
+		case entities.ScopePasswordChange:
+			AddGinError(c, usecases.ErrPasswordChangeRequired)
+		default:
+			c.AbortWithError(http.StatusUnauthorized, usecases.ErrNotAValidAccessToken)
+			return
+		}
+		m.HandleErrors(c)
+		return
+	}
+
+	user, err := m.userRepo.SelectByUserId(c, claims.AccountId())
+	if err != nil {
+		m.logger.Info().Msgf("failed to find user of the token: %s", err.Error())
+		c.AbortWithError(http.StatusUnauthorized, usecases.ErrNotAValidAccessToken)
+		return
+	}
+
+	err = usecases.CheckUserStatus(user)
+	if err != nil {
+		AddGinError(c, err)
+		m.HandleErrors(c)
+		return
+	}
+
+	// The restricted tokens have no session. A regular token is accepted only
+	// while its session is alive, so the revoked sessions, e.g. after a role
+	// change, lose their roles and permissions at once. A later sign in
+	// replaces the session, the tokens of the earlier one report another
+	// authentication time.
+	if claims.Scope() == "" {
+		session, err := m.sessionRepo.SelectByUserId(c, claims.AccountId())
+		if err != nil {
+			m.logger.Info().Msgf("failed to find session of the token: %s", err.Error())
+			c.AbortWithError(http.StatusUnauthorized, usecases.ErrNotAValidAccessToken)
+			return
+		}
+		if session.Authentication.Time.Unix() != claims.Authentication().Time.Unix() {
+			m.logger.Info().Msg("the session of the token has been replaced")
+			c.AbortWithError(http.StatusUnauthorized, usecases.ErrNotAValidAccessToken)
+			return
+		}
+	}
+
 	c.Set("user_id", claims.AccountId())
+	c.Set("claims", claims)
 }
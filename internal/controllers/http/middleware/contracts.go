@@ -1,9 +1,24 @@
 package middleware
 
-import "auth/internal/entities"
+import (
+	"auth/internal/entities"
+	"context"
+)
 
 type (
 	SessionService interface {
 		ParseToken(string) (entities.AccessTokenClaims, error)
 	}
+
+	UserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+	}
+
+	SessionRepository interface {
+		SelectByUserId(context.Context, string) (entities.Session, error)
+	}
+
+	RateLimitRepository interface {
+		Take(context.Context, string, entities.RateLimit) (entities.RateLimitResult, error)
+	}
 )
@@ -2,28 +2,53 @@ package middleware
 
 import (
 	"auth/internal/controllers"
+	"auth/internal/controllers/responses"
 	"auth/internal/usecases"
 	"errors"
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"strconv"
 )
 
 func (m *middleware) HandleErrors(c *gin.Context) {
 	if len(c.Errors) > 0 {
 		err := c.Errors.Last()
 
+		var retryErr *usecases.RetryAfterError
+		if errors.As(err, &retryErr) {
+			c.Header("Retry-After", strconv.Itoa(ceilSeconds(retryErr.RetryAfter)))
+		}
+
 		// Common ////////////////////////////////////////////////////////////////////////
 		if errors.Is(err, controllers.ErrDataBindError) {
 			c.AbortWithStatusJSON(http.StatusBadRequest, err.Error())
 			return
 		}
 
+		var stepUpErr *controllers.ReauthenticationRequiredError
+		if errors.As(err, &stepUpErr) {
+			response := responses.NewReauthenticationRequired(stepUpErr.MaxAge, stepUpErr.ACR)
+			c.Header("WWW-Authenticate", response.WWWAuthenticate())
+			c.AbortWithStatusJSON(http.StatusUnauthorized, response)
+			return
+		}
+
 		if errors.Is(err, controllers.ErrAuthRequired) {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, err.Error())
 			return
 		}
 
-		if errors.Is(err, usecases.ErrEntityAlreadyExists) {
+		if errors.Is(err, controllers.ErrForbidden) || errors.Is(err, controllers.ErrOrganizationRequired) {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+
+		if errors.Is(err, controllers.ErrRateLimitExceeded) {
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, err.Error())
+			return
+		}
+
+		if errors.Is(err, usecases.ErrEntityAlreadyExists) || errors.Is(err, usecases.ErrLastAdmin) {
 			c.AbortWithStatusJSON(http.StatusConflict, err.Error())
 			return
 		}
@@ -37,7 +62,9 @@ func (m *middleware) HandleErrors(c *gin.Context) {
 		///////////////////////////////////////////////////////////////////////////////////
 
 		// Auth ///////////////////////////////////////////////////////////////////////////
-		if errors.Is(err, usecases.ErrWrongPassword) {
+		if errors.Is(err, usecases.ErrWrongPassword) || errors.Is(err, usecases.ErrInvalidCredentials) ||
+			errors.Is(err, usecases.ErrInvalidMFACode) || errors.Is(err, usecases.ErrInvalidWebAuthnCredential) ||
+			errors.Is(err, usecases.ErrInvalidPasswordlessCode) || errors.Is(err, usecases.ErrInvalidSMSCode) {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, err.Error())
 			return
 		}
@@ -57,6 +84,64 @@ func (m *middleware) HandleErrors(c *gin.Context) {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, err.Error())
 			return
 		}
+		if errors.Is(err, usecases.ErrSuspiciousSession) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, err.Error())
+			return
+		}
+		if errors.Is(err, usecases.ErrSuspiciousLogin) {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+
+		if errors.Is(err, usecases.ErrUserDisabled) || errors.Is(err, usecases.ErrUserBanned) {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, usecases.ErrPasswordChangeRequired) || errors.Is(err, usecases.ErrMFARequired) {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, usecases.ErrTooManyAttempts) || errors.Is(err, usecases.ErrTooManySMS) {
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, err.Error())
+			return
+		}
+		if errors.Is(err, usecases.ErrUserLocked) || errors.Is(err, usecases.ErrAccountTemporarilyLocked) {
+			c.AbortWithStatusJSON(http.StatusLocked, err.Error())
+			return
+		}
+
+		if errors.Is(err, usecases.ErrOrganizationAccessDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+
+		if errors.Is(err, usecases.ErrPasswordlessDisabled) {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+
+		if errors.Is(err, usecases.ErrInvalidWebhookSignature) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, err.Error())
+			return
+		}
+		if errors.Is(err, usecases.ErrWebhookReplayed) {
+			c.AbortWithStatusJSON(http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, usecases.ErrInboundWebhookDisabled) {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+
+		if errors.Is(err, usecases.ErrInviteOnly) {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+
+		if errors.Is(err, usecases.ErrInvalidInvitation) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, err.Error())
+			return
+		}
 
 		if errors.Is(err, usecases.ErrSessionNotFound) {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, err.Error())
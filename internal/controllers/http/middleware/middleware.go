@@ -1,20 +1,47 @@
 package middleware
 
 import (
+	"auth/internal/entities"
 	"auth/pkg/logger"
 	"github.com/gin-gonic/gin"
 )
 
 type middleware struct {
-	logger  logger.Logger
-	manager SessionService
+	logger          logger.Logger
+	manager         SessionService
+	userRepo        UserRepository
+	sessionRepo     SessionRepository
+	rateLimitRepo   RateLimitRepository
+	rateLimitPolicy entities.RateLimitPolicy
+	stepUpPolicy    entities.StepUpPolicy
 }
 
 type Middleware interface {
 	Authenticate(c *gin.Context)
+	AuthenticatePasswordChange(c *gin.Context)
+	RequirePermission(permission string) gin.HandlerFunc
+	RequireOrganization(c *gin.Context)
+	RequireStepUp(c *gin.Context)
+	RateLimit(c *gin.Context)
 	HandleErrors(c *gin.Context)
 }
 
-func NewMiddleware(manager SessionService, logger logger.Logger) Middleware {
-	return &middleware{logger: logger, manager: manager}
+func NewMiddleware(
+	manager SessionService,
+	userRepo UserRepository,
+	sessionRepo SessionRepository,
+	rateLimitRepo RateLimitRepository,
+	rateLimitPolicy entities.RateLimitPolicy,
+	stepUpPolicy entities.StepUpPolicy,
+	logger logger.Logger,
+) Middleware {
+	return &middleware{
+		logger:          logger,
+		manager:         manager,
+		userRepo:        userRepo,
+		sessionRepo:     sessionRepo,
+		rateLimitRepo:   rateLimitRepo,
+		rateLimitPolicy: rateLimitPolicy,
+		stepUpPolicy:    stepUpPolicy,
+	}
 }
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/entities"
+	"auth/internal/usecases"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"math"
+	"strconv"
+	"time"
+)
+
+// RateLimit applies the token bucket limit of the matched route and reports
+// it in the RateLimit-* headers. When the storage fails the request is let
+// through, the limits must not take the service down.
+func (m *middleware) RateLimit(c *gin.Context) {
+	if c.FullPath() == "" {
+		return
+	}
+	route := c.Request.Method + " " + c.FullPath()
+
+	limit, ok := m.rateLimitPolicy.ForRoute(route)
+	if !ok {
+		return
+	}
+
+	key := route + "|" + m.rateLimitKey(c, limit.Key)
+	result, err := m.rateLimitRepo.Take(c, key, limit)
+	if err != nil {
+		m.logger.Error().Msgf("failed to apply rate limit: %s", err.Error())
+		return
+	}
+
+	c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+	c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.Reset)))
+	c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d", limit.Limit, ceilSeconds(limit.Period)))
+
+	if !result.Allowed {
+		AddGinError(c, &usecases.RetryAfterError{Err: controllers.ErrRateLimitExceeded, RetryAfter: result.RetryAfter})
+		m.HandleErrors(c)
+		return
+	}
+}
+
+// rateLimitKey identifies the caller. The user is taken from the access token
+// without checking the session, and the client from the configured header;
+// requests without them are counted by IP address.
+func (m *middleware) rateLimitKey(c *gin.Context, keyType string) string {
+	switch keyType {
+	case entities.RateLimitKeyUser:
+		if token := c.GetHeader("Authorization"); token != "" {
+			claims, err := m.manager.ParseToken(token)
+			if err == nil {
+				return "user:" + claims.AccountId()
+			}
+		}
+	case entities.RateLimitKeyClient:
+		if clientId := c.GetHeader(m.rateLimitPolicy.ClientIdHeader); clientId != "" {
+			return "client:" + clientId
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+func ceilSeconds(duration time.Duration) int {
+	return int(math.Ceil(duration.Seconds()))
+}
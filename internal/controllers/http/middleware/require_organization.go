@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/entities"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireOrganization lets the request through only when the session has an
+// active organization and puts its id into the context as "org_id".
+// Organization scoped handlers must take the tenant from there and never from
+// the request itself.
+func (m *middleware) RequireOrganization(c *gin.Context) {
+	value, exists := c.Get("claims")
+	if !exists {
+		AddGinError(c, controllers.ErrAuthRequired)
+		m.HandleErrors(c)
+		return
+	}
+
+	claims, ok := value.(entities.AccessTokenClaims)
+	if !ok || claims.OrganizationId() == "" {
+		AddGinError(c, controllers.ErrOrganizationRequired)
+		m.HandleErrors(c)
+		return
+	}
+
+	c.Set("org_id", claims.OrganizationId())
+}
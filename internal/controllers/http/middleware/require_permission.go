@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/entities"
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission returns a handler that lets the request through only when
+// the access token grants the permission. It must run after Authenticate, it
+// relies on the claims the latter puts into the context.
+func (m *middleware) RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, exists := c.Get("claims")
+		if !exists {
+			AddGinError(c, controllers.ErrAuthRequired)
+			m.HandleErrors(c)
+			return
+		}
+
+		claims, ok := value.(entities.AccessTokenClaims)
+		if !ok || !claims.HasPermission(permission) {
+			AddGinError(c, controllers.ErrForbidden)
+			m.HandleErrors(c)
+			return
+		}
+	}
+}
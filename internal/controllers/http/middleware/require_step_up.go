@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/entities"
+	"github.com/gin-gonic/gin"
+	"time"
+)
+
+// RequireStepUp lets the request through only when the user of the access
+// token authenticated recently and strongly enough for a sensitive
+// operation. Otherwise the client gets the reauthentication required error
+// telling what to do: sign in again, with the second factor if acr_values
+// asks for it. It must run after Authenticate.
+func (m *middleware) RequireStepUp(c *gin.Context) {
+	value, exists := c.Get("claims")
+	if !exists {
+		AddGinError(c, controllers.ErrAuthRequired)
+		m.HandleErrors(c)
+		return
+	}
+
+	claims, ok := value.(entities.AccessTokenClaims)
+	if !ok || !m.stepUpPolicy.Satisfied(claims.Authentication(), time.Now()) {
+		AddGinError(c, &controllers.ReauthenticationRequiredError{
+			MaxAge: m.stepUpPolicy.MaxAge,
+			ACR:    m.stepUpPolicy.ACR,
+		})
+		m.HandleErrors(c)
+		return
+	}
+}
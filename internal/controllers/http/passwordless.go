@@ -0,0 +1,93 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+)
+
+type passwordlessController struct {
+	logger  logger.Logger
+	useCase usecases.PasswordlessUseCase
+}
+
+func NewPasswordlessController(
+	handler *gin.Engine,
+	useCase usecases.PasswordlessUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	u := &passwordlessController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/auth/passwordless/start", u.StartPasswordless, middleware.HandleErrors)
+	handler.POST("/auth/passwordless/complete", u.CompletePasswordless, middleware.HandleErrors)
+}
+
+// StartPasswordless godoc
+// @Summary      начало входа без пароля по email
+// @Description  отправка на email одноразового кода из 6 цифр (method=code) или ссылки для входа (method=link). Ответ одинаков для существующих и несуществующих пользователей. Возвращённый deviceToken также сохраняется в cookie passwordless_device: вход можно завершить только на устройстве, с которого он начат
+// @Accept       json
+// @Produce      json
+// @Param request body requests.StartPasswordless true "структура запроса"
+// @Success      202  {object}  responses.PasswordlessStarted
+// @Failure 400 {object} string "некорректный формат запроса или email"
+// @Failure 403 {object} string "вход без пароля отключен"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/passwordless/start [post]
+func (router *passwordlessController) StartPasswordless(c *gin.Context) {
+	var request requests.StartPasswordless
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := router.useCase.StartPasswordless(c, c.Writer, &request)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to start passwordless sign in"))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+// CompletePasswordless godoc
+// @Summary      завершение входа без пароля по email
+// @Description  проверка кода из письма или токена из ссылки и выдача тех же токенов, что и при входе по паролю; необязательный orgId выбирает активную организацию сессии. deviceToken берётся из тела запроса или из cookie passwordless_device. Если аккаунта нет и политика регистрации это позволяет, он создаётся. Если включена двухфакторная аутентификация, вместо сессии возвращается mfaRequired, кроме входа с доверенного устройства
+// @Accept       json
+// @Produce      json
+// @Param request body requests.CompletePasswordless true "структура запроса"
+// @Success      200  {object}  responses.SignIn
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "неверный или истёкший код, либо вход начат на другом устройстве"
+// @Failure 403 {object} string "вход без пароля отключен, пользователь отключен, заблокирован навсегда, не состоит в выбранной организации или вход заблокирован как подозрительный"
+// @Failure 423 {object} string "аккаунт заблокирован после неудачных попыток входа, заголовок Retry-After"
+// @Failure 429 {object} string "слишком много неудачных попыток входа, заголовок Retry-After"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/passwordless/complete [post]
+func (router *passwordlessController) CompletePasswordless(c *gin.Context) {
+	var request requests.CompletePasswordless
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+	if request.DeviceToken == "" {
+		request.DeviceToken, _ = c.Cookie(usecases.PasswordlessDeviceCookie)
+	}
+	request.TrustedDeviceToken, _ = c.Cookie(usecases.TrustedDeviceCookie)
+
+	response, err := router.useCase.CompletePasswordless(c, c.Writer, &request, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to complete passwordless sign in"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
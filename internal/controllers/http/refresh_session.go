@@ -31,13 +31,15 @@ func NewRefreshSessionController(
 
 // RefreshSession godoc
 // @Summary      обновление сессии
-// @Description  возвращает новую пару токенов при отправке старой пары и при условии их валидности
+// @Description  возвращает новую пару токенов при отправке старой пары и при условии их валидности; orgId переключает активную организацию, без него сохраняется текущая
 // @Accept       json
 // @Produce      json
 // @Param request body requests.RefreshSession true "request format"
 // @Success      200  {object}  responses.Session
 // @Failure 400 {object} string "некорректный формат запроса"
-// @Failure 401 {object} string "невалидная пара токенов, либо истекший refresh token"
+// @Failure 401 {object} string "невалидная пара токенов, истекший refresh token, либо сессия завершена как подозрительная после смены IP-адреса"
+// @Failure 403 {object} string "пользователь отключен, заблокирован навсегда или не состоит в выбранной организации"
+// @Failure 423 {object} string "пользователь временно заблокирован"
 // @Failure 500 {object} string "внутренняя ошибка сервера"
 // @Router       /auth/token/update [post]
 func (r *refreshSessionController) RefreshSession(c *gin.Context) {
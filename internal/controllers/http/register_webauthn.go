@@ -0,0 +1,79 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type registerWebAuthnController struct {
+	logger  logger.Logger
+	useCase usecases.RegisterWebAuthnUseCase
+}
+
+func NewRegisterWebAuthnController(
+	handler *gin.Engine,
+	useCase usecases.RegisterWebAuthnUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	r := &registerWebAuthnController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/auth/webauthn/register/begin", middleware.Authenticate, r.Begin, middleware.HandleErrors)
+	handler.POST("/auth/webauthn/register/finish", middleware.Authenticate, r.Finish, middleware.HandleErrors)
+}
+
+// Begin godoc
+// @Summary      начало регистрации passkey
+// @Description  создание challenge для navigator.credentials.create; уже зарегистрированные ключи пользователя исключаются
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Success 200 {object} responses.WebAuthnOptions
+// @Failure 401 {object} string "некорректный токен"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/webauthn/register/begin [post]
+func (r *registerWebAuthnController) Begin(c *gin.Context) {
+	response, err := r.useCase.Begin(c, c.GetString("user_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Finish godoc
+// @Summary      завершение регистрации passkey
+// @Description  проверка ответа navigator.credentials.create и сохранение ключа. После регистрации ключ требуется как второй фактор при входе по паролю, а passkey можно использовать для входа без пароля
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.FinishWebAuthnRegistration true "структура запроса"
+// @Success 200 {object} responses.WebAuthnCredential
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный токен, ключ не прошёл проверку или challenge истёк"
+// @Failure 409 {object} string "ключ уже зарегистрирован"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/webauthn/register/finish [post]
+func (r *registerWebAuthnController) Finish(c *gin.Context) {
+	var request requests.FinishWebAuthnRegistration
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := r.useCase.Finish(c, c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type removeMemberController struct {
+	logger  logger.Logger
+	useCase usecases.RemoveMemberUseCase
+}
+
+func NewRemoveMemberController(
+	handler *gin.Engine,
+	useCase usecases.RemoveMemberUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	o := &removeMemberController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.DELETE("/organizations/members/:user_id", middleware.Authenticate, middleware.RequireOrganization, o.RemoveMember, middleware.HandleErrors)
+}
+
+// RemoveMember godoc
+// @Summary      исключение участника из организации
+// @Description  исключение участника из активной организации сессии; доступно владельцам и администраторам организации, владельца исключить нельзя
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        user_id path string true "id пользователя"
+// @Success 200 "ok"
+// @Failure 400 {object} string "попытка исключить владельца"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав в организации"
+// @Failure 404 {object} string "участник не найден"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /organizations/members/{user_id} [delete]
+func (o *removeMemberController) RemoveMember(c *gin.Context) {
+	err := o.useCase.RemoveMember(c, c.GetString("org_id"), c.GetString("user_id"), c.Param("user_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "member removed")
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"auth/internal/entities"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -16,7 +17,7 @@ func InitServiceMiddleware(handler *gin.Engine) {
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"PUT", "PATCH", "POST", "GET", "DELETE"},
 		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", "Accept-Encoding"},
-		ExposeHeaders:    []string{"Content-Length", "Access-Control-Allow-Origin", "Access-Control-Allow-Credentials", "Access-Control-Allow-Headers", "Access-Control-Allow-Methods"},
+		ExposeHeaders:    []string{"Content-Length", "Access-Control-Allow-Origin", "Access-Control-Allow-Credentials", "Access-Control-Allow-Headers", "Access-Control-Allow-Methods", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy", "Retry-After"},
 		AllowCredentials: true,
 		AllowOriginFunc: func(origin string) bool {
 			return origin == "*"
@@ -24,6 +25,16 @@ func InitServiceMiddleware(handler *gin.Engine) {
 		MaxAge: 12 * time.Hour,
 	}))
 
+	handler.Use(requestInfo)
+
 	handler.GET("/", func(c *gin.Context) { c.Redirect(http.StatusPermanentRedirect, "/swagger/index.html") })
 	handler.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
+
+// requestInfo keeps the address and the user agent of the request in the
+// context, the audit logger records them with the events.
+func requestInfo(c *gin.Context) {
+	c.Set(entities.ContextIPKey, c.ClientIP())
+	c.Set(entities.ContextUserAgentKey, c.Request.UserAgent())
+	c.Next()
+}
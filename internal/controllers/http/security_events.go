@@ -0,0 +1,62 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type securityEventsController struct {
+	logger  logger.Logger
+	useCase usecases.SecurityEventsUseCase
+}
+
+func NewSecurityEventsController(
+	handler *gin.Engine,
+	useCase usecases.SecurityEventsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	s := &securityEventsController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/auth/user/security-events", middleware.Authenticate, s.ListOwn, middleware.HandleErrors)
+}
+
+// ListOwn godoc
+// @Summary      история событий безопасности
+// @Description  входы, смены пароля, настройка второго фактора и другие события, в которых пользователь был инициатором или объектом действия, от новых к старым
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        page    query int    false "номер страницы, начиная с 1"
+// @Param        limit   query int    false "размер страницы, не больше 100"
+// @Param        type    query string false "тип события" example(sign_in)
+// @Param        outcome query string false "результат" Enums(success, failure)
+// @Param        from    query string false "начало периода в RFC 3339"
+// @Param        to      query string false "конец периода в RFC 3339"
+// @Success 200 {object} responses.AuditEventList
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/user/security-events [get]
+func (s *securityEventsController) ListOwn(c *gin.Context) {
+	var request requests.ListSecurityEvents
+	if err := c.ShouldBindQuery(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := s.useCase.ListOwn(c, c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
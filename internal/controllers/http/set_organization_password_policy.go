@@ -0,0 +1,59 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type setOrganizationPasswordPolicyController struct {
+	logger  logger.Logger
+	useCase usecases.SetOrganizationPasswordPolicyUseCase
+}
+
+func NewSetOrganizationPasswordPolicyController(
+	handler *gin.Engine,
+	useCase usecases.SetOrganizationPasswordPolicyUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	o := &setOrganizationPasswordPolicyController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.PUT("/organizations/password-policy", middleware.Authenticate, middleware.RequireOrganization, o.SetPasswordPolicy, middleware.HandleErrors)
+}
+
+// SetPasswordPolicy godoc
+// @Summary      срок действия паролей в организации
+// @Description  задаёт срок действия пароля в секундах для входов в активную организацию сессии вместо `password_policy.max_age`; `null` возвращает общий срок, `0` отключает проверку. Доступно владельцам организации
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.SetOrganizationPasswordPolicy true "структура запроса"
+// @Success 200 "ok"
+// @Failure 400 {object} string "некорректный формат запроса или отрицательный срок"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 403 {object} string "недостаточно прав в организации"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /organizations/password-policy [put]
+func (o *setOrganizationPasswordPolicyController) SetPasswordPolicy(c *gin.Context) {
+	var request requests.SetOrganizationPasswordPolicy
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	err := o.useCase.SetPasswordPolicy(c, c.GetString("org_id"), c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "password policy updated")
+}
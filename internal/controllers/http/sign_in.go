@@ -32,14 +32,16 @@ func NewSignInController(
 
 // SignIn godoc
 // @Summary      вход в аккаунт
-// @Description  вход в аккаунт с использованием email + пароль для получения токенов
+// @Description  вход в аккаунт с использованием email + пароль для получения токенов; необязательный orgId выбирает активную организацию сессии. Если включена двухфакторная аутентификация, вместо сессии возвращается mfaRequired и ограниченный токен для /auth/mfa/verify, кроме входа с доверенного устройства (cookie trusted_device). Если срок действия пароля истёк, вместо сессии возвращается passwordChangeRequired и ограниченный токен для смены пароля. Подозрительный вход (новое устройство, новая страна, невозможное перемещение, много аккаунтов с одного IP) требует второй фактор даже с доверенного устройства, сопровождается письмом или блокируется
 // @Accept       json
 // @Produce      json
 // @Param request body requests.SignIn true "структура запроса"
 // @Success      200  {object}  responses.SignIn
 // @Failure 400 {object} string "некорректный формат запроса"
-// @Failure 401 {object} string "неправильный пароль"
-// @Failure 404 {object} string "пользователь не найден"
+// @Failure 401 {object} string "неверный email или пароль, ответ одинаков для несуществующих и существующих пользователей"
+// @Failure 403 {object} string "пользователь отключен, заблокирован навсегда, не состоит в выбранной организации или вход заблокирован как подозрительный"
+// @Failure 423 {object} string "пользователь временно заблокирован или аккаунт заблокирован после неудачных попыток входа, заголовок Retry-After"
+// @Failure 429 {object} string "слишком много неудачных попыток входа, заголовок Retry-After"
 // @Failure 500 {object} string "внутренняя ошибка сервера"
 // @Router       /auth/signin [post]
 func (router *signInController) SignIn(c *gin.Context) {
@@ -49,6 +51,8 @@ func (router *signInController) SignIn(c *gin.Context) {
 		return
 	}
 
+	request.TrustedDeviceToken, _ = c.Cookie(usecases.TrustedDeviceCookie)
+
 	userAgent := c.Request.UserAgent()
 	ip := c.ClientIP()
 
@@ -28,17 +28,20 @@ func NewSignUpController(
 	}
 
 	handler.POST("/auth/signup", u.SignUp, middleware.HandleErrors)
+	handler.POST("/auth/signup/invitation", u.SignUpByInvitation, middleware.HandleErrors)
 }
 
 // SignUp godoc
 // @Summary      регистрация нового пользователя
-// @Description  регистрация нового пользователя
+// @Description  регистрация нового пользователя. В режиме единообразного ответа возвращает 202 с checkEmail и для свободного, и для занятого email, а владельцу существующего аккаунта отправляет письмо
 // @Accept       json
 // @Produce      json
 // @Param request body requests.SignUp true "структура запрос"
 // @Success      200  {object}  responses.SignUp
+// @Success      202  {object}  responses.SignUp
 // @Failure 400 {object} string "некорректный формат запроса"
-// @Failure 409 {object} string "пользователь уже существует"
+// @Failure 403 {object} string "регистрация доступна только по приглашению"
+// @Failure 409 {object} string "пользователь уже существует, кроме режима единообразного ответа"
 // @Failure 500 {object} string "внутренняя ошибка сервера"
 // @Router       /auth/signup [post]
 func (u *signupController) SignUp(c *gin.Context) {
@@ -59,5 +62,41 @@ func (u *signupController) SignUp(c *gin.Context) {
 		return
 	}
 
+	if response.CheckEmail {
+		c.JSON(http.StatusAccepted, response)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// SignUpByInvitation godoc
+// @Summary      регистрация по приглашению
+// @Description  регистрация пользователя с email из приглашения; выдаёт роль и членство в организации из приглашения, доступна и в режиме регистрации только по приглашениям
+// @Accept       json
+// @Produce      json
+// @Param request body requests.SignUpByInvitation true "структура запроса"
+// @Success      200  {object}  responses.SignUp
+// @Failure 400 {object} string "некорректный формат запроса или недействительное приглашение"
+// @Failure 409 {object} string "пользователь уже существует"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/signup/invitation [post]
+func (u *signupController) SignUpByInvitation(c *gin.Context) {
+	var request requests.SignUpByInvitation
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := u.user.CreateInvitedUser(c, c.Writer, request, c.GetHeader("User-Agent"), c.ClientIP())
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to create account"))
+		return
+	}
+
+	if response.CheckEmail {
+		c.JSON(http.StatusAccepted, response)
+		return
+	}
 	c.JSON(http.StatusOK, response)
 }
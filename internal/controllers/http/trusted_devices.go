@@ -0,0 +1,89 @@
+package http
+
+import (
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type trustedDevicesController struct {
+	logger  logger.Logger
+	useCase usecases.TrustedDevicesUseCase
+}
+
+func NewTrustedDevicesController(
+	handler *gin.Engine,
+	useCase usecases.TrustedDevicesUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	t := &trustedDevicesController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.GET("/auth/user/trusted-devices", middleware.Authenticate, t.List, middleware.HandleErrors)
+	handler.DELETE("/auth/user/trusted-devices", middleware.Authenticate, t.RevokeAll, middleware.HandleErrors)
+	handler.DELETE("/auth/user/trusted-devices/:device_id", middleware.Authenticate, t.Revoke, middleware.HandleErrors)
+}
+
+// List godoc
+// @Summary      список доверенных устройств
+// @Description  устройства, на которых пользователь выбрал rememberDevice при прохождении второго фактора; вход с них не требует второго фактора до истечения срока доверия
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Success 200 {array} responses.TrustedDevice
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/user/trusted-devices [get]
+func (t *trustedDevicesController) List(c *gin.Context) {
+	response, err := t.useCase.List(c, c.GetString("user_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Revoke godoc
+// @Summary      отзыв доверенного устройства
+// @Description  следующий вход с устройства снова потребует второй фактор
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param        device_id path string true "id устройства"
+// @Success 200 "ok"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 404 {object} string "устройство не найдено"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/user/trusted-devices/{device_id} [delete]
+func (t *trustedDevicesController) Revoke(c *gin.Context) {
+	err := t.useCase.Revoke(c, c.GetString("user_id"), c.Param("device_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "trusted device revoked")
+}
+
+// RevokeAll godoc
+// @Summary      отзыв всех доверенных устройств
+// @Description  следующий вход с любого устройства снова потребует второй фактор
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Success 200 "ok"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/user/trusted-devices [delete]
+func (t *trustedDevicesController) RevokeAll(c *gin.Context) {
+	err := t.useCase.RevokeAll(c, c.GetString("user_id"))
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "trusted devices revoked")
+}
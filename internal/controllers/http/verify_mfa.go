@@ -0,0 +1,91 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+)
+
+type verifyMFAController struct {
+	logger  logger.Logger
+	useCase usecases.VerifyMFAUseCase
+}
+
+func NewVerifyMFAController(
+	handler *gin.Engine,
+	useCase usecases.VerifyMFAUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	u := &verifyMFAController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/auth/mfa/verify", u.VerifyMFA, middleware.HandleErrors)
+	handler.POST("/auth/mfa/sms", u.SendMFASMS, middleware.HandleErrors)
+}
+
+// VerifyMFA godoc
+// @Summary      второй фактор входа
+// @Description  завершение входа кодом TOTP, одноразовым кодом восстановления или кодом из SMS (smsCode, см. /auth/mfa/sms); mfaToken — ограниченный токен, полученный в ответе /auth/signin. Код TOTP принимается только один раз. С rememberDevice устройство становится доверенным: в cookie trusted_device сохраняется подписанный токен устройства, и следующие входы с него не требуют второго фактора
+// @Accept       json
+// @Produce      json
+// @Param request body requests.VerifyMFA true "структура запроса"
+// @Success      200  {object}  responses.SignIn
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный mfaToken или неверный код"
+// @Failure 403 {object} string "пользователь отключен, заблокирован навсегда, не состоит в выбранной организации или вход заблокирован как подозрительный"
+// @Failure 423 {object} string "аккаунт заблокирован после неудачных попыток входа, заголовок Retry-After"
+// @Failure 429 {object} string "слишком много неудачных попыток входа, заголовок Retry-After"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/mfa/verify [post]
+func (router *verifyMFAController) VerifyMFA(c *gin.Context) {
+	var request requests.VerifyMFA
+	if err := c.ShouldBindJSON(&request); err != nil || (request.Code == "" && request.RecoveryCode == "" && request.SMSCode == "") {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := router.useCase.VerifyMFA(c, c.Writer, &request, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to verify second factor"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SendMFASMS godoc
+// @Summary      код второго фактора в SMS
+// @Description  отправка кода из 6 цифр на подтверждённый телефон пользователя, если среди методов в ответе /auth/signin есть sms; код передаётся в smsCode запроса /auth/mfa/verify. Повторный запрос заменяет прежний код, число SMS на один номер ограничено
+// @Accept       json
+// @Produce      json
+// @Param request body requests.SendMFASMS true "структура запроса"
+// @Success      202  {object}  responses.SMSCodeSent
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный mfaToken"
+// @Failure 404 {object} string "у пользователя нет подтверждённого телефона"
+// @Failure 429 {object} string "слишком много SMS на этот номер, заголовок Retry-After"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/mfa/sms [post]
+func (router *verifyMFAController) SendMFASMS(c *gin.Context) {
+	var request requests.SendMFASMS
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := router.useCase.SendMFASMS(c, &request)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to send sms code"))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
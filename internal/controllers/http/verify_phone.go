@@ -0,0 +1,88 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type verifyPhoneController struct {
+	logger  logger.Logger
+	useCase usecases.VerifyPhoneUseCase
+}
+
+func NewVerifyPhoneController(
+	handler *gin.Engine,
+	useCase usecases.VerifyPhoneUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	v := &verifyPhoneController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/auth/user/phone", middleware.Authenticate, v.Start, middleware.HandleErrors)
+	handler.POST("/auth/user/phone/confirm", middleware.Authenticate, v.Confirm, middleware.HandleErrors)
+}
+
+// Start godoc
+// @Summary      привязка телефона
+// @Description  отправка кода из 6 цифр в SMS на телефон в международном формате; телефон сохраняется только после подтверждения кодом. Число SMS на один номер ограничено
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.StartPhoneVerification true "структура запроса"
+// @Success 202 {object} responses.SMSCodeSent
+// @Failure 400 {object} string "некорректный формат запроса или телефона"
+// @Failure 401 {object} string "некорректный access token"
+// @Failure 429 {object} string "слишком много SMS на этот номер, заголовок Retry-After"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/user/phone [post]
+func (v *verifyPhoneController) Start(c *gin.Context) {
+	var request requests.StartPhoneVerification
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := v.useCase.Start(c, c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+// Confirm godoc
+// @Summary      подтверждение телефона
+// @Description  проверка кода из SMS; телефон сохраняется как подтверждённый и может использоваться как второй фактор входа. После нескольких неверных кодов нужно запросить новый
+// @Accept       json
+// @Produce      json
+// @Param Authorization header string true "access token"
+// @Param request body requests.ConfirmPhone true "структура запроса"
+// @Success 200 "ok"
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный access token, неверный или истёкший код"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/user/phone/confirm [post]
+func (v *verifyPhoneController) Confirm(c *gin.Context) {
+	var request requests.ConfirmPhone
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	err := v.useCase.Confirm(c, c.GetString("user_id"), request)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, "phone verified")
+}
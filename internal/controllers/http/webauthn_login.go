@@ -0,0 +1,91 @@
+package http
+
+import (
+	"auth/internal/controllers"
+	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
+	"auth/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+)
+
+type webAuthnLoginController struct {
+	logger  logger.Logger
+	useCase usecases.WebAuthnLoginUseCase
+}
+
+func NewWebAuthnLoginController(
+	handler *gin.Engine,
+	useCase usecases.WebAuthnLoginUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	u := &webAuthnLoginController{
+		logger:  logger,
+		useCase: useCase,
+	}
+
+	handler.POST("/auth/webauthn/login/begin", u.BeginWebAuthnLogin, middleware.HandleErrors)
+	handler.POST("/auth/webauthn/login/finish", u.FinishWebAuthnLogin, middleware.HandleErrors)
+}
+
+// BeginWebAuthnLogin godoc
+// @Summary      начало входа по passkey
+// @Description  создание challenge для navigator.credentials.get. С mfaToken из ответа /auth/signin ключ используется как второй фактор, без него — для входа без пароля по passkey с проверкой пользователя
+// @Accept       json
+// @Produce      json
+// @Param request body requests.BeginWebAuthnLogin true "структура запроса"
+// @Success      200  {object}  responses.WebAuthnOptions
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "некорректный mfaToken"
+// @Failure 403 {object} string "вход без пароля отключен"
+// @Failure 404 {object} string "у пользователя нет ключей"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/webauthn/login/begin [post]
+func (router *webAuthnLoginController) BeginWebAuthnLogin(c *gin.Context) {
+	var request requests.BeginWebAuthnLogin
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := router.useCase.BeginWebAuthnLogin(c, &request)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to begin webauthn login"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// FinishWebAuthnLogin godoc
+// @Summary      завершение входа по passkey
+// @Description  проверка ответа navigator.credentials.get и выдача тех же токенов, что и при входе по паролю; необязательный orgId выбирает активную организацию сессии. Неудачные попытки учитываются защитой от подбора пароля. Если ключ был вторым фактором, rememberDevice делает устройство доверенным, как в /auth/mfa/verify
+// @Accept       json
+// @Produce      json
+// @Param request body requests.FinishWebAuthnLogin true "структура запроса"
+// @Success      200  {object}  responses.SignIn
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 401 {object} string "ключ не прошёл проверку или challenge истёк"
+// @Failure 403 {object} string "пользователь отключен, заблокирован навсегда, не состоит в выбранной организации или вход заблокирован как подозрительный"
+// @Failure 423 {object} string "аккаунт заблокирован после неудачных попыток входа, заголовок Retry-After"
+// @Failure 429 {object} string "слишком много неудачных попыток входа, заголовок Retry-After"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/webauthn/login/finish [post]
+func (router *webAuthnLoginController) FinishWebAuthnLogin(c *gin.Context) {
+	var request requests.FinishWebAuthnLogin
+	if err := c.ShouldBindJSON(&request); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := router.useCase.FinishWebAuthnLogin(c, c.Writer, &request, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to finish webauthn login"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -3,35 +3,71 @@ package http
 import (
 	"auth/internal/controllers"
 	"auth/internal/controllers/http/middleware"
+	"auth/internal/controllers/requests"
+	"auth/internal/usecases"
 	"auth/pkg/logger"
+	"errors"
 	"github.com/gin-gonic/gin"
+	"io"
 	"net/http"
 )
 
+// maxWebhookBodySize limits the inbound webhook body, the alerts are small.
+const maxWebhookBodySize = 64 << 10
+
 type webhookController struct {
-	logger logger.Logger
+	logger  logger.Logger
+	useCase usecases.WebhookAlertsUseCase
 }
 
 func NewWebhookController(
 	handler *gin.Engine,
+	useCase usecases.WebhookAlertsUseCase,
 	middleware middleware.Middleware,
 	logger logger.Logger,
 ) {
 	wc := &webhookController{
-		logger: logger,
+		logger:  logger,
+		useCase: useCase,
 	}
 
 	handler.POST("/auth/webhook", wc.HandleWebhook, middleware.HandleErrors)
 }
 
+// HandleWebhook godoc
+// @Summary      входящий вебхук
+// @Description  принимает событие безопасности, подписанное общим секретом: HMAC-SHA256 от "timestamp.body" в заголовке Webhook-Signature; запросы со временем подписи дальше допуска и повторы отклоняются, принятые события сохраняются
+// @Accept       json
+// @Produce      json
+// @Param Webhook-Timestamp header string true "время подписи, unix-секунды"
+// @Param Webhook-Signature header string true "подпись v1=<hex>, несколько подписей через пробел"
+// @Param        request body requests.WebhookAlert true "событие"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} string "некорректное тело запроса"
+// @Failure 401 {object} string "неверная или устаревшая подпись"
+// @Failure 403 {object} string "входящий вебхук не настроен"
+// @Failure 409 {object} string "повтор уже принятого запроса"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router       /auth/webhook [post]
 func (wc *webhookController) HandleWebhook(c *gin.Context) {
-	var message map[string]interface{}
-	if err := c.ShouldBindJSON(&message); err != nil {
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookBodySize))
+	if err != nil {
 		middleware.AddGinError(c, controllers.ErrDataBindError)
 		return
 	}
 
-	wc.logger.Info().Msgf("Message: %+v\n", message)
+	err = wc.useCase.Receive(c, requests.ReceiveWebhook{
+		Timestamp: c.GetHeader("Webhook-Timestamp"),
+		Signature: c.GetHeader("Webhook-Signature"),
+		Body:      body,
+	})
+	if errors.Is(err, usecases.ErrInvalidWebhookSignature) {
+		wc.logger.Warn().Msgf("rejected inbound webhook from %s: %s", c.ClientIP(), err.Error())
+	}
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"status":  "success",
@@ -0,0 +1,22 @@
+package requests
+
+import "time"
+
+type ListUsers struct {
+	Page   int    `form:"page" example:"1"`
+	Limit  int    `form:"limit" example:"20"`
+	Email  string `form:"email" example:"mail.ru"`
+	Status string `form:"status" example:"active"`
+}
+
+type UpdateUser struct {
+	Email       *string   `json:"email" example:"example@mail.ru"`
+	Status      *string   `json:"status" example:"locked"`
+	Reason      string    `json:"reason" example:"suspicious activity"`
+	LockedUntil time.Time `json:"lockedUntil" example:"2030-01-01T00:00:00Z"`
+}
+
+type CreateUserInvitation struct {
+	Email string `json:"email" binding:"required" example:"example@mail.ru"`
+	Role  string `json:"role" example:"support"`
+}
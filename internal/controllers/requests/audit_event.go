@@ -0,0 +1,24 @@
+package requests
+
+import "time"
+
+type ListSecurityEvents struct {
+	Page    int       `form:"page" example:"1"`
+	Limit   int       `form:"limit" example:"20"`
+	Type    string    `form:"type" example:"sign_in"`
+	Outcome string    `form:"outcome" example:"failure"`
+	From    time.Time `form:"from" example:"2024-01-01T00:00:00Z"`
+	To      time.Time `form:"to" example:"2024-02-01T00:00:00Z"`
+}
+
+type ListAuditEvents struct {
+	Page      int       `form:"page" example:"1"`
+	Limit     int       `form:"limit" example:"20"`
+	UserId    string    `form:"userId" example:"e1e25658-3817-4051-8d0d-d13d575f08a4"`
+	ActorId   string    `form:"actorId" example:"e1e25658-3817-4051-8d0d-d13d575f08a4"`
+	SubjectId string    `form:"subjectId" example:"e1e25658-3817-4051-8d0d-d13d575f08a4"`
+	Type      string    `form:"type" example:"sign_in"`
+	Outcome   string    `form:"outcome" example:"failure"`
+	From      time.Time `form:"from" example:"2024-01-01T00:00:00Z"`
+	To        time.Time `form:"to" example:"2024-02-01T00:00:00Z"`
+}
@@ -0,0 +1,21 @@
+package requests
+
+type CreateOrganization struct {
+	Name string `json:"name" binding:"required" example:"Acme Inc."`
+}
+
+// SetOrganizationPasswordPolicy overrides the password max age of the
+// password policy in seconds, null restores it and 0 disables the expiry.
+type SetOrganizationPasswordPolicy struct {
+	MaxAge *int64 `json:"maxAge" example:"7776000"`
+}
+
+type CreateInvitation struct {
+	Email string `json:"email" binding:"required" example:"colleague@mail.ru"`
+	Role  string `json:"role" example:"member"`
+}
+
+type AcceptInvitation struct {
+	InvitationId string `json:"invitationId" binding:"required" example:"6f1c3f0e-0b8a-4a55-9a3c-0f7b1b7d2f10"`
+	Token        string `json:"token" binding:"required" example:"pQ3d1n0w9hXr2v7tJk5yB8cZ4aF6eG1iL0mN2oP3qR4"`
+}
@@ -0,0 +1,17 @@
+package requests
+
+type StartPasswordless struct {
+	Email  string `json:"email" binding:"required" example:"example@mail.ru"`
+	Method string `json:"method" binding:"omitempty,oneof=code link" example:"code"`
+}
+
+// CompletePasswordless carries the emailed code or the token from the magic
+// link. The device token may be omitted when the cookie set at the start is
+// sent instead.
+type CompletePasswordless struct {
+	DeviceToken    string `json:"deviceToken" example:"q2n8xM0b7VZp3yTn4kJ1cW5rL9sA6dE0fG2hI4jK8mN"`
+	Code           string `json:"code" binding:"required" example:"123456"`
+	OrganizationId string `json:"orgId" example:"0b3bd2d2-8d45-4d2e-a6a7-5b4d2c4ad0b1"`
+	// TrustedDeviceToken is taken from the trusted device cookie.
+	TrustedDeviceToken string `json:"-"`
+}
@@ -0,0 +1,9 @@
+package requests
+
+type StartPhoneVerification struct {
+	Phone string `json:"phone" binding:"required" example:"+79991234567"`
+}
+
+type ConfirmPhone struct {
+	Code string `json:"code" binding:"required" example:"654321"`
+}
@@ -0,0 +1,21 @@
+package requests
+
+type CreateRole struct {
+	Name        string   `json:"name" binding:"required" example:"support"`
+	Description string   `json:"description" example:"support team"`
+	Permissions []string `json:"permissions" example:"users:read"`
+}
+
+type UpdateRole struct {
+	Description *string   `json:"description" example:"support team"`
+	Permissions *[]string `json:"permissions" example:"users:read,sessions:revoke"`
+}
+
+type CreatePermission struct {
+	Name        string `json:"name" binding:"required" example:"reports:read"`
+	Description string `json:"description" example:"read reports"`
+}
+
+type SetUserRoles struct {
+	Roles []string `json:"roles" binding:"required" example:"user,support"`
+}
@@ -1,6 +1,35 @@
 package requests
 
 type SignIn struct {
-	Email    string `json:"email" binding:"required" example:"example@mail.ru"`
-	Password string `json:"password" binding:"required" example:"123superPassword"`
+	Email          string `json:"email" binding:"required" example:"example@mail.ru"`
+	Password       string `json:"password" binding:"required" example:"123superPassword"`
+	OrganizationId string `json:"orgId" example:"0b3bd2d2-8d45-4d2e-a6a7-5b4d2c4ad0b1"`
+	// TrustedDeviceToken is taken from the trusted device cookie.
+	TrustedDeviceToken string `json:"-"`
+}
+
+type ChangePassword struct {
+	CurrentPassword string `json:"currentPassword" binding:"required" example:"123superPassword"`
+	NewPassword     string `json:"newPassword" binding:"required" example:"correct horse battery staple 42"`
+}
+
+// VerifyMFA takes either the TOTP code, one of the recovery codes or the code
+// sent by SMS.
+// RememberDevice trusts the device, later sign ins from it skip the second
+// factor.
+type VerifyMFA struct {
+	MFAToken       string `json:"mfaToken" binding:"required" example:"eyJhbGciOiJIUzUxMiIsInR5cCI6IkpXVCJ9..."`
+	Code           string `json:"code" example:"123456"`
+	RecoveryCode   string `json:"recoveryCode" example:"sqhbj-nv54b"`
+	SMSCode        string `json:"smsCode" example:"654321"`
+	OrganizationId string `json:"orgId" example:"0b3bd2d2-8d45-4d2e-a6a7-5b4d2c4ad0b1"`
+	RememberDevice bool   `json:"rememberDevice" example:"true"`
+}
+
+type SendMFASMS struct {
+	MFAToken string `json:"mfaToken" binding:"required" example:"eyJhbGciOiJIUzUxMiIsInR5cCI6IkpXVCJ9..."`
+}
+
+type ConfirmTOTP struct {
+	Code string `json:"code" binding:"required" example:"123456"`
 }
@@ -4,3 +4,9 @@ type SignUp struct {
 	Email    string `json:"email" binding:"required" example:"example@mail.ru"`
 	Password string `json:"password" binding:"required" example:"123superPassword"`
 }
+
+type SignUpByInvitation struct {
+	InvitationId string `json:"invitationId" binding:"required" example:"6f1c3f0e-0b8a-4a55-9a3c-0f7b1b7d2f10"`
+	Token        string `json:"token" binding:"required" example:"pQ3d1n0w9hXr2v7tJk5yB8cZ4aF6eG1iL0mN2oP3qR4"`
+	Password     string `json:"password" binding:"required" example:"123superPassword"`
+}
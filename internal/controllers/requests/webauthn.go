@@ -0,0 +1,27 @@
+package requests
+
+import "encoding/json"
+
+// BeginWebAuthnLogin starts the passwordless login without the MFA token and
+// the second factor with it.
+type BeginWebAuthnLogin struct {
+	MFAToken string `json:"mfaToken" example:"eyJhbGciOiJIUzUxMiIsInR5cCI6IkpXVCJ9..."`
+}
+
+// FinishWebAuthnLogin carries the PublicKeyCredential returned by
+// navigator.credentials.get. RememberDevice trusts the device when the key
+// is the second factor.
+type FinishWebAuthnLogin struct {
+	SessionId      string          `json:"sessionId" binding:"required" example:"6f1c1a52-5d5e-4a0c-9d3b-58c3f3f2b1a7"`
+	Credential     json.RawMessage `json:"credential" binding:"required" swaggertype:"object"`
+	OrganizationId string          `json:"orgId" example:"0b3bd2d2-8d45-4d2e-a6a7-5b4d2c4ad0b1"`
+	RememberDevice bool            `json:"rememberDevice" example:"true"`
+}
+
+// FinishWebAuthnRegistration carries the PublicKeyCredential returned by
+// navigator.credentials.create.
+type FinishWebAuthnRegistration struct {
+	SessionId  string          `json:"sessionId" binding:"required" example:"6f1c1a52-5d5e-4a0c-9d3b-58c3f3f2b1a7"`
+	Name       string          `json:"name" binding:"max=64" example:"MacBook"`
+	Credential json.RawMessage `json:"credential" binding:"required" swaggertype:"object"`
+}
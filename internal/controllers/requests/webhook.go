@@ -0,0 +1,51 @@
+package requests
+
+import "time"
+
+type CreateWebhookEndpoint struct {
+	URL    string   `json:"url" binding:"required" example:"https://example.com/webhooks/auth"`
+	Events []string `json:"events" example:"user.deleted,user.password_changed"`
+}
+
+type UpdateWebhookEndpoint struct {
+	URL    *string   `json:"url" example:"https://example.com/webhooks/auth"`
+	Events *[]string `json:"events" example:"session.ip_changed"`
+}
+
+type ListWebhookDeliveries struct {
+	Status string `form:"status" example:"dead"`
+	Limit  int    `form:"limit" example:"20"`
+}
+
+// ReceiveWebhook is the raw inbound webhook, the body is parsed only after
+// the signature has been verified.
+type ReceiveWebhook struct {
+	Timestamp string
+	Signature string
+	Body      []byte
+}
+
+// WebhookAlert is the schema of the inbound webhook body, the fields not
+// listed here are rejected.
+type WebhookAlert struct {
+	Id        string           `json:"id" example:"c3f1e2d4-5a6b-4c7d-8e9f-0a1b2c3d4e5f"`
+	Type      string           `json:"type" example:"session.ip_changed"`
+	CreatedAt time.Time        `json:"createdAt" example:"2025-01-01T00:00:00Z"`
+	Data      WebhookAlertData `json:"data"`
+}
+
+type WebhookAlertData struct {
+	UserId     string `json:"userId" example:"e1e25658-3817-4051-8d0d-d13d575f08a4"`
+	IP         string `json:"ip,omitempty" example:"203.0.113.7"`
+	PreviousIP string `json:"previousIp,omitempty" example:"198.51.100.4"`
+	UserAgent  string `json:"userAgent,omitempty" example:"Mozilla/5.0"`
+}
+
+type ListWebhookAlerts struct {
+	Page   int       `form:"page" example:"1"`
+	Limit  int       `form:"limit" example:"20"`
+	UserId string    `form:"userId" example:"e1e25658-3817-4051-8d0d-d13d575f08a4"`
+	Type   string    `form:"type" example:"session.ip_changed"`
+	From   time.Time `form:"from" example:"2024-01-01T00:00:00Z"`
+	To     time.Time `form:"to" example:"2024-02-01T00:00:00Z"`
+}
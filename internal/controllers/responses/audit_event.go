@@ -0,0 +1,22 @@
+package responses
+
+import "time"
+
+type AuditEvent struct {
+	Id        string    `json:"id" example:"9a0c1e4b-7a43-4a8e-9f0d-2b6f3a1c5d7e"`
+	Type      string    `json:"type" example:"sign_in"`
+	ActorId   string    `json:"actorId,omitempty" example:"e1e25658-3817-4051-8d0d-d13d575f08a4"`
+	SubjectId string    `json:"subjectId,omitempty" example:"e1e25658-3817-4051-8d0d-d13d575f08a4"`
+	IP        string    `json:"ip" example:"203.0.113.7"`
+	UserAgent string    `json:"userAgent" example:"Mozilla/5.0"`
+	Outcome   string    `json:"outcome" example:"failure"`
+	Reason    string    `json:"reason,omitempty" example:"invalid email or password"`
+	CreatedAt time.Time `json:"createdAt" example:"2024-01-01T00:00:00Z"`
+}
+
+type AuditEventList struct {
+	Events []AuditEvent `json:"events"`
+	Total  int          `json:"total" example:"42"`
+	Page   int          `json:"page" example:"1"`
+	Limit  int          `json:"limit" example:"20"`
+}
@@ -0,0 +1,11 @@
+package responses
+
+type TOTPEnrollment struct {
+	Secret string `json:"secret" example:"JBSWY3DPEHPK3PXP"`
+	URI    string `json:"uri" example:"otpauth://totp/auth:example@mail.ru?secret=JBSWY3DPEHPK3PXP&issuer=auth"`
+}
+
+// RecoveryCodes are shown once, only their hashes are stored.
+type RecoveryCodes struct {
+	Codes []string `json:"recoveryCodes" example:"sqhbj-nv54b,ry2o4-hwoby"`
+}
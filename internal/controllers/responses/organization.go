@@ -0,0 +1,33 @@
+package responses
+
+import "time"
+
+type Organization struct {
+	Id   string `json:"id" example:"0b3bd2d2-8d45-4d2e-a6a7-5b4d2c4ad0b1"`
+	Name string `json:"name" example:"Acme Inc."`
+	Role string `json:"role" example:"owner"`
+}
+
+type Membership struct {
+	OrganizationId   string    `json:"organizationId" example:"0b3bd2d2-8d45-4d2e-a6a7-5b4d2c4ad0b1"`
+	OrganizationName string    `json:"organizationName" example:"Acme Inc."`
+	Role             string    `json:"role" example:"member"`
+	JoinedAt         time.Time `json:"joinedAt" example:"2024-01-01T00:00:00Z"`
+}
+
+type Member struct {
+	UserId   string    `json:"userId" example:"e1e25658-3817-4051-8d0d-d13d575f08a4"`
+	Email    string    `json:"email" example:"example@mail.ru"`
+	Role     string    `json:"role" example:"member"`
+	JoinedAt time.Time `json:"joinedAt" example:"2024-01-01T00:00:00Z"`
+}
+
+type Invitation struct {
+	Id             string    `json:"id" example:"6f1c3f0e-0b8a-4a55-9a3c-0f7b1b7d2f10"`
+	OrganizationId string    `json:"organizationId,omitempty" example:"0b3bd2d2-8d45-4d2e-a6a7-5b4d2c4ad0b1"`
+	Email          string    `json:"email" example:"colleague@mail.ru"`
+	Role           string    `json:"role,omitempty" example:"member"`
+	UserRole       string    `json:"userRole,omitempty" example:"support"`
+	Token          string    `json:"token" example:"pQ3d1n0w9hXr2v7tJk5yB8cZ4aF6eG1iL0mN2oP3qR4"`
+	ExpiresAt      time.Time `json:"expiresAt" example:"2024-01-08T00:00:00Z"`
+}
@@ -0,0 +1,15 @@
+package responses
+
+type PasswordPolicy struct {
+	MinLength        int    `json:"minLength" example:"8"`
+	MaxLength        int    `json:"maxLength" example:"128"`
+	MaxBytes         int    `json:"maxBytes" example:"72"`
+	RequireLetter    bool   `json:"requireLetter" example:"true"`
+	RequireLowercase bool   `json:"requireLowercase" example:"false"`
+	RequireUppercase bool   `json:"requireUppercase" example:"false"`
+	RequireDigit     bool   `json:"requireDigit" example:"true"`
+	RequireSymbol    bool   `json:"requireSymbol" example:"false"`
+	AllowUnicode     bool   `json:"allowUnicode" example:"true"`
+	ForbidEmail      bool   `json:"forbidEmail" example:"true"`
+	Normalization    string `json:"normalization" example:"NFKC"`
+}
@@ -0,0 +1,11 @@
+package responses
+
+import "time"
+
+// PasswordlessStarted is returned whether the email was sent or not, the
+// device token is sent back to complete the sign in.
+type PasswordlessStarted struct {
+	DeviceToken string    `json:"deviceToken" example:"q2n8xM0b7VZp3yTn4kJ1cW5rL9sA6dE0fG2hI4jK8mN"`
+	Method      string    `json:"method" example:"code"`
+	ExpiresAt   time.Time `json:"expiresAt" example:"2024-01-01T00:10:00Z"`
+}
@@ -0,0 +1,14 @@
+package responses
+
+type Role struct {
+	Id          int      `json:"id" example:"3"`
+	Name        string   `json:"name" example:"support"`
+	Description string   `json:"description" example:"support team"`
+	Permissions []string `json:"permissions" example:"users:read"`
+}
+
+type Permission struct {
+	Id          int    `json:"id" example:"6"`
+	Name        string `json:"name" example:"reports:read"`
+	Description string `json:"description" example:"read reports"`
+}
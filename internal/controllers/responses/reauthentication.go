@@ -0,0 +1,45 @@
+package responses
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// insufficientUserAuthentication is the error code of RFC 9470.
+const insufficientUserAuthentication = "insufficient_user_authentication"
+
+// ReauthenticationRequired is returned when the operation needs a more
+// recent or a stronger authentication. The client signs in again, with the
+// second factor when acrValues asks for it, and repeats the request with the
+// new access token.
+type ReauthenticationRequired struct {
+	Error       string `json:"error" example:"insufficient_user_authentication"`
+	Description string `json:"errorDescription" example:"reauthentication required"`
+	MaxAge      int    `json:"maxAge,omitempty" example:"900"`
+	ACRValues   string `json:"acrValues,omitempty" example:"aal2"`
+}
+
+func NewReauthenticationRequired(maxAge time.Duration, acr string) ReauthenticationRequired {
+	return ReauthenticationRequired{
+		Error:       insufficientUserAuthentication,
+		Description: "reauthentication required",
+		MaxAge:      int(maxAge.Seconds()),
+		ACRValues:   acr,
+	}
+}
+
+// WWWAuthenticate returns the challenge for the WWW-Authenticate header.
+func (r ReauthenticationRequired) WWWAuthenticate() string {
+	params := []string{
+		fmt.Sprintf("error=%q", r.Error),
+		fmt.Sprintf("error_description=%q", r.Description),
+	}
+	if r.ACRValues != "" {
+		params = append(params, fmt.Sprintf("acr_values=%q", r.ACRValues))
+	}
+	if r.MaxAge > 0 {
+		params = append(params, fmt.Sprintf("max_age=%d", r.MaxAge))
+	}
+	return "Bearer " + strings.Join(params, ", ")
+}
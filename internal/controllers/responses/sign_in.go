@@ -1,10 +1,33 @@
 package responses
 
+import "time"
+
+// SignIn carries either the session or a restricted token: the MFA challenge
+// when the second factor is required, or the token that only allows changing
+// the expired password.
 type SignIn struct {
-	Id      string  `json:"id" example:"2"`
-	Session Session `json:"session"`
+	Id                     string           `json:"id" example:"2"`
+	Session                *Session         `json:"session,omitempty"`
+	MFARequired            bool             `json:"mfaRequired,omitempty" example:"false"`
+	MFAMethods             []string         `json:"mfaMethods,omitempty" example:"totp,webauthn"`
+	PasswordChangeRequired bool             `json:"passwordChangeRequired,omitempty" example:"false"`
+	RestrictedToken        *RestrictedToken `json:"restrictedToken,omitempty"`
+}
+
+type RestrictedToken struct {
+	Token     string    `json:"token" example:"eyJhbGciOiJIUzUxMiIsInR5cCI6IkpXVCJ9..."`
+	Scope     string    `json:"scope" example:"password_change"`
+	ExpiresAt time.Time `json:"expiresAt" example:"2024-01-01T00:10:00Z"`
 }
 
 func NewSignIn(id string, session Session) SignIn {
-	return SignIn{Id: id, Session: session}
+	return SignIn{Id: id, Session: &session}
+}
+
+func NewPasswordChangeRequired(id string, token RestrictedToken) SignIn {
+	return SignIn{Id: id, PasswordChangeRequired: true, RestrictedToken: &token}
+}
+
+func NewMFARequired(id string, methods []string, token RestrictedToken) SignIn {
+	return SignIn{Id: id, MFARequired: true, MFAMethods: methods, RestrictedToken: &token}
 }
@@ -1,10 +1,17 @@
 package responses
 
+// SignUp carries the session of the new user. In the uniform sign up mode it
+// only asks to check the email, whether the email was free or taken.
 type SignUp struct {
-	Id      string  `json:"id"  binding:"required" example:"2"`
-	Session Session `json:"session"  binding:"required"`
+	Id         string   `json:"id,omitempty" example:"2"`
+	Session    *Session `json:"session,omitempty"`
+	CheckEmail bool     `json:"checkEmail,omitempty" example:"false"`
 }
 
 func NewSignUp(id string, session Session) SignUp {
-	return SignUp{Id: id, Session: session}
+	return SignUp{Id: id, Session: &session}
+}
+
+func NewSignUpCheckEmail() SignUp {
+	return SignUp{CheckEmail: true}
 }
@@ -0,0 +1,10 @@
+package responses
+
+import "time"
+
+// SMSCodeSent shows only the last digits of the phone the code has been sent
+// to.
+type SMSCodeSent struct {
+	Phone     string    `json:"phone" example:"+*******4567"`
+	ExpiresAt time.Time `json:"expiresAt" example:"2024-01-01T00:05:00Z"`
+}
@@ -0,0 +1,26 @@
+package responses
+
+import (
+	"auth/internal/entities"
+	"time"
+)
+
+type TrustedDevice struct {
+	Id         string    `json:"id" example:"6f1c1a52-5d5e-4a0c-9d3b-58c3f3f2b1a7"`
+	UserAgent  string    `json:"userAgent" example:"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)"`
+	IP         string    `json:"ip" example:"203.0.113.7"`
+	CreatedAt  time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z"`
+	LastUsedAt time.Time `json:"lastUsedAt" example:"2025-01-15T00:00:00Z"`
+	ExpiresAt  time.Time `json:"expiresAt" example:"2025-01-31T00:00:00Z"`
+}
+
+func NewTrustedDevice(device entities.TrustedDevice) TrustedDevice {
+	return TrustedDevice{
+		Id:         device.Id,
+		UserAgent:  device.UserAgent,
+		IP:         device.IP,
+		CreatedAt:  device.CreatedAt,
+		LastUsedAt: device.LastUsedAt,
+		ExpiresAt:  device.ExpiresAt,
+	}
+}
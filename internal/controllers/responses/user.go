@@ -6,4 +6,19 @@ type User struct {
 	Id               string
 	Email            string
 	RegistrationDate time.Time
+	Roles            []string
+	Permissions      []string
+	Status           string
+	StatusReason     string
+	LockedUntil      time.Time
+	Phone            string
+	PhoneVerifiedAt  time.Time
+	Memberships      []Membership
+}
+
+type UserList struct {
+	Users []User `json:"users"`
+	Total int    `json:"total" example:"42"`
+	Page  int    `json:"page" example:"1"`
+	Limit int    `json:"limit" example:"20"`
 }
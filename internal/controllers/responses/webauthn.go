@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WebAuthnOptions are passed to navigator.credentials as is, the session id
+// is sent back with the resulting credential.
+type WebAuthnOptions struct {
+	SessionId string          `json:"sessionId" example:"6f1c1a52-5d5e-4a0c-9d3b-58c3f3f2b1a7"`
+	Options   json.RawMessage `json:"options" swaggertype:"object"`
+}
+
+type WebAuthnCredential struct {
+	Id        string    `json:"id" example:"b3a4mZ2Yc8Jt1n0Q5bVvKg"`
+	Name      string    `json:"name" example:"MacBook"`
+	CreatedAt time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z"`
+}
@@ -0,0 +1,96 @@
+package responses
+
+import (
+	"auth/internal/entities"
+	"time"
+)
+
+// WebhookEndpoint carries the signing secret only in the responses to the
+// creation and to the rotation of the secret.
+type WebhookEndpoint struct {
+	Id        string    `json:"id" example:"5b0d3c9e-2f4a-4e61-8c1d-7a9e0f3b2c41"`
+	URL       string    `json:"url" example:"https://example.com/webhooks/auth"`
+	Events    []string  `json:"events" example:"user.deleted"`
+	Active    bool      `json:"active" example:"true"`
+	Secret    string    `json:"secret,omitempty" example:"kq3V9w8sX1yZ..."`
+	CreatedAt time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z"`
+	UpdatedAt time.Time `json:"updatedAt" example:"2025-01-15T00:00:00Z"`
+}
+
+type WebhookDelivery struct {
+	Id             string    `json:"id" example:"0e7c2d4a-1b3f-4c5d-9e8f-6a7b8c9d0e1f"`
+	EndpointId     string    `json:"endpointId" example:"5b0d3c9e-2f4a-4e61-8c1d-7a9e0f3b2c41"`
+	EventId        string    `json:"eventId" example:"c3f1e2d4-5a6b-4c7d-8e9f-0a1b2c3d4e5f"`
+	EventType      string    `json:"eventType" example:"user.deleted"`
+	Status         string    `json:"status" example:"dead"`
+	Attempts       int       `json:"attempts" example:"8"`
+	LastStatusCode int       `json:"lastStatusCode" example:"503"`
+	LastError      string    `json:"lastError,omitempty" example:"webhook endpoint responded with status 503"`
+	NextAttemptAt  time.Time `json:"nextAttemptAt" example:"2025-01-01T01:00:00Z"`
+	CreatedAt      time.Time `json:"createdAt" example:"2025-01-01T00:00:00Z"`
+	DeliveredAt    time.Time `json:"deliveredAt" example:"0001-01-01T00:00:00Z"`
+}
+
+func NewWebhookEndpoint(endpoint entities.WebhookEndpoint) WebhookEndpoint {
+	events := endpoint.EventTypes
+	if events == nil {
+		events = []string{}
+	}
+	return WebhookEndpoint{
+		Id:        endpoint.Id,
+		URL:       endpoint.URL,
+		Events:    events,
+		Active:    endpoint.Active,
+		CreatedAt: endpoint.CreatedAt,
+		UpdatedAt: endpoint.UpdatedAt,
+	}
+}
+
+func NewWebhookDelivery(delivery entities.WebhookDelivery) WebhookDelivery {
+	return WebhookDelivery{
+		Id:             delivery.Id,
+		EndpointId:     delivery.EndpointId,
+		EventId:        delivery.EventId,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+type WebhookAlert struct {
+	Id         string    `json:"id" example:"9a0c1e4b-7a43-4a8e-9f0d-2b6f3a1c5d7e"`
+	EventId    string    `json:"eventId" example:"c3f1e2d4-5a6b-4c7d-8e9f-0a1b2c3d4e5f"`
+	Type       string    `json:"type" example:"session.ip_changed"`
+	UserId     string    `json:"userId" example:"e1e25658-3817-4051-8d0d-d13d575f08a4"`
+	IP         string    `json:"ip,omitempty" example:"203.0.113.7"`
+	PreviousIP string    `json:"previousIp,omitempty" example:"198.51.100.4"`
+	UserAgent  string    `json:"userAgent,omitempty" example:"Mozilla/5.0"`
+	OccurredAt time.Time `json:"occurredAt" example:"2025-01-01T00:00:00Z"`
+	ReceivedAt time.Time `json:"receivedAt" example:"2025-01-01T00:00:01Z"`
+}
+
+type WebhookAlertList struct {
+	Alerts []WebhookAlert `json:"alerts"`
+	Total  int            `json:"total" example:"42"`
+	Page   int            `json:"page" example:"1"`
+	Limit  int            `json:"limit" example:"20"`
+}
+
+func NewWebhookAlert(alert entities.WebhookAlert) WebhookAlert {
+	return WebhookAlert{
+		Id:         alert.Id,
+		EventId:    alert.EventId,
+		Type:       alert.Type,
+		UserId:     alert.UserId,
+		IP:         alert.IP,
+		PreviousIP: alert.PreviousIP,
+		UserAgent:  alert.UserAgent,
+		OccurredAt: alert.OccurredAt,
+		ReceivedAt: alert.ReceivedAt,
+	}
+}
@@ -0,0 +1,87 @@
+package entities
+
+import "time"
+
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+)
+
+// Types of the audit events, the subject of an event is a user unless the
+// type names another entity.
+const (
+	AuditSignUp                 = "sign_up"
+	AuditSignIn                 = "sign_in"
+	AuditMFAVerify              = "mfa_verify"
+	AuditMFASMSSend             = "mfa_sms_send"
+	AuditWebAuthnLogin          = "webauthn_login"
+	AuditPasswordlessStart      = "passwordless_start"
+	AuditPasswordlessComplete   = "passwordless_complete"
+	AuditTokenRefresh           = "token_refresh"
+	AuditRiskDetected           = "risk_detected"
+	AuditRiskNotify             = "risk_notify"
+	AuditTokenIssue             = "token_issue"
+	AuditLogout                 = "logout"
+	AuditPasswordChange         = "password_change"
+	AuditTOTPEnroll             = "totp_enroll"
+	AuditTOTPConfirm            = "totp_confirm"
+	AuditWebAuthnRegister       = "webauthn_register"
+	AuditPhoneVerificationStart = "phone_verification_start"
+	AuditPhoneVerify            = "phone_verify"
+	AuditTrustedDeviceRevoke    = "trusted_device_revoke"
+	AuditUserUpdate             = "user_update"
+	AuditUserDelete             = "user_delete"
+	AuditUserRolesSet           = "user_roles_set"
+	AuditSessionsRevoke         = "sessions_revoke"
+	AuditUserInvite             = "user_invite"
+	AuditRoleCreate             = "role_create"
+	AuditRoleUpdate             = "role_update"
+	AuditRoleDelete             = "role_delete"
+	AuditPermissionCreate       = "permission_create"
+	AuditPermissionDelete       = "permission_delete"
+	AuditOrganizationCreate     = "organization_create"
+	AuditInvitationCreate       = "invitation_create"
+	AuditInvitationAccept       = "invitation_accept"
+	AuditMemberRemove           = "member_remove"
+	AuditOrganizationPolicySet  = "organization_policy_set"
+	AuditWebhookCreate          = "webhook_create"
+	AuditWebhookUpdate          = "webhook_update"
+	AuditWebhookDelete          = "webhook_delete"
+	AuditWebhookSecretRotate    = "webhook_secret_rotate"
+	AuditWebhookReplay          = "webhook_replay"
+	AuditUserRead               = "user_read"
+	AuditUserList               = "user_list"
+	AuditRoleList               = "role_list"
+	AuditPermissionList         = "permission_list"
+	AuditMemberList             = "member_list"
+	AuditSecurityEventsRead     = "security_events_read"
+	AuditAuditEventsRead        = "audit_events_read"
+	AuditWebhookList            = "webhook_list"
+	AuditWebhookDeliveryList    = "webhook_delivery_list"
+	AuditWebhookDeliver         = "webhook_deliver"
+	AuditWebhookReceive         = "webhook_receive"
+	AuditWebhookAlertList       = "webhook_alert_list"
+	AuditDomainEventPublish     = "domain_event_publish"
+)
+
+// Keys of the request data the HTTP layer keeps in the request context, the
+// audit log completes the events with them.
+const (
+	ContextUserIdKey    = "user_id"
+	ContextIPKey        = "client_ip"
+	ContextUserAgentKey = "user_agent"
+)
+
+// AuditEvent records who did what to whom and whether it succeeded. The actor
+// is empty for the requests of anonymous clients, e.g. a failed sign in.
+type AuditEvent struct {
+	Id        string
+	Type      string
+	ActorId   string
+	SubjectId string
+	IP        string
+	UserAgent string
+	Outcome   string
+	Reason    string
+	CreatedAt time.Time
+}
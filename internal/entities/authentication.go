@@ -0,0 +1,91 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+// Authentication methods reported in the amr claim. The values follow
+// RFC 8176 where it has one, email stands for the code or the link sent by
+// the passwordless sign in.
+const (
+	AuthMethodPassword = "pwd"
+	AuthMethodOTP      = "otp"
+	AuthMethodWebAuthn = "webauthn"
+	AuthMethodEmail    = "email"
+	AuthMethodSMS      = "sms"
+)
+
+// Authentication context classes reported in the acr claim, named after the
+// NIST authenticator assurance levels: aal1 is a single factor, aal2 is two
+// factors or a passkey with the user verification.
+const (
+	ACRSingleFactor = "aal1"
+	ACRMultiFactor  = "aal2"
+)
+
+var acrLevels = []string{ACRSingleFactor, ACRMultiFactor}
+
+// Authentication tells how and when the user authenticated. It is kept in
+// the session, so refreshed tokens report the original sign in.
+type Authentication struct {
+	Methods []string
+	Time    time.Time
+}
+
+func NewAuthentication(methods ...string) Authentication {
+	return Authentication{Methods: methods, Time: time.Now()}
+}
+
+// With returns the authentication completed with one more factor.
+func (a Authentication) With(method string) Authentication {
+	methods := append(slices.Clone(a.Methods), method)
+	return NewAuthentication(methods...)
+}
+
+func (a Authentication) Has(method string) bool {
+	return slices.Contains(a.Methods, method)
+}
+
+// ACR returns the context class of the authentication, it is empty when the
+// user has not authenticated at all, e.g. for tokens issued by the service.
+func (a Authentication) ACR() string {
+	if len(a.Methods) == 0 {
+		return ""
+	}
+	if a.Has(AuthMethodWebAuthn) || len(a.Methods) > 1 {
+		return ACRMultiFactor
+	}
+	return ACRSingleFactor
+}
+
+// SatisfiesACR reports whether the context class is at least the minimum
+// one. An empty minimum is satisfied by anything.
+func SatisfiesACR(acr, minimum string) bool {
+	if minimum == "" {
+		return true
+	}
+	level := slices.Index(acrLevels, acr)
+	return level >= 0 && level >= slices.Index(acrLevels, minimum)
+}
+
+func IsKnownACR(acr string) bool {
+	return slices.Contains(acrLevels, acr)
+}
+
+// StepUpPolicy describes the authentication required by sensitive
+// operations: not older than MaxAge and of at least the ACR class. Zero
+// values disable the corresponding check.
+type StepUpPolicy struct {
+	MaxAge time.Duration
+	ACR    string
+}
+
+// Satisfied reports whether the authentication of the token is recent and
+// strong enough.
+func (p StepUpPolicy) Satisfied(authentication Authentication, now time.Time) bool {
+	if p.MaxAge > 0 && (authentication.Time.IsZero() || now.Sub(authentication.Time) > p.MaxAge) {
+		return false
+	}
+	return SatisfiesACR(authentication.ACR(), p.ACR)
+}
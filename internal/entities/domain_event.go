@@ -0,0 +1,112 @@
+package entities
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Types of the domain events published to the message broker.
+const (
+	DomainUserCreated    = "user.created"
+	DomainUserDeleted    = "user.deleted"
+	DomainSessionCreated = "session.created"
+	DomainSessionRevoked = "session.revoked"
+)
+
+// DomainEventVersion is the version of the event data, it is a part of the
+// CloudEvents type, so an incompatible change gets a new type the consumers
+// have to subscribe to explicitly.
+const DomainEventVersion = "v1"
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	cloudEventsTypePrefix  = "auth."
+)
+
+// DomainEvent is a change other services may react to. It is written to the
+// outbox in the transaction of the change and published afterwards at least
+// once. The events of a user are published in the order of Sequence.
+type DomainEvent struct {
+	Id          string
+	Sequence    int64
+	Type        string
+	UserId      string
+	Data        map[string]string
+	CreatedAt   time.Time
+	PublishedAt time.Time
+}
+
+// DomainEventPolicy describes the publishing. BatchSize is how many pending
+// events are published at once, the published events are kept for Retention.
+type DomainEventPolicy struct {
+	BatchSize int
+	Retention time.Duration
+}
+
+// cloudEvent is the structured mode JSON envelope of the CloudEvents 1.0
+// specification with the sequence extension.
+type cloudEvent struct {
+	SpecVersion     string            `json:"specversion"`
+	Id              string            `json:"id"`
+	Source          string            `json:"source"`
+	Type            string            `json:"type"`
+	Subject         string            `json:"subject"`
+	Time            time.Time         `json:"time"`
+	DataContentType string            `json:"datacontenttype"`
+	Sequence        string            `json:"sequence"`
+	Data            map[string]string `json:"data"`
+}
+
+func NewUserCreatedEvent(userId, email string) DomainEvent {
+	return newDomainEvent(DomainUserCreated, userId, map[string]string{"userId": userId, "email": email})
+}
+
+func NewUserDeletedEvent(userId string) DomainEvent {
+	return newDomainEvent(DomainUserDeleted, userId, map[string]string{"userId": userId})
+}
+
+func NewSessionCreatedEvent(session Session) DomainEvent {
+	return newDomainEvent(DomainSessionCreated, session.UserId, map[string]string{
+		"userId":         session.UserId,
+		"sessionId":      strconv.Itoa(session.Id),
+		"organizationId": session.OrganizationId,
+		"ip":             session.IP,
+		"userAgent":      session.UserAgent,
+	})
+}
+
+func NewSessionRevokedEvent(userId string, sessionId int) DomainEvent {
+	return newDomainEvent(DomainSessionRevoked, userId, map[string]string{"userId": userId, "sessionId": strconv.Itoa(sessionId)})
+}
+
+func newDomainEvent(eventType, userId string, data map[string]string) DomainEvent {
+	return DomainEvent{
+		Type:      eventType,
+		UserId:    userId,
+		Data:      data,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// CloudEventType is the versioned type of the event, e.g. auth.user.created.v1.
+func (e DomainEvent) CloudEventType() string {
+	return cloudEventsTypePrefix + e.Type + "." + DomainEventVersion
+}
+
+// CloudEvent is the JSON envelope of the event, the source identifies the
+// instance of the service. The subject is the user, the consumers order the
+// events of a user by the sequence.
+func (e DomainEvent) CloudEvent(source string) ([]byte, error) {
+	return json.Marshal(cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		Id:              e.Id,
+		Source:          source,
+		Type:            e.CloudEventType(),
+		Subject:         e.UserId,
+		Time:            e.CreatedAt,
+		DataContentType: "application/json",
+		Sequence:        strconv.FormatInt(e.Sequence, 10),
+		Data:            e.Data,
+	})
+}
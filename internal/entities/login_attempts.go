@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// LoginAttempts counts failed sign in attempts for one key, an account or an
+// IP address.
+type LoginAttempts struct {
+	Key           string
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   time.Time
+}
+
+// LockoutPolicy describes how failed sign in attempts are throttled. After
+// FreeAttempts failures every next attempt waits BaseDelay doubled for each
+// failure up to MaxDelay, after MaxFailures the key is locked for
+// LockoutDuration. Failures older than Window are forgotten.
+type LockoutPolicy struct {
+	Window             time.Duration
+	FreeAttempts       int
+	BaseDelay          time.Duration
+	MaxDelay           time.Duration
+	AccountMaxFailures int
+	IPMaxFailures      int
+	LockoutDuration    time.Duration
+}
+
+func AccountAttemptsKey(email Email) string {
+	return "account:" + strings.ToLower(string(email))
+}
+
+func IPAttemptsKey(ip string) string {
+	return "ip:" + ip
+}
+
+// Delay returns the time to wait after the given number of failures. A zero
+// MaxDelay leaves the delay uncapped, the doubling then stops at the largest
+// duration instead of overflowing.
+func (p LockoutPolicy) Delay(failures int) time.Duration {
+	if p.BaseDelay <= 0 || failures <= p.FreeAttempts {
+		return 0
+	}
+
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = math.MaxInt64
+	}
+
+	delay := min(p.BaseDelay, maxDelay)
+	for i := p.FreeAttempts + 1; i < failures && delay < maxDelay; i++ {
+		if delay > maxDelay/2 {
+			return maxDelay
+		}
+		delay *= 2
+	}
+	return delay
+}
+
+// RetryAfter returns how long the next attempt has to wait and whether the
+// key is locked out.
+func (a LoginAttempts) RetryAfter(policy LockoutPolicy, now time.Time) (time.Duration, bool) {
+	if now.Before(a.LockedUntil) {
+		return a.LockedUntil.Sub(now), true
+	}
+	if a.Failures == 0 || now.Sub(a.LastFailureAt) >= policy.Window {
+		return 0, false
+	}
+
+	next := a.LastFailureAt.Add(policy.Delay(a.Failures))
+	if now.Before(next) {
+		return next.Sub(now), false
+	}
+	return 0, false
+}
+
+// ShouldLock reports whether the failures reached maxFailures, a zero
+// maxFailures disables the lockout.
+func (a LoginAttempts) ShouldLock(maxFailures int) bool {
+	return maxFailures > 0 && a.Failures >= maxFailures
+}
@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+// ScopeMFA restricts the token to finishing the sign in with the second
+// factor.
+const ScopeMFA = "mfa"
+
+// TOTP is the authenticator app enrolment of the user. The secret is stored
+// encrypted and the enrolment only counts once it has been confirmed with a
+// code.
+type TOTP struct {
+	UserId       string
+	Secret       string
+	ConfirmedAt  time.Time
+	LastUsedStep int64
+}
+
+func (t TOTP) IsConfirmed() bool {
+	return !t.ConfirmedAt.IsZero()
+}
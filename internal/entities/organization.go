@@ -0,0 +1,140 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	OrganizationRoleOwner  = "owner"
+	OrganizationRoleAdmin  = "admin"
+	OrganizationRoleMember = "member"
+)
+
+const (
+	minOrganizationNameLen = 2
+	maxOrganizationNameLen = 100
+)
+
+// Organization may override the password max age of the password policy for
+// the sign ins into it, nil keeps the policy one and zero disables the expiry.
+type Organization struct {
+	Id             string
+	Name           string
+	PasswordMaxAge *time.Duration
+	CreatedAt      time.Time
+}
+
+// Membership binds a user to an organization with a per-organization role.
+// OrganizationName and Email are filled in by selects for presentation only,
+// PasswordMaxAge is the override of the organization.
+type Membership struct {
+	OrganizationId   string
+	OrganizationName string
+	UserId           string
+	Email            Email
+	Role             string
+	PasswordMaxAge   *time.Duration
+	JoinedAt         time.Time
+}
+
+// Invitation is a single-use invite bound to an email. It either adds the
+// user to an organization with Role or, when OrganizationId is empty, only
+// lets the user sign up. UserRole is an optional RBAC role granted on sign up.
+type Invitation struct {
+	Id             string
+	OrganizationId string
+	Email          Email
+	Role           string
+	UserRole       string
+	TokenHash      string
+	InvitedBy      string
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+	AcceptedAt     time.Time
+}
+
+func NewOrganization(name string) Organization {
+	return Organization{Name: strings.TrimSpace(name)}
+}
+
+func (o Organization) Validate() error {
+	length := utf8.RuneCountInString(o.Name)
+	if length < minOrganizationNameLen || length > maxOrganizationNameLen {
+		return errors.New(fmt.Sprintf("organization name length can't be less than %d OR more %d",
+			minOrganizationNameLen, maxOrganizationNameLen))
+	}
+	return nil
+}
+
+func ValidateOrganizationRole(role string) error {
+	switch role {
+	case OrganizationRoleOwner, OrganizationRoleAdmin, OrganizationRoleMember:
+		return nil
+	}
+	return errors.New(fmt.Sprintf("wrong organization role %q", role))
+}
+
+// CanManageMembers reports whether the member may invite and remove other
+// members of the organization.
+func (m Membership) CanManageMembers() bool {
+	return m.Role == OrganizationRoleOwner || m.Role == OrganizationRoleAdmin
+}
+
+// CanManageSettings reports whether the member may change the settings of
+// the organization.
+func (m Membership) CanManageSettings() bool {
+	return m.Role == OrganizationRoleOwner
+}
+
+// PasswordMaxAgeOr returns the password max age for the sign in into the
+// organization of the membership, the policy one unless the organization
+// overrides it. The empty membership of a sign in without an organization
+// has no override.
+func (m Membership) PasswordMaxAgeOr(policyMaxAge time.Duration) time.Duration {
+	if m.PasswordMaxAge == nil {
+		return policyMaxAge
+	}
+	return *m.PasswordMaxAge
+}
+
+func (i Invitation) Validate() error {
+	err := i.Email.Validate()
+	if err != nil {
+		return err
+	}
+	if !i.IsForOrganization() {
+		if i.Role != "" {
+			return errors.New("organization role requires an organization")
+		}
+		return nil
+	}
+	if i.Role != OrganizationRoleAdmin && i.Role != OrganizationRoleMember {
+		return errors.New(fmt.Sprintf("wrong invitation role %q", i.Role))
+	}
+	return nil
+}
+
+func (i Invitation) IsForOrganization() bool {
+	return i.OrganizationId != ""
+}
+
+// Membership returns the membership the invited user gets on acceptance, it
+// is empty for invitations without an organization.
+func (i Invitation) Membership(userId string) Membership {
+	if !i.IsForOrganization() {
+		return Membership{}
+	}
+	return Membership{OrganizationId: i.OrganizationId, UserId: userId, Role: i.Role}
+}
+
+func (i Invitation) IsAccepted() bool {
+	return !i.AcceptedAt.IsZero()
+}
+
+func (i Invitation) IsExpired(now time.Time) bool {
+	return now.After(i.ExpiresAt)
+}
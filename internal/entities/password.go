@@ -3,40 +3,126 @@ package entities
 import (
 	"errors"
 	"fmt"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 type Password string
 
-const (
-	minPasswordLen = 8
-	maxPasswordLen = 20
-)
+// PasswordPolicy describes the requirements to new passwords. The length is
+// counted in characters after the NFKC normalisation, MaxLength of 0 means no
+// upper limit. MaxBytes limits the UTF-8 size for the hash algorithms that
+// ignore the rest of a longer password, 0 means no limit. HistorySize is the
+// number of the last passwords, the current one included, that can't be
+// reused; MaxAge of 0 disables the expiry.
+type PasswordPolicy struct {
+	MinLength        int
+	MaxLength        int
+	MaxBytes         int
+	RequireLetter    bool
+	RequireLowercase bool
+	RequireUppercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	AllowUnicode     bool
+	ForbidEmail      bool
+	HistorySize      int
+	MaxAge           time.Duration
+}
 
-func (p Password) Validate() error {
-	if !p.validateLength() {
-		return errors.New(fmt.Sprintf("password length can't be less than %d OR more %d", minPasswordLen, maxPasswordLen))
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:     8,
+		MaxLength:     128,
+		RequireLetter: true,
+		RequireDigit:  true,
+		AllowUnicode:  true,
+		ForbidEmail:   true,
+		HistorySize:   5,
 	}
-	if !p.validateCharacters() {
-		return errors.New("password should contain at least one Latin alphabet character and one digit")
-	}
-	return nil
 }
 
-func (p Password) validateLength() bool {
-	return stringLengthInRange(string(p), minPasswordLen, maxPasswordLen)
+// NewPassword normalises the password with NFKC, so the same password typed
+// with different keyboards or input methods produces the same hash.
+func NewPassword(password string) Password {
+	return Password(norm.NFKC.String(password))
 }
 
-func (p Password) validateCharacters() bool {
-	digitCount, letterCount := 0, 0
+func (p Password) Validate(policy PasswordPolicy, email Email) error {
+	length := utf8.RuneCountInString(string(p))
+	if length < policy.MinLength || (policy.MaxLength > 0 && length > policy.MaxLength) {
+		return policy.lengthError()
+	}
+	if policy.MaxBytes > 0 && len(p) > policy.MaxBytes {
+		return errors.New(fmt.Sprintf("password can't be longer than %d bytes", policy.MaxBytes))
+	}
+
+	var hasLetter, hasLower, hasUpper, hasDigit, hasSymbol bool
 	for _, char := range string(p) {
-		if isLatinLetter(char) {
-			letterCount++
-		} else if isDigit(char) {
-			digitCount++
+		if !policy.AllowUnicode && (char > unicode.MaxASCII || !unicode.IsPrint(char)) {
+			return errors.New("password should contain only printable ASCII characters")
 		}
+
+		switch {
+		case unicode.IsLetter(char):
+			hasLetter = true
+			hasLower = hasLower || unicode.IsLower(char)
+			hasUpper = hasUpper || unicode.IsUpper(char)
+		case unicode.IsDigit(char):
+			hasDigit = true
+		case unicode.IsPunct(char) || unicode.IsSymbol(char):
+			hasSymbol = true
+		}
+	}
+
+	if policy.RequireLetter && !hasLetter {
+		return errors.New("password should contain at least one letter")
+	}
+	if policy.RequireLowercase && !hasLower {
+		return errors.New("password should contain at least one lowercase letter")
+	}
+	if policy.RequireUppercase && !hasUpper {
+		return errors.New("password should contain at least one uppercase letter")
 	}
-	if digitCount > 0 && letterCount > 0 {
+	if policy.RequireDigit && !hasDigit {
+		return errors.New("password should contain at least one digit")
+	}
+	if policy.RequireSymbol && !hasSymbol {
+		return errors.New("password should contain at least one special character")
+	}
+
+	if policy.ForbidEmail && p.containsEmail(email) {
+		return errors.New("password should not contain the email")
+	}
+	return nil
+}
+
+// lengthError names only the bounds the policy sets.
+func (p PasswordPolicy) lengthError() error {
+	if p.MaxLength == 0 {
+		return errors.New(fmt.Sprintf("password length can't be less than %d", p.MinLength))
+	}
+	if p.MinLength == 0 {
+		return errors.New(fmt.Sprintf("password length can't be more than %d", p.MaxLength))
+	}
+	return errors.New(fmt.Sprintf("password length can't be less than %d OR more %d", p.MinLength, p.MaxLength))
+}
+
+// containsEmail checks both the whole email and its local part, the latter
+// is skipped when it is too short to be meaningful.
+func (p Password) containsEmail(email Email) bool {
+	password := strings.ToLower(string(p))
+	address := strings.ToLower(string(email))
+	if address == "" {
+		return false
+	}
+	if strings.Contains(password, address) {
 		return true
 	}
-	return false
+
+	localPart, _, _ := strings.Cut(address, "@")
+	return len(localPart) >= 3 && strings.Contains(password, localPart)
 }
@@ -0,0 +1,49 @@
+package entities
+
+import "time"
+
+// Ways to deliver the passwordless sign in, a one-time code to type in or a
+// link to follow.
+const (
+	PasswordlessMethodCode = "code"
+	PasswordlessMethodLink = "link"
+)
+
+// PasswordlessToken is a pending passwordless sign in. Only the hashes of the
+// emailed secret and of the device token are stored, the device token is
+// given to the client that started the sign in, so the emailed secret alone
+// is not enough to complete it.
+type PasswordlessToken struct {
+	Id         string
+	Email      Email
+	Method     string
+	SecretHash string
+	DeviceHash string
+	Attempts   int
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+func (t PasswordlessToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// PasswordlessPolicy describes the passwordless sign in by email. LinkURL is
+// the page of the client that completes the sign in with the token from the
+// link, SignUp allows creating the account on the first sign in.
+type PasswordlessPolicy struct {
+	Enabled     bool
+	CodeTTL     time.Duration
+	LinkTTL     time.Duration
+	MaxAttempts int
+	LinkURL     string
+	SignUp      bool
+}
+
+// TTL returns how long the secret sent by the method stays valid.
+func (p PasswordlessPolicy) TTL(method string) time.Duration {
+	if method == PasswordlessMethodLink {
+		return p.LinkTTL
+	}
+	return p.CodeTTL
+}
@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Phone is a phone number in the E.164 format, e.g. +79991234567.
+type Phone string
+
+var phoneFormat = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// NewPhone drops the spaces, dashes, dots and parentheses people usually
+// type in phone numbers.
+func NewPhone(phone string) Phone {
+	return Phone(strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(phone)))
+}
+
+func (p Phone) Validate() error {
+	if !phoneFormat.MatchString(string(p)) {
+		return errors.New("phone must be in the international format, e.g. +79991234567")
+	}
+	return nil
+}
+
+// Masked hides all but the last digits of the number, it is shown to the
+// user whose phone the code has been sent to.
+func (p Phone) Masked() string {
+	const visible = 4
+	if len(p) <= visible+1 {
+		return string(p)
+	}
+	return string(p[:1]) + strings.Repeat("*", len(p)-visible-1) + string(p[len(p)-visible:])
+}
@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	RateLimitKeyIP     = "ip"
+	RateLimitKeyUser   = "user"
+	RateLimitKeyClient = "client"
+)
+
+// RateLimit allows Limit requests per Period, the requests are counted
+// separately for every IP address, user or client depending on Key.
+type RateLimit struct {
+	Limit  int
+	Period time.Duration
+	Key    string
+}
+
+func (l RateLimit) Enabled() bool {
+	return l.Limit > 0 && l.Period > 0
+}
+
+// rate returns the number of tokens added to the bucket per second.
+func (l RateLimit) rate() float64 {
+	return float64(l.Limit) / l.Period.Seconds()
+}
+
+// RateLimitPolicy maps routes, formatted as "METHOD /path", to their limits.
+// Routes without a limit of their own use Default.
+type RateLimitPolicy struct {
+	Default        RateLimit
+	Routes         map[string]RateLimit
+	ClientIdHeader string
+}
+
+func (p RateLimitPolicy) ForRoute(route string) (RateLimit, bool) {
+	limit, ok := p.Routes[route]
+	if !ok {
+		limit = p.Default
+	}
+	return limit, limit.Enabled()
+}
+
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+// TokenBucket is the state of one rate limit counter. A new bucket is full.
+type TokenBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Take refills the bucket for the time passed since the last update and
+// takes one token if there is any.
+func (b TokenBucket) Take(limit RateLimit, now time.Time) (TokenBucket, RateLimitResult) {
+	capacity := float64(limit.Limit)
+	rate := limit.rate()
+
+	tokens := capacity
+	if !b.UpdatedAt.IsZero() {
+		tokens = math.Min(capacity, b.Tokens+now.Sub(b.UpdatedAt).Seconds()*rate)
+	}
+
+	result := RateLimitResult{Limit: limit.Limit}
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = secondsToDuration((1 - tokens) / rate)
+	}
+	result.Remaining = int(math.Floor(tokens))
+	result.Reset = secondsToDuration((capacity - tokens) / rate)
+
+	return TokenBucket{Tokens: tokens, UpdatedAt: now}, result
+}
+
+// FullAt returns the time the bucket is refilled completely, after that it
+// is the same as a new one and may be dropped.
+func (b TokenBucket) FullAt(limit RateLimit) time.Time {
+	return b.UpdatedAt.Add(secondsToDuration((float64(limit.Limit) - b.Tokens) / limit.rate()))
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,162 @@
+package entities
+
+import (
+	"math"
+	"time"
+)
+
+// Signals of a suspicious sign in.
+const (
+	RiskNewDevice          = "new_device"
+	RiskNewCountry         = "new_country"
+	RiskImpossibleTravel   = "impossible_travel"
+	RiskManyAccountsFromIP = "many_accounts_from_ip"
+)
+
+const (
+	earthRadiusKm = 6371.0
+	// travelMinDistanceKm is the distance always possible between two logins,
+	// the locations of a database are only accurate to tens of kilometers.
+	travelMinDistanceKm = 100.0
+)
+
+// Location is where the IP address is according to the GeoIP database, the
+// fields of an unknown address are empty.
+type Location struct {
+	Country   string
+	Latitude  float64
+	Longitude float64
+}
+
+func (l Location) HasCoordinates() bool {
+	return l.Latitude != 0 || l.Longitude != 0
+}
+
+// DistanceKm returns the great-circle distance between the locations.
+func (l Location) DistanceKm(other Location) float64 {
+	lat1, lat2 := l.Latitude*math.Pi/180, other.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (other.Longitude - l.Longitude) * math.Pi / 180
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Login is a successful sign in or a refresh from a new address, the device
+// is told by the user agent.
+type Login struct {
+	UserId    string
+	IP        string
+	UserAgent string
+	Location  Location
+	CreatedAt time.Time
+}
+
+// LoginHistory is what the previous logins of the user tell about the new
+// one. AccountsFromIP counts the other users that logged in from its address.
+type LoginHistory struct {
+	Logins         int
+	KnownDevice    bool
+	KnownCountry   bool
+	Last           Login
+	AccountsFromIP int
+}
+
+// RiskPolicy scores the signals of a login and picks the reaction. The score
+// is the sum of the scores of the signals, a threshold of zero is disabled.
+// A login is impossible travel when it would take moving faster than
+// MaxTravelSpeed km/h from the previous one. HistoryRetention is how long
+// the logins are kept.
+type RiskPolicy struct {
+	NewDeviceScore          int
+	NewCountryScore         int
+	ImpossibleTravelScore   int
+	ManyAccountsFromIPScore int
+
+	MaxTravelSpeed      float64
+	AccountsPerIP       int
+	AccountsPerIPWindow time.Duration
+	HistoryRetention    time.Duration
+
+	NotifyThreshold    int
+	ChallengeThreshold int
+	BlockThreshold     int
+}
+
+// RiskAssessment is the verdict on a login. Challenge asks for the second
+// factor, Notify emails the user about the login.
+type RiskAssessment struct {
+	Login     Login
+	Score     int
+	Signals   []string
+	Notify    bool
+	Challenge bool
+	Block     bool
+}
+
+func (p RiskPolicy) Enabled() bool {
+	return p.NotifyThreshold > 0 || p.ChallengeThreshold > 0 || p.BlockThreshold > 0
+}
+
+// Signals compares the login with the history. The first login of a user
+// has nothing to compare with and only the address is checked.
+func (p RiskPolicy) Signals(login Login, history LoginHistory) []string {
+	var signals []string
+	if history.Logins > 0 && !history.KnownDevice {
+		signals = append(signals, RiskNewDevice)
+	}
+	if history.Logins > 0 && login.Location.Country != "" && !history.KnownCountry {
+		signals = append(signals, RiskNewCountry)
+	}
+	if history.Logins > 0 && p.ImpossibleTravel(history.Last, login) {
+		signals = append(signals, RiskImpossibleTravel)
+	}
+	if p.AccountsPerIP > 0 && history.AccountsFromIP >= p.AccountsPerIP {
+		signals = append(signals, RiskManyAccountsFromIP)
+	}
+	return signals
+}
+
+// ImpossibleTravel reports whether nobody could get from the location of the
+// previous login to the location of the next one in the time between them.
+func (p RiskPolicy) ImpossibleTravel(previous, next Login) bool {
+	if p.MaxTravelSpeed <= 0 || !previous.Location.HasCoordinates() || !next.Location.HasCoordinates() {
+		return false
+	}
+	distance := previous.Location.DistanceKm(next.Location)
+	if distance <= travelMinDistanceKm {
+		return false
+	}
+	hours := next.CreatedAt.Sub(previous.CreatedAt).Hours()
+	return hours <= 0 || distance/hours > p.MaxTravelSpeed
+}
+
+// Assess scores the signals and picks the reactions whose thresholds the
+// score reaches.
+func (p RiskPolicy) Assess(login Login, signals []string) RiskAssessment {
+	assessment := RiskAssessment{Login: login, Signals: signals}
+	for _, signal := range signals {
+		assessment.Score += p.score(signal)
+	}
+	assessment.Notify = reaches(assessment.Score, p.NotifyThreshold)
+	assessment.Challenge = reaches(assessment.Score, p.ChallengeThreshold)
+	assessment.Block = reaches(assessment.Score, p.BlockThreshold)
+	return assessment
+}
+
+func (p RiskPolicy) score(signal string) int {
+	switch signal {
+	case RiskNewDevice:
+		return p.NewDeviceScore
+	case RiskNewCountry:
+		return p.NewCountryScore
+	case RiskImpossibleTravel:
+		return p.ImpossibleTravelScore
+	case RiskManyAccountsFromIP:
+		return p.ManyAccountsFromIPScore
+	}
+	return 0
+}
+
+func reaches(score, threshold int) bool {
+	return threshold > 0 && score >= threshold
+}
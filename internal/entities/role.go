@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+const (
+	PermissionUsersRead      = "users:read"
+	PermissionUsersWrite     = "users:write"
+	PermissionUsersDelete    = "users:delete"
+	PermissionSessionsRevoke = "sessions:revoke"
+	PermissionRolesManage    = "roles:manage"
+	PermissionUsersInvite    = "users:invite"
+	PermissionAuditRead      = "audit:read"
+	PermissionWebhooksManage = "webhooks:manage"
+	PermissionSessionsIssue  = "sessions:issue"
+)
+
+var accessNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_.:-]{1,63}$`)
+
+type Role struct {
+	Id          int
+	Name        string
+	Description string
+	Permissions []string
+}
+
+type Permission struct {
+	Id          int
+	Name        string
+	Description string
+}
+
+func (r Role) Validate() error {
+	if !accessNameRegexp.MatchString(r.Name) {
+		return errors.New(fmt.Sprintf("wrong role name %q", r.Name))
+	}
+	for _, permission := range r.Permissions {
+		if !accessNameRegexp.MatchString(permission) {
+			return errors.New(fmt.Sprintf("wrong permission name %q", permission))
+		}
+	}
+	return nil
+}
+
+// IsBuiltIn reports whether the role is created by the migrations and relied
+// upon by the service itself, such roles can't be removed.
+func (r Role) IsBuiltIn() bool {
+	return r.Name == RoleUser || r.Name == RoleAdmin
+}
+
+func (p Permission) Validate() error {
+	if !accessNameRegexp.MatchString(p.Name) {
+		return errors.New(fmt.Sprintf("wrong permission name %q", p.Name))
+	}
+	return nil
+}
@@ -5,12 +5,14 @@ import "time"
 type Session struct {
 	Id              int
 	UserId          string
+	OrganizationId  string
 	AccessToken     string
 	RefreshToken    string
 	UserAgent       string
 	IP              string
 	AccessExpiresAt time.Time
 	ExpiresAt       time.Time
+	Authentication  Authentication
 }
 
 func (s *Session) IsExpired() bool {
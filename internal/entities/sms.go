@@ -0,0 +1,38 @@
+package entities
+
+import "time"
+
+// Purposes of the SMS codes, a code sent for one of them can't be used for
+// another.
+const (
+	SMSPurposePhoneVerification = "phone_verification"
+	SMSPurposeMFA               = "mfa"
+)
+
+// SMSCode is the pending one-time code sent to the phone. Only the hash of
+// the code is stored, a new code for the same purpose replaces the previous
+// one.
+type SMSCode struct {
+	Id        string
+	UserId    string
+	Phone     Phone
+	Purpose   string
+	CodeHash  string
+	Attempts  int
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// SMSPolicy describes the SMS codes. NumberLimit restricts how many codes are
+// sent to one phone number, MaxAttempts is the number of wrong codes after
+// which the code is dropped.
+type SMSPolicy struct {
+	CodeTTL     time.Duration
+	MaxAttempts int
+	NumberLimit RateLimit
+}
+
+// SMSNumberKey is the rate limit key of the codes sent to the phone.
+func SMSNumberKey(phone Phone) string {
+	return "sms:" + string(phone)
+}
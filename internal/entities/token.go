@@ -1,18 +1,141 @@
 package entities
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 const (
-	UserIdClaimName    = "sub"
-	ExpiresAtClaimName = "exp"
+	UserIdClaimName           = "sub"
+	ExpiresAtClaimName        = "exp"
+	RolesClaimName            = "roles"
+	PermissionsClaimName      = "permissions"
+	OrganizationIdClaimName   = "org_id"
+	OrganizationRoleClaimName = "org_role"
+	ScopeClaimName            = "scope"
+	AuthMethodsClaimName      = "amr"
+	AuthTimeClaimName         = "auth_time"
+	ACRClaimName              = "acr"
+	DeviceIdClaimName         = "device_id"
 )
 
+// ScopePasswordChange restricts the token to changing the expired password.
+const ScopePasswordChange = "password_change"
+
 type AccessTokenClaims map[string]any
 
 func (c AccessTokenClaims) AccountId() string { return c[UserIdClaimName].(string) }
 
 func (c AccessTokenClaims) ExpiresAt() time.Time { return time.Unix(c[ExpiresAtClaimName].(int64), 0) }
 
-func NewClaims(accountId string, expiresAt time.Time) AccessTokenClaims {
-	return AccessTokenClaims{UserIdClaimName: accountId, ExpiresAtClaimName: expiresAt.Unix()}
+func (c AccessTokenClaims) Roles() []string { return c.stringSlice(RolesClaimName) }
+
+func (c AccessTokenClaims) Permissions() []string { return c.stringSlice(PermissionsClaimName) }
+
+func (c AccessTokenClaims) OrganizationId() string {
+	organizationId, _ := c[OrganizationIdClaimName].(string)
+	return organizationId
+}
+
+func (c AccessTokenClaims) OrganizationRole() string {
+	role, _ := c[OrganizationRoleClaimName].(string)
+	return role
+}
+
+// Scope returns the restriction of the token, it is empty for the regular
+// access tokens.
+func (c AccessTokenClaims) Scope() string {
+	scope, _ := c[ScopeClaimName].(string)
+	return scope
+}
+
+// DeviceId returns the trusted device of the token kept in the trusted device
+// cookie.
+func (c AccessTokenClaims) DeviceId() string {
+	deviceId, _ := c[DeviceIdClaimName].(string)
+	return deviceId
+}
+
+// Authentication returns how and when the user of the token authenticated,
+// it is empty for tokens issued without the user's authentication.
+func (c AccessTokenClaims) Authentication() Authentication {
+	result := Authentication{Methods: c.stringSlice(AuthMethodsClaimName)}
+	// Parsed tokens keep the JSON numbers as float64.
+	switch value := c[AuthTimeClaimName].(type) {
+	case int64:
+		result.Time = time.Unix(value, 0)
+	case float64:
+		result.Time = time.Unix(int64(value), 0)
+	}
+	return result
+}
+
+func (c AccessTokenClaims) HasPermission(permission string) bool {
+	return slices.Contains(c.Permissions(), permission)
+}
+
+// stringSlice reads a list claim both from freshly built claims and from
+// parsed tokens, where JSON arrays are decoded into []any.
+func (c AccessTokenClaims) stringSlice(name string) []string {
+	switch value := c[name].(type) {
+	case []string:
+		return value
+	case []any:
+		result := make([]string, 0, len(value))
+		for _, item := range value {
+			if str, ok := item.(string); ok {
+				result = append(result, str)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+func NewClaims(accountId string, roles []string, permissions []string, expiresAt time.Time) AccessTokenClaims {
+	return AccessTokenClaims{
+		UserIdClaimName:      accountId,
+		RolesClaimName:       roles,
+		PermissionsClaimName: permissions,
+		ExpiresAtClaimName:   expiresAt.Unix(),
+	}
+}
+
+// NewRestrictedClaims builds claims of a token that only allows the action of
+// the scope and carries no roles or permissions.
+func NewRestrictedClaims(accountId string, scope string, expiresAt time.Time) AccessTokenClaims {
+	return AccessTokenClaims{
+		UserIdClaimName:    accountId,
+		ScopeClaimName:     scope,
+		ExpiresAtClaimName: expiresAt.Unix(),
+	}
+}
+
+// NewTrustedDeviceClaims builds claims of the token kept in the trusted device
+// cookie, the token only identifies the device of the user.
+func NewTrustedDeviceClaims(accountId string, deviceId string, expiresAt time.Time) AccessTokenClaims {
+	claims := NewRestrictedClaims(accountId, ScopeTrustedDevice, expiresAt)
+	claims[DeviceIdClaimName] = deviceId
+	return claims
+}
+
+// SetOrganization puts the active organization of the session into the
+// claims, an empty membership leaves the token without an organization.
+func (c AccessTokenClaims) SetOrganization(membership Membership) {
+	if membership.OrganizationId == "" {
+		return
+	}
+	c[OrganizationIdClaimName] = membership.OrganizationId
+	c[OrganizationRoleClaimName] = membership.Role
+}
+
+// SetAuthentication puts the amr, auth_time and acr claims, nothing is put
+// when the user has not authenticated.
+func (c AccessTokenClaims) SetAuthentication(authentication Authentication) {
+	if len(authentication.Methods) == 0 {
+		return
+	}
+	c[AuthMethodsClaimName] = authentication.Methods
+	c[AuthTimeClaimName] = authentication.Time.Unix()
+	c[ACRClaimName] = authentication.ACR()
 }
@@ -0,0 +1,25 @@
+package entities
+
+import "time"
+
+// ScopeTrustedDevice marks the token kept in the trusted device cookie, it is
+// never accepted as an access token.
+const ScopeTrustedDevice = "trusted_device"
+
+// TrustedDevice is a browser the user has chosen to remember after passing
+// the second factor, the sign in from it skips the second factor until the
+// device expires or is revoked. The device is identified by the signed
+// cookie that carries its id.
+type TrustedDevice struct {
+	Id         string
+	UserId     string
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+}
+
+func (d TrustedDevice) IsExpired(now time.Time) bool {
+	return !now.Before(d.ExpiresAt)
+}
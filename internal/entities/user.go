@@ -1,31 +1,87 @@
 package entities
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
 type User struct {
-	Id               string
-	Email            Email
-	Password         Password
-	RegistrationDate time.Time
+	Id                string
+	Email             Email
+	Password          Password
+	RegistrationDate  time.Time
+	Roles             []string
+	Permissions       []string
+	Status            UserStatus
+	StatusReason      string
+	LockedUntil       time.Time
+	PasswordChangedAt time.Time
+	Phone             Phone
+	PhoneVerifiedAt   time.Time
 }
 
 func NewUser(email string, password string) User {
 	var result User
 
 	result.Email = Email(email)
-	result.Password = Password(password)
+	result.Password = NewPassword(password)
+	result.Roles = []string{RoleUser}
+	result.Status = UserStatusActive
 
 	return result
 }
 
-func (a User) Validate() error {
+func (a User) Validate(passwordPolicy PasswordPolicy) error {
 	err := a.Email.Validate()
 	if err != nil {
 		return err
 	}
-	err = a.Password.Validate()
+	err = a.Password.Validate(passwordPolicy, a.Email)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// PasswordExpired reports whether the password is older than maxAge, a zero
+// maxAge disables the expiry.
+func (a User) PasswordExpired(maxAge time.Duration, now time.Time) bool {
+	if maxAge <= 0 || a.PasswordChangedAt.IsZero() {
+		return false
+	}
+	return now.After(a.PasswordChangedAt.Add(maxAge))
+}
+
+// PhoneVerified reports whether the phone has been confirmed with a code, only
+// a verified phone receives the SMS codes of the second factor.
+func (a User) PhoneVerified() bool {
+	return a.Phone != "" && !a.PhoneVerifiedAt.IsZero()
+}
+
+func (a User) IsActive() bool {
+	return a.Status.IsActive(a.LockedUntil, time.Now())
+}
+
+// SetStatus changes the user's status. LockedUntil is only kept for locked
+// users and a reason is only kept for non-active ones.
+func (a *User) SetStatus(status UserStatus, reason string, lockedUntil time.Time) error {
+	err := status.Validate()
+	if err != nil {
+		return err
+	}
+	if status == UserStatusLocked && lockedUntil.IsZero() {
+		return errors.New("locked status requires locked_until")
+	}
+
+	a.Status = status
+	a.StatusReason = ""
+	a.LockedUntil = time.Time{}
+
+	if status != UserStatusActive {
+		a.StatusReason = reason
+	}
+	if status == UserStatusLocked {
+		a.LockedUntil = lockedUntil
+	}
+	return nil
+}
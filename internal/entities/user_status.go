@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type UserStatus string
+
+const (
+	UserStatusActive   UserStatus = "active"
+	UserStatusDisabled UserStatus = "disabled"
+	UserStatusLocked   UserStatus = "locked"
+	UserStatusBanned   UserStatus = "banned"
+)
+
+func (s UserStatus) Validate() error {
+	switch s {
+	case UserStatusActive, UserStatusDisabled, UserStatusLocked, UserStatusBanned:
+		return nil
+	}
+	return errors.New(fmt.Sprintf("unknown user status %q", string(s)))
+}
+
+// IsActive reports whether a user with this status may authenticate at the
+// given moment. A lock stops applying once lockedUntil has passed.
+func (s UserStatus) IsActive(lockedUntil time.Time, now time.Time) bool {
+	switch s {
+	case UserStatusDisabled, UserStatusBanned:
+		return false
+	case UserStatusLocked:
+		return !now.Before(lockedUntil)
+	}
+	return true
+}
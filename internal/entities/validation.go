@@ -13,11 +13,3 @@ func isEmail(str string) bool {
 func stringLengthInRange(str string, min, max int) bool {
 	return len(str) >= min && len(str) <= max
 }
-
-func isLatinLetter(symbol rune) bool {
-	return (symbol >= 'a' && symbol <= 'z') || (symbol >= 'A' && symbol <= 'Z')
-}
-
-func isDigit(symbol rune) bool {
-	return symbol >= '0' && symbol <= '9'
-}
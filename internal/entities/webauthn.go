@@ -0,0 +1,51 @@
+package entities
+
+import "time"
+
+const (
+	MFAMethodTOTP     = "totp"
+	MFAMethodWebAuthn = "webauthn"
+	MFAMethodSMS      = "sms"
+)
+
+// Purposes of the WebAuthn ceremony, a challenge issued for one of them can't
+// be used to finish another.
+const (
+	WebAuthnRegistration = "registration"
+	WebAuthnLogin        = "login"
+	WebAuthnMFA          = "mfa"
+)
+
+// WebAuthnCredential is a passkey or a security key registered by the user.
+type WebAuthnCredential struct {
+	Id              []byte
+	UserId          string
+	Name            string
+	PublicKey       []byte
+	AttestationType string
+	AAGUID          []byte
+	SignCount       uint32
+	Transports      []string
+	BackupEligible  bool
+	BackupState     bool
+	CreatedAt       time.Time
+	LastUsedAt      time.Time
+}
+
+// WebAuthnSession keeps the challenge between the beginning and the end of a
+// ceremony. Data is opaque to everything but the WebAuthn service, UserId is
+// empty for the passwordless login where the user is not known in advance.
+// AuthMethods are the factors the user has already passed when the key is
+// the second one.
+type WebAuthnSession struct {
+	Id          string
+	UserId      string
+	Purpose     string
+	Data        []byte
+	AuthMethods []string
+	ExpiresAt   time.Time
+}
+
+func (s WebAuthnSession) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
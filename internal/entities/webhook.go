@@ -0,0 +1,140 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// Types of the events sent to the webhook endpoints.
+const (
+	WebhookSessionIPChanged    = "session.ip_changed"
+	WebhookUserPasswordChanged = "user.password_changed"
+	WebhookUserDeleted         = "user.deleted"
+)
+
+// WebhookEventTypes are the event types an endpoint can subscribe to.
+var WebhookEventTypes = []string{
+	WebhookSessionIPChanged,
+	WebhookUserPasswordChanged,
+	WebhookUserDeleted,
+}
+
+// Statuses of the webhook deliveries. A delivery is dead once all the
+// attempts have failed, it stays in the table as the dead letter.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryDead      = "dead"
+)
+
+func IsWebhookDeliveryStatus(status string) bool {
+	return status == WebhookDeliveryPending || status == WebhookDeliveryDelivered || status == WebhookDeliveryDead
+}
+
+// WebhookEvent is what happened, it is written to the outbox together with
+// the change that caused it and sent to every subscribed endpoint.
+type WebhookEvent struct {
+	Id        string
+	Type      string
+	CreatedAt time.Time
+	Data      map[string]string
+}
+
+type webhookPayload struct {
+	Id        string            `json:"id"`
+	Type      string            `json:"type"`
+	CreatedAt time.Time         `json:"createdAt"`
+	Data      map[string]string `json:"data"`
+}
+
+func NewWebhookEvent(eventType string, data map[string]string) WebhookEvent {
+	return WebhookEvent{
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+}
+
+// Payload is the JSON body of the request sent to the endpoints.
+func (e WebhookEvent) Payload() ([]byte, error) {
+	return json.Marshal(webhookPayload{
+		Id:        e.Id,
+		Type:      e.Type,
+		CreatedAt: e.CreatedAt,
+		Data:      e.Data,
+	})
+}
+
+// WebhookEndpoint receives the events of the subscribed types, an endpoint
+// without event types receives all of them. The secret signs the requests,
+// it is stored encrypted.
+type WebhookEndpoint struct {
+	Id         string
+	URL        string
+	Secret     string
+	EventTypes []string
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (e WebhookEndpoint) Validate() error {
+	u, err := url.Parse(e.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook url %q", e.URL)
+	}
+	if e.Secret == "" {
+		return errors.New("the webhook secret is empty")
+	}
+	for _, eventType := range e.EventTypes {
+		if !slices.Contains(WebhookEventTypes, eventType) {
+			return fmt.Errorf("unknown webhook event type %q", eventType)
+		}
+	}
+	return nil
+}
+
+// WebhookDelivery is the event queued for one endpoint. URL and Secret are
+// the ones of the endpoint at the time the delivery is claimed.
+type WebhookDelivery struct {
+	Id             string
+	EndpointId     string
+	EventId        string
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    time.Time
+	URL            string
+	Secret         string
+}
+
+// WebhookPolicy describes the delivery. The failed delivery is retried with
+// the delay doubling from BaseDelay up to MaxDelay until MaxAttempts have
+// been made. Lease is how long a claimed delivery is hidden from the other
+// workers.
+type WebhookPolicy struct {
+	BatchSize   int
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	Lease       time.Duration
+}
+
+// Backoff returns the delay before the attempt following the given number
+// of failed ones.
+func (p WebhookPolicy) Backoff(failures int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
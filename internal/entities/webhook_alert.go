@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+)
+
+const (
+	maxWebhookEventIdLength   = 64
+	maxWebhookUserAgentLength = 512
+)
+
+// WebhookAlert is the event received on the inbound webhook from another
+// instance of the service or from a system using the same payload.
+type WebhookAlert struct {
+	Id         string
+	EventId    string
+	Type       string
+	UserId     string
+	IP         string
+	PreviousIP string
+	UserAgent  string
+	OccurredAt time.Time
+	ReceivedAt time.Time
+}
+
+func (a WebhookAlert) Validate() error {
+	if a.EventId == "" || len(a.EventId) > maxWebhookEventIdLength {
+		return errors.New("invalid webhook event id")
+	}
+	if !slices.Contains(WebhookEventTypes, a.Type) {
+		return fmt.Errorf("unknown webhook event type %q", a.Type)
+	}
+	if a.OccurredAt.IsZero() {
+		return errors.New("the webhook event time is empty")
+	}
+	if a.UserId == "" {
+		return errors.New("the webhook user id is empty")
+	}
+	if a.IP != "" && net.ParseIP(a.IP) == nil {
+		return fmt.Errorf("invalid ip address %q", a.IP)
+	}
+	if a.PreviousIP != "" && net.ParseIP(a.PreviousIP) == nil {
+		return fmt.Errorf("invalid ip address %q", a.PreviousIP)
+	}
+	if len(a.UserAgent) > maxWebhookUserAgentLength {
+		return errors.New("the user agent is too long")
+	}
+	return nil
+}
+
+// InboundWebhookPolicy describes the verification of the inbound webhooks.
+// The requests signed more than Tolerance ago or ahead are rejected, so a
+// nonce only has to be kept until its request gets stale.
+type InboundWebhookPolicy struct {
+	Secret    string
+	Tolerance time.Duration
+}
+
+func (p InboundWebhookPolicy) Enabled() bool {
+	return p.Secret != ""
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+	"time"
+)
+
+// AuditEventFilter narrows the audit events down, empty fields match any
+// event. UserId matches the events where the user is either the actor or the
+// subject.
+type AuditEventFilter struct {
+	UserId    string
+	ActorId   string
+	SubjectId string
+	Type      string
+	Outcome   string
+	From      time.Time
+	To        time.Time
+	Offset    int
+	Limit     int
+}
+
+type AuditEventRepository interface {
+	Insert(context context.Context, event entities.AuditEvent) error
+	Select(context context.Context, filter AuditEventFilter) ([]entities.AuditEvent, error)
+	Count(context context.Context, filter AuditEventFilter) (int, error)
+}
+
+type auditEventRepository struct {
+	insertCommand InsertAuditEventCommand
+	selectCommand SelectAuditEventsCommand
+	countCommand  CountAuditEventsCommand
+}
+
+func NewAuditEventRepository(
+	insertCommand InsertAuditEventCommand,
+	selectCommand SelectAuditEventsCommand,
+	countCommand CountAuditEventsCommand,
+) AuditEventRepository {
+	return &auditEventRepository{
+		insertCommand: insertCommand,
+		selectCommand: selectCommand,
+		countCommand:  countCommand,
+	}
+}
+
+func (r *auditEventRepository) Insert(context context.Context, event entities.AuditEvent) error {
+	return r.insertCommand.Execute(context, event)
+}
+
+func (r *auditEventRepository) Select(context context.Context, filter AuditEventFilter) ([]entities.AuditEvent, error) {
+	return r.selectCommand.Execute(context, filter)
+}
+
+func (r *auditEventRepository) Count(context context.Context, filter AuditEventFilter) (int, error) {
+	return r.countCommand.Execute(context, filter)
+}
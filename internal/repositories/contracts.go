@@ -3,6 +3,7 @@ package repositories
 import (
 	"auth/internal/entities"
 	"context"
+	"time"
 )
 
 type (
@@ -15,6 +16,105 @@ type (
 	SelectUserByEmailCommand interface {
 		Execute(context context.Context, email entities.Email) (entities.User, error)
 	}
+	SelectUserListCommand interface {
+		Execute(context context.Context, filter UserFilter) ([]entities.User, error)
+	}
+	CountUsersCommand interface {
+		Execute(context context.Context, filter UserFilter) (int, error)
+	}
+	UpdateUserCommand interface {
+		Execute(context context.Context, user entities.User) error
+	}
+	DeleteUserCommand interface {
+		Execute(context context.Context, id string, event entities.WebhookEvent) error
+	}
+	UpdateUserRolesCommand interface {
+		Execute(context context.Context, userId string, roles []string) error
+	}
+	UpdateUserPasswordCommand interface {
+		Execute(context context.Context, userId string, password entities.Password) error
+	}
+	UpdateUserPhoneCommand interface {
+		Execute(context context.Context, userId string, phone entities.Phone) error
+	}
+	ChangeUserPasswordCommand interface {
+		Execute(context context.Context, userId string, password entities.Password, historySize int, event entities.WebhookEvent) error
+	}
+	SelectPasswordHistoryCommand interface {
+		Execute(context context.Context, userId string, limit int) ([]entities.Password, error)
+	}
+)
+
+type (
+	SelectAllRolesCommand interface {
+		Execute(context context.Context) ([]entities.Role, error)
+	}
+	SelectRoleByIdCommand interface {
+		Execute(context context.Context, id int) (entities.Role, error)
+	}
+	SelectRolesByNamesCommand interface {
+		Execute(context context.Context, names []string) ([]entities.Role, error)
+	}
+	InsertRoleCommand interface {
+		Execute(context context.Context, role entities.Role) (int, error)
+	}
+	UpdateRoleCommand interface {
+		Execute(context context.Context, role entities.Role) error
+	}
+	DeleteRoleCommand interface {
+		Execute(context context.Context, id int) error
+	}
+)
+
+type (
+	SelectAllPermissionsCommand interface {
+		Execute(context context.Context) ([]entities.Permission, error)
+	}
+	SelectPermissionsByNamesCommand interface {
+		Execute(context context.Context, names []string) ([]entities.Permission, error)
+	}
+	InsertPermissionCommand interface {
+		Execute(context context.Context, permission entities.Permission) (int, error)
+	}
+	DeletePermissionCommand interface {
+		Execute(context context.Context, id int) error
+	}
+)
+
+type (
+	InsertOrganizationCommand interface {
+		Execute(context context.Context, organization entities.Organization, ownerId string) (string, error)
+	}
+	SelectMemberCommand interface {
+		Execute(context context.Context, organizationId, userId string) (entities.Membership, error)
+	}
+	SelectMembersCommand interface {
+		Execute(context context.Context, organizationId string) ([]entities.Membership, error)
+	}
+	SelectMembershipsByUserIdCommand interface {
+		Execute(context context.Context, userId string) ([]entities.Membership, error)
+	}
+	DeleteMemberCommand interface {
+		Execute(context context.Context, organizationId, userId string) error
+	}
+	UpdateOrganizationPasswordMaxAgeCommand interface {
+		Execute(context context.Context, organizationId string, maxAge *time.Duration) error
+	}
+)
+
+type (
+	InsertInvitationCommand interface {
+		Execute(context context.Context, invitation entities.Invitation) (string, error)
+	}
+	SelectInvitationByIdCommand interface {
+		Execute(context context.Context, id string) (entities.Invitation, error)
+	}
+	AcceptInvitationCommand interface {
+		Execute(context context.Context, invitation entities.Invitation, userId string) error
+	}
+	SignUpByInvitationCommand interface {
+		Execute(context context.Context, invitation entities.Invitation, user entities.User) (string, error)
+	}
 )
 
 type (
@@ -30,7 +130,198 @@ type (
 		Execute(ctx context.Context, userId string) error
 	}
 
+	DeleteByRoleIdCommand interface {
+		Execute(ctx context.Context, roleId int) error
+	}
+
 	UpdateSessionCommand interface {
 		Execute(ctx context.Context, session entities.Session) error
 	}
+
+	UpdateSessionWithEventCommand interface {
+		Execute(ctx context.Context, session entities.Session, event entities.WebhookEvent) error
+	}
+)
+
+type (
+	SelectLoginAttemptsCommand interface {
+		Execute(context context.Context, key string) (entities.LoginAttempts, error)
+	}
+	RegisterLoginFailureCommand interface {
+		Execute(context context.Context, key string, window time.Duration) (entities.LoginAttempts, error)
+	}
+	LockLoginAttemptsCommand interface {
+		Execute(context context.Context, key string, until time.Time) error
+	}
+	ResetLoginAttemptsCommand interface {
+		Execute(context context.Context, key string) error
+	}
+)
+
+type (
+	TakeRateLimitTokenCommand interface {
+		Execute(context context.Context, key string, limit entities.RateLimit) (entities.RateLimitResult, error)
+	}
+)
+
+type (
+	SelectTOTPCommand interface {
+		Execute(context context.Context, userId string) (entities.TOTP, error)
+	}
+	SaveTOTPCommand interface {
+		Execute(context context.Context, totp entities.TOTP) error
+	}
+	ConfirmTOTPCommand interface {
+		Execute(context context.Context, userId string, step int64, recoveryCodeHashes []string) error
+	}
+	UseTOTPStepCommand interface {
+		Execute(context context.Context, userId string, step int64) error
+	}
+	UseRecoveryCodeCommand interface {
+		Execute(context context.Context, userId string, codeHash string) error
+	}
+)
+
+type (
+	SelectWebAuthnCredentialsCommand interface {
+		Execute(context context.Context, userId string) ([]entities.WebAuthnCredential, error)
+	}
+	InsertWebAuthnCredentialCommand interface {
+		Execute(context context.Context, credential entities.WebAuthnCredential) error
+	}
+	UpdateWebAuthnCredentialUsageCommand interface {
+		Execute(context context.Context, credential entities.WebAuthnCredential) error
+	}
+	InsertWebAuthnSessionCommand interface {
+		Execute(context context.Context, session entities.WebAuthnSession) (string, error)
+	}
+	TakeWebAuthnSessionCommand interface {
+		Execute(context context.Context, id string) (entities.WebAuthnSession, error)
+	}
+)
+
+type (
+	InsertPasswordlessTokenCommand interface {
+		Execute(context context.Context, token entities.PasswordlessToken) (string, error)
+	}
+	SelectPasswordlessTokenByDeviceCommand interface {
+		Execute(context context.Context, deviceHash string) (entities.PasswordlessToken, error)
+	}
+	RegisterPasswordlessTokenFailureCommand interface {
+		Execute(context context.Context, id string) (int, error)
+	}
+	DeletePasswordlessTokenCommand interface {
+		Execute(context context.Context, id string) error
+	}
+)
+
+type (
+	InsertTrustedDeviceCommand interface {
+		Execute(context context.Context, device entities.TrustedDevice) (string, error)
+	}
+	SelectTrustedDeviceCommand interface {
+		Execute(context context.Context, userId, id string) (entities.TrustedDevice, error)
+	}
+	SelectTrustedDevicesCommand interface {
+		Execute(context context.Context, userId string) ([]entities.TrustedDevice, error)
+	}
+	UpdateTrustedDeviceUsageCommand interface {
+		Execute(context context.Context, id string) error
+	}
+	DeleteTrustedDeviceCommand interface {
+		Execute(context context.Context, userId, id string) error
+	}
+	DeleteTrustedDevicesCommand interface {
+		Execute(context context.Context, userId string) error
+	}
+)
+
+type (
+	SaveSMSCodeCommand interface {
+		Execute(context context.Context, code entities.SMSCode) error
+	}
+	SelectSMSCodeCommand interface {
+		Execute(context context.Context, userId, purpose string) (entities.SMSCode, error)
+	}
+	RegisterSMSCodeFailureCommand interface {
+		Execute(context context.Context, id string) (int, error)
+	}
+	DeleteSMSCodeCommand interface {
+		Execute(context context.Context, id string) error
+	}
+)
+
+type (
+	InsertAuditEventCommand interface {
+		Execute(context context.Context, event entities.AuditEvent) error
+	}
+	SelectAuditEventsCommand interface {
+		Execute(context context.Context, filter AuditEventFilter) ([]entities.AuditEvent, error)
+	}
+	CountAuditEventsCommand interface {
+		Execute(context context.Context, filter AuditEventFilter) (int, error)
+	}
+)
+
+type (
+	InsertWebhookEndpointCommand interface {
+		Execute(context context.Context, endpoint entities.WebhookEndpoint) (entities.WebhookEndpoint, error)
+	}
+	SelectWebhookEndpointsCommand interface {
+		Execute(context context.Context) ([]entities.WebhookEndpoint, error)
+	}
+	SelectWebhookEndpointCommand interface {
+		Execute(context context.Context, id string) (entities.WebhookEndpoint, error)
+	}
+	UpdateWebhookEndpointCommand interface {
+		Execute(context context.Context, endpoint entities.WebhookEndpoint) error
+	}
+	DeleteWebhookEndpointCommand interface {
+		Execute(context context.Context, id string) error
+	}
+	ClaimWebhookDeliveriesCommand interface {
+		Execute(context context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error)
+	}
+	UpdateWebhookDeliveryCommand interface {
+		Execute(context context.Context, delivery entities.WebhookDelivery) error
+	}
+	SelectWebhookDeliveriesCommand interface {
+		Execute(context context.Context, filter WebhookDeliveryFilter) ([]entities.WebhookDelivery, error)
+	}
+	ReplayWebhookDeliveryCommand interface {
+		Execute(context context.Context, id string) (entities.WebhookDelivery, error)
+	}
+)
+
+type (
+	InsertWebhookAlertCommand interface {
+		Execute(context context.Context, alert entities.WebhookAlert) error
+	}
+	SelectWebhookAlertsCommand interface {
+		Execute(context context.Context, filter WebhookAlertFilter) ([]entities.WebhookAlert, error)
+	}
+	CountWebhookAlertsCommand interface {
+		Execute(context context.Context, filter WebhookAlertFilter) (int, error)
+	}
+	InsertWebhookNonceCommand interface {
+		Execute(context context.Context, nonce string, expiresAt time.Time) error
+	}
+)
+
+type (
+	RelayDomainEventsCommand interface {
+		Execute(context context.Context, limit int, publish func(entities.DomainEvent) error) (int, error)
+	}
+	PurgeDomainEventsCommand interface {
+		Execute(context context.Context, before time.Time) error
+	}
+)
+
+type (
+	SelectLoginHistoryCommand interface {
+		Execute(context context.Context, login entities.Login, ipSince time.Time) (entities.LoginHistory, error)
+	}
+	InsertLoginCommand interface {
+		Execute(context context.Context, login entities.Login, keepSince time.Time) error
+	}
 )
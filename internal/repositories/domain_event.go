@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+	"time"
+)
+
+// DomainEventRepository reads the outbox of the domain events, the events are
+// written to it by the commands making the changes.
+type DomainEventRepository interface {
+	Relay(context context.Context, limit int, publish func(entities.DomainEvent) error) (int, error)
+	Purge(context context.Context, before time.Time) error
+}
+
+type domainEventRepository struct {
+	relayCommand RelayDomainEventsCommand
+	purgeCommand PurgeDomainEventsCommand
+}
+
+func NewDomainEventRepository(relayCommand RelayDomainEventsCommand, purgeCommand PurgeDomainEventsCommand) DomainEventRepository {
+	return &domainEventRepository{
+		relayCommand: relayCommand,
+		purgeCommand: purgeCommand,
+	}
+}
+
+// Relay passes the pending events to publish in order and marks the ones
+// published without an error.
+func (r *domainEventRepository) Relay(context context.Context, limit int, publish func(entities.DomainEvent) error) (int, error) {
+	return r.relayCommand.Execute(context, limit, publish)
+}
+
+func (r *domainEventRepository) Purge(context context.Context, before time.Time) error {
+	return r.purgeCommand.Execute(context, before)
+}
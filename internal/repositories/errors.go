@@ -3,6 +3,8 @@ package repositories
 import "errors"
 
 var (
-	ErrEntityNotFound  = errors.New("entity not found")
-	ErrSessionNotFound = errors.New("session not found")
+	ErrEntityNotFound      = errors.New("entity not found")
+	ErrEntityAlreadyExists = errors.New("entity already exists")
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrLastAdmin           = errors.New("last admin")
 )
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+)
+
+type InvitationRepository interface {
+	Insert(context context.Context, invitation entities.Invitation) (string, error)
+	SelectById(context context.Context, id string) (entities.Invitation, error)
+	Accept(context context.Context, invitation entities.Invitation, userId string) error
+	SignUp(context context.Context, invitation entities.Invitation, user entities.User) (string, error)
+}
+
+type invitationRepository struct {
+	insertCommand     InsertInvitationCommand
+	selectByIdCommand SelectInvitationByIdCommand
+	acceptCommand     AcceptInvitationCommand
+	signUpCommand     SignUpByInvitationCommand
+}
+
+func NewInvitationRepository(
+	insertCommand InsertInvitationCommand,
+	selectByIdCommand SelectInvitationByIdCommand,
+	acceptCommand AcceptInvitationCommand,
+	signUpCommand SignUpByInvitationCommand,
+) InvitationRepository {
+	return &invitationRepository{
+		insertCommand:     insertCommand,
+		selectByIdCommand: selectByIdCommand,
+		acceptCommand:     acceptCommand,
+		signUpCommand:     signUpCommand,
+	}
+}
+
+func (i *invitationRepository) Insert(context context.Context, invitation entities.Invitation) (string, error) {
+	return i.insertCommand.Execute(context, invitation)
+}
+
+func (i *invitationRepository) SelectById(context context.Context, id string) (entities.Invitation, error) {
+	return i.selectByIdCommand.Execute(context, id)
+}
+
+func (i *invitationRepository) Accept(context context.Context, invitation entities.Invitation, userId string) error {
+	return i.acceptCommand.Execute(context, invitation, userId)
+}
+
+func (i *invitationRepository) SignUp(context context.Context, invitation entities.Invitation, user entities.User) (string, error) {
+	return i.signUpCommand.Execute(context, invitation, user)
+}
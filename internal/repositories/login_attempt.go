@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+	"time"
+)
+
+// LoginAttemptRepository stores failed sign in attempts. Select returns empty
+// attempts for unknown keys.
+type LoginAttemptRepository interface {
+	Select(context context.Context, key string) (entities.LoginAttempts, error)
+	RegisterFailure(context context.Context, key string, window time.Duration) (entities.LoginAttempts, error)
+	Lock(context context.Context, key string, until time.Time) error
+	Reset(context context.Context, key string) error
+}
+
+type loginAttemptRepository struct {
+	selectCommand          SelectLoginAttemptsCommand
+	registerFailureCommand RegisterLoginFailureCommand
+	lockCommand            LockLoginAttemptsCommand
+	resetCommand           ResetLoginAttemptsCommand
+}
+
+func NewLoginAttemptRepository(
+	selectCommand SelectLoginAttemptsCommand,
+	registerFailureCommand RegisterLoginFailureCommand,
+	lockCommand LockLoginAttemptsCommand,
+	resetCommand ResetLoginAttemptsCommand,
+) LoginAttemptRepository {
+	return &loginAttemptRepository{
+		selectCommand:          selectCommand,
+		registerFailureCommand: registerFailureCommand,
+		lockCommand:            lockCommand,
+		resetCommand:           resetCommand,
+	}
+}
+
+func (r *loginAttemptRepository) Select(context context.Context, key string) (entities.LoginAttempts, error) {
+	return r.selectCommand.Execute(context, key)
+}
+
+func (r *loginAttemptRepository) RegisterFailure(context context.Context, key string, window time.Duration) (entities.LoginAttempts, error) {
+	return r.registerFailureCommand.Execute(context, key, window)
+}
+
+func (r *loginAttemptRepository) Lock(context context.Context, key string, until time.Time) error {
+	return r.lockCommand.Execute(context, key, until)
+}
+
+func (r *loginAttemptRepository) Reset(context context.Context, key string) error {
+	return r.resetCommand.Execute(context, key)
+}
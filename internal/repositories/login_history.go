@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+	"time"
+)
+
+// LoginHistoryRepository keeps the logins of the users the risk of a new
+// login is assessed against.
+type LoginHistoryRepository interface {
+	History(context context.Context, login entities.Login, ipSince time.Time) (entities.LoginHistory, error)
+	Insert(context context.Context, login entities.Login, keepSince time.Time) error
+}
+
+type loginHistoryRepository struct {
+	selectCommand SelectLoginHistoryCommand
+	insertCommand InsertLoginCommand
+}
+
+func NewLoginHistoryRepository(selectCommand SelectLoginHistoryCommand, insertCommand InsertLoginCommand) LoginHistoryRepository {
+	return &loginHistoryRepository{
+		selectCommand: selectCommand,
+		insertCommand: insertCommand,
+	}
+}
+
+// History compares the login with the previous ones of the user, the other
+// users are counted among the logins from its address since ipSince.
+func (r *loginHistoryRepository) History(context context.Context, login entities.Login, ipSince time.Time) (entities.LoginHistory, error) {
+	return r.selectCommand.Execute(context, login, ipSince)
+}
+
+// Insert stores the login, the logins of the user made before keepSince are
+// deleted.
+func (r *loginHistoryRepository) Insert(context context.Context, login entities.Login, keepSince time.Time) error {
+	return r.insertCommand.Execute(context, login, keepSince)
+}
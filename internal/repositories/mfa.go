@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+)
+
+type MFARepository interface {
+	SelectTOTP(context context.Context, userId string) (entities.TOTP, error)
+	SaveTOTP(context context.Context, totp entities.TOTP) error
+	ConfirmTOTP(context context.Context, userId string, step int64, recoveryCodeHashes []string) error
+	UseTOTPStep(context context.Context, userId string, step int64) error
+	UseRecoveryCode(context context.Context, userId string, codeHash string) error
+}
+
+type mfaRepository struct {
+	selectTOTPCommand      SelectTOTPCommand
+	saveTOTPCommand        SaveTOTPCommand
+	confirmTOTPCommand     ConfirmTOTPCommand
+	useTOTPStepCommand     UseTOTPStepCommand
+	useRecoveryCodeCommand UseRecoveryCodeCommand
+}
+
+func NewMFARepository(
+	selectTOTPCommand SelectTOTPCommand,
+	saveTOTPCommand SaveTOTPCommand,
+	confirmTOTPCommand ConfirmTOTPCommand,
+	useTOTPStepCommand UseTOTPStepCommand,
+	useRecoveryCodeCommand UseRecoveryCodeCommand,
+) MFARepository {
+	return &mfaRepository{
+		selectTOTPCommand:      selectTOTPCommand,
+		saveTOTPCommand:        saveTOTPCommand,
+		confirmTOTPCommand:     confirmTOTPCommand,
+		useTOTPStepCommand:     useTOTPStepCommand,
+		useRecoveryCodeCommand: useRecoveryCodeCommand,
+	}
+}
+
+func (r *mfaRepository) SelectTOTP(context context.Context, userId string) (entities.TOTP, error) {
+	return r.selectTOTPCommand.Execute(context, userId)
+}
+
+func (r *mfaRepository) SaveTOTP(context context.Context, totp entities.TOTP) error {
+	return r.saveTOTPCommand.Execute(context, totp)
+}
+
+func (r *mfaRepository) ConfirmTOTP(context context.Context, userId string, step int64, recoveryCodeHashes []string) error {
+	return r.confirmTOTPCommand.Execute(context, userId, step, recoveryCodeHashes)
+}
+
+func (r *mfaRepository) UseTOTPStep(context context.Context, userId string, step int64) error {
+	return r.useTOTPStepCommand.Execute(context, userId, step)
+}
+
+func (r *mfaRepository) UseRecoveryCode(context context.Context, userId string, codeHash string) error {
+	return r.useRecoveryCodeCommand.Execute(context, userId, codeHash)
+}
@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+	"time"
+)
+
+// OrganizationRepository works with organizations and their members. Every
+// member query is scoped by the organization id, the only exception is
+// SelectMembershipsByUserId which lists the organizations of one user.
+type OrganizationRepository interface {
+	Insert(context context.Context, organization entities.Organization, ownerId string) (string, error)
+	SelectMember(context context.Context, organizationId, userId string) (entities.Membership, error)
+	SelectMembers(context context.Context, organizationId string) ([]entities.Membership, error)
+	SelectMembershipsByUserId(context context.Context, userId string) ([]entities.Membership, error)
+	DeleteMember(context context.Context, organizationId, userId string) error
+	UpdatePasswordMaxAge(context context.Context, organizationId string, maxAge *time.Duration) error
+}
+
+type organizationRepository struct {
+	insertCommand                    InsertOrganizationCommand
+	selectMemberCommand              SelectMemberCommand
+	selectMembersCommand             SelectMembersCommand
+	selectMembershipsByUserIdCommand SelectMembershipsByUserIdCommand
+	deleteMemberCommand              DeleteMemberCommand
+	updatePasswordMaxAgeCommand      UpdateOrganizationPasswordMaxAgeCommand
+}
+
+func NewOrganizationRepository(
+	insertCommand InsertOrganizationCommand,
+	selectMemberCommand SelectMemberCommand,
+	selectMembersCommand SelectMembersCommand,
+	selectMembershipsByUserIdCommand SelectMembershipsByUserIdCommand,
+	deleteMemberCommand DeleteMemberCommand,
+	updatePasswordMaxAgeCommand UpdateOrganizationPasswordMaxAgeCommand,
+) OrganizationRepository {
+	return &organizationRepository{
+		insertCommand:                    insertCommand,
+		selectMemberCommand:              selectMemberCommand,
+		selectMembersCommand:             selectMembersCommand,
+		selectMembershipsByUserIdCommand: selectMembershipsByUserIdCommand,
+		deleteMemberCommand:              deleteMemberCommand,
+		updatePasswordMaxAgeCommand:      updatePasswordMaxAgeCommand,
+	}
+}
+
+func (o *organizationRepository) Insert(context context.Context, organization entities.Organization, ownerId string) (string, error) {
+	return o.insertCommand.Execute(context, organization, ownerId)
+}
+
+func (o *organizationRepository) SelectMember(context context.Context, organizationId, userId string) (entities.Membership, error) {
+	return o.selectMemberCommand.Execute(context, organizationId, userId)
+}
+
+func (o *organizationRepository) SelectMembers(context context.Context, organizationId string) ([]entities.Membership, error) {
+	return o.selectMembersCommand.Execute(context, organizationId)
+}
+
+func (o *organizationRepository) SelectMembershipsByUserId(context context.Context, userId string) ([]entities.Membership, error) {
+	return o.selectMembershipsByUserIdCommand.Execute(context, userId)
+}
+
+func (o *organizationRepository) DeleteMember(context context.Context, organizationId, userId string) error {
+	return o.deleteMemberCommand.Execute(context, organizationId, userId)
+}
+
+// UpdatePasswordMaxAge overrides the password max age of the policy for the
+// organization, nil restores the policy one.
+func (o *organizationRepository) UpdatePasswordMaxAge(context context.Context, organizationId string, maxAge *time.Duration) error {
+	return o.updatePasswordMaxAgeCommand.Execute(context, organizationId, maxAge)
+}
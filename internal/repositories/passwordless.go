@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+)
+
+type PasswordlessRepository interface {
+	InsertToken(context context.Context, token entities.PasswordlessToken) (string, error)
+	SelectTokenByDevice(context context.Context, deviceHash string) (entities.PasswordlessToken, error)
+	RegisterTokenFailure(context context.Context, id string) (int, error)
+	DeleteToken(context context.Context, id string) error
+}
+
+type passwordlessRepository struct {
+	insertTokenCommand          InsertPasswordlessTokenCommand
+	selectTokenByDeviceCommand  SelectPasswordlessTokenByDeviceCommand
+	registerTokenFailureCommand RegisterPasswordlessTokenFailureCommand
+	deleteTokenCommand          DeletePasswordlessTokenCommand
+}
+
+func NewPasswordlessRepository(
+	insertTokenCommand InsertPasswordlessTokenCommand,
+	selectTokenByDeviceCommand SelectPasswordlessTokenByDeviceCommand,
+	registerTokenFailureCommand RegisterPasswordlessTokenFailureCommand,
+	deleteTokenCommand DeletePasswordlessTokenCommand,
+) PasswordlessRepository {
+	return &passwordlessRepository{
+		insertTokenCommand:          insertTokenCommand,
+		selectTokenByDeviceCommand:  selectTokenByDeviceCommand,
+		registerTokenFailureCommand: registerTokenFailureCommand,
+		deleteTokenCommand:          deleteTokenCommand,
+	}
+}
+
+func (r *passwordlessRepository) InsertToken(context context.Context, token entities.PasswordlessToken) (string, error) {
+	return r.insertTokenCommand.Execute(context, token)
+}
+
+func (r *passwordlessRepository) SelectTokenByDevice(context context.Context, deviceHash string) (entities.PasswordlessToken, error) {
+	return r.selectTokenByDeviceCommand.Execute(context, deviceHash)
+}
+
+func (r *passwordlessRepository) RegisterTokenFailure(context context.Context, id string) (int, error) {
+	return r.registerTokenFailureCommand.Execute(context, id)
+}
+
+func (r *passwordlessRepository) DeleteToken(context context.Context, id string) error {
+	return r.deleteTokenCommand.Execute(context, id)
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+)
+
+type PermissionRepository interface {
+	SelectAll(context context.Context) ([]entities.Permission, error)
+	SelectByNames(context context.Context, names []string) ([]entities.Permission, error)
+	Insert(context context.Context, permission entities.Permission) (int, error)
+	Delete(context context.Context, id int) error
+}
+
+type permissionRepository struct {
+	selectAllCommand     SelectAllPermissionsCommand
+	selectByNamesCommand SelectPermissionsByNamesCommand
+	insertCommand        InsertPermissionCommand
+	deleteCommand        DeletePermissionCommand
+}
+
+func NewPermissionRepository(
+	selectAllCommand SelectAllPermissionsCommand,
+	selectByNamesCommand SelectPermissionsByNamesCommand,
+	insertCommand InsertPermissionCommand,
+	deleteCommand DeletePermissionCommand,
+) PermissionRepository {
+	return &permissionRepository{
+		selectAllCommand:     selectAllCommand,
+		selectByNamesCommand: selectByNamesCommand,
+		insertCommand:        insertCommand,
+		deleteCommand:        deleteCommand,
+	}
+}
+
+func (p *permissionRepository) SelectAll(context context.Context) ([]entities.Permission, error) {
+	return p.selectAllCommand.Execute(context)
+}
+
+func (p *permissionRepository) SelectByNames(context context.Context, names []string) ([]entities.Permission, error) {
+	return p.selectByNamesCommand.Execute(context, names)
+}
+
+func (p *permissionRepository) Insert(context context.Context, permission entities.Permission) (int, error) {
+	return p.insertCommand.Execute(context, permission)
+}
+
+func (p *permissionRepository) Delete(context context.Context, id int) error {
+	return p.deleteCommand.Execute(context, id)
+}
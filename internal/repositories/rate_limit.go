@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+)
+
+// RateLimitRepository keeps the token buckets of the rate limits.
+type RateLimitRepository interface {
+	Take(context context.Context, key string, limit entities.RateLimit) (entities.RateLimitResult, error)
+}
+
+type rateLimitRepository struct {
+	takeCommand TakeRateLimitTokenCommand
+}
+
+func NewRateLimitRepository(takeCommand TakeRateLimitTokenCommand) RateLimitRepository {
+	return &rateLimitRepository{takeCommand: takeCommand}
+}
+
+func (r *rateLimitRepository) Take(context context.Context, key string, limit entities.RateLimit) (entities.RateLimitResult, error) {
+	return r.takeCommand.Execute(context, key, limit)
+}
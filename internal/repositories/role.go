@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+)
+
+type RoleRepository interface {
+	SelectAll(context context.Context) ([]entities.Role, error)
+	SelectById(context context.Context, id int) (entities.Role, error)
+	SelectByNames(context context.Context, names []string) ([]entities.Role, error)
+	Insert(context context.Context, role entities.Role) (int, error)
+	Update(context context.Context, role entities.Role) error
+	Delete(context context.Context, id int) error
+}
+
+type roleRepository struct {
+	selectAllCommand     SelectAllRolesCommand
+	selectByIdCommand    SelectRoleByIdCommand
+	selectByNamesCommand SelectRolesByNamesCommand
+	insertCommand        InsertRoleCommand
+	updateCommand        UpdateRoleCommand
+	deleteCommand        DeleteRoleCommand
+}
+
+func NewRoleRepository(
+	selectAllCommand SelectAllRolesCommand,
+	selectByIdCommand SelectRoleByIdCommand,
+	selectByNamesCommand SelectRolesByNamesCommand,
+	insertCommand InsertRoleCommand,
+	updateCommand UpdateRoleCommand,
+	deleteCommand DeleteRoleCommand,
+) RoleRepository {
+	return &roleRepository{
+		selectAllCommand:     selectAllCommand,
+		selectByIdCommand:    selectByIdCommand,
+		selectByNamesCommand: selectByNamesCommand,
+		insertCommand:        insertCommand,
+		updateCommand:        updateCommand,
+		deleteCommand:        deleteCommand,
+	}
+}
+
+func (r *roleRepository) SelectAll(context context.Context) ([]entities.Role, error) {
+	return r.selectAllCommand.Execute(context)
+}
+
+func (r *roleRepository) SelectById(context context.Context, id int) (entities.Role, error) {
+	return r.selectByIdCommand.Execute(context, id)
+}
+
+func (r *roleRepository) SelectByNames(context context.Context, names []string) ([]entities.Role, error) {
+	return r.selectByNamesCommand.Execute(context, names)
+}
+
+func (r *roleRepository) Insert(context context.Context, role entities.Role) (int, error) {
+	return r.insertCommand.Execute(context, role)
+}
+
+func (r *roleRepository) Update(context context.Context, role entities.Role) error {
+	return r.updateCommand.Execute(context, role)
+}
+
+func (r *roleRepository) Delete(context context.Context, id int) error {
+	return r.deleteCommand.Execute(context, id)
+}
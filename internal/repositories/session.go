@@ -9,28 +9,36 @@ type SessionRepository interface {
 	Insert(context context.Context, session entities.Session) error
 	SelectByUserId(context context.Context, userId string) (entities.Session, error)
 	Update(context context.Context, session entities.Session) error
+	UpdateWithEvent(context context.Context, session entities.Session, event entities.WebhookEvent) error
 	DeleteByUserId(context context.Context, userId string) error
+	DeleteByRoleId(context context.Context, roleId int) error
 }
 
 type sessionRepository struct {
 	insertSessionCommand  InsertSessionCommand
 	selectUserIdCommand   SelectByUserIdCommand
 	updateCommand         UpdateSessionCommand
+	updateWithEventCmd    UpdateSessionWithEventCommand
 	deleteByUserIdCommand DeleteByUserIdCommand
+	deleteByRoleIdCommand DeleteByRoleIdCommand
 }
 
 func NewSessionRepository(
 	insertSessionCommand InsertSessionCommand,
 	selectByUserIdCommand SelectByUserIdCommand,
 	updateCommand UpdateSessionCommand,
+	updateWithEventCmd UpdateSessionWithEventCommand,
 	deleteByUserIdCommand DeleteByUserIdCommand,
+	deleteByRoleIdCommand DeleteByRoleIdCommand,
 ) SessionRepository {
 
 	return &sessionRepository{
 		insertSessionCommand:  insertSessionCommand,
 		selectUserIdCommand:   selectByUserIdCommand,
 		updateCommand:         updateCommand,
+		updateWithEventCmd:    updateWithEventCmd,
 		deleteByUserIdCommand: deleteByUserIdCommand,
+		deleteByRoleIdCommand: deleteByRoleIdCommand,
 	}
 }
 
@@ -46,6 +54,17 @@ func (s *sessionRepository) Update(context context.Context, session entities.Ses
 	return s.updateCommand.Execute(context, session)
 }
 
+// UpdateWithEvent updates the session and queues the event for the webhooks
+// in the same transaction.
+func (s *sessionRepository) UpdateWithEvent(context context.Context, session entities.Session, event entities.WebhookEvent) error {
+	return s.updateWithEventCmd.Execute(context, session, event)
+}
+
 func (s *sessionRepository) DeleteByUserId(context context.Context, userId string) error {
 	return s.deleteByUserIdCommand.Execute(context, userId)
 }
+
+// DeleteByRoleId ends the sessions of every holder of the role.
+func (s *sessionRepository) DeleteByRoleId(context context.Context, roleId int) error {
+	return s.deleteByRoleIdCommand.Execute(context, roleId)
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+)
+
+type SMSCodeRepository interface {
+	SaveCode(context context.Context, code entities.SMSCode) error
+	SelectCode(context context.Context, userId, purpose string) (entities.SMSCode, error)
+	RegisterCodeFailure(context context.Context, id string) (int, error)
+	DeleteCode(context context.Context, id string) error
+}
+
+type smsCodeRepository struct {
+	saveCodeCommand            SaveSMSCodeCommand
+	selectCodeCommand          SelectSMSCodeCommand
+	registerCodeFailureCommand RegisterSMSCodeFailureCommand
+	deleteCodeCommand          DeleteSMSCodeCommand
+}
+
+func NewSMSCodeRepository(
+	saveCodeCommand SaveSMSCodeCommand,
+	selectCodeCommand SelectSMSCodeCommand,
+	registerCodeFailureCommand RegisterSMSCodeFailureCommand,
+	deleteCodeCommand DeleteSMSCodeCommand,
+) SMSCodeRepository {
+	return &smsCodeRepository{
+		saveCodeCommand:            saveCodeCommand,
+		selectCodeCommand:          selectCodeCommand,
+		registerCodeFailureCommand: registerCodeFailureCommand,
+		deleteCodeCommand:          deleteCodeCommand,
+	}
+}
+
+func (r *smsCodeRepository) SaveCode(context context.Context, code entities.SMSCode) error {
+	return r.saveCodeCommand.Execute(context, code)
+}
+
+func (r *smsCodeRepository) SelectCode(context context.Context, userId, purpose string) (entities.SMSCode, error) {
+	return r.selectCodeCommand.Execute(context, userId, purpose)
+}
+
+func (r *smsCodeRepository) RegisterCodeFailure(context context.Context, id string) (int, error) {
+	return r.registerCodeFailureCommand.Execute(context, id)
+}
+
+func (r *smsCodeRepository) DeleteCode(context context.Context, id string) error {
+	return r.deleteCodeCommand.Execute(context, id)
+}
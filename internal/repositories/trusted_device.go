@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+)
+
+type TrustedDeviceRepository interface {
+	Insert(context context.Context, device entities.TrustedDevice) (string, error)
+	Select(context context.Context, userId, id string) (entities.TrustedDevice, error)
+	SelectByUserId(context context.Context, userId string) ([]entities.TrustedDevice, error)
+	UpdateUsage(context context.Context, id string) error
+	Delete(context context.Context, userId, id string) error
+	DeleteByUserId(context context.Context, userId string) error
+}
+
+type trustedDeviceRepository struct {
+	insertCommand         InsertTrustedDeviceCommand
+	selectCommand         SelectTrustedDeviceCommand
+	selectByUserIdCommand SelectTrustedDevicesCommand
+	updateUsageCommand    UpdateTrustedDeviceUsageCommand
+	deleteCommand         DeleteTrustedDeviceCommand
+	deleteByUserIdCommand DeleteTrustedDevicesCommand
+}
+
+func NewTrustedDeviceRepository(
+	insertCommand InsertTrustedDeviceCommand,
+	selectCommand SelectTrustedDeviceCommand,
+	selectByUserIdCommand SelectTrustedDevicesCommand,
+	updateUsageCommand UpdateTrustedDeviceUsageCommand,
+	deleteCommand DeleteTrustedDeviceCommand,
+	deleteByUserIdCommand DeleteTrustedDevicesCommand,
+) TrustedDeviceRepository {
+	return &trustedDeviceRepository{
+		insertCommand:         insertCommand,
+		selectCommand:         selectCommand,
+		selectByUserIdCommand: selectByUserIdCommand,
+		updateUsageCommand:    updateUsageCommand,
+		deleteCommand:         deleteCommand,
+		deleteByUserIdCommand: deleteByUserIdCommand,
+	}
+}
+
+func (r *trustedDeviceRepository) Insert(context context.Context, device entities.TrustedDevice) (string, error) {
+	return r.insertCommand.Execute(context, device)
+}
+
+func (r *trustedDeviceRepository) Select(context context.Context, userId, id string) (entities.TrustedDevice, error) {
+	return r.selectCommand.Execute(context, userId, id)
+}
+
+func (r *trustedDeviceRepository) SelectByUserId(context context.Context, userId string) ([]entities.TrustedDevice, error) {
+	return r.selectByUserIdCommand.Execute(context, userId)
+}
+
+func (r *trustedDeviceRepository) UpdateUsage(context context.Context, id string) error {
+	return r.updateUsageCommand.Execute(context, id)
+}
+
+func (r *trustedDeviceRepository) Delete(context context.Context, userId, id string) error {
+	return r.deleteCommand.Execute(context, userId, id)
+}
+
+func (r *trustedDeviceRepository) DeleteByUserId(context context.Context, userId string) error {
+	return r.deleteByUserIdCommand.Execute(context, userId)
+}
@@ -6,27 +6,70 @@ import (
 	"errors"
 )
 
+type UserFilter struct {
+	Email  string
+	Status entities.UserStatus
+	Offset int
+	Limit  int
+}
+
 type userRepo struct {
 	selectUserByIdCommand    SelectUserByIdCommand
 	selectUserByEmailCommand SelectUserByEmailCommand
+	selectUserListCommand    SelectUserListCommand
+	countUsersCommand        CountUsersCommand
 	insertUserCommand        InsertUserCommand
+	updateUserCommand        UpdateUserCommand
+	deleteUserCommand        DeleteUserCommand
+	updateUserRolesCommand   UpdateUserRolesCommand
+	updatePasswordCommand    UpdateUserPasswordCommand
+	changePasswordCommand    ChangeUserPasswordCommand
+	selectHistoryCommand     SelectPasswordHistoryCommand
+	updatePhoneCommand       UpdateUserPhoneCommand
 }
 
 type UserRepository interface {
 	Insert(context context.Context, user entities.User) (string, error)
 	SelectByUserId(context context.Context, id string) (entities.User, error)
 	SelectByEmail(context context.Context, email entities.Email) (entities.User, error)
+	SelectList(context context.Context, filter UserFilter) ([]entities.User, error)
+	Count(context context.Context, filter UserFilter) (int, error)
 	CheckEmailExists(context context.Context, email entities.Email) (bool, error)
+	Update(context context.Context, user entities.User) error
+	Delete(context context.Context, id string, event entities.WebhookEvent) error
+	UpdateRoles(context context.Context, userId string, roles []string) error
+	UpdatePassword(context context.Context, userId string, password entities.Password) error
+	ChangePassword(context context.Context, userId string, password entities.Password, historySize int, event entities.WebhookEvent) error
+	SelectPasswordHistory(context context.Context, userId string, limit int) ([]entities.Password, error)
+	UpdatePhone(context context.Context, userId string, phone entities.Phone) error
 }
 
 func NewUserRepository(
 	selectUserByIdCommand SelectUserByIdCommand,
 	selectUserByEmailCommand SelectUserByEmailCommand,
-	insertUserCommand InsertUserCommand) UserRepository {
+	selectUserListCommand SelectUserListCommand,
+	countUsersCommand CountUsersCommand,
+	insertUserCommand InsertUserCommand,
+	updateUserCommand UpdateUserCommand,
+	deleteUserCommand DeleteUserCommand,
+	updateUserRolesCommand UpdateUserRolesCommand,
+	updatePasswordCommand UpdateUserPasswordCommand,
+	changePasswordCommand ChangeUserPasswordCommand,
+	selectHistoryCommand SelectPasswordHistoryCommand,
+	updatePhoneCommand UpdateUserPhoneCommand) UserRepository {
 	return &userRepo{
 		selectUserByIdCommand:    selectUserByIdCommand,
 		selectUserByEmailCommand: selectUserByEmailCommand,
+		selectUserListCommand:    selectUserListCommand,
+		countUsersCommand:        countUsersCommand,
 		insertUserCommand:        insertUserCommand,
+		updateUserCommand:        updateUserCommand,
+		deleteUserCommand:        deleteUserCommand,
+		updateUserRolesCommand:   updateUserRolesCommand,
+		updatePasswordCommand:    updatePasswordCommand,
+		changePasswordCommand:    changePasswordCommand,
+		selectHistoryCommand:     selectHistoryCommand,
+		updatePhoneCommand:       updatePhoneCommand,
 	}
 }
 
@@ -42,6 +85,48 @@ func (u *userRepo) SelectByEmail(context context.Context, email entities.Email)
 	return u.selectUserByEmailCommand.Execute(context, email)
 }
 
+func (u *userRepo) SelectList(context context.Context, filter UserFilter) ([]entities.User, error) {
+	return u.selectUserListCommand.Execute(context, filter)
+}
+
+func (u *userRepo) Count(context context.Context, filter UserFilter) (int, error) {
+	return u.countUsersCommand.Execute(context, filter)
+}
+
+func (u *userRepo) Update(context context.Context, user entities.User) error {
+	return u.updateUserCommand.Execute(context, user)
+}
+
+// Delete removes the user, the event is queued for the webhooks in the same
+// transaction.
+func (u *userRepo) Delete(context context.Context, id string, event entities.WebhookEvent) error {
+	return u.deleteUserCommand.Execute(context, id, event)
+}
+
+func (u *userRepo) UpdateRoles(context context.Context, userId string, roles []string) error {
+	return u.updateUserRolesCommand.Execute(context, userId, roles)
+}
+
+func (u *userRepo) UpdatePassword(context context.Context, userId string, password entities.Password) error {
+	return u.updatePasswordCommand.Execute(context, userId, password)
+}
+
+// ChangePassword sets a new password chosen by the user, unlike
+// UpdatePassword it keeps the previous hash in the history and resets the
+// password age. The event is queued for the webhooks in the same transaction.
+func (u *userRepo) ChangePassword(context context.Context, userId string, password entities.Password, historySize int, event entities.WebhookEvent) error {
+	return u.changePasswordCommand.Execute(context, userId, password, historySize, event)
+}
+
+func (u *userRepo) SelectPasswordHistory(context context.Context, userId string, limit int) ([]entities.Password, error) {
+	return u.selectHistoryCommand.Execute(context, userId, limit)
+}
+
+// UpdatePhone saves the phone confirmed with a code as the verified one.
+func (u *userRepo) UpdatePhone(context context.Context, userId string, phone entities.Phone) error {
+	return u.updatePhoneCommand.Execute(context, userId, phone)
+}
+
 func (u *userRepo) CheckEmailExists(context context.Context, email entities.Email) (bool, error) {
 	_, err := u.SelectByEmail(context, email)
 
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+)
+
+type WebAuthnRepository interface {
+	SelectCredentials(context context.Context, userId string) ([]entities.WebAuthnCredential, error)
+	InsertCredential(context context.Context, credential entities.WebAuthnCredential) error
+	UpdateCredentialUsage(context context.Context, credential entities.WebAuthnCredential) error
+	InsertSession(context context.Context, session entities.WebAuthnSession) (string, error)
+	TakeSession(context context.Context, id string) (entities.WebAuthnSession, error)
+}
+
+type webAuthnRepository struct {
+	selectCredentialsCommand     SelectWebAuthnCredentialsCommand
+	insertCredentialCommand      InsertWebAuthnCredentialCommand
+	updateCredentialUsageCommand UpdateWebAuthnCredentialUsageCommand
+	insertSessionCommand         InsertWebAuthnSessionCommand
+	takeSessionCommand           TakeWebAuthnSessionCommand
+}
+
+func NewWebAuthnRepository(
+	selectCredentialsCommand SelectWebAuthnCredentialsCommand,
+	insertCredentialCommand InsertWebAuthnCredentialCommand,
+	updateCredentialUsageCommand UpdateWebAuthnCredentialUsageCommand,
+	insertSessionCommand InsertWebAuthnSessionCommand,
+	takeSessionCommand TakeWebAuthnSessionCommand,
+) WebAuthnRepository {
+	return &webAuthnRepository{
+		selectCredentialsCommand:     selectCredentialsCommand,
+		insertCredentialCommand:      insertCredentialCommand,
+		updateCredentialUsageCommand: updateCredentialUsageCommand,
+		insertSessionCommand:         insertSessionCommand,
+		takeSessionCommand:           takeSessionCommand,
+	}
+}
+
+func (r *webAuthnRepository) SelectCredentials(context context.Context, userId string) ([]entities.WebAuthnCredential, error) {
+	return r.selectCredentialsCommand.Execute(context, userId)
+}
+
+func (r *webAuthnRepository) InsertCredential(context context.Context, credential entities.WebAuthnCredential) error {
+	return r.insertCredentialCommand.Execute(context, credential)
+}
+
+func (r *webAuthnRepository) UpdateCredentialUsage(context context.Context, credential entities.WebAuthnCredential) error {
+	return r.updateCredentialUsageCommand.Execute(context, credential)
+}
+
+func (r *webAuthnRepository) InsertSession(context context.Context, session entities.WebAuthnSession) (string, error) {
+	return r.insertSessionCommand.Execute(context, session)
+}
+
+func (r *webAuthnRepository) TakeSession(context context.Context, id string) (entities.WebAuthnSession, error) {
+	return r.takeSessionCommand.Execute(context, id)
+}
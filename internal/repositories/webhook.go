@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+	"time"
+)
+
+// WebhookDeliveryFilter selects the latest deliveries of the endpoint, an
+// empty status matches any delivery.
+type WebhookDeliveryFilter struct {
+	EndpointId string
+	Status     string
+	Limit      int
+}
+
+type WebhookRepository interface {
+	InsertEndpoint(context context.Context, endpoint entities.WebhookEndpoint) (entities.WebhookEndpoint, error)
+	SelectEndpoints(context context.Context) ([]entities.WebhookEndpoint, error)
+	SelectEndpoint(context context.Context, id string) (entities.WebhookEndpoint, error)
+	UpdateEndpoint(context context.Context, endpoint entities.WebhookEndpoint) error
+	DeleteEndpoint(context context.Context, id string) error
+	ClaimDeliveries(context context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error)
+	UpdateDelivery(context context.Context, delivery entities.WebhookDelivery) error
+	SelectDeliveries(context context.Context, filter WebhookDeliveryFilter) ([]entities.WebhookDelivery, error)
+	ReplayDelivery(context context.Context, id string) (entities.WebhookDelivery, error)
+}
+
+type webhookRepository struct {
+	insertEndpointCommand   InsertWebhookEndpointCommand
+	selectEndpointsCommand  SelectWebhookEndpointsCommand
+	selectEndpointCommand   SelectWebhookEndpointCommand
+	updateEndpointCommand   UpdateWebhookEndpointCommand
+	deleteEndpointCommand   DeleteWebhookEndpointCommand
+	claimDeliveriesCommand  ClaimWebhookDeliveriesCommand
+	updateDeliveryCommand   UpdateWebhookDeliveryCommand
+	selectDeliveriesCommand SelectWebhookDeliveriesCommand
+	replayDeliveryCommand   ReplayWebhookDeliveryCommand
+}
+
+func NewWebhookRepository(
+	insertEndpointCommand InsertWebhookEndpointCommand,
+	selectEndpointsCommand SelectWebhookEndpointsCommand,
+	selectEndpointCommand SelectWebhookEndpointCommand,
+	updateEndpointCommand UpdateWebhookEndpointCommand,
+	deleteEndpointCommand DeleteWebhookEndpointCommand,
+	claimDeliveriesCommand ClaimWebhookDeliveriesCommand,
+	updateDeliveryCommand UpdateWebhookDeliveryCommand,
+	selectDeliveriesCommand SelectWebhookDeliveriesCommand,
+	replayDeliveryCommand ReplayWebhookDeliveryCommand,
+) WebhookRepository {
+	return &webhookRepository{
+		insertEndpointCommand:   insertEndpointCommand,
+		selectEndpointsCommand:  selectEndpointsCommand,
+		selectEndpointCommand:   selectEndpointCommand,
+		updateEndpointCommand:   updateEndpointCommand,
+		deleteEndpointCommand:   deleteEndpointCommand,
+		claimDeliveriesCommand:  claimDeliveriesCommand,
+		updateDeliveryCommand:   updateDeliveryCommand,
+		selectDeliveriesCommand: selectDeliveriesCommand,
+		replayDeliveryCommand:   replayDeliveryCommand,
+	}
+}
+
+func (r *webhookRepository) InsertEndpoint(context context.Context, endpoint entities.WebhookEndpoint) (entities.WebhookEndpoint, error) {
+	return r.insertEndpointCommand.Execute(context, endpoint)
+}
+
+func (r *webhookRepository) SelectEndpoints(context context.Context) ([]entities.WebhookEndpoint, error) {
+	return r.selectEndpointsCommand.Execute(context)
+}
+
+func (r *webhookRepository) SelectEndpoint(context context.Context, id string) (entities.WebhookEndpoint, error) {
+	return r.selectEndpointCommand.Execute(context, id)
+}
+
+func (r *webhookRepository) UpdateEndpoint(context context.Context, endpoint entities.WebhookEndpoint) error {
+	return r.updateEndpointCommand.Execute(context, endpoint)
+}
+
+func (r *webhookRepository) DeleteEndpoint(context context.Context, id string) error {
+	return r.deleteEndpointCommand.Execute(context, id)
+}
+
+// ClaimDeliveries takes the due deliveries for sending, they are hidden from
+// the other workers for the lease.
+func (r *webhookRepository) ClaimDeliveries(context context.Context, limit int, lease time.Duration) ([]entities.WebhookDelivery, error) {
+	return r.claimDeliveriesCommand.Execute(context, limit, lease)
+}
+
+func (r *webhookRepository) UpdateDelivery(context context.Context, delivery entities.WebhookDelivery) error {
+	return r.updateDeliveryCommand.Execute(context, delivery)
+}
+
+func (r *webhookRepository) SelectDeliveries(context context.Context, filter WebhookDeliveryFilter) ([]entities.WebhookDelivery, error) {
+	return r.selectDeliveriesCommand.Execute(context, filter)
+}
+
+// ReplayDelivery queues the event of the delivery once more, it returns the
+// new delivery.
+func (r *webhookRepository) ReplayDelivery(context context.Context, id string) (entities.WebhookDelivery, error) {
+	return r.replayDeliveryCommand.Execute(context, id)
+}
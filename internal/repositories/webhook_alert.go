@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"auth/internal/entities"
+	"context"
+	"time"
+)
+
+// WebhookAlertFilter narrows the received alerts down, empty fields match any
+// alert. The period is of the time the alert was received.
+type WebhookAlertFilter struct {
+	UserId string
+	Type   string
+	From   time.Time
+	To     time.Time
+	Offset int
+	Limit  int
+}
+
+type WebhookAlertRepository interface {
+	Insert(context context.Context, alert entities.WebhookAlert) error
+	Select(context context.Context, filter WebhookAlertFilter) ([]entities.WebhookAlert, error)
+	Count(context context.Context, filter WebhookAlertFilter) (int, error)
+}
+
+type webhookAlertRepository struct {
+	insertCommand InsertWebhookAlertCommand
+	selectCommand SelectWebhookAlertsCommand
+	countCommand  CountWebhookAlertsCommand
+}
+
+func NewWebhookAlertRepository(
+	insertCommand InsertWebhookAlertCommand,
+	selectCommand SelectWebhookAlertsCommand,
+	countCommand CountWebhookAlertsCommand,
+) WebhookAlertRepository {
+	return &webhookAlertRepository{
+		insertCommand: insertCommand,
+		selectCommand: selectCommand,
+		countCommand:  countCommand,
+	}
+}
+
+func (r *webhookAlertRepository) Insert(context context.Context, alert entities.WebhookAlert) error {
+	return r.insertCommand.Execute(context, alert)
+}
+
+func (r *webhookAlertRepository) Select(context context.Context, filter WebhookAlertFilter) ([]entities.WebhookAlert, error) {
+	return r.selectCommand.Execute(context, filter)
+}
+
+func (r *webhookAlertRepository) Count(context context.Context, filter WebhookAlertFilter) (int, error) {
+	return r.countCommand.Execute(context, filter)
+}
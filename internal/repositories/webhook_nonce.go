@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// WebhookNonceRepository remembers the nonces of the inbound webhooks to
+// reject the replayed requests. Insert yields ErrEntityAlreadyExists for a
+// nonce seen before it expired.
+type WebhookNonceRepository interface {
+	Insert(context context.Context, nonce string, expiresAt time.Time) error
+}
+
+type webhookNonceRepository struct {
+	insertCommand InsertWebhookNonceCommand
+}
+
+func NewWebhookNonceRepository(insertCommand InsertWebhookNonceCommand) WebhookNonceRepository {
+	return &webhookNonceRepository{insertCommand: insertCommand}
+}
+
+func (r *webhookNonceRepository) Insert(context context.Context, nonce string, expiresAt time.Time) error {
+	return r.insertCommand.Execute(context, nonce, expiresAt)
+}
@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type acceptInvitationUseCase struct {
+	userRepo         AcceptInvitationUserRepository
+	organizationRepo AcceptInvitationOrganizationRepository
+	invitationRepo   AcceptInvitationInvitationRepository
+	hashService      AcceptInvitationHashService
+	auditLogger      AcceptInvitationAuditLogger
+}
+
+type AcceptInvitationUseCase interface {
+	AcceptInvitation(context context.Context, userId string, request requests.AcceptInvitation) (responses.Membership, error)
+}
+
+func NewAcceptInvitationUseCase(
+	userRepo AcceptInvitationUserRepository,
+	organizationRepo AcceptInvitationOrganizationRepository,
+	invitationRepo AcceptInvitationInvitationRepository,
+	hashService AcceptInvitationHashService,
+	auditLogger AcceptInvitationAuditLogger,
+) AcceptInvitationUseCase {
+	return &acceptInvitationUseCase{
+		userRepo:         userRepo,
+		organizationRepo: organizationRepo,
+		invitationRepo:   invitationRepo,
+		hashService:      hashService,
+		auditLogger:      auditLogger,
+	}
+}
+
+func (u *acceptInvitationUseCase) AcceptInvitation(context context.Context, userId string, request requests.AcceptInvitation) (responses.Membership, error) {
+	response, err := u.acceptInvitation(context, userId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditInvitationAccept, userId, err))
+	return response, err
+}
+
+func (u *acceptInvitationUseCase) acceptInvitation(context context.Context, userId string, request requests.AcceptInvitation) (responses.Membership, error) {
+	invitation, err := u.invitationRepo.SelectById(context, request.InvitationId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.Membership{}, fmt.Errorf("%w: invitation not found", ErrInvalidInvitation)
+		}
+		return responses.Membership{}, fmt.Errorf("failed to find invitation: %w", err)
+	}
+
+	err = checkInvitation(invitation, request.Token, u.hashService)
+	if err != nil {
+		return responses.Membership{}, err
+	}
+	if !invitation.IsForOrganization() {
+		return responses.Membership{}, fmt.Errorf("%w: invitation is not bound to an organization", ErrInvalidInvitation)
+	}
+
+	user, err := u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		return responses.Membership{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	if !strings.EqualFold(string(user.Email), string(invitation.Email)) {
+		return responses.Membership{}, fmt.Errorf("%w: invitation was issued for another email", ErrInvalidInvitation)
+	}
+
+	_, err = u.organizationRepo.SelectMember(context, invitation.OrganizationId, userId)
+	if err == nil {
+		return responses.Membership{}, fmt.Errorf("%w: user is already a member of the organization", ErrEntityAlreadyExists)
+	}
+	if !errors.Is(err, repositories.ErrEntityNotFound) {
+		return responses.Membership{}, fmt.Errorf("failed to find membership: %w", err)
+	}
+
+	err = u.invitationRepo.Accept(context, invitation, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.Membership{}, fmt.Errorf("%w: invitation has already been used", ErrInvalidInvitation)
+		}
+		return responses.Membership{}, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	membership, err := u.organizationRepo.SelectMember(context, invitation.OrganizationId, userId)
+	if err != nil {
+		return responses.Membership{}, fmt.Errorf("failed to find membership: %w", err)
+	}
+	return newMembershipResponse(membership), nil
+}
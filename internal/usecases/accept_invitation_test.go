@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockAcceptInvitationUserRepo    *MockAcceptInvitationUserRepository
+	mockAcceptInvitationOrgRepo     *MockAcceptInvitationOrganizationRepository
+	mockAcceptInvitationRepo        *MockAcceptInvitationInvitationRepository
+	mockAcceptInvitationHashService *MockAcceptInvitationHashService
+	mockAcceptInvitationAuditLogger *MockAcceptInvitationAuditLogger
+)
+
+func initAcceptInvitationMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAcceptInvitationUserRepo = NewMockAcceptInvitationUserRepository(ctrl)
+	mockAcceptInvitationOrgRepo = NewMockAcceptInvitationOrganizationRepository(ctrl)
+	mockAcceptInvitationRepo = NewMockAcceptInvitationInvitationRepository(ctrl)
+	mockAcceptInvitationHashService = NewMockAcceptInvitationHashService(ctrl)
+	mockAcceptInvitationAuditLogger = NewMockAcceptInvitationAuditLogger(ctrl)
+	mockAcceptInvitationAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func newTestAcceptInvitationUseCase() AcceptInvitationUseCase {
+	return NewAcceptInvitationUseCase(
+		mockAcceptInvitationUserRepo,
+		mockAcceptInvitationOrgRepo,
+		mockAcceptInvitationRepo,
+		mockAcceptInvitationHashService,
+		mockAcceptInvitationAuditLogger,
+	)
+}
+
+func TestAcceptInvitationUseCase_AcceptInvitation_Success(t *testing.T) {
+	ctx := context.Background()
+	initAcceptInvitationMocks(t)
+
+	request := requests.AcceptInvitation{InvitationId: "invitation-id", Token: "raw-token"}
+	invitation := entities.Invitation{
+		Id:             "invitation-id",
+		OrganizationId: "org-id",
+		Email:          "Colleague@mail.ru",
+		Role:           entities.OrganizationRoleAdmin,
+		TokenHash:      "hashed-token",
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+	user := entities.User{Id: "user-id", Email: "colleague@mail.ru"}
+	membership := entities.Membership{OrganizationId: "org-id", OrganizationName: "Acme", UserId: "user-id", Role: entities.OrganizationRoleAdmin}
+
+	gomock.InOrder(
+		mockAcceptInvitationRepo.EXPECT().SelectById(ctx, "invitation-id").Return(invitation, nil),
+		mockAcceptInvitationHashService.EXPECT().CompareStringAndHash("raw-token", "hashed-token").Return(true),
+		mockAcceptInvitationUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil),
+		mockAcceptInvitationOrgRepo.EXPECT().SelectMember(ctx, "org-id", "user-id").Return(entities.Membership{}, repositories.ErrEntityNotFound),
+		mockAcceptInvitationRepo.EXPECT().Accept(ctx, invitation, "user-id").Return(nil),
+		mockAcceptInvitationOrgRepo.EXPECT().SelectMember(ctx, "org-id", "user-id").Return(membership, nil),
+	)
+
+	result, err := newTestAcceptInvitationUseCase().AcceptInvitation(ctx, "user-id", request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "org-id", result.OrganizationId)
+	assert.Equal(t, "Acme", result.OrganizationName)
+	assert.Equal(t, entities.OrganizationRoleAdmin, result.Role)
+}
+
+func TestAcceptInvitationUseCase_AcceptInvitation_WrongToken(t *testing.T) {
+	ctx := context.Background()
+	initAcceptInvitationMocks(t)
+
+	invitation := entities.Invitation{Id: "invitation-id", TokenHash: "hashed-token", ExpiresAt: time.Now().Add(time.Hour)}
+
+	mockAcceptInvitationRepo.EXPECT().SelectById(ctx, "invitation-id").Return(invitation, nil)
+	mockAcceptInvitationHashService.EXPECT().CompareStringAndHash("wrong-token", "hashed-token").Return(false)
+
+	_, err := newTestAcceptInvitationUseCase().AcceptInvitation(ctx, "user-id",
+		requests.AcceptInvitation{InvitationId: "invitation-id", Token: "wrong-token"})
+
+	assert.ErrorIs(t, err, ErrInvalidInvitation)
+}
+
+func TestAcceptInvitationUseCase_AcceptInvitation_Expired(t *testing.T) {
+	ctx := context.Background()
+	initAcceptInvitationMocks(t)
+
+	invitation := entities.Invitation{Id: "invitation-id", TokenHash: "hashed-token", ExpiresAt: time.Now().Add(-time.Hour)}
+
+	mockAcceptInvitationRepo.EXPECT().SelectById(ctx, "invitation-id").Return(invitation, nil)
+	mockAcceptInvitationHashService.EXPECT().CompareStringAndHash("raw-token", "hashed-token").Return(true)
+
+	_, err := newTestAcceptInvitationUseCase().AcceptInvitation(ctx, "user-id",
+		requests.AcceptInvitation{InvitationId: "invitation-id", Token: "raw-token"})
+
+	assert.ErrorIs(t, err, ErrInvalidInvitation)
+	assert.ErrorContains(t, err, "expired")
+}
+
+func TestAcceptInvitationUseCase_AcceptInvitation_AnotherEmail(t *testing.T) {
+	ctx := context.Background()
+	initAcceptInvitationMocks(t)
+
+	invitation := entities.Invitation{
+		Id:             "invitation-id",
+		OrganizationId: "org-id",
+		Email:          "colleague@mail.ru",
+		TokenHash:      "hashed-token",
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+
+	mockAcceptInvitationRepo.EXPECT().SelectById(ctx, "invitation-id").Return(invitation, nil)
+	mockAcceptInvitationHashService.EXPECT().CompareStringAndHash("raw-token", "hashed-token").Return(true)
+	mockAcceptInvitationUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{Id: "user-id", Email: "other@mail.ru"}, nil)
+
+	_, err := newTestAcceptInvitationUseCase().AcceptInvitation(ctx, "user-id",
+		requests.AcceptInvitation{InvitationId: "invitation-id", Token: "raw-token"})
+
+	assert.ErrorIs(t, err, ErrInvalidInvitation)
+}
+
+func TestAcceptInvitationUseCase_AcceptInvitation_NoOrganization(t *testing.T) {
+	ctx := context.Background()
+	initAcceptInvitationMocks(t)
+
+	invitation := entities.Invitation{
+		Id:        "invitation-id",
+		Email:     "colleague@mail.ru",
+		TokenHash: "hashed-token",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	mockAcceptInvitationRepo.EXPECT().SelectById(ctx, "invitation-id").Return(invitation, nil)
+	mockAcceptInvitationHashService.EXPECT().CompareStringAndHash("raw-token", "hashed-token").Return(true)
+
+	_, err := newTestAcceptInvitationUseCase().AcceptInvitation(ctx, "user-id",
+		requests.AcceptInvitation{InvitationId: "invitation-id", Token: "raw-token"})
+
+	assert.ErrorIs(t, err, ErrInvalidInvitation)
+}
@@ -0,0 +1,134 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type changePasswordUseCase struct {
+	userRepo       ChangePasswordUserRepository
+	sessionRepo    ChangePasswordSessionRepository
+	attemptRepo    ChangePasswordLoginAttemptRepository
+	hashService    ChangePasswordHashService
+	breachService  ChangePasswordBreachedPasswordService
+	passwordPolicy entities.PasswordPolicy
+	auditLogger    ChangePasswordAuditLogger
+}
+
+type ChangePasswordUseCase interface {
+	ChangePassword(context context.Context, userId string, request requests.ChangePassword) error
+}
+
+func NewChangePasswordUseCase(
+	userRepo ChangePasswordUserRepository,
+	sessionRepo ChangePasswordSessionRepository,
+	attemptRepo ChangePasswordLoginAttemptRepository,
+	hashService ChangePasswordHashService,
+	breachService ChangePasswordBreachedPasswordService,
+	passwordPolicy entities.PasswordPolicy,
+	auditLogger ChangePasswordAuditLogger,
+) ChangePasswordUseCase {
+	return &changePasswordUseCase{
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		attemptRepo:    attemptRepo,
+		hashService:    hashService,
+		breachService:  breachService,
+		passwordPolicy: passwordPolicy,
+		auditLogger:    auditLogger,
+	}
+}
+
+// ChangePassword replaces the password, closes all sessions of the user and
+// resets the failed sign in attempts of the account.
+func (u *changePasswordUseCase) ChangePassword(context context.Context, userId string, request requests.ChangePassword) error {
+	err := u.changePassword(context, userId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditPasswordChange, userId, err))
+	return err
+}
+
+func (u *changePasswordUseCase) changePassword(context context.Context, userId string, request requests.ChangePassword) error {
+	user, err := u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+		}
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	currentPassword := entities.NewPassword(request.CurrentPassword)
+	if !u.hashService.CompareStringAndHash(string(currentPassword), string(user.Password)) {
+		return fmt.Errorf("failed to compare password: %w", ErrWrongPassword)
+	}
+
+	password := entities.NewPassword(request.NewPassword)
+	err = password.Validate(u.passwordPolicy, user.Email)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+	err = checkBreachedPassword(password, u.breachService)
+	if err != nil {
+		return err
+	}
+
+	reused, err := u.isReused(context, user, password)
+	if err != nil {
+		return err
+	}
+	if reused {
+		return fmt.Errorf("%w: password can't match any of the last %d passwords", ErrInvalidEntity, u.passwordPolicy.HistorySize)
+	}
+
+	hashedPassword, err := u.hashService.GenerateHash(string(password))
+	if err != nil {
+		return fmt.Errorf("%w: failed to hash the password", err)
+	}
+
+	event := entities.NewWebhookEvent(entities.WebhookUserPasswordChanged, map[string]string{"userId": userId})
+	err = u.userRepo.ChangePassword(context, userId, entities.Password(hashedPassword), u.previousPasswordsCount(), event)
+	if err != nil {
+		return fmt.Errorf("failed to change password: %w", err)
+	}
+
+	err = u.sessionRepo.DeleteByUserId(context, userId)
+	if err != nil {
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	err = u.attemptRepo.Reset(context, entities.AccountAttemptsKey(user.Email))
+	if err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+	return nil
+}
+
+// isReused compares the password with the current one and the previous ones
+// kept in the history.
+func (u *changePasswordUseCase) isReused(context context.Context, user entities.User, password entities.Password) (bool, error) {
+	if u.passwordPolicy.HistorySize <= 0 {
+		return false, nil
+	}
+	if u.hashService.CompareStringAndHash(string(password), string(user.Password)) {
+		return true, nil
+	}
+
+	history, err := u.userRepo.SelectPasswordHistory(context, user.Id, u.previousPasswordsCount())
+	if err != nil {
+		return false, fmt.Errorf("failed to select password history: %w", err)
+	}
+	for _, hash := range history {
+		if u.hashService.CompareStringAndHash(string(password), string(hash)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// previousPasswordsCount is the history size without the current password.
+func (u *changePasswordUseCase) previousPasswordsCount() int {
+	return max(u.passwordPolicy.HistorySize-1, 0)
+}
@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockChangePasswordUserRepo      *MockChangePasswordUserRepository
+	mockChangePasswordSessionRepo   *MockChangePasswordSessionRepository
+	mockChangePasswordAttemptRepo   *MockChangePasswordLoginAttemptRepository
+	mockChangePasswordHashService   *MockChangePasswordHashService
+	mockChangePasswordBreachService *MockChangePasswordBreachedPasswordService
+	mockChangePasswordAuditLogger   *MockChangePasswordAuditLogger
+)
+
+func initChangePasswordMocks(t *testing.T) ChangePasswordUseCase {
+	ctrl := gomock.NewController(t)
+	mockChangePasswordUserRepo = NewMockChangePasswordUserRepository(ctrl)
+	mockChangePasswordSessionRepo = NewMockChangePasswordSessionRepository(ctrl)
+	mockChangePasswordAttemptRepo = NewMockChangePasswordLoginAttemptRepository(ctrl)
+	mockChangePasswordHashService = NewMockChangePasswordHashService(ctrl)
+	mockChangePasswordBreachService = NewMockChangePasswordBreachedPasswordService(ctrl)
+	mockChangePasswordAuditLogger = NewMockChangePasswordAuditLogger(ctrl)
+	mockChangePasswordAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	policy := entities.DefaultPasswordPolicy()
+	policy.HistorySize = 3
+	return NewChangePasswordUseCase(
+		mockChangePasswordUserRepo,
+		mockChangePasswordSessionRepo,
+		mockChangePasswordAttemptRepo,
+		mockChangePasswordHashService,
+		mockChangePasswordBreachService,
+		policy,
+		mockChangePasswordAuditLogger)
+}
+
+var changePasswordUser = entities.User{
+	Id:       "user-id",
+	Email:    entities.Email("test@mail.ru"),
+	Password: entities.Password("current-hash"),
+}
+
+func TestChangePasswordUseCase_ChangePassword_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := initChangePasswordMocks(t)
+
+	request := requests.ChangePassword{CurrentPassword: "password123", NewPassword: "newPassword456"}
+
+	mockChangePasswordUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(changePasswordUser, nil)
+	mockChangePasswordHashService.EXPECT().CompareStringAndHash("password123", "current-hash").Return(true)
+	mockChangePasswordBreachService.EXPECT().IsBreached("newPassword456").Return(false, nil)
+	mockChangePasswordHashService.EXPECT().CompareStringAndHash("newPassword456", "current-hash").Return(false)
+	mockChangePasswordUserRepo.EXPECT().SelectPasswordHistory(ctx, "user-id", 2).
+		Return([]entities.Password{"old-hash-1", "old-hash-2"}, nil)
+	mockChangePasswordHashService.EXPECT().CompareStringAndHash("newPassword456", "old-hash-1").Return(false)
+	mockChangePasswordHashService.EXPECT().CompareStringAndHash("newPassword456", "old-hash-2").Return(false)
+	mockChangePasswordHashService.EXPECT().GenerateHash("newPassword456").Return([]byte("new-hash"), nil)
+	mockChangePasswordUserRepo.EXPECT().ChangePassword(ctx, "user-id", entities.Password("new-hash"), 2, webhookEventOf(entities.WebhookUserPasswordChanged, map[string]string{"userId": "user-id"})).Return(nil)
+	mockChangePasswordSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	mockChangePasswordAttemptRepo.EXPECT().Reset(ctx, "account:test@mail.ru").Return(nil)
+
+	err := useCase.ChangePassword(ctx, "user-id", request)
+
+	assert.NoError(t, err)
+}
+
+func TestChangePasswordUseCase_ChangePassword_WrongPassword(t *testing.T) {
+	ctx := context.Background()
+	useCase := initChangePasswordMocks(t)
+
+	request := requests.ChangePassword{CurrentPassword: "wrong123", NewPassword: "newPassword456"}
+
+	mockChangePasswordUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(changePasswordUser, nil)
+	mockChangePasswordHashService.EXPECT().CompareStringAndHash("wrong123", "current-hash").Return(false)
+
+	err := useCase.ChangePassword(ctx, "user-id", request)
+
+	assert.True(t, errors.Is(err, ErrWrongPassword))
+}
+
+func TestChangePasswordUseCase_ChangePassword_ReusedPassword(t *testing.T) {
+	ctx := context.Background()
+	useCase := initChangePasswordMocks(t)
+
+	request := requests.ChangePassword{CurrentPassword: "password123", NewPassword: "oldPassword789"}
+
+	mockChangePasswordUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(changePasswordUser, nil)
+	mockChangePasswordHashService.EXPECT().CompareStringAndHash("password123", "current-hash").Return(true)
+	mockChangePasswordBreachService.EXPECT().IsBreached("oldPassword789").Return(false, nil)
+	mockChangePasswordHashService.EXPECT().CompareStringAndHash("oldPassword789", "current-hash").Return(false)
+	mockChangePasswordUserRepo.EXPECT().SelectPasswordHistory(ctx, "user-id", 2).
+		Return([]entities.Password{"old-hash-1"}, nil)
+	mockChangePasswordHashService.EXPECT().CompareStringAndHash("oldPassword789", "old-hash-1").Return(true)
+
+	err := useCase.ChangePassword(ctx, "user-id", request)
+
+	assert.True(t, errors.Is(err, ErrInvalidEntity))
+}
+
+func TestChangePasswordUseCase_ChangePassword_PolicyViolation(t *testing.T) {
+	ctx := context.Background()
+	useCase := initChangePasswordMocks(t)
+
+	request := requests.ChangePassword{CurrentPassword: "password123", NewPassword: "short"}
+
+	mockChangePasswordUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(changePasswordUser, nil)
+	mockChangePasswordHashService.EXPECT().CompareStringAndHash("password123", "current-hash").Return(true)
+
+	err := useCase.ChangePassword(ctx, "user-id", request)
+
+	assert.True(t, errors.Is(err, ErrInvalidEntity))
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"auth/internal/entities"
+	"auth/internal/repositories"
 	"context"
 	"net/http"
 	"time"
@@ -12,6 +13,7 @@ import (
 type (
 	SignInUserRepository interface {
 		SelectByEmail(context.Context, entities.Email) (entities.User, error)
+		UpdatePassword(context.Context, string, entities.Password) error
 	}
 
 	SignInSessionRepository interface {
@@ -22,36 +24,481 @@ type (
 	SignInHashService interface {
 		GenerateHash(stringToHash string) ([]byte, error)
 		CompareStringAndHash(string, string) bool
+		NeedsRehash(string) bool
 	}
 
 	SignInSessionService interface {
-		CreateSession(account entities.User) (entities.Session, error)
+		CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error)
+		CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error)
+		ParseToken(token string) (entities.AccessTokenClaims, error)
 	}
 
 	SignInCookieService interface {
 		Set(w http.ResponseWriter, name, value string, expires time.Time)
 	}
 
+	SignInOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+	}
+
+	SignInMFARepository interface {
+		SelectTOTP(context.Context, string) (entities.TOTP, error)
+	}
+
+	SignInWebAuthnRepository interface {
+		SelectCredentials(context.Context, string) ([]entities.WebAuthnCredential, error)
+	}
+
+	SignInTrustedDeviceRepository interface {
+		Select(context.Context, string, string) (entities.TrustedDevice, error)
+		UpdateUsage(context.Context, string) error
+	}
+
+	SignInLoginAttemptRepository interface {
+		Select(context.Context, string) (entities.LoginAttempts, error)
+		RegisterFailure(context.Context, string, time.Duration) (entities.LoginAttempts, error)
+		Lock(context.Context, string, time.Time) error
+		Reset(context.Context, string) error
+	}
+
+	SignInRiskEngine interface {
+		Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error)
+		Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error
+	}
+
+	SignInAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+
+	CompleteSignInSessionRepository interface {
+		Insert(context.Context, entities.Session) error
+		DeleteByUserId(context.Context, string) error
+	}
+
+	CompleteSignInOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+	}
+
+	CompleteSignInLoginAttemptRepository interface {
+		Reset(context.Context, string) error
+	}
+
+	CompleteSignInMFARepository interface {
+		SelectTOTP(context.Context, string) (entities.TOTP, error)
+	}
+
+	CompleteSignInWebAuthnRepository interface {
+		SelectCredentials(context.Context, string) ([]entities.WebAuthnCredential, error)
+	}
+
+	CompleteSignInHashService interface {
+		GenerateHash(stringToHash string) ([]byte, error)
+	}
+
+	CompleteSignInSessionService interface {
+		CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error)
+		CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error)
+	}
+
+	CompleteSignInCookieService interface {
+		Set(w http.ResponseWriter, name, value string, expires time.Time)
+	}
+
+	CompleteSignInRiskEngine interface {
+		Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error)
+		Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error
+	}
+
+	CompleteSignInAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+
+	VerifyMFAUserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+	}
+
+	VerifyMFASessionRepository interface {
+		Insert(context.Context, entities.Session) error
+		DeleteByUserId(context.Context, string) error
+	}
+
+	VerifyMFAHashService interface {
+		GenerateHash(stringToHash string) ([]byte, error)
+		CompareStringAndHash(string, string) bool
+	}
+
+	VerifyMFASessionService interface {
+		CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error)
+		CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error)
+		CreateDeviceToken(account entities.User, deviceId string, expiresAt time.Time) (string, error)
+		ParseToken(token string) (entities.AccessTokenClaims, error)
+	}
+
+	VerifyMFACookieService interface {
+		Set(w http.ResponseWriter, name, value string, expires time.Time)
+	}
+
+	VerifyMFAOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+	}
+
+	VerifyMFAMFARepository interface {
+		SelectTOTP(context.Context, string) (entities.TOTP, error)
+		UseTOTPStep(context.Context, string, int64) error
+		UseRecoveryCode(context.Context, string, string) error
+	}
+
+	VerifyMFAMFAService interface {
+		Validate(secret, code string, lastUsedStep int64) (int64, bool)
+		HashRecoveryCode(code string) string
+	}
+
+	VerifyMFAEncryptionService interface {
+		Decrypt(ciphertext string) (string, error)
+	}
+
+	VerifyMFAWebAuthnRepository interface {
+		SelectCredentials(context.Context, string) ([]entities.WebAuthnCredential, error)
+	}
+
+	VerifyMFATrustedDeviceRepository interface {
+		Insert(context.Context, entities.TrustedDevice) (string, error)
+	}
+
+	VerifyMFASMSCodeRepository interface {
+		SaveCode(context.Context, entities.SMSCode) error
+		SelectCode(context.Context, string, string) (entities.SMSCode, error)
+		RegisterCodeFailure(context.Context, string) (int, error)
+		DeleteCode(context.Context, string) error
+	}
+
+	VerifyMFASMSSender interface {
+		Send(to, message string) error
+	}
+
+	VerifyMFARateLimitRepository interface {
+		Take(context.Context, string, entities.RateLimit) (entities.RateLimitResult, error)
+	}
+
+	VerifyMFARandomService interface {
+		GenerateCode(digits int) (string, error)
+	}
+
+	VerifyMFALoginAttemptRepository interface {
+		Select(context.Context, string) (entities.LoginAttempts, error)
+		RegisterFailure(context.Context, string, time.Duration) (entities.LoginAttempts, error)
+		Lock(context.Context, string, time.Time) error
+		Reset(context.Context, string) error
+	}
+
+	VerifyMFARiskEngine interface {
+		Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error)
+		Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error
+	}
+
+	VerifyMFAAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+
+	WebAuthnLoginUserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+	}
+
+	WebAuthnLoginSessionRepository interface {
+		Insert(context.Context, entities.Session) error
+		DeleteByUserId(context.Context, string) error
+	}
+
+	WebAuthnLoginHashService interface {
+		GenerateHash(stringToHash string) ([]byte, error)
+	}
+
+	WebAuthnLoginSessionService interface {
+		CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error)
+		CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error)
+		CreateDeviceToken(account entities.User, deviceId string, expiresAt time.Time) (string, error)
+		ParseToken(token string) (entities.AccessTokenClaims, error)
+	}
+
+	WebAuthnLoginCookieService interface {
+		Set(w http.ResponseWriter, name, value string, expires time.Time)
+	}
+
+	WebAuthnLoginOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+	}
+
+	WebAuthnLoginMFARepository interface {
+		SelectTOTP(context.Context, string) (entities.TOTP, error)
+	}
+
+	WebAuthnLoginWebAuthnRepository interface {
+		SelectCredentials(context.Context, string) ([]entities.WebAuthnCredential, error)
+		UpdateCredentialUsage(context.Context, entities.WebAuthnCredential) error
+		InsertSession(context.Context, entities.WebAuthnSession) (string, error)
+		TakeSession(context.Context, string) (entities.WebAuthnSession, error)
+	}
+
+	WebAuthnLoginWebAuthnService interface {
+		BeginLogin(user entities.User, credentials []entities.WebAuthnCredential) ([]byte, entities.WebAuthnSession, error)
+		BeginDiscoverableLogin() ([]byte, entities.WebAuthnSession, error)
+		CredentialOwner(response []byte) (string, error)
+		FinishLogin(user entities.User, credentials []entities.WebAuthnCredential, session entities.WebAuthnSession, response []byte) (entities.WebAuthnCredential, error)
+	}
+
+	WebAuthnLoginTrustedDeviceRepository interface {
+		Insert(context.Context, entities.TrustedDevice) (string, error)
+	}
+
+	WebAuthnLoginLoginAttemptRepository interface {
+		Select(context.Context, string) (entities.LoginAttempts, error)
+		RegisterFailure(context.Context, string, time.Duration) (entities.LoginAttempts, error)
+		Lock(context.Context, string, time.Time) error
+		Reset(context.Context, string) error
+	}
+
+	WebAuthnLoginRiskEngine interface {
+		Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error)
+		Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error
+	}
+
+	WebAuthnLoginAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+
+	PasswordlessUserRepository interface {
+		SelectByEmail(context.Context, entities.Email) (entities.User, error)
+		Insert(context.Context, entities.User) (string, error)
+	}
+
+	PasswordlessSessionRepository interface {
+		Insert(context.Context, entities.Session) error
+		DeleteByUserId(context.Context, string) error
+	}
+
+	PasswordlessHashService interface {
+		GenerateHash(stringToHash string) ([]byte, error)
+		CompareStringAndHash(string, string) bool
+	}
+
+	PasswordlessSessionService interface {
+		CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error)
+		CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error)
+		ParseToken(token string) (entities.AccessTokenClaims, error)
+	}
+
+	PasswordlessCookieService interface {
+		Set(w http.ResponseWriter, name, value string, expires time.Time)
+	}
+
+	PasswordlessOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+	}
+
+	PasswordlessMFARepository interface {
+		SelectTOTP(context.Context, string) (entities.TOTP, error)
+	}
+
+	PasswordlessWebAuthnRepository interface {
+		SelectCredentials(context.Context, string) ([]entities.WebAuthnCredential, error)
+	}
+
+	PasswordlessTokenRepository interface {
+		InsertToken(context.Context, entities.PasswordlessToken) (string, error)
+		SelectTokenByDevice(context.Context, string) (entities.PasswordlessToken, error)
+		RegisterTokenFailure(context.Context, string) (int, error)
+		DeleteToken(context.Context, string) error
+	}
+
+	PasswordlessTrustedDeviceRepository interface {
+		Select(context.Context, string, string) (entities.TrustedDevice, error)
+		UpdateUsage(context.Context, string) error
+	}
+
+	PasswordlessMailService interface {
+		Send(to, subject, body string) error
+	}
+
+	PasswordlessRandomService interface {
+		GenerateToken() (string, error)
+		GenerateCode(digits int) (string, error)
+		HashToken(token string) string
+	}
+
+	PasswordlessLoginAttemptRepository interface {
+		Select(context.Context, string) (entities.LoginAttempts, error)
+		RegisterFailure(context.Context, string, time.Duration) (entities.LoginAttempts, error)
+		Lock(context.Context, string, time.Time) error
+		Reset(context.Context, string) error
+	}
+
+	PasswordlessRiskEngine interface {
+		Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error)
+		Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error
+	}
+
+	PasswordlessAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+
 	SignUpUserRepository interface {
 		CheckEmailExists(context.Context, entities.Email) (bool, error)
 		Insert(context.Context, entities.User) (string, error)
+		SelectByUserId(context.Context, string) (entities.User, error)
+	}
+
+	SignUpInvitationRepository interface {
+		SelectById(context.Context, string) (entities.Invitation, error)
+		SignUp(context.Context, entities.Invitation, entities.User) (string, error)
 	}
 
 	SignUpSessionRepository interface {
 		Insert(context.Context, entities.Session) error
 	}
 
+	SignUpMailService interface {
+		Send(to, subject, body string) error
+	}
+
 	SignUpSessionService interface {
-		CreateSession(user entities.User) (entities.Session, error)
+		CreateSession(user entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error)
 	}
 
 	SignUpHashService interface {
 		GenerateHash(stringToHash string) ([]byte, error)
+		CompareStringAndHash(string, string) bool
+	}
+
+	SignUpBreachedPasswordService interface {
+		IsBreached(password string) (bool, error)
 	}
 
 	SignUpCookieService interface {
 		Set(w http.ResponseWriter, name, value string, expires time.Time)
 	}
+
+	SignUpAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	ChangePasswordUserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+		SelectPasswordHistory(context.Context, string, int) ([]entities.Password, error)
+		ChangePassword(context.Context, string, entities.Password, int, entities.WebhookEvent) error
+	}
+
+	EnrollTOTPUserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+	}
+
+	EnrollTOTPMFARepository interface {
+		SelectTOTP(context.Context, string) (entities.TOTP, error)
+		SaveTOTP(context.Context, entities.TOTP) error
+		ConfirmTOTP(context.Context, string, int64, []string) error
+	}
+
+	EnrollTOTPMFAService interface {
+		GenerateSecret() (string, error)
+		URI(account, secret string) string
+		Validate(secret, code string, lastUsedStep int64) (int64, bool)
+		GenerateRecoveryCodes() ([]string, error)
+		HashRecoveryCode(code string) string
+	}
+
+	EnrollTOTPEncryptionService interface {
+		Encrypt(plaintext string) (string, error)
+		Decrypt(ciphertext string) (string, error)
+	}
+
+	EnrollTOTPAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+
+	TrustedDevicesRepository interface {
+		SelectByUserId(context.Context, string) ([]entities.TrustedDevice, error)
+		Delete(context.Context, string, string) error
+		DeleteByUserId(context.Context, string) error
+	}
+
+	TrustedDevicesAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+
+	VerifyPhoneUserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+		UpdatePhone(context.Context, string, entities.Phone) error
+	}
+
+	VerifyPhoneSMSCodeRepository interface {
+		SaveCode(context.Context, entities.SMSCode) error
+		SelectCode(context.Context, string, string) (entities.SMSCode, error)
+		RegisterCodeFailure(context.Context, string) (int, error)
+		DeleteCode(context.Context, string) error
+	}
+
+	VerifyPhoneRateLimitRepository interface {
+		Take(context.Context, string, entities.RateLimit) (entities.RateLimitResult, error)
+	}
+
+	VerifyPhoneHashService interface {
+		GenerateHash(stringToHash string) ([]byte, error)
+		CompareStringAndHash(string, string) bool
+	}
+
+	VerifyPhoneRandomService interface {
+		GenerateCode(digits int) (string, error)
+	}
+
+	VerifyPhoneSMSSender interface {
+		Send(to, message string) error
+	}
+
+	VerifyPhoneAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+
+	RegisterWebAuthnUserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+	}
+
+	RegisterWebAuthnRepository interface {
+		SelectCredentials(context.Context, string) ([]entities.WebAuthnCredential, error)
+		InsertCredential(context.Context, entities.WebAuthnCredential) error
+		InsertSession(context.Context, entities.WebAuthnSession) (string, error)
+		TakeSession(context.Context, string) (entities.WebAuthnSession, error)
+	}
+
+	RegisterWebAuthnService interface {
+		BeginRegistration(user entities.User, credentials []entities.WebAuthnCredential) ([]byte, entities.WebAuthnSession, error)
+		FinishRegistration(user entities.User, credentials []entities.WebAuthnCredential, session entities.WebAuthnSession, response []byte) (entities.WebAuthnCredential, error)
+	}
+
+	RegisterWebAuthnAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+
+	ChangePasswordSessionRepository interface {
+		DeleteByUserId(context.Context, string) error
+	}
+
+	ChangePasswordLoginAttemptRepository interface {
+		Reset(context.Context, string) error
+	}
+
+	ChangePasswordHashService interface {
+		GenerateHash(stringToHash string) ([]byte, error)
+		CompareStringAndHash(string, string) bool
+	}
+
+	ChangePasswordBreachedPasswordService interface {
+		IsBreached(password string) (bool, error)
+	}
+
+	ChangePasswordAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
 )
 
 type (
@@ -73,7 +520,11 @@ type (
 	}
 
 	GenerateTokensSessionService interface {
-		CreateSession(account entities.User) (entities.Session, error)
+		CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error)
+	}
+
+	GenerateTokensAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
 	}
 )
 
@@ -86,11 +537,12 @@ type (
 		SelectByUserId(context.Context, string) (entities.Session, error)
 		DeleteByUserId(context.Context, string) error
 		Update(context context.Context, session entities.Session) error
+		UpdateWithEvent(context context.Context, session entities.Session, event entities.WebhookEvent) error
 	}
 
 	RefreshSessionSessionService interface {
 		ParseToken(token string) (entities.AccessTokenClaims, error)
-		CreateSession(account entities.User) (entities.Session, error)
+		CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error)
 	}
 
 	RefreshSessionCookieService interface {
@@ -102,12 +554,307 @@ type (
 		GenerateHash(stringToHash string) ([]byte, error)
 		CompareStringAndHash(string, string) bool
 	}
+
+	RefreshSessionOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+	}
+
+	RefreshSessionRiskEngine interface {
+		Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error)
+		Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error
+	}
+
+	RefreshSessionAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
 )
 
 type (
 	GetUserUserRepository interface {
 		SelectByUserId(context.Context, string) (entities.User, error)
 	}
+
+	GetUserOrganizationRepository interface {
+		SelectMembershipsByUserId(context.Context, string) ([]entities.Membership, error)
+	}
+
+	GetUserAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	ListUsersUserRepository interface {
+		SelectList(context.Context, repositories.UserFilter) ([]entities.User, error)
+		Count(context.Context, repositories.UserFilter) (int, error)
+	}
+
+	ListUsersAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	UpdateUserUserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+		CheckEmailExists(context.Context, entities.Email) (bool, error)
+		Update(context.Context, entities.User) error
+	}
+
+	UpdateUserSessionRepository interface {
+		DeleteByUserId(context.Context, string) error
+	}
+
+	UpdateUserAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	DeleteUserUserRepository interface {
+		Delete(context.Context, string, entities.WebhookEvent) error
+	}
+
+	DeleteUserAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	RevokeSessionsUserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+	}
+
+	RevokeSessionsSessionRepository interface {
+		DeleteByUserId(context.Context, string) error
+	}
+
+	RevokeSessionsAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	ListRolesRoleRepository interface {
+		SelectAll(context.Context) ([]entities.Role, error)
+	}
+
+	ListRolesAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	CreateRoleRoleRepository interface {
+		SelectByNames(context.Context, []string) ([]entities.Role, error)
+		Insert(context.Context, entities.Role) (int, error)
+	}
+
+	CreateRolePermissionRepository interface {
+		SelectByNames(context.Context, []string) ([]entities.Permission, error)
+	}
+
+	CreateRoleAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	UpdateRoleRoleRepository interface {
+		SelectById(context.Context, int) (entities.Role, error)
+		Update(context.Context, entities.Role) error
+	}
+
+	UpdateRolePermissionRepository interface {
+		SelectByNames(context.Context, []string) ([]entities.Permission, error)
+	}
+
+	UpdateRoleSessionRepository interface {
+		DeleteByRoleId(context.Context, int) error
+	}
+
+	UpdateRoleAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	DeleteRoleRoleRepository interface {
+		SelectById(context.Context, int) (entities.Role, error)
+		Delete(context.Context, int) error
+	}
+
+	DeleteRoleSessionRepository interface {
+		DeleteByRoleId(context.Context, int) error
+	}
+
+	DeleteRoleAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	ListPermissionsPermissionRepository interface {
+		SelectAll(context.Context) ([]entities.Permission, error)
+	}
+
+	ListPermissionsAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	CreatePermissionPermissionRepository interface {
+		SelectByNames(context.Context, []string) ([]entities.Permission, error)
+		Insert(context.Context, entities.Permission) (int, error)
+	}
+
+	CreatePermissionAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	DeletePermissionPermissionRepository interface {
+		Delete(context.Context, int) error
+	}
+
+	DeletePermissionAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	SetUserRolesUserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+		UpdateRoles(context.Context, string, []string) error
+	}
+
+	SetUserRolesRoleRepository interface {
+		SelectByNames(context.Context, []string) ([]entities.Role, error)
+	}
+
+	SetUserRolesSessionRepository interface {
+		DeleteByUserId(context.Context, string) error
+	}
+
+	SetUserRolesAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	CreateOrganizationOrganizationRepository interface {
+		Insert(context.Context, entities.Organization, string) (string, error)
+	}
+
+	CreateOrganizationAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	ListMembersOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+		SelectMembers(context.Context, string) ([]entities.Membership, error)
+	}
+
+	ListMembersAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	RemoveMemberOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+		DeleteMember(context.Context, string, string) error
+	}
+
+	RemoveMemberAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	SetOrganizationPasswordPolicyOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+		UpdatePasswordMaxAge(context.Context, string, *time.Duration) error
+	}
+
+	SetOrganizationPasswordPolicyAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	CreateInvitationOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+	}
+
+	CreateInvitationInvitationRepository interface {
+		Insert(context.Context, entities.Invitation) (string, error)
+	}
+
+	CreateInvitationHashService interface {
+		GenerateHash(stringToHash string) ([]byte, error)
+	}
+
+	CreateInvitationRandomService interface {
+		GenerateToken() (string, error)
+	}
+
+	CreateInvitationAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	CreateUserInvitationUserRepository interface {
+		CheckEmailExists(context.Context, entities.Email) (bool, error)
+	}
+
+	CreateUserInvitationRoleRepository interface {
+		SelectByNames(context.Context, []string) ([]entities.Role, error)
+	}
+
+	CreateUserInvitationInvitationRepository interface {
+		Insert(context.Context, entities.Invitation) (string, error)
+	}
+
+	CreateUserInvitationHashService interface {
+		GenerateHash(stringToHash string) ([]byte, error)
+	}
+
+	CreateUserInvitationRandomService interface {
+		GenerateToken() (string, error)
+	}
+
+	CreateUserInvitationAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	AcceptInvitationUserRepository interface {
+		SelectByUserId(context.Context, string) (entities.User, error)
+	}
+
+	AcceptInvitationOrganizationRepository interface {
+		SelectMember(context.Context, string, string) (entities.Membership, error)
+	}
+
+	AcceptInvitationInvitationRepository interface {
+		SelectById(context.Context, string) (entities.Invitation, error)
+		Accept(context.Context, entities.Invitation, string) error
+	}
+
+	AcceptInvitationHashService interface {
+		CompareStringAndHash(string, string) bool
+	}
+
+	AcceptInvitationAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
 )
 
 type (
@@ -120,4 +867,118 @@ type (
 	LogoutSessionService interface {
 		ParseToken(token string) (entities.AccessTokenClaims, error)
 	}
+
+	LogoutAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	SecurityEventsAuditEventRepository interface {
+		Select(context.Context, repositories.AuditEventFilter) ([]entities.AuditEvent, error)
+		Count(context.Context, repositories.AuditEventFilter) (int, error)
+	}
+
+	SecurityEventsAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	WebhooksWebhookRepository interface {
+		InsertEndpoint(context.Context, entities.WebhookEndpoint) (entities.WebhookEndpoint, error)
+		ClaimDeliveries(context.Context, int, time.Duration) ([]entities.WebhookDelivery, error)
+		UpdateDelivery(context.Context, entities.WebhookDelivery) error
+	}
+
+	WebhooksEncryptionService interface {
+		Encrypt(plaintext string) (string, error)
+		Decrypt(ciphertext string) (string, error)
+	}
+
+	WebhooksSender interface {
+		Send(url, secret string, delivery entities.WebhookDelivery) (int, error)
+	}
+
+	WebhooksAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	WebhookEndpointsWebhookRepository interface {
+		InsertEndpoint(context.Context, entities.WebhookEndpoint) (entities.WebhookEndpoint, error)
+		SelectEndpoints(context.Context) ([]entities.WebhookEndpoint, error)
+		SelectEndpoint(context.Context, string) (entities.WebhookEndpoint, error)
+		UpdateEndpoint(context.Context, entities.WebhookEndpoint) error
+		DeleteEndpoint(context.Context, string) error
+		SelectDeliveries(context.Context, repositories.WebhookDeliveryFilter) ([]entities.WebhookDelivery, error)
+		ReplayDelivery(context.Context, string) (entities.WebhookDelivery, error)
+	}
+
+	WebhookEndpointsEncryptionService interface {
+		Encrypt(plaintext string) (string, error)
+	}
+
+	WebhookEndpointsRandomService interface {
+		GenerateToken() (string, error)
+	}
+
+	WebhookEndpointsAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	WebhookAlertsAlertRepository interface {
+		Insert(context.Context, entities.WebhookAlert) error
+		Select(context.Context, repositories.WebhookAlertFilter) ([]entities.WebhookAlert, error)
+		Count(context.Context, repositories.WebhookAlertFilter) (int, error)
+	}
+
+	WebhookAlertsNonceRepository interface {
+		Insert(context context.Context, nonce string, expiresAt time.Time) error
+	}
+
+	WebhookAlertsSignatureService interface {
+		Verify(secret, timestamp string, body []byte, signature string) bool
+	}
+
+	WebhookAlertsAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	DomainEventsEventRepository interface {
+		Relay(context context.Context, limit int, publish func(entities.DomainEvent) error) (int, error)
+		Purge(context context.Context, before time.Time) error
+	}
+
+	DomainEventsPublisher interface {
+		Publish(context context.Context, event entities.DomainEvent) error
+	}
+
+	DomainEventsAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
+)
+
+type (
+	RiskEngineLoginHistoryRepository interface {
+		History(context context.Context, login entities.Login, ipSince time.Time) (entities.LoginHistory, error)
+		Insert(context context.Context, login entities.Login, keepSince time.Time) error
+	}
+
+	RiskEngineGeoIPService interface {
+		Locate(ip string) entities.Location
+	}
+
+	RiskEngineMailService interface {
+		Send(to, subject, body string) error
+	}
+
+	RiskEngineAuditLogger interface {
+		Log(context.Context, entities.AuditEvent)
+	}
 )
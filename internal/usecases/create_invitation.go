@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"context"
+	"fmt"
+	"time"
+)
+
+type createInvitationUseCase struct {
+	organizationRepo CreateInvitationOrganizationRepository
+	invitationRepo   CreateInvitationInvitationRepository
+	hashService      CreateInvitationHashService
+	randomService    CreateInvitationRandomService
+	ttl              time.Duration
+	auditLogger      CreateInvitationAuditLogger
+}
+
+type CreateInvitationUseCase interface {
+	CreateInvitation(context context.Context, organizationId, actorId string, request requests.CreateInvitation) (responses.Invitation, error)
+}
+
+func NewCreateInvitationUseCase(
+	organizationRepo CreateInvitationOrganizationRepository,
+	invitationRepo CreateInvitationInvitationRepository,
+	hashService CreateInvitationHashService,
+	randomService CreateInvitationRandomService,
+	ttl time.Duration,
+	auditLogger CreateInvitationAuditLogger,
+) CreateInvitationUseCase {
+	return &createInvitationUseCase{
+		organizationRepo: organizationRepo,
+		invitationRepo:   invitationRepo,
+		hashService:      hashService,
+		randomService:    randomService,
+		ttl:              ttl,
+		auditLogger:      auditLogger,
+	}
+}
+
+func (u *createInvitationUseCase) CreateInvitation(context context.Context, organizationId, actorId string, request requests.CreateInvitation) (responses.Invitation, error) {
+	response, err := u.createInvitation(context, organizationId, actorId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditInvitationCreate, organizationId, err))
+	return response, err
+}
+
+func (u *createInvitationUseCase) createInvitation(context context.Context, organizationId, actorId string, request requests.CreateInvitation) (responses.Invitation, error) {
+	actor, err := selectMembership(context, u.organizationRepo, organizationId, actorId)
+	if err != nil {
+		return responses.Invitation{}, err
+	}
+	if !actor.CanManageMembers() {
+		return responses.Invitation{}, fmt.Errorf("%w: only owners and admins can invite members", ErrOrganizationAccessDenied)
+	}
+
+	invitation := entities.Invitation{
+		OrganizationId: organizationId,
+		Email:          entities.Email(request.Email),
+		Role:           request.Role,
+		InvitedBy:      actorId,
+		ExpiresAt:      time.Now().Add(u.ttl),
+	}
+	if invitation.Role == "" {
+		invitation.Role = entities.OrganizationRoleMember
+	}
+	return issueInvitation(context, u.invitationRepo, u.hashService, u.randomService, invitation)
+}
@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockCreateInvitationOrgRepo       *MockCreateInvitationOrganizationRepository
+	mockCreateInvitationRepo          *MockCreateInvitationInvitationRepository
+	mockCreateInvitationHashService   *MockCreateInvitationHashService
+	mockCreateInvitationRandomService *MockCreateInvitationRandomService
+	mockCreateInvitationAuditLogger   *MockCreateInvitationAuditLogger
+)
+
+func initCreateInvitationMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCreateInvitationOrgRepo = NewMockCreateInvitationOrganizationRepository(ctrl)
+	mockCreateInvitationRepo = NewMockCreateInvitationInvitationRepository(ctrl)
+	mockCreateInvitationHashService = NewMockCreateInvitationHashService(ctrl)
+	mockCreateInvitationRandomService = NewMockCreateInvitationRandomService(ctrl)
+	mockCreateInvitationAuditLogger = NewMockCreateInvitationAuditLogger(ctrl)
+	mockCreateInvitationAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func newTestCreateInvitationUseCase() CreateInvitationUseCase {
+	return NewCreateInvitationUseCase(
+		mockCreateInvitationOrgRepo,
+		mockCreateInvitationRepo,
+		mockCreateInvitationHashService,
+		mockCreateInvitationRandomService,
+		24*time.Hour,
+		mockCreateInvitationAuditLogger,
+	)
+}
+
+func TestCreateInvitationUseCase_CreateInvitation_Success(t *testing.T) {
+	ctx := context.Background()
+	initCreateInvitationMocks(t)
+
+	actor := entities.Membership{OrganizationId: "org-id", UserId: "owner-id", Role: entities.OrganizationRoleOwner}
+
+	mockCreateInvitationOrgRepo.EXPECT().SelectMember(ctx, "org-id", "owner-id").Return(actor, nil)
+	mockCreateInvitationRandomService.EXPECT().GenerateToken().Return("raw-token", nil)
+	mockCreateInvitationHashService.EXPECT().GenerateHash("raw-token").Return([]byte("hashed-token"), nil)
+	mockCreateInvitationRepo.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, invitation entities.Invitation) (string, error) {
+			assert.Equal(t, "org-id", invitation.OrganizationId)
+			assert.Equal(t, entities.Email("colleague@mail.ru"), invitation.Email)
+			assert.Equal(t, entities.OrganizationRoleMember, invitation.Role)
+			assert.Equal(t, "hashed-token", invitation.TokenHash)
+			assert.Equal(t, "owner-id", invitation.InvitedBy)
+			return "invitation-id", nil
+		})
+
+	result, err := newTestCreateInvitationUseCase().CreateInvitation(ctx, "org-id", "owner-id",
+		requests.CreateInvitation{Email: "colleague@mail.ru"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "invitation-id", result.Id)
+	assert.Equal(t, "raw-token", result.Token)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), result.ExpiresAt, time.Minute)
+}
+
+func TestCreateInvitationUseCase_CreateInvitation_ActorIsMember(t *testing.T) {
+	ctx := context.Background()
+	initCreateInvitationMocks(t)
+
+	actor := entities.Membership{OrganizationId: "org-id", UserId: "member-id", Role: entities.OrganizationRoleMember}
+
+	mockCreateInvitationOrgRepo.EXPECT().SelectMember(ctx, "org-id", "member-id").Return(actor, nil)
+
+	_, err := newTestCreateInvitationUseCase().CreateInvitation(ctx, "org-id", "member-id",
+		requests.CreateInvitation{Email: "colleague@mail.ru"})
+
+	assert.ErrorIs(t, err, ErrOrganizationAccessDenied)
+}
+
+func TestCreateInvitationUseCase_CreateInvitation_OwnerRole(t *testing.T) {
+	ctx := context.Background()
+	initCreateInvitationMocks(t)
+
+	actor := entities.Membership{OrganizationId: "org-id", UserId: "owner-id", Role: entities.OrganizationRoleOwner}
+
+	mockCreateInvitationOrgRepo.EXPECT().SelectMember(ctx, "org-id", "owner-id").Return(actor, nil)
+
+	_, err := newTestCreateInvitationUseCase().CreateInvitation(ctx, "org-id", "owner-id",
+		requests.CreateInvitation{Email: "colleague@mail.ru", Role: entities.OrganizationRoleOwner})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"context"
+	"fmt"
+)
+
+type createOrganizationUseCase struct {
+	organizationRepo CreateOrganizationOrganizationRepository
+	auditLogger      CreateOrganizationAuditLogger
+}
+
+type CreateOrganizationUseCase interface {
+	CreateOrganization(context context.Context, userId string, request requests.CreateOrganization) (responses.Organization, error)
+}
+
+func NewCreateOrganizationUseCase(
+	organizationRepo CreateOrganizationOrganizationRepository,
+	auditLogger CreateOrganizationAuditLogger,
+) CreateOrganizationUseCase {
+	return &createOrganizationUseCase{
+		organizationRepo: organizationRepo,
+		auditLogger:      auditLogger,
+	}
+}
+
+func (u *createOrganizationUseCase) CreateOrganization(context context.Context, userId string, request requests.CreateOrganization) (responses.Organization, error) {
+	response, err := u.createOrganization(context, userId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditOrganizationCreate, response.Id, err))
+	return response, err
+}
+
+func (u *createOrganizationUseCase) createOrganization(context context.Context, userId string, request requests.CreateOrganization) (responses.Organization, error) {
+	organization := entities.NewOrganization(request.Name)
+	err := organization.Validate()
+	if err != nil {
+		return responses.Organization{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	organization.Id, err = u.organizationRepo.Insert(context, organization, userId)
+	if err != nil {
+		return responses.Organization{}, fmt.Errorf("failed to insert organization: %w", err)
+	}
+
+	return responses.Organization{
+		Id:   organization.Id,
+		Name: organization.Name,
+		Role: entities.OrganizationRoleOwner,
+	}, nil
+}
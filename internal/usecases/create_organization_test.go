@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockCreateOrganizationRepo        *MockCreateOrganizationOrganizationRepository
+	mockCreateOrganizationAuditLogger *MockCreateOrganizationAuditLogger
+)
+
+func initCreateOrganizationMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCreateOrganizationRepo = NewMockCreateOrganizationOrganizationRepository(ctrl)
+	mockCreateOrganizationAuditLogger = NewMockCreateOrganizationAuditLogger(ctrl)
+	mockCreateOrganizationAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestCreateOrganizationUseCase_CreateOrganization_Success(t *testing.T) {
+	ctx := context.Background()
+	initCreateOrganizationMocks(t)
+
+	mockCreateOrganizationRepo.EXPECT().Insert(ctx, entities.Organization{Name: "Acme"}, "user-id").Return("org-id", nil)
+
+	useCase := NewCreateOrganizationUseCase(mockCreateOrganizationRepo, mockCreateOrganizationAuditLogger)
+
+	result, err := useCase.CreateOrganization(ctx, "user-id", requests.CreateOrganization{Name: "  Acme "})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "org-id", result.Id)
+	assert.Equal(t, "Acme", result.Name)
+	assert.Equal(t, entities.OrganizationRoleOwner, result.Role)
+}
+
+func TestCreateOrganizationUseCase_CreateOrganization_InvalidName(t *testing.T) {
+	ctx := context.Background()
+	initCreateOrganizationMocks(t)
+
+	useCase := NewCreateOrganizationUseCase(mockCreateOrganizationRepo, mockCreateOrganizationAuditLogger)
+
+	_, err := useCase.CreateOrganization(ctx, "user-id", requests.CreateOrganization{Name: " "})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"context"
+	"fmt"
+)
+
+type createPermissionUseCase struct {
+	permissionRepo CreatePermissionPermissionRepository
+	auditLogger    CreatePermissionAuditLogger
+}
+
+type CreatePermissionUseCase interface {
+	CreatePermission(context context.Context, request requests.CreatePermission) (responses.Permission, error)
+}
+
+func NewCreatePermissionUseCase(
+	permissionRepo CreatePermissionPermissionRepository,
+	auditLogger CreatePermissionAuditLogger,
+) CreatePermissionUseCase {
+	return &createPermissionUseCase{
+		permissionRepo: permissionRepo,
+		auditLogger:    auditLogger,
+	}
+}
+
+func (u *createPermissionUseCase) CreatePermission(context context.Context, request requests.CreatePermission) (responses.Permission, error) {
+	response, err := u.createPermission(context, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditPermissionCreate, request.Name, err))
+	return response, err
+}
+
+func (u *createPermissionUseCase) createPermission(context context.Context, request requests.CreatePermission) (responses.Permission, error) {
+	permission := entities.Permission{
+		Name:        request.Name,
+		Description: request.Description,
+	}
+	err := permission.Validate()
+	if err != nil {
+		return responses.Permission{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	existing, err := u.permissionRepo.SelectByNames(context, []string{permission.Name})
+	if err != nil {
+		return responses.Permission{}, fmt.Errorf("failed to check if the permission exists: %w", err)
+	}
+	if len(existing) > 0 {
+		return responses.Permission{}, fmt.Errorf("%w: permission %s already exists", ErrEntityAlreadyExists, permission.Name)
+	}
+
+	permission.Id, err = u.permissionRepo.Insert(context, permission)
+	if err != nil {
+		return responses.Permission{}, fmt.Errorf("failed to insert permission: %w", err)
+	}
+
+	return newPermissionResponse(permission), nil
+}
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockCreatePermissionRepo        *MockCreatePermissionPermissionRepository
+	mockCreatePermissionAuditLogger *MockCreatePermissionAuditLogger
+)
+
+func initCreatePermissionMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCreatePermissionRepo = NewMockCreatePermissionPermissionRepository(ctrl)
+	mockCreatePermissionAuditLogger = NewMockCreatePermissionAuditLogger(ctrl)
+	mockCreatePermissionAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestCreatePermissionUseCase_CreatePermission_Success(t *testing.T) {
+	ctx := context.Background()
+	initCreatePermissionMocks(t)
+
+	request := requests.CreatePermission{Name: "reports:read", Description: "read reports"}
+	permission := entities.Permission{Name: "reports:read", Description: "read reports"}
+
+	mockCreatePermissionRepo.EXPECT().SelectByNames(ctx, []string{"reports:read"}).Return(nil, nil)
+	mockCreatePermissionRepo.EXPECT().Insert(ctx, permission).Return(6, nil)
+
+	useCase := NewCreatePermissionUseCase(mockCreatePermissionRepo, mockCreatePermissionAuditLogger)
+
+	result, err := useCase.CreatePermission(ctx, request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 6, result.Id)
+	assert.Equal(t, "reports:read", result.Name)
+}
+
+func TestCreatePermissionUseCase_CreatePermission_AlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	initCreatePermissionMocks(t)
+
+	mockCreatePermissionRepo.EXPECT().SelectByNames(ctx, []string{entities.PermissionUsersRead}).
+		Return([]entities.Permission{{Id: 1, Name: entities.PermissionUsersRead}}, nil)
+
+	useCase := NewCreatePermissionUseCase(mockCreatePermissionRepo, mockCreatePermissionAuditLogger)
+
+	_, err := useCase.CreatePermission(ctx, requests.CreatePermission{Name: entities.PermissionUsersRead})
+
+	assert.ErrorIs(t, err, ErrEntityAlreadyExists)
+}
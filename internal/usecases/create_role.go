@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"context"
+	"fmt"
+	"strings"
+)
+
+type createRoleUseCase struct {
+	roleRepo       CreateRoleRoleRepository
+	permissionRepo CreateRolePermissionRepository
+	auditLogger    CreateRoleAuditLogger
+}
+
+type CreateRoleUseCase interface {
+	CreateRole(context context.Context, request requests.CreateRole) (responses.Role, error)
+}
+
+func NewCreateRoleUseCase(
+	roleRepo CreateRoleRoleRepository,
+	permissionRepo CreateRolePermissionRepository,
+	auditLogger CreateRoleAuditLogger,
+) CreateRoleUseCase {
+	return &createRoleUseCase{
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+		auditLogger:    auditLogger,
+	}
+}
+
+func (u *createRoleUseCase) CreateRole(context context.Context, request requests.CreateRole) (responses.Role, error) {
+	response, err := u.createRole(context, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditRoleCreate, request.Name, err))
+	return response, err
+}
+
+func (u *createRoleUseCase) createRole(context context.Context, request requests.CreateRole) (responses.Role, error) {
+	role := entities.Role{
+		Name:        request.Name,
+		Description: request.Description,
+		Permissions: request.Permissions,
+	}
+	err := role.Validate()
+	if err != nil {
+		return responses.Role{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	existing, err := u.roleRepo.SelectByNames(context, []string{role.Name})
+	if err != nil {
+		return responses.Role{}, fmt.Errorf("failed to check if the role exists: %w", err)
+	}
+	if len(existing) > 0 {
+		return responses.Role{}, fmt.Errorf("%w: role %s already exists", ErrEntityAlreadyExists, role.Name)
+	}
+
+	err = checkPermissionsExist(context, u.permissionRepo, role.Permissions)
+	if err != nil {
+		return responses.Role{}, err
+	}
+
+	role.Id, err = u.roleRepo.Insert(context, role)
+	if err != nil {
+		return responses.Role{}, fmt.Errorf("failed to insert role: %w", err)
+	}
+
+	return newRoleResponse(role), nil
+}
+
+func checkPermissionsExist(context context.Context, permissionRepo CreateRolePermissionRepository, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	permissions, err := permissionRepo.SelectByNames(context, names)
+	if err != nil {
+		return fmt.Errorf("failed to select permissions: %w", err)
+	}
+
+	found := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		found = append(found, permission.Name)
+	}
+	if missing := missingNames(names, found); len(missing) > 0 {
+		return fmt.Errorf("%w: unknown permissions %s", ErrInvalidEntity, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockCreateRoleRoleRepo       *MockCreateRoleRoleRepository
+	mockCreateRolePermissionRepo *MockCreateRolePermissionRepository
+	mockCreateRoleAuditLogger    *MockCreateRoleAuditLogger
+)
+
+func initCreateRoleMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCreateRoleRoleRepo = NewMockCreateRoleRoleRepository(ctrl)
+	mockCreateRolePermissionRepo = NewMockCreateRolePermissionRepository(ctrl)
+	mockCreateRoleAuditLogger = NewMockCreateRoleAuditLogger(ctrl)
+	mockCreateRoleAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestCreateRoleUseCase_CreateRole_Success(t *testing.T) {
+	ctx := context.Background()
+	initCreateRoleMocks(t)
+
+	request := requests.CreateRole{
+		Name:        "support",
+		Description: "support team",
+		Permissions: []string{entities.PermissionUsersRead},
+	}
+	role := entities.Role{
+		Name:        "support",
+		Description: "support team",
+		Permissions: []string{entities.PermissionUsersRead},
+	}
+
+	mockCreateRoleRoleRepo.EXPECT().SelectByNames(ctx, []string{"support"}).Return(nil, nil)
+	mockCreateRolePermissionRepo.EXPECT().SelectByNames(ctx, []string{entities.PermissionUsersRead}).
+		Return([]entities.Permission{{Id: 1, Name: entities.PermissionUsersRead}}, nil)
+	mockCreateRoleRoleRepo.EXPECT().Insert(ctx, role).Return(3, nil)
+
+	useCase := NewCreateRoleUseCase(mockCreateRoleRoleRepo, mockCreateRolePermissionRepo, mockCreateRoleAuditLogger)
+
+	result, err := useCase.CreateRole(ctx, request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result.Id)
+	assert.Equal(t, "support", result.Name)
+}
+
+func TestCreateRoleUseCase_CreateRole_InvalidName(t *testing.T) {
+	ctx := context.Background()
+	initCreateRoleMocks(t)
+
+	useCase := NewCreateRoleUseCase(mockCreateRoleRoleRepo, mockCreateRolePermissionRepo, mockCreateRoleAuditLogger)
+
+	_, err := useCase.CreateRole(ctx, requests.CreateRole{Name: "Support Team"})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestCreateRoleUseCase_CreateRole_AlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	initCreateRoleMocks(t)
+
+	mockCreateRoleRoleRepo.EXPECT().SelectByNames(ctx, []string{"support"}).
+		Return([]entities.Role{{Id: 3, Name: "support"}}, nil)
+
+	useCase := NewCreateRoleUseCase(mockCreateRoleRoleRepo, mockCreateRolePermissionRepo, mockCreateRoleAuditLogger)
+
+	_, err := useCase.CreateRole(ctx, requests.CreateRole{Name: "support"})
+
+	assert.ErrorIs(t, err, ErrEntityAlreadyExists)
+}
+
+func TestCreateRoleUseCase_CreateRole_UnknownPermission(t *testing.T) {
+	ctx := context.Background()
+	initCreateRoleMocks(t)
+
+	request := requests.CreateRole{
+		Name:        "support",
+		Permissions: []string{entities.PermissionUsersRead, "reports:read"},
+	}
+
+	mockCreateRoleRoleRepo.EXPECT().SelectByNames(ctx, []string{"support"}).Return(nil, nil)
+	mockCreateRolePermissionRepo.EXPECT().SelectByNames(ctx, request.Permissions).
+		Return([]entities.Permission{{Id: 1, Name: entities.PermissionUsersRead}}, nil)
+
+	useCase := NewCreateRoleUseCase(mockCreateRoleRoleRepo, mockCreateRolePermissionRepo, mockCreateRoleAuditLogger)
+
+	_, err := useCase.CreateRole(ctx, request)
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+	assert.ErrorContains(t, err, "reports:read")
+}
@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"context"
+	"fmt"
+	"time"
+)
+
+type createUserInvitationUseCase struct {
+	userRepo       CreateUserInvitationUserRepository
+	roleRepo       CreateUserInvitationRoleRepository
+	invitationRepo CreateUserInvitationInvitationRepository
+	hashService    CreateUserInvitationHashService
+	randomService  CreateUserInvitationRandomService
+	ttl            time.Duration
+	auditLogger    CreateUserInvitationAuditLogger
+}
+
+type CreateUserInvitationUseCase interface {
+	CreateUserInvitation(context context.Context, actorId string, request requests.CreateUserInvitation) (responses.Invitation, error)
+}
+
+func NewCreateUserInvitationUseCase(
+	userRepo CreateUserInvitationUserRepository,
+	roleRepo CreateUserInvitationRoleRepository,
+	invitationRepo CreateUserInvitationInvitationRepository,
+	hashService CreateUserInvitationHashService,
+	randomService CreateUserInvitationRandomService,
+	ttl time.Duration,
+	auditLogger CreateUserInvitationAuditLogger,
+) CreateUserInvitationUseCase {
+	return &createUserInvitationUseCase{
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		invitationRepo: invitationRepo,
+		hashService:    hashService,
+		randomService:  randomService,
+		ttl:            ttl,
+		auditLogger:    auditLogger,
+	}
+}
+
+// CreateUserInvitation issues a sign up invitation that is not bound to an
+// organization, optionally granting an RBAC role to the invited user.
+func (u *createUserInvitationUseCase) CreateUserInvitation(context context.Context, actorId string, request requests.CreateUserInvitation) (responses.Invitation, error) {
+	response, err := u.createUserInvitation(context, actorId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditUserInvite, request.Email, err))
+	return response, err
+}
+
+func (u *createUserInvitationUseCase) createUserInvitation(context context.Context, actorId string, request requests.CreateUserInvitation) (responses.Invitation, error) {
+	email := entities.Email(request.Email)
+	exists, err := u.userRepo.CheckEmailExists(context, email)
+	if err != nil {
+		return responses.Invitation{}, fmt.Errorf("failed to check if the email is already taken: %w", err)
+	}
+	if exists {
+		return responses.Invitation{}, fmt.Errorf("%w: email has already been taken", ErrEntityAlreadyExists)
+	}
+
+	if request.Role != "" {
+		roles, err := u.roleRepo.SelectByNames(context, []string{request.Role})
+		if err != nil {
+			return responses.Invitation{}, fmt.Errorf("failed to select roles: %w", err)
+		}
+		if len(roles) == 0 {
+			return responses.Invitation{}, fmt.Errorf("%w: unknown role %s", ErrInvalidEntity, request.Role)
+		}
+	}
+
+	invitation := entities.Invitation{
+		Email:     email,
+		UserRole:  request.Role,
+		InvitedBy: actorId,
+		ExpiresAt: time.Now().Add(u.ttl),
+	}
+	return issueInvitation(context, u.invitationRepo, u.hashService, u.randomService, invitation)
+}
@@ -0,0 +1,109 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockCreateUserInvitationUserRepo      *MockCreateUserInvitationUserRepository
+	mockCreateUserInvitationRoleRepo      *MockCreateUserInvitationRoleRepository
+	mockCreateUserInvitationRepo          *MockCreateUserInvitationInvitationRepository
+	mockCreateUserInvitationHashService   *MockCreateUserInvitationHashService
+	mockCreateUserInvitationRandomService *MockCreateUserInvitationRandomService
+	mockCreateUserInvitationAuditLogger   *MockCreateUserInvitationAuditLogger
+)
+
+func initCreateUserInvitationMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCreateUserInvitationUserRepo = NewMockCreateUserInvitationUserRepository(ctrl)
+	mockCreateUserInvitationRoleRepo = NewMockCreateUserInvitationRoleRepository(ctrl)
+	mockCreateUserInvitationRepo = NewMockCreateUserInvitationInvitationRepository(ctrl)
+	mockCreateUserInvitationHashService = NewMockCreateUserInvitationHashService(ctrl)
+	mockCreateUserInvitationRandomService = NewMockCreateUserInvitationRandomService(ctrl)
+	mockCreateUserInvitationAuditLogger = NewMockCreateUserInvitationAuditLogger(ctrl)
+	mockCreateUserInvitationAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func newTestCreateUserInvitationUseCase() CreateUserInvitationUseCase {
+	return NewCreateUserInvitationUseCase(
+		mockCreateUserInvitationUserRepo,
+		mockCreateUserInvitationRoleRepo,
+		mockCreateUserInvitationRepo,
+		mockCreateUserInvitationHashService,
+		mockCreateUserInvitationRandomService,
+		24*time.Hour,
+		mockCreateUserInvitationAuditLogger,
+	)
+}
+
+func TestCreateUserInvitationUseCase_CreateUserInvitation_Success(t *testing.T) {
+	ctx := context.Background()
+	initCreateUserInvitationMocks(t)
+
+	mockCreateUserInvitationUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("new@mail.ru")).Return(false, nil)
+	mockCreateUserInvitationRoleRepo.EXPECT().SelectByNames(ctx, []string{"support"}).Return([]entities.Role{{Name: "support"}}, nil)
+	mockCreateUserInvitationRandomService.EXPECT().GenerateToken().Return("raw-token", nil)
+	mockCreateUserInvitationHashService.EXPECT().GenerateHash("raw-token").Return([]byte("hashed-token"), nil)
+	mockCreateUserInvitationRepo.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, invitation entities.Invitation) (string, error) {
+			assert.Empty(t, invitation.OrganizationId)
+			assert.Empty(t, invitation.Role)
+			assert.Equal(t, "support", invitation.UserRole)
+			assert.Equal(t, "hashed-token", invitation.TokenHash)
+			assert.Equal(t, "admin-id", invitation.InvitedBy)
+			return "invitation-id", nil
+		})
+
+	result, err := newTestCreateUserInvitationUseCase().CreateUserInvitation(ctx, "admin-id",
+		requests.CreateUserInvitation{Email: "new@mail.ru", Role: "support"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "invitation-id", result.Id)
+	assert.Equal(t, "support", result.UserRole)
+	assert.Equal(t, "raw-token", result.Token)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), result.ExpiresAt, time.Minute)
+}
+
+func TestCreateUserInvitationUseCase_CreateUserInvitation_EmailTaken(t *testing.T) {
+	ctx := context.Background()
+	initCreateUserInvitationMocks(t)
+
+	mockCreateUserInvitationUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("taken@mail.ru")).Return(true, nil)
+
+	_, err := newTestCreateUserInvitationUseCase().CreateUserInvitation(ctx, "admin-id",
+		requests.CreateUserInvitation{Email: "taken@mail.ru"})
+
+	assert.ErrorIs(t, err, ErrEntityAlreadyExists)
+}
+
+func TestCreateUserInvitationUseCase_CreateUserInvitation_UnknownRole(t *testing.T) {
+	ctx := context.Background()
+	initCreateUserInvitationMocks(t)
+
+	mockCreateUserInvitationUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("new@mail.ru")).Return(false, nil)
+	mockCreateUserInvitationRoleRepo.EXPECT().SelectByNames(ctx, []string{"ghost"}).Return(nil, nil)
+
+	_, err := newTestCreateUserInvitationUseCase().CreateUserInvitation(ctx, "admin-id",
+		requests.CreateUserInvitation{Email: "new@mail.ru", Role: "ghost"})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestCreateUserInvitationUseCase_CreateUserInvitation_InvalidEmail(t *testing.T) {
+	ctx := context.Background()
+	initCreateUserInvitationMocks(t)
+
+	mockCreateUserInvitationUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("invalid")).Return(false, nil)
+
+	_, err := newTestCreateUserInvitationUseCase().CreateUserInvitation(ctx, "admin-id",
+		requests.CreateUserInvitation{Email: "invalid"})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+type deletePermissionUseCase struct {
+	permissionRepo DeletePermissionPermissionRepository
+	auditLogger    DeletePermissionAuditLogger
+}
+
+type DeletePermissionUseCase interface {
+	DeletePermission(context context.Context, permissionId int) error
+}
+
+func NewDeletePermissionUseCase(
+	permissionRepo DeletePermissionPermissionRepository,
+	auditLogger DeletePermissionAuditLogger,
+) DeletePermissionUseCase {
+	return &deletePermissionUseCase{
+		permissionRepo: permissionRepo,
+		auditLogger:    auditLogger,
+	}
+}
+
+func (u *deletePermissionUseCase) DeletePermission(context context.Context, permissionId int) error {
+	err := u.deletePermission(context, permissionId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditPermissionDelete, strconv.Itoa(permissionId), err))
+	return err
+}
+
+func (u *deletePermissionUseCase) deletePermission(context context.Context, permissionId int) error {
+	err := u.permissionRepo.Delete(context, permissionId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to delete permission: %w", ErrEntityNotFound)
+		}
+		return fmt.Errorf("failed to delete permission: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockDeletePermissionRepo        *MockDeletePermissionPermissionRepository
+	mockDeletePermissionAuditLogger *MockDeletePermissionAuditLogger
+)
+
+func initDeletePermissionMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDeletePermissionRepo = NewMockDeletePermissionPermissionRepository(ctrl)
+	mockDeletePermissionAuditLogger = NewMockDeletePermissionAuditLogger(ctrl)
+	mockDeletePermissionAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestDeletePermissionUseCase_DeletePermission_Success(t *testing.T) {
+	ctx := context.Background()
+	initDeletePermissionMocks(t)
+
+	mockDeletePermissionRepo.EXPECT().Delete(ctx, 6).Return(nil)
+
+	useCase := NewDeletePermissionUseCase(mockDeletePermissionRepo, mockDeletePermissionAuditLogger)
+
+	err := useCase.DeletePermission(ctx, 6)
+
+	assert.NoError(t, err)
+}
+
+func TestDeletePermissionUseCase_DeletePermission_NotFound(t *testing.T) {
+	ctx := context.Background()
+	initDeletePermissionMocks(t)
+
+	mockDeletePermissionRepo.EXPECT().Delete(ctx, 6).Return(repositories.ErrEntityNotFound)
+
+	useCase := NewDeletePermissionUseCase(mockDeletePermissionRepo, mockDeletePermissionAuditLogger)
+
+	err := useCase.DeletePermission(ctx, 6)
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
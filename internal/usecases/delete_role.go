@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+type deleteRoleUseCase struct {
+	roleRepo    DeleteRoleRoleRepository
+	sessionRepo DeleteRoleSessionRepository
+	auditLogger DeleteRoleAuditLogger
+}
+
+type DeleteRoleUseCase interface {
+	DeleteRole(context context.Context, roleId int) error
+}
+
+func NewDeleteRoleUseCase(
+	roleRepo DeleteRoleRoleRepository,
+	sessionRepo DeleteRoleSessionRepository,
+	auditLogger DeleteRoleAuditLogger,
+) DeleteRoleUseCase {
+	return &deleteRoleUseCase{
+		roleRepo:    roleRepo,
+		sessionRepo: sessionRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (u *deleteRoleUseCase) DeleteRole(context context.Context, roleId int) error {
+	err := u.deleteRole(context, roleId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditRoleDelete, strconv.Itoa(roleId), err))
+	return err
+}
+
+func (u *deleteRoleUseCase) deleteRole(context context.Context, roleId int) error {
+	role, err := u.roleRepo.SelectById(context, roleId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to find role: %w", ErrEntityNotFound)
+		}
+		return fmt.Errorf("failed to find role: %w", err)
+	}
+
+	if role.IsBuiltIn() {
+		return fmt.Errorf("%w: built-in role %s can't be deleted", ErrInvalidEntity, role.Name)
+	}
+
+	// The holders lose the permissions of the role with it, their sessions
+	// end before the role is gone and they can't be found.
+	err = u.sessionRepo.DeleteByRoleId(context, roleId)
+	if err != nil {
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	err = u.roleRepo.Delete(context, roleId)
+	if err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockDeleteRoleRepo        *MockDeleteRoleRoleRepository
+	mockDeleteRoleSessionRepo *MockDeleteRoleSessionRepository
+	mockDeleteRoleAuditLogger *MockDeleteRoleAuditLogger
+)
+
+func initDeleteRoleMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDeleteRoleRepo = NewMockDeleteRoleRoleRepository(ctrl)
+	mockDeleteRoleSessionRepo = NewMockDeleteRoleSessionRepository(ctrl)
+	mockDeleteRoleAuditLogger = NewMockDeleteRoleAuditLogger(ctrl)
+	mockDeleteRoleAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestDeleteRoleUseCase_DeleteRole_Success(t *testing.T) {
+	ctx := context.Background()
+	initDeleteRoleMocks(t)
+
+	mockDeleteRoleRepo.EXPECT().SelectById(ctx, 3).Return(entities.Role{Id: 3, Name: "support"}, nil)
+	gomock.InOrder(
+		mockDeleteRoleSessionRepo.EXPECT().DeleteByRoleId(ctx, 3).Return(nil),
+		mockDeleteRoleRepo.EXPECT().Delete(ctx, 3).Return(nil),
+	)
+
+	useCase := NewDeleteRoleUseCase(mockDeleteRoleRepo, mockDeleteRoleSessionRepo, mockDeleteRoleAuditLogger)
+
+	err := useCase.DeleteRole(ctx, 3)
+
+	assert.NoError(t, err)
+}
+
+func TestDeleteRoleUseCase_DeleteRole_BuiltIn(t *testing.T) {
+	ctx := context.Background()
+	initDeleteRoleMocks(t)
+
+	mockDeleteRoleRepo.EXPECT().SelectById(ctx, 2).Return(entities.Role{Id: 2, Name: entities.RoleAdmin}, nil)
+
+	useCase := NewDeleteRoleUseCase(mockDeleteRoleRepo, mockDeleteRoleSessionRepo, mockDeleteRoleAuditLogger)
+
+	err := useCase.DeleteRole(ctx, 2)
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestDeleteRoleUseCase_DeleteRole_NotFound(t *testing.T) {
+	ctx := context.Background()
+	initDeleteRoleMocks(t)
+
+	mockDeleteRoleRepo.EXPECT().SelectById(ctx, 3).Return(entities.Role{}, repositories.ErrEntityNotFound)
+
+	useCase := NewDeleteRoleUseCase(mockDeleteRoleRepo, mockDeleteRoleSessionRepo, mockDeleteRoleAuditLogger)
+
+	err := useCase.DeleteRole(ctx, 3)
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
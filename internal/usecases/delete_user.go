@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type deleteUserUseCase struct {
+	userRepo    DeleteUserUserRepository
+	auditLogger DeleteUserAuditLogger
+}
+
+type DeleteUserUseCase interface {
+	DeleteUser(context context.Context, userId string) error
+}
+
+func NewDeleteUserUseCase(
+	userRepo DeleteUserUserRepository,
+	auditLogger DeleteUserAuditLogger,
+) DeleteUserUseCase {
+	return &deleteUserUseCase{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (u *deleteUserUseCase) DeleteUser(context context.Context, userId string) error {
+	err := u.deleteUser(context, userId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditUserDelete, userId, err))
+	return err
+}
+
+func (u *deleteUserUseCase) deleteUser(context context.Context, userId string) error {
+	event := entities.NewWebhookEvent(entities.WebhookUserDeleted, map[string]string{"userId": userId})
+	err := u.userRepo.Delete(context, userId, event)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to delete user: %w", ErrEntityNotFound)
+		}
+		if errors.Is(err, repositories.ErrLastAdmin) {
+			return fmt.Errorf("failed to delete user: %w", ErrLastAdmin)
+		}
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockDeleteUserRepo        *MockDeleteUserUserRepository
+	mockDeleteUserAuditLogger *MockDeleteUserAuditLogger
+)
+
+func initDeleteUserMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDeleteUserRepo = NewMockDeleteUserUserRepository(ctrl)
+	mockDeleteUserAuditLogger = NewMockDeleteUserAuditLogger(ctrl)
+	mockDeleteUserAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestDeleteUserUseCase_DeleteUser_Success(t *testing.T) {
+	ctx := context.Background()
+	initDeleteUserMocks(t)
+
+	mockDeleteUserRepo.EXPECT().Delete(ctx, "user-id", webhookEventOf(entities.WebhookUserDeleted, map[string]string{"userId": "user-id"})).Return(nil)
+
+	useCase := NewDeleteUserUseCase(mockDeleteUserRepo, mockDeleteUserAuditLogger)
+
+	err := useCase.DeleteUser(ctx, "user-id")
+
+	assert.NoError(t, err)
+}
+
+func TestDeleteUserUseCase_DeleteUser_NotFound(t *testing.T) {
+	ctx := context.Background()
+	initDeleteUserMocks(t)
+
+	mockDeleteUserRepo.EXPECT().Delete(ctx, "user-id", webhookEventOf(entities.WebhookUserDeleted, map[string]string{"userId": "user-id"})).Return(repositories.ErrEntityNotFound)
+
+	useCase := NewDeleteUserUseCase(mockDeleteUserRepo, mockDeleteUserAuditLogger)
+
+	err := useCase.DeleteUser(ctx, "user-id")
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestDeleteUserUseCase_DeleteUser_RecordsAuditEvent(t *testing.T) {
+	ctx := context.Background()
+	initDeleteUserMocks(t)
+
+	mockDeleteUserRepo.EXPECT().Delete(ctx, "user-id", webhookEventOf(entities.WebhookUserDeleted, map[string]string{"userId": "user-id"})).Return(nil)
+	auditLogger := NewMockDeleteUserAuditLogger(gomock.NewController(t))
+	auditLogger.EXPECT().Log(ctx, entities.AuditEvent{
+		Type:      entities.AuditUserDelete,
+		SubjectId: "user-id",
+		Outcome:   entities.AuditOutcomeSuccess,
+	})
+
+	err := NewDeleteUserUseCase(mockDeleteUserRepo, auditLogger).DeleteUser(ctx, "user-id")
+
+	assert.NoError(t, err)
+}
+
+func TestDeleteUserUseCase_DeleteUser_HidesInternalErrorInAuditEvent(t *testing.T) {
+	ctx := context.Background()
+	initDeleteUserMocks(t)
+
+	mockDeleteUserRepo.EXPECT().Delete(ctx, "user-id", webhookEventOf(entities.WebhookUserDeleted, map[string]string{"userId": "user-id"})).Return(fmt.Errorf("connection refused"))
+	auditLogger := NewMockDeleteUserAuditLogger(gomock.NewController(t))
+	auditLogger.EXPECT().Log(ctx, entities.AuditEvent{
+		Type:      entities.AuditUserDelete,
+		SubjectId: "user-id",
+		Outcome:   entities.AuditOutcomeFailure,
+		Reason:    "internal error",
+	})
+
+	err := NewDeleteUserUseCase(mockDeleteUserRepo, auditLogger).DeleteUser(ctx, "user-id")
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// errEventBlocked skips the events following a failed one of the same user,
+// they stay in the outbox and are published after it.
+var errEventBlocked = errors.New("an earlier event of the user is not published")
+
+type domainEventsUseCase struct {
+	eventRepo   DomainEventsEventRepository
+	publisher   DomainEventsPublisher
+	policy      entities.DomainEventPolicy
+	auditLogger DomainEventsAuditLogger
+}
+
+// DomainEventsUseCase relays the domain events from the outbox to the message
+// broker. An event is marked published only once the broker has accepted it,
+// so it is published at least once.
+type DomainEventsUseCase interface {
+	PublishDue(context context.Context) (int, error)
+}
+
+func NewDomainEventsUseCase(
+	eventRepo DomainEventsEventRepository,
+	publisher DomainEventsPublisher,
+	policy entities.DomainEventPolicy,
+	auditLogger DomainEventsAuditLogger,
+) DomainEventsUseCase {
+	return &domainEventsUseCase{
+		eventRepo:   eventRepo,
+		publisher:   publisher,
+		policy:      policy,
+		auditLogger: auditLogger,
+	}
+}
+
+// PublishDue publishes a batch of the pending events in order and returns
+// the number of the events in the batch. After a failure the rest of the
+// events of that user wait for the next batch, the other users go on. A
+// batch with failures is recorded in the audit log once.
+func (u *domainEventsUseCase) PublishDue(context context.Context) (int, error) {
+	failures := make(map[string]error)
+	count, err := u.eventRepo.Relay(context, u.policy.BatchSize, func(event entities.DomainEvent) error {
+		if _, failed := failures[event.UserId]; failed {
+			return errEventBlocked
+		}
+		err := u.publisher.Publish(context, event)
+		if err != nil {
+			failures[event.UserId] = fmt.Errorf("event %s: %w", event.Id, err)
+		}
+		return err
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to relay domain events: %w", err)
+		u.auditLogger.Log(context, newAuditEvent(entities.AuditDomainEventPublish, "", err))
+		return 0, err
+	}
+
+	if u.policy.Retention > 0 {
+		err = u.eventRepo.Purge(context, time.Now().Add(-u.policy.Retention))
+		if err != nil {
+			return count, fmt.Errorf("failed to purge domain events: %w", err)
+		}
+	}
+
+	if len(failures) > 0 {
+		errs := make([]error, 0, len(failures))
+		for _, err := range failures {
+			errs = append(errs, err)
+		}
+		err = fmt.Errorf("failed to publish domain events: %w", errors.Join(errs...))
+		u.auditLogger.Log(context, newAuditEvent(entities.AuditDomainEventPublish, "", err))
+		return count, err
+	}
+	return count, nil
+}
@@ -0,0 +1,134 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockDomainEventsRepo        *MockDomainEventsEventRepository
+	mockDomainEventsPublisher   *MockDomainEventsPublisher
+	mockDomainEventsAuditLogger *MockDomainEventsAuditLogger
+)
+
+var testDomainEventPolicy = entities.DomainEventPolicy{
+	BatchSize: 10,
+	Retention: 24 * time.Hour,
+}
+
+func initDomainEventsMocks(t *testing.T, policy entities.DomainEventPolicy) DomainEventsUseCase {
+	ctrl := gomock.NewController(t)
+	mockDomainEventsRepo = NewMockDomainEventsEventRepository(ctrl)
+	mockDomainEventsPublisher = NewMockDomainEventsPublisher(ctrl)
+	mockDomainEventsAuditLogger = NewMockDomainEventsAuditLogger(ctrl)
+	mockDomainEventsAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+	return NewDomainEventsUseCase(mockDomainEventsRepo, mockDomainEventsPublisher, policy, mockDomainEventsAuditLogger)
+}
+
+// relayEvents makes the repository pass the events to the publish function
+// and collects the ids of the ones it accepted.
+func relayEvents(events []entities.DomainEvent, published *[]string) func(context.Context, int, func(entities.DomainEvent) error) (int, error) {
+	return func(_ context.Context, _ int, publish func(entities.DomainEvent) error) (int, error) {
+		for _, event := range events {
+			if publish(event) == nil {
+				*published = append(*published, event.Id)
+			}
+		}
+		return len(events), nil
+	}
+}
+
+func TestDomainEventsUseCase_PublishDue_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := initDomainEventsMocks(t, testDomainEventPolicy)
+
+	events := []entities.DomainEvent{
+		{Id: "event-1", Sequence: 1, Type: entities.DomainUserCreated, UserId: "user-1"},
+		{Id: "event-2", Sequence: 2, Type: entities.DomainSessionCreated, UserId: "user-1"},
+	}
+	var published []string
+
+	mockDomainEventsRepo.EXPECT().Relay(ctx, 10, gomock.Any()).DoAndReturn(relayEvents(events, &published))
+	gomock.InOrder(
+		mockDomainEventsPublisher.EXPECT().Publish(ctx, events[0]).Return(nil),
+		mockDomainEventsPublisher.EXPECT().Publish(ctx, events[1]).Return(nil),
+	)
+	mockDomainEventsRepo.EXPECT().Purge(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, before time.Time) error {
+		assert.WithinDuration(t, time.Now().Add(-24*time.Hour), before, time.Minute)
+		return nil
+	})
+
+	count, err := useCase.PublishDue(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{"event-1", "event-2"}, published)
+}
+
+func TestDomainEventsUseCase_PublishDue_KeepsUserOrderAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	useCase := initDomainEventsMocks(t, entities.DomainEventPolicy{BatchSize: 10})
+
+	events := []entities.DomainEvent{
+		{Id: "event-1", Sequence: 1, Type: entities.DomainSessionCreated, UserId: "user-1"},
+		{Id: "event-2", Sequence: 2, Type: entities.DomainUserCreated, UserId: "user-2"},
+		{Id: "event-3", Sequence: 3, Type: entities.DomainSessionRevoked, UserId: "user-1"},
+	}
+	var published []string
+
+	mockDomainEventsRepo.EXPECT().Relay(ctx, 10, gomock.Any()).DoAndReturn(relayEvents(events, &published))
+	mockDomainEventsPublisher.EXPECT().Publish(ctx, events[0]).Return(errors.New("broker is down"))
+	mockDomainEventsPublisher.EXPECT().Publish(ctx, events[1]).Return(nil)
+
+	count, err := useCase.PublishDue(ctx)
+
+	assert.ErrorContains(t, err, "broker is down")
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []string{"event-2"}, published)
+}
+
+func TestDomainEventsUseCase_PublishDue_RelayError(t *testing.T) {
+	ctx := context.Background()
+	useCase := initDomainEventsMocks(t, testDomainEventPolicy)
+
+	mockDomainEventsRepo.EXPECT().Relay(ctx, 10, gomock.Any()).Return(0, errors.New("db down"))
+
+	count, err := useCase.PublishDue(ctx)
+
+	assert.Error(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestDomainEventsUseCase_PublishDue_RecordsFailedBatch(t *testing.T) {
+	ctx := context.Background()
+	initDomainEventsMocks(t, entities.DomainEventPolicy{BatchSize: 10})
+	auditLogger := NewMockDomainEventsAuditLogger(gomock.NewController(t))
+
+	events := []entities.DomainEvent{
+		{Id: "event-1", Sequence: 1, Type: entities.DomainUserCreated, UserId: "user-1"},
+		{Id: "event-2", Sequence: 2, Type: entities.DomainSessionCreated, UserId: "user-1"},
+	}
+	var published []string
+
+	mockDomainEventsRepo.EXPECT().Relay(ctx, 10, gomock.Any()).DoAndReturn(relayEvents(events, &published))
+	mockDomainEventsPublisher.EXPECT().Publish(ctx, events[0]).Return(errors.New("broker down"))
+	// The second event of the user waits for the first one and isn't sent,
+	// the batch is recorded once.
+	auditLogger.EXPECT().Log(ctx, entities.AuditEvent{
+		Type:    entities.AuditDomainEventPublish,
+		Outcome: entities.AuditOutcomeFailure,
+		Reason:  auditInternalError,
+	})
+
+	useCase := NewDomainEventsUseCase(mockDomainEventsRepo, mockDomainEventsPublisher, entities.DomainEventPolicy{BatchSize: 10}, auditLogger)
+	_, err := useCase.PublishDue(ctx)
+
+	assert.Error(t, err)
+	assert.Empty(t, published)
+}
@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type enrollTOTPUseCase struct {
+	userRepo          EnrollTOTPUserRepository
+	mfaRepo           EnrollTOTPMFARepository
+	mfaService        EnrollTOTPMFAService
+	encryptionService EnrollTOTPEncryptionService
+	auditLogger       EnrollTOTPAuditLogger
+}
+
+type EnrollTOTPUseCase interface {
+	Enroll(context context.Context, userId string) (responses.TOTPEnrollment, error)
+	Confirm(context context.Context, userId string, request requests.ConfirmTOTP) (responses.RecoveryCodes, error)
+}
+
+func NewEnrollTOTPUseCase(
+	userRepo EnrollTOTPUserRepository,
+	mfaRepo EnrollTOTPMFARepository,
+	mfaService EnrollTOTPMFAService,
+	encryptionService EnrollTOTPEncryptionService,
+	auditLogger EnrollTOTPAuditLogger,
+) EnrollTOTPUseCase {
+	return &enrollTOTPUseCase{
+		userRepo:          userRepo,
+		mfaRepo:           mfaRepo,
+		mfaService:        mfaService,
+		encryptionService: encryptionService,
+		auditLogger:       auditLogger,
+	}
+}
+
+// Enroll generates a new secret, it is not required at sign in until the
+// user confirms it with a code. Repeating the enrolment replaces the
+// unconfirmed secret.
+func (u *enrollTOTPUseCase) Enroll(context context.Context, userId string) (responses.TOTPEnrollment, error) {
+	response, err := u.enroll(context, userId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditTOTPEnroll, userId, err))
+	return response, err
+}
+
+func (u *enrollTOTPUseCase) enroll(context context.Context, userId string) (responses.TOTPEnrollment, error) {
+	user, err := u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.TOTPEnrollment{}, fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+		}
+		return responses.TOTPEnrollment{}, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	secret, err := u.mfaService.GenerateSecret()
+	if err != nil {
+		return responses.TOTPEnrollment{}, fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+
+	encrypted, err := u.encryptionService.Encrypt(secret)
+	if err != nil {
+		return responses.TOTPEnrollment{}, fmt.Errorf("failed to encrypt totp secret: %w", err)
+	}
+
+	err = u.mfaRepo.SaveTOTP(context, entities.TOTP{
+		UserId: user.Id,
+		Secret: encrypted,
+	})
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityAlreadyExists) {
+			return responses.TOTPEnrollment{}, fmt.Errorf("%w: totp is already enabled", ErrEntityAlreadyExists)
+		}
+		return responses.TOTPEnrollment{}, fmt.Errorf("failed to save totp: %w", err)
+	}
+
+	return responses.TOTPEnrollment{
+		Secret: secret,
+		URI:    u.mfaService.URI(string(user.Email), secret),
+	}, nil
+}
+
+// Confirm enables the second factor and returns the recovery codes, they are
+// shown only once.
+func (u *enrollTOTPUseCase) Confirm(context context.Context, userId string, request requests.ConfirmTOTP) (responses.RecoveryCodes, error) {
+	response, err := u.confirm(context, userId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditTOTPConfirm, userId, err))
+	return response, err
+}
+
+func (u *enrollTOTPUseCase) confirm(context context.Context, userId string, request requests.ConfirmTOTP) (responses.RecoveryCodes, error) {
+	totp, err := u.mfaRepo.SelectTOTP(context, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.RecoveryCodes{}, fmt.Errorf("totp enrolment not found: %w", ErrEntityNotFound)
+		}
+		return responses.RecoveryCodes{}, fmt.Errorf("failed to select totp: %w", err)
+	}
+	if totp.IsConfirmed() {
+		return responses.RecoveryCodes{}, fmt.Errorf("%w: totp is already enabled", ErrEntityAlreadyExists)
+	}
+
+	secret, err := u.encryptionService.Decrypt(totp.Secret)
+	if err != nil {
+		return responses.RecoveryCodes{}, fmt.Errorf("failed to decrypt totp secret: %w", err)
+	}
+
+	step, ok := u.mfaService.Validate(secret, request.Code, 0)
+	if !ok {
+		return responses.RecoveryCodes{}, ErrInvalidMFACode
+	}
+
+	codes, err := u.mfaService.GenerateRecoveryCodes()
+	if err != nil {
+		return responses.RecoveryCodes{}, fmt.Errorf("failed to generate recovery codes: %w", err)
+	}
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = u.mfaService.HashRecoveryCode(code)
+	}
+
+	err = u.mfaRepo.ConfirmTOTP(context, userId, step, hashes)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.RecoveryCodes{}, fmt.Errorf("totp enrolment not found: %w", ErrEntityNotFound)
+		}
+		return responses.RecoveryCodes{}, fmt.Errorf("failed to confirm totp: %w", err)
+	}
+
+	return responses.RecoveryCodes{Codes: codes}, nil
+}
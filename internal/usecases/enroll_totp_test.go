@@ -0,0 +1,116 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockEnrollTOTPUserRepo    *MockEnrollTOTPUserRepository
+	mockEnrollTOTPMFARepo     *MockEnrollTOTPMFARepository
+	mockEnrollTOTPMFAService  *MockEnrollTOTPMFAService
+	mockEnrollTOTPEncryption  *MockEnrollTOTPEncryptionService
+	mockEnrollTOTPAuditLogger *MockEnrollTOTPAuditLogger
+)
+
+func initEnrollTOTPMocks(t *testing.T) EnrollTOTPUseCase {
+	ctrl := gomock.NewController(t)
+	mockEnrollTOTPUserRepo = NewMockEnrollTOTPUserRepository(ctrl)
+	mockEnrollTOTPMFARepo = NewMockEnrollTOTPMFARepository(ctrl)
+	mockEnrollTOTPMFAService = NewMockEnrollTOTPMFAService(ctrl)
+	mockEnrollTOTPEncryption = NewMockEnrollTOTPEncryptionService(ctrl)
+	mockEnrollTOTPAuditLogger = NewMockEnrollTOTPAuditLogger(ctrl)
+	mockEnrollTOTPAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return NewEnrollTOTPUseCase(
+		mockEnrollTOTPUserRepo,
+		mockEnrollTOTPMFARepo,
+		mockEnrollTOTPMFAService,
+		mockEnrollTOTPEncryption,
+		mockEnrollTOTPAuditLogger)
+}
+
+func TestEnrollTOTPUseCase_Enroll_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := initEnrollTOTPMocks(t)
+
+	mockEnrollTOTPUserRepo.EXPECT().SelectByUserId(ctx, "user-id").
+		Return(entities.User{Id: "user-id", Email: "test@mail.ru"}, nil)
+	mockEnrollTOTPMFAService.EXPECT().GenerateSecret().Return("SECRET", nil)
+	mockEnrollTOTPEncryption.EXPECT().Encrypt("SECRET").Return("encrypted", nil)
+	mockEnrollTOTPMFARepo.EXPECT().SaveTOTP(ctx, entities.TOTP{UserId: "user-id", Secret: "encrypted"}).Return(nil)
+	mockEnrollTOTPMFAService.EXPECT().URI("test@mail.ru", "SECRET").Return("otpauth://totp/auth:test@mail.ru")
+
+	response, err := useCase.Enroll(ctx, "user-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SECRET", response.Secret)
+	assert.Equal(t, "otpauth://totp/auth:test@mail.ru", response.URI)
+}
+
+func TestEnrollTOTPUseCase_Enroll_AlreadyEnabled(t *testing.T) {
+	ctx := context.Background()
+	useCase := initEnrollTOTPMocks(t)
+
+	mockEnrollTOTPUserRepo.EXPECT().SelectByUserId(ctx, "user-id").
+		Return(entities.User{Id: "user-id", Email: "test@mail.ru"}, nil)
+	mockEnrollTOTPMFAService.EXPECT().GenerateSecret().Return("SECRET", nil)
+	mockEnrollTOTPEncryption.EXPECT().Encrypt("SECRET").Return("encrypted", nil)
+	mockEnrollTOTPMFARepo.EXPECT().SaveTOTP(ctx, gomock.Any()).Return(repositories.ErrEntityAlreadyExists)
+
+	_, err := useCase.Enroll(ctx, "user-id")
+
+	assert.ErrorIs(t, err, ErrEntityAlreadyExists)
+}
+
+func TestEnrollTOTPUseCase_Confirm_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := initEnrollTOTPMocks(t)
+
+	mockEnrollTOTPMFARepo.EXPECT().SelectTOTP(ctx, "user-id").
+		Return(entities.TOTP{UserId: "user-id", Secret: "encrypted"}, nil)
+	mockEnrollTOTPEncryption.EXPECT().Decrypt("encrypted").Return("SECRET", nil)
+	mockEnrollTOTPMFAService.EXPECT().Validate("SECRET", "123456", int64(0)).Return(int64(42), true)
+	mockEnrollTOTPMFAService.EXPECT().GenerateRecoveryCodes().Return([]string{"code-1", "code-2"}, nil)
+	mockEnrollTOTPMFAService.EXPECT().HashRecoveryCode("code-1").Return("hash-1")
+	mockEnrollTOTPMFAService.EXPECT().HashRecoveryCode("code-2").Return("hash-2")
+	mockEnrollTOTPMFARepo.EXPECT().ConfirmTOTP(ctx, "user-id", int64(42), []string{"hash-1", "hash-2"}).Return(nil)
+
+	response, err := useCase.Confirm(ctx, "user-id", requests.ConfirmTOTP{Code: "123456"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"code-1", "code-2"}, response.Codes)
+}
+
+func TestEnrollTOTPUseCase_Confirm_WrongCode(t *testing.T) {
+	ctx := context.Background()
+	useCase := initEnrollTOTPMocks(t)
+
+	mockEnrollTOTPMFARepo.EXPECT().SelectTOTP(ctx, "user-id").
+		Return(entities.TOTP{UserId: "user-id", Secret: "encrypted"}, nil)
+	mockEnrollTOTPEncryption.EXPECT().Decrypt("encrypted").Return("SECRET", nil)
+	mockEnrollTOTPMFAService.EXPECT().Validate("SECRET", "000000", int64(0)).Return(int64(0), false)
+
+	_, err := useCase.Confirm(ctx, "user-id", requests.ConfirmTOTP{Code: "000000"})
+
+	assert.ErrorIs(t, err, ErrInvalidMFACode)
+}
+
+func TestEnrollTOTPUseCase_Confirm_AlreadyEnabled(t *testing.T) {
+	ctx := context.Background()
+	useCase := initEnrollTOTPMocks(t)
+
+	mockEnrollTOTPMFARepo.EXPECT().SelectTOTP(ctx, "user-id").
+		Return(entities.TOTP{UserId: "user-id", Secret: "encrypted", ConfirmedAt: time.Now()}, nil)
+
+	_, err := useCase.Confirm(ctx, "user-id", requests.ConfirmTOTP{Code: "123456"})
+
+	assert.ErrorIs(t, err, ErrEntityAlreadyExists)
+}
@@ -1,18 +1,63 @@
 package usecases
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var ErrInvalidEntity = errors.New("validation error")
 var ErrEntityNotFound = errors.New("entity not found")
 var ErrEntityAlreadyExists = errors.New("entity already exists")
+var ErrLastAdmin = errors.New("the last administrator can't lose the admin role")
 
 var ErrWrongPassword = errors.New("wrong password")
+var ErrInvalidCredentials = errors.New("invalid email or password")
+
+var ErrUserDisabled = errors.New("user is disabled")
+var ErrUserLocked = errors.New("user is locked")
+var ErrUserBanned = errors.New("user is banned")
+var ErrPasswordChangeRequired = errors.New("password change required")
+var ErrMFARequired = errors.New("two-factor authentication required")
+var ErrInvalidMFACode = errors.New("invalid two-factor authentication code")
+var ErrInvalidWebAuthnCredential = errors.New("invalid webauthn credential")
+var ErrInvalidPasswordlessCode = errors.New("invalid or expired sign in code")
+var ErrPasswordlessDisabled = errors.New("passwordless sign in is disabled")
+var ErrInvalidSMSCode = errors.New("invalid or expired sms code")
+var ErrTooManySMS = errors.New("too many sms sent to the phone")
+var ErrTooManyAttempts = errors.New("too many sign in attempts")
+var ErrAccountTemporarilyLocked = errors.New("account is temporarily locked")
 
 var ErrAccessTokenExpired = errors.New("access token is expired")
 var ErrRefreshTokenExpired = errors.New("refresh token is expired")
 var ErrNotAValidAccessToken = errors.New("invalid access token")
 var ErrNotAValidRefreshToken = errors.New("invalid refresh token")
 
+var ErrOrganizationAccessDenied = errors.New("organization access denied")
+var ErrInvalidInvitation = errors.New("invalid invitation")
+var ErrInviteOnly = errors.New("sign up is available by invitation only")
+
 var ErrSessionNotFound = errors.New("session not found")
 var ErrInvalidUserAgent = errors.New("invalid user agent")
 var ErrInvalidInput = errors.New("invalid input")
+
+var ErrSuspiciousLogin = errors.New("sign in blocked as suspicious")
+var ErrSuspiciousSession = errors.New("session ended as suspicious, sign in again")
+
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+var ErrWebhookReplayed = errors.New("webhook has already been received")
+var ErrInboundWebhookDisabled = errors.New("inbound webhook is disabled")
+
+// RetryAfterError tells the client how long to wait before the next attempt,
+// it is sent in the Retry-After header.
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"auth/internal/controllers/responses"
+	"auth/internal/entities"
 	"auth/internal/repositories"
 	"context"
 	"errors"
@@ -15,6 +16,7 @@ type generateTokensUseCase struct {
 	hashProvider   GenerateTokensHashService
 	cookieService  GenerateTokensCookieService
 	sessionManager GenerateTokensSessionService
+	auditLogger    GenerateTokensAuditLogger
 }
 
 type GenerateTokensUseCase interface {
@@ -27,6 +29,7 @@ func NewGenerateTokensUseCase(
 	hashProvider GenerateTokensHashService,
 	cookieService GenerateTokensCookieService,
 	sessionManager GenerateTokensSessionService,
+	auditLogger GenerateTokensAuditLogger,
 ) GenerateTokensUseCase {
 	return &generateTokensUseCase{
 		userRepo:       userRepo,
@@ -34,10 +37,17 @@ func NewGenerateTokensUseCase(
 		hashProvider:   hashProvider,
 		sessionManager: sessionManager,
 		cookieService:  cookieService,
+		auditLogger:    auditLogger,
 	}
 }
 
 func (uc *generateTokensUseCase) GenerateTokens(context context.Context, writer http.ResponseWriter, userId, ip, userAgent string) (responses.Session, error) {
+	response, err := uc.generateTokens(context, writer, userId, ip, userAgent)
+	uc.auditLogger.Log(context, newAuditEvent(entities.AuditTokenIssue, userId, err))
+	return response, err
+}
+
+func (uc *generateTokensUseCase) generateTokens(context context.Context, writer http.ResponseWriter, userId, ip, userAgent string) (responses.Session, error) {
 	user, err := uc.userRepo.SelectByUserId(context, userId)
 	if err != nil {
 		if errors.Is(err, repositories.ErrEntityNotFound) {
@@ -46,12 +56,20 @@ func (uc *generateTokensUseCase) GenerateTokens(context context.Context, writer
 		return responses.Session{}, fmt.Errorf("failed to find user: %w", err)
 	}
 
+	err = CheckUserStatus(user)
+	if err != nil {
+		return responses.Session{}, fmt.Errorf("user can't receive tokens: %w", err)
+	}
+
 	err = uc.sessionRepo.DeleteByUserId(context, user.Id)
 	if err != nil {
 		return responses.Session{}, fmt.Errorf("failed to delete session: %w", err)
 	}
 
-	session, err := uc.sessionManager.CreateSession(user)
+	// The session is issued on behalf of the user by an administrator, the
+	// user doesn't authenticate here. The tokens carry no amr and can't pass
+	// the step-up checks.
+	session, err := uc.sessionManager.CreateSession(user, entities.Membership{}, entities.Authentication{})
 	if err != nil {
 		return responses.Session{}, fmt.Errorf("%w: couldn't create session", err)
 	}
@@ -14,11 +14,12 @@ import (
 )
 
 var (
-	mockGenTokensUserRepo       *MockGenerateTokensUserRepository
-	mockGenTokensSessionRepo    *MockGenerateTokensSessionRepository
-	mockGenTokensHashService    *MockGenerateTokensHashService
-	mockGenTokensSessionService *MockGenerateTokensSessionService
-	mockGenTokensCookieService  *MockGenerateTokensCookieService
+	mockGenTokensUserRepo         *MockGenerateTokensUserRepository
+	mockGenTokensSessionRepo      *MockGenerateTokensSessionRepository
+	mockGenTokensHashService      *MockGenerateTokensHashService
+	mockGenTokensSessionService   *MockGenerateTokensSessionService
+	mockGenTokensCookieService    *MockGenerateTokensCookieService
+	mockGenerateTokensAuditLogger *MockGenerateTokensAuditLogger
 )
 
 func initGenerateTokensMocks(t *testing.T) {
@@ -28,6 +29,8 @@ func initGenerateTokensMocks(t *testing.T) {
 	mockGenTokensHashService = NewMockGenerateTokensHashService(ctrl)
 	mockGenTokensSessionService = NewMockGenerateTokensSessionService(ctrl)
 	mockGenTokensCookieService = NewMockGenerateTokensCookieService(ctrl)
+	mockGenerateTokensAuditLogger = NewMockGenerateTokensAuditLogger(ctrl)
+	mockGenerateTokensAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
 }
 
 func TestGenerateTokensUseCase_GenerateTokens_Success(t *testing.T) {
@@ -52,7 +55,7 @@ func TestGenerateTokensUseCase_GenerateTokens_Success(t *testing.T) {
 
 	mockGenTokensUserRepo.EXPECT().SelectByUserId(ctx, userId).Return(user, nil)
 	mockGenTokensSessionRepo.EXPECT().DeleteByUserId(ctx, userId).Return(nil)
-	mockGenTokensSessionService.EXPECT().CreateSession(user).Return(session, nil)
+	mockGenTokensSessionService.EXPECT().CreateSession(user, entities.Membership{}, entities.Authentication{}).Return(session, nil)
 	mockGenTokensHashService.EXPECT().GenerateHash("new-refresh-token").Return([]byte("hashed-refresh-token"), nil)
 	mockGenTokensSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
 	mockGenTokensCookieService.EXPECT().Set(writer, "access_token", session.AccessToken, session.AccessExpiresAt)
@@ -62,7 +65,8 @@ func TestGenerateTokensUseCase_GenerateTokens_Success(t *testing.T) {
 		mockGenTokensSessionRepo,
 		mockGenTokensHashService,
 		mockGenTokensCookieService,
-		mockGenTokensSessionService)
+		mockGenTokensSessionService,
+		mockGenerateTokensAuditLogger)
 
 	result, err := useCase.GenerateTokens(ctx, writer, userId, ip, userAgent)
 
@@ -84,7 +88,8 @@ func TestGenerateTokensUseCase_GenerateTokens_UserNotFound(t *testing.T) {
 		mockGenTokensSessionRepo,
 		mockGenTokensHashService,
 		mockGenTokensCookieService,
-		mockGenTokensSessionService)
+		mockGenTokensSessionService,
+		mockGenerateTokensAuditLogger)
 
 	result, err := useCase.GenerateTokens(ctx, nil, userId, "", "")
 
@@ -108,7 +113,8 @@ func TestGenerateTokensUseCase_GenerateTokens_DeleteSessionError(t *testing.T) {
 		mockGenTokensSessionRepo,
 		mockGenTokensHashService,
 		mockGenTokensCookieService,
-		mockGenTokensSessionService)
+		mockGenTokensSessionService,
+		mockGenerateTokensAuditLogger)
 
 	result, err := useCase.GenerateTokens(ctx, nil, userId, "", "")
 
@@ -126,14 +132,15 @@ func TestGenerateTokensUseCase_GenerateTokens_CreateSessionError(t *testing.T) {
 
 	mockGenTokensUserRepo.EXPECT().SelectByUserId(ctx, userId).Return(user, nil)
 	mockGenTokensSessionRepo.EXPECT().DeleteByUserId(ctx, userId).Return(nil)
-	mockGenTokensSessionService.EXPECT().CreateSession(user).Return(entities.Session{}, fmt.Errorf("session error"))
+	mockGenTokensSessionService.EXPECT().CreateSession(user, entities.Membership{}, entities.Authentication{}).Return(entities.Session{}, fmt.Errorf("session error"))
 
 	useCase := NewGenerateTokensUseCase(
 		mockGenTokensUserRepo,
 		mockGenTokensSessionRepo,
 		mockGenTokensHashService,
 		mockGenTokensCookieService,
-		mockGenTokensSessionService)
+		mockGenTokensSessionService,
+		mockGenerateTokensAuditLogger)
 
 	result, err := useCase.GenerateTokens(ctx, nil, userId, "", "")
 
@@ -154,7 +161,7 @@ func TestGenerateTokensUseCase_GenerateTokens_HashError(t *testing.T) {
 
 	mockGenTokensUserRepo.EXPECT().SelectByUserId(ctx, userId).Return(user, nil)
 	mockGenTokensSessionRepo.EXPECT().DeleteByUserId(ctx, userId).Return(nil)
-	mockGenTokensSessionService.EXPECT().CreateSession(user).Return(session, nil)
+	mockGenTokensSessionService.EXPECT().CreateSession(user, entities.Membership{}, entities.Authentication{}).Return(session, nil)
 	mockGenTokensHashService.EXPECT().GenerateHash(session.RefreshToken).Return(nil, fmt.Errorf("hash error"))
 
 	useCase := NewGenerateTokensUseCase(
@@ -162,7 +169,8 @@ func TestGenerateTokensUseCase_GenerateTokens_HashError(t *testing.T) {
 		mockGenTokensSessionRepo,
 		mockGenTokensHashService,
 		mockGenTokensCookieService,
-		mockGenTokensSessionService)
+		mockGenTokensSessionService,
+		mockGenerateTokensAuditLogger)
 
 	result, err := useCase.GenerateTokens(ctx, nil, userId, "", "")
 
@@ -170,3 +178,27 @@ func TestGenerateTokensUseCase_GenerateTokens_HashError(t *testing.T) {
 	assert.Empty(t, result.AccessToken)
 	assert.Contains(t, err.Error(), "failed to hash refresh token")
 }
+
+func TestGenerateTokensUseCase_GenerateTokens_UserDisabled(t *testing.T) {
+	ctx := context.Background()
+	initGenerateTokensMocks(t)
+
+	user := entities.User{
+		Id:     "user-id",
+		Status: entities.UserStatusDisabled,
+	}
+
+	mockGenTokensUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+
+	useCase := NewGenerateTokensUseCase(
+		mockGenTokensUserRepo,
+		mockGenTokensSessionRepo,
+		mockGenTokensHashService,
+		mockGenTokensCookieService,
+		mockGenTokensSessionService,
+		mockGenerateTokensAuditLogger)
+
+	_, err := useCase.GenerateTokens(ctx, nil, "user-id", "", "")
+
+	assert.ErrorIs(t, err, ErrUserDisabled)
+}
@@ -0,0 +1,34 @@
+package usecases
+
+import (
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+)
+
+type getPasswordPolicyUseCase struct {
+	policy entities.PasswordPolicy
+}
+
+type GetPasswordPolicyUseCase interface {
+	GetPasswordPolicy() responses.PasswordPolicy
+}
+
+func NewGetPasswordPolicyUseCase(policy entities.PasswordPolicy) GetPasswordPolicyUseCase {
+	return &getPasswordPolicyUseCase{policy: policy}
+}
+
+func (u *getPasswordPolicyUseCase) GetPasswordPolicy() responses.PasswordPolicy {
+	return responses.PasswordPolicy{
+		MinLength:        u.policy.MinLength,
+		MaxLength:        u.policy.MaxLength,
+		MaxBytes:         u.policy.MaxBytes,
+		RequireLetter:    u.policy.RequireLetter,
+		RequireLowercase: u.policy.RequireLowercase,
+		RequireUppercase: u.policy.RequireUppercase,
+		RequireDigit:     u.policy.RequireDigit,
+		RequireSymbol:    u.policy.RequireSymbol,
+		AllowUnicode:     u.policy.AllowUnicode,
+		ForbidEmail:      u.policy.ForbidEmail,
+		Normalization:    "NFKC",
+	}
+}
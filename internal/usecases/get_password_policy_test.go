@@ -0,0 +1,21 @@
+package usecases
+
+import (
+	"testing"
+
+	"auth/internal/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPasswordPolicyUseCase_GetPasswordPolicy(t *testing.T) {
+	policy := entities.DefaultPasswordPolicy()
+	policy.RequireUppercase = true
+
+	result := NewGetPasswordPolicyUseCase(policy).GetPasswordPolicy()
+
+	assert.Equal(t, policy.MinLength, result.MinLength)
+	assert.Equal(t, policy.MaxLength, result.MaxLength)
+	assert.True(t, result.RequireUppercase)
+	assert.True(t, result.ForbidEmail)
+	assert.Equal(t, "NFKC", result.Normalization)
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"auth/internal/controllers/responses"
+	"auth/internal/entities"
 	"auth/internal/repositories"
 	"context"
 	"errors"
@@ -9,18 +10,34 @@ import (
 )
 
 type getUserUseCase struct {
-	userRepo GetUserUserRepository
+	userRepo         GetUserUserRepository
+	organizationRepo GetUserOrganizationRepository
+	auditLogger      GetUserAuditLogger
 }
 
 type GetUserUseCase interface {
 	GetUserUseCase(context context.Context, userId string) (responses.User, error)
 }
 
-func NewGetUserUseCase(userRepo GetUserUserRepository) GetUserUseCase {
-	return &getUserUseCase{userRepo: userRepo}
+func NewGetUserUseCase(
+	userRepo GetUserUserRepository,
+	organizationRepo GetUserOrganizationRepository,
+	auditLogger GetUserAuditLogger,
+) GetUserUseCase {
+	return &getUserUseCase{
+		userRepo:         userRepo,
+		organizationRepo: organizationRepo,
+		auditLogger:      auditLogger,
+	}
 }
 
 func (g getUserUseCase) GetUserUseCase(context context.Context, userId string) (responses.User, error) {
+	response, err := g.getUser(context, userId)
+	g.auditLogger.Log(context, newAuditEvent(entities.AuditUserRead, userId, err))
+	return response, err
+}
+
+func (g getUserUseCase) getUser(context context.Context, userId string) (responses.User, error) {
 	user, err := g.userRepo.SelectByUserId(context, userId)
 	if err != nil {
 		if errors.Is(err, repositories.ErrEntityNotFound) {
@@ -29,11 +46,15 @@ func (g getUserUseCase) GetUserUseCase(context context.Context, userId string) (
 		return responses.User{}, fmt.Errorf("%w failed to find account", err)
 	}
 
-	userResponse := responses.User{
-		Id:               user.Id,
-		Email:            string(user.Email),
-		RegistrationDate: user.RegistrationDate,
+	memberships, err := g.organizationRepo.SelectMembershipsByUserId(context, userId)
+	if err != nil {
+		return responses.User{}, fmt.Errorf("failed to select memberships: %w", err)
 	}
 
-	return userResponse, nil
+	response := newUserResponse(user)
+	response.Memberships = make([]responses.Membership, 0, len(memberships))
+	for _, membership := range memberships {
+		response.Memberships = append(response.Memberships, newMembershipResponse(membership))
+	}
+	return response, nil
 }
@@ -13,12 +13,17 @@ import (
 )
 
 var (
-	mockGetUserRepo *MockGetUserUserRepository
+	mockGetUserRepo        *MockGetUserUserRepository
+	mockGetUserOrgRepo     *MockGetUserOrganizationRepository
+	mockGetUserAuditLogger *MockGetUserAuditLogger
 )
 
 func initGetUserMocks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockGetUserRepo = NewMockGetUserUserRepository(ctrl)
+	mockGetUserOrgRepo = NewMockGetUserOrganizationRepository(ctrl)
+	mockGetUserAuditLogger = NewMockGetUserAuditLogger(ctrl)
+	mockGetUserAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
 }
 
 func TestGetUserUseCase_GetUserUseCase_Success(t *testing.T) {
@@ -33,9 +38,14 @@ func TestGetUserUseCase_GetUserUseCase_Success(t *testing.T) {
 		RegistrationDate: now,
 	}
 
+	memberships := []entities.Membership{
+		{OrganizationId: "org-id", OrganizationName: "Acme", UserId: userId, Role: entities.OrganizationRoleAdmin},
+	}
+
 	mockGetUserRepo.EXPECT().SelectByUserId(ctx, userId).Return(user, nil)
+	mockGetUserOrgRepo.EXPECT().SelectMembershipsByUserId(ctx, userId).Return(memberships, nil)
 
-	useCase := NewGetUserUseCase(mockGetUserRepo)
+	useCase := NewGetUserUseCase(mockGetUserRepo, mockGetUserOrgRepo, mockGetUserAuditLogger)
 
 	result, err := useCase.GetUserUseCase(ctx, userId)
 
@@ -43,6 +53,9 @@ func TestGetUserUseCase_GetUserUseCase_Success(t *testing.T) {
 	assert.Equal(t, userId, result.Id)
 	assert.Equal(t, "test@mail.ru", result.Email)
 	assert.Equal(t, now, result.RegistrationDate)
+	assert.Len(t, result.Memberships, 1)
+	assert.Equal(t, "org-id", result.Memberships[0].OrganizationId)
+	assert.Equal(t, entities.OrganizationRoleAdmin, result.Memberships[0].Role)
 }
 
 func TestGetUserUseCase_GetUserUseCase_UserNotFound(t *testing.T) {
@@ -53,7 +66,7 @@ func TestGetUserUseCase_GetUserUseCase_UserNotFound(t *testing.T) {
 
 	mockGetUserRepo.EXPECT().SelectByUserId(ctx, userId).Return(entities.User{}, repositories.ErrEntityNotFound)
 
-	useCase := NewGetUserUseCase(mockGetUserRepo)
+	useCase := NewGetUserUseCase(mockGetUserRepo, mockGetUserOrgRepo, mockGetUserAuditLogger)
 
 	result, err := useCase.GetUserUseCase(ctx, userId)
 
@@ -70,7 +83,7 @@ func TestGetUserUseCase_GetUserUseCase_RepositoryError(t *testing.T) {
 
 	mockGetUserRepo.EXPECT().SelectByUserId(ctx, userId).Return(entities.User{}, fmt.Errorf("db error"))
 
-	useCase := NewGetUserUseCase(mockGetUserRepo)
+	useCase := NewGetUserUseCase(mockGetUserRepo, mockGetUserOrgRepo, mockGetUserAuditLogger)
 
 	result, err := useCase.GetUserUseCase(ctx, userId)
 
@@ -78,3 +91,21 @@ func TestGetUserUseCase_GetUserUseCase_RepositoryError(t *testing.T) {
 	assert.Empty(t, result.Id)
 	assert.Contains(t, err.Error(), "failed to find account")
 }
+
+func TestGetUserUseCase_GetUserUseCase_RecordsAuditEvent(t *testing.T) {
+	ctx := context.Background()
+	initGetUserMocks(t)
+	auditLogger := NewMockGetUserAuditLogger(gomock.NewController(t))
+
+	mockGetUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{}, repositories.ErrEntityNotFound)
+	auditLogger.EXPECT().Log(ctx, entities.AuditEvent{
+		Type:      entities.AuditUserRead,
+		SubjectId: "user-id",
+		Outcome:   entities.AuditOutcomeFailure,
+		Reason:    ErrEntityNotFound.Error(),
+	})
+
+	_, err := NewGetUserUseCase(mockGetUserRepo, mockGetUserOrgRepo, auditLogger).GetUserUseCase(ctx, "user-id")
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"context"
+	"fmt"
+)
+
+type listMembersUseCase struct {
+	organizationRepo ListMembersOrganizationRepository
+	auditLogger      ListMembersAuditLogger
+}
+
+type ListMembersUseCase interface {
+	ListMembers(context context.Context, organizationId, userId string) ([]responses.Member, error)
+}
+
+func NewListMembersUseCase(
+	organizationRepo ListMembersOrganizationRepository,
+	auditLogger ListMembersAuditLogger,
+) ListMembersUseCase {
+	return &listMembersUseCase{organizationRepo: organizationRepo, auditLogger: auditLogger}
+}
+
+func (u *listMembersUseCase) ListMembers(context context.Context, organizationId, userId string) ([]responses.Member, error) {
+	response, err := u.listMembers(context, organizationId, userId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditMemberList, organizationId, err))
+	return response, err
+}
+
+func (u *listMembersUseCase) listMembers(context context.Context, organizationId, userId string) ([]responses.Member, error) {
+	_, err := selectMembership(context, u.organizationRepo, organizationId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.organizationRepo.SelectMembers(context, organizationId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select members: %w", err)
+	}
+
+	result := make([]responses.Member, 0, len(members))
+	for _, member := range members {
+		result = append(result, newMemberResponse(member))
+	}
+	return result, nil
+}
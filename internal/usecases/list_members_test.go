@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockListMembersRepo        *MockListMembersOrganizationRepository
+	mockListMembersAuditLogger *MockListMembersAuditLogger
+)
+
+func initListMembersMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockListMembersRepo = NewMockListMembersOrganizationRepository(ctrl)
+	mockListMembersAuditLogger = NewMockListMembersAuditLogger(ctrl)
+	mockListMembersAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestListMembersUseCase_ListMembers_Success(t *testing.T) {
+	ctx := context.Background()
+	initListMembersMocks(t)
+
+	members := []entities.Membership{
+		{OrganizationId: "org-id", UserId: "owner-id", Email: "owner@mail.ru", Role: entities.OrganizationRoleOwner},
+		{OrganizationId: "org-id", UserId: "user-id", Email: "user@mail.ru", Role: entities.OrganizationRoleMember},
+	}
+
+	mockListMembersRepo.EXPECT().SelectMember(ctx, "org-id", "user-id").Return(members[1], nil)
+	mockListMembersRepo.EXPECT().SelectMembers(ctx, "org-id").Return(members, nil)
+
+	useCase := NewListMembersUseCase(mockListMembersRepo, mockListMembersAuditLogger)
+
+	result, err := useCase.ListMembers(ctx, "org-id", "user-id")
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "owner@mail.ru", result[0].Email)
+}
+
+func TestListMembersUseCase_ListMembers_NotMember(t *testing.T) {
+	ctx := context.Background()
+	initListMembersMocks(t)
+
+	mockListMembersRepo.EXPECT().SelectMember(ctx, "org-id", "user-id").Return(entities.Membership{}, repositories.ErrEntityNotFound)
+
+	useCase := NewListMembersUseCase(mockListMembersRepo, mockListMembersAuditLogger)
+
+	_, err := useCase.ListMembers(ctx, "org-id", "user-id")
+
+	assert.ErrorIs(t, err, ErrOrganizationAccessDenied)
+}
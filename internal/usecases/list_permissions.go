@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"context"
+	"fmt"
+)
+
+type listPermissionsUseCase struct {
+	permissionRepo ListPermissionsPermissionRepository
+	auditLogger    ListPermissionsAuditLogger
+}
+
+type ListPermissionsUseCase interface {
+	ListPermissions(context context.Context) ([]responses.Permission, error)
+}
+
+func NewListPermissionsUseCase(
+	permissionRepo ListPermissionsPermissionRepository,
+	auditLogger ListPermissionsAuditLogger,
+) ListPermissionsUseCase {
+	return &listPermissionsUseCase{permissionRepo: permissionRepo, auditLogger: auditLogger}
+}
+
+func (u *listPermissionsUseCase) ListPermissions(context context.Context) ([]responses.Permission, error) {
+	response, err := u.listPermissions(context)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditPermissionList, "", err))
+	return response, err
+}
+
+func (u *listPermissionsUseCase) listPermissions(context context.Context) ([]responses.Permission, error) {
+	permissions, err := u.permissionRepo.SelectAll(context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select permissions: %w", err)
+	}
+
+	result := make([]responses.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		result = append(result, newPermissionResponse(permission))
+	}
+	return result, nil
+}
@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockListPermissionsRepo        *MockListPermissionsPermissionRepository
+	mockListPermissionsAuditLogger *MockListPermissionsAuditLogger
+)
+
+func initListPermissionsMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockListPermissionsRepo = NewMockListPermissionsPermissionRepository(ctrl)
+	mockListPermissionsAuditLogger = NewMockListPermissionsAuditLogger(ctrl)
+	mockListPermissionsAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestListPermissionsUseCase_ListPermissions_Success(t *testing.T) {
+	ctx := context.Background()
+	initListPermissionsMocks(t)
+
+	permissions := []entities.Permission{
+		{Id: 1, Name: entities.PermissionUsersRead},
+		{Id: 2, Name: entities.PermissionUsersWrite},
+	}
+
+	mockListPermissionsRepo.EXPECT().SelectAll(ctx).Return(permissions, nil)
+
+	useCase := NewListPermissionsUseCase(mockListPermissionsRepo, mockListPermissionsAuditLogger)
+
+	result, err := useCase.ListPermissions(ctx)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, entities.PermissionUsersWrite, result[1].Name)
+}
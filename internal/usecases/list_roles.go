@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"context"
+	"fmt"
+)
+
+type listRolesUseCase struct {
+	roleRepo    ListRolesRoleRepository
+	auditLogger ListRolesAuditLogger
+}
+
+type ListRolesUseCase interface {
+	ListRoles(context context.Context) ([]responses.Role, error)
+}
+
+func NewListRolesUseCase(roleRepo ListRolesRoleRepository, auditLogger ListRolesAuditLogger) ListRolesUseCase {
+	return &listRolesUseCase{roleRepo: roleRepo, auditLogger: auditLogger}
+}
+
+func (u *listRolesUseCase) ListRoles(context context.Context) ([]responses.Role, error) {
+	response, err := u.listRoles(context)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditRoleList, "", err))
+	return response, err
+}
+
+func (u *listRolesUseCase) listRoles(context context.Context) ([]responses.Role, error) {
+	roles, err := u.roleRepo.SelectAll(context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select roles: %w", err)
+	}
+
+	result := make([]responses.Role, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, newRoleResponse(role))
+	}
+	return result, nil
+}
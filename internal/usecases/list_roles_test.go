@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockListRolesRepo        *MockListRolesRoleRepository
+	mockListRolesAuditLogger *MockListRolesAuditLogger
+)
+
+func initListRolesMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockListRolesRepo = NewMockListRolesRoleRepository(ctrl)
+	mockListRolesAuditLogger = NewMockListRolesAuditLogger(ctrl)
+	mockListRolesAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestListRolesUseCase_ListRoles_Success(t *testing.T) {
+	ctx := context.Background()
+	initListRolesMocks(t)
+
+	roles := []entities.Role{
+		{Id: 1, Name: entities.RoleUser},
+		{Id: 2, Name: entities.RoleAdmin, Permissions: []string{entities.PermissionUsersRead}},
+	}
+
+	mockListRolesRepo.EXPECT().SelectAll(ctx).Return(roles, nil)
+
+	useCase := NewListRolesUseCase(mockListRolesRepo, mockListRolesAuditLogger)
+
+	result, err := useCase.ListRoles(ctx)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, entities.RoleAdmin, result[1].Name)
+	assert.Equal(t, []string{entities.PermissionUsersRead}, result[1].Permissions)
+}
+
+func TestListRolesUseCase_ListRoles_SelectError(t *testing.T) {
+	ctx := context.Background()
+	initListRolesMocks(t)
+
+	mockListRolesRepo.EXPECT().SelectAll(ctx).Return(nil, fmt.Errorf("db error"))
+
+	useCase := NewListRolesUseCase(mockListRolesRepo, mockListRolesAuditLogger)
+
+	_, err := useCase.ListRoles(ctx)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"fmt"
+)
+
+const (
+	defaultUsersPageLimit = 20
+	maxUsersPageLimit     = 100
+)
+
+type listUsersUseCase struct {
+	userRepo    ListUsersUserRepository
+	auditLogger ListUsersAuditLogger
+}
+
+type ListUsersUseCase interface {
+	ListUsers(context context.Context, request requests.ListUsers) (responses.UserList, error)
+}
+
+func NewListUsersUseCase(userRepo ListUsersUserRepository, auditLogger ListUsersAuditLogger) ListUsersUseCase {
+	return &listUsersUseCase{userRepo: userRepo, auditLogger: auditLogger}
+}
+
+func (u *listUsersUseCase) ListUsers(context context.Context, request requests.ListUsers) (responses.UserList, error) {
+	response, err := u.listUsers(context, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditUserList, "", err))
+	return response, err
+}
+
+func (u *listUsersUseCase) listUsers(context context.Context, request requests.ListUsers) (responses.UserList, error) {
+	page := request.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := request.Limit
+	if limit < 1 {
+		limit = defaultUsersPageLimit
+	}
+	if limit > maxUsersPageLimit {
+		limit = maxUsersPageLimit
+	}
+
+	filter := repositories.UserFilter{
+		Email:  request.Email,
+		Offset: (page - 1) * limit,
+		Limit:  limit,
+	}
+	if request.Status != "" {
+		filter.Status = entities.UserStatus(request.Status)
+		err := filter.Status.Validate()
+		if err != nil {
+			return responses.UserList{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+		}
+	}
+
+	total, err := u.userRepo.Count(context, filter)
+	if err != nil {
+		return responses.UserList{}, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	users, err := u.userRepo.SelectList(context, filter)
+	if err != nil {
+		return responses.UserList{}, fmt.Errorf("failed to select users: %w", err)
+	}
+
+	result := responses.UserList{
+		Users: make([]responses.User, 0, len(users)),
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	}
+	for _, user := range users {
+		result.Users = append(result.Users, newUserResponse(user))
+	}
+
+	return result, nil
+}
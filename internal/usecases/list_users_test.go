@@ -0,0 +1,99 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockListUsersRepo        *MockListUsersUserRepository
+	mockListUsersAuditLogger *MockListUsersAuditLogger
+)
+
+func initListUsersMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockListUsersRepo = NewMockListUsersUserRepository(ctrl)
+	mockListUsersAuditLogger = NewMockListUsersAuditLogger(ctrl)
+	mockListUsersAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestListUsersUseCase_ListUsers_Success(t *testing.T) {
+	ctx := context.Background()
+	initListUsersMocks(t)
+
+	request := requests.ListUsers{Page: 3, Limit: 10, Email: "mail", Status: "banned"}
+	filter := repositories.UserFilter{
+		Email:  "mail",
+		Status: entities.UserStatusBanned,
+		Offset: 20,
+		Limit:  10,
+	}
+	users := []entities.User{
+		{Id: "first", Email: "first@mail.ru", Status: entities.UserStatusBanned},
+		{Id: "second", Email: "second@mail.ru", Status: entities.UserStatusBanned},
+	}
+
+	mockListUsersRepo.EXPECT().Count(ctx, filter).Return(22, nil)
+	mockListUsersRepo.EXPECT().SelectList(ctx, filter).Return(users, nil)
+
+	useCase := NewListUsersUseCase(mockListUsersRepo, mockListUsersAuditLogger)
+
+	result, err := useCase.ListUsers(ctx, request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 22, result.Total)
+	assert.Equal(t, 3, result.Page)
+	assert.Equal(t, 10, result.Limit)
+	assert.Len(t, result.Users, 2)
+	assert.Equal(t, "second@mail.ru", result.Users[1].Email)
+}
+
+func TestListUsersUseCase_ListUsers_DefaultPagination(t *testing.T) {
+	ctx := context.Background()
+	initListUsersMocks(t)
+
+	filter := repositories.UserFilter{Offset: 0, Limit: maxUsersPageLimit}
+
+	mockListUsersRepo.EXPECT().Count(ctx, filter).Return(0, nil)
+	mockListUsersRepo.EXPECT().SelectList(ctx, filter).Return(nil, nil)
+
+	useCase := NewListUsersUseCase(mockListUsersRepo, mockListUsersAuditLogger)
+
+	result, err := useCase.ListUsers(ctx, requests.ListUsers{Page: -1, Limit: 1000})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Page)
+	assert.NotNil(t, result.Users)
+}
+
+func TestListUsersUseCase_ListUsers_InvalidStatus(t *testing.T) {
+	ctx := context.Background()
+	initListUsersMocks(t)
+
+	useCase := NewListUsersUseCase(mockListUsersRepo, mockListUsersAuditLogger)
+
+	_, err := useCase.ListUsers(ctx, requests.ListUsers{Status: "unknown"})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestListUsersUseCase_ListUsers_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	initListUsersMocks(t)
+
+	mockListUsersRepo.EXPECT().Count(ctx, gomock.Any()).Return(0, fmt.Errorf("db error"))
+
+	useCase := NewListUsersUseCase(mockListUsersRepo, mockListUsersAuditLogger)
+
+	_, err := useCase.ListUsers(ctx, requests.ListUsers{})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to count users")
+}
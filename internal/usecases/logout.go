@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"auth/internal/entities"
 	"auth/internal/repositories"
 	"context"
 	"errors"
@@ -10,6 +11,7 @@ import (
 type logoutUseCase struct {
 	sessionRepo   LogoutSessionRepository
 	cookieService LogoutCookieService
+	auditLogger   LogoutAuditLogger
 }
 
 type LogoutUseCase interface {
@@ -19,14 +21,22 @@ type LogoutUseCase interface {
 func NewLogoutUseCase(
 	sessionRepo LogoutSessionRepository,
 	cookieService LogoutCookieService,
+	auditLogger LogoutAuditLogger,
 ) LogoutUseCase {
 	return &logoutUseCase{
 		sessionRepo:   sessionRepo,
 		cookieService: cookieService,
+		auditLogger:   auditLogger,
 	}
 }
 
 func (l logoutUseCase) Logout(context context.Context, writer http.ResponseWriter, userId string) error {
+	err := l.logout(context, writer, userId)
+	l.auditLogger.Log(context, newAuditEvent(entities.AuditLogout, userId, err))
+	return err
+}
+
+func (l logoutUseCase) logout(context context.Context, writer http.ResponseWriter, userId string) error {
 	l.cookieService.Clear(writer, "access_token")
 	err := l.sessionRepo.DeleteByUserId(context, userId)
 	if errors.Is(err, repositories.ErrSessionNotFound) {
@@ -13,12 +13,15 @@ import (
 var (
 	mockLogoutSessionRepo   *MockLogoutSessionRepository
 	mockLogoutCookieService *MockLogoutCookieService
+	mockLogoutAuditLogger   *MockLogoutAuditLogger
 )
 
 func initLogoutMocks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockLogoutSessionRepo = NewMockLogoutSessionRepository(ctrl)
 	mockLogoutCookieService = NewMockLogoutCookieService(ctrl)
+	mockLogoutAuditLogger = NewMockLogoutAuditLogger(ctrl)
+	mockLogoutAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
 }
 
 func TestLogoutUseCase_Logout_Success(t *testing.T) {
@@ -33,7 +36,8 @@ func TestLogoutUseCase_Logout_Success(t *testing.T) {
 
 	useCase := NewLogoutUseCase(
 		mockLogoutSessionRepo,
-		mockLogoutCookieService)
+		mockLogoutCookieService,
+		mockLogoutAuditLogger)
 
 	err := useCase.Logout(ctx, writer, userId)
 
@@ -52,7 +56,8 @@ func TestLogoutUseCase_Logout_DeleteError(t *testing.T) {
 
 	useCase := NewLogoutUseCase(
 		mockLogoutSessionRepo,
-		mockLogoutCookieService)
+		mockLogoutCookieService,
+		mockLogoutAuditLogger)
 
 	err := useCase.Logout(ctx, writer, userId)
 
@@ -6,6 +6,7 @@ package usecases
 
 import (
 	entities "auth/internal/entities"
+	repositories "auth/internal/repositories"
 	context "context"
 	http "net/http"
 	reflect "reflect"
@@ -52,6 +53,20 @@ func (mr *MockSignInUserRepositoryMockRecorder) SelectByEmail(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByEmail", reflect.TypeOf((*MockSignInUserRepository)(nil).SelectByEmail), arg0, arg1)
 }
 
+// UpdatePassword mocks base method.
+func (m *MockSignInUserRepository) UpdatePassword(arg0 context.Context, arg1 string, arg2 entities.Password) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockSignInUserRepositoryMockRecorder) UpdatePassword(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockSignInUserRepository)(nil).UpdatePassword), arg0, arg1, arg2)
+}
+
 // MockSignInSessionRepository is a mock of SignInSessionRepository interface.
 type MockSignInSessionRepository struct {
 	ctrl     *gomock.Controller
@@ -155,6 +170,20 @@ func (mr *MockSignInHashServiceMockRecorder) GenerateHash(stringToHash interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockSignInHashService)(nil).GenerateHash), stringToHash)
 }
 
+// NeedsRehash mocks base method.
+func (m *MockSignInHashService) NeedsRehash(arg0 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedsRehash", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// NeedsRehash indicates an expected call of NeedsRehash.
+func (mr *MockSignInHashServiceMockRecorder) NeedsRehash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsRehash", reflect.TypeOf((*MockSignInHashService)(nil).NeedsRehash), arg0)
+}
+
 // MockSignInSessionService is a mock of SignInSessionService interface.
 type MockSignInSessionService struct {
 	ctrl     *gomock.Controller
@@ -178,19 +207,50 @@ func (m *MockSignInSessionService) EXPECT() *MockSignInSessionServiceMockRecorde
 	return m.recorder
 }
 
+// CreateRestrictedToken mocks base method.
+func (m *MockSignInSessionService) CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRestrictedToken", account, scope, authentication)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRestrictedToken indicates an expected call of CreateRestrictedToken.
+func (mr *MockSignInSessionServiceMockRecorder) CreateRestrictedToken(account, scope, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestrictedToken", reflect.TypeOf((*MockSignInSessionService)(nil).CreateRestrictedToken), account, scope, authentication)
+}
+
 // CreateSession mocks base method.
-func (m *MockSignInSessionService) CreateSession(account entities.User) (entities.Session, error) {
+func (m *MockSignInSessionService) CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", account)
+	ret := m.ctrl.Call(m, "CreateSession", account, membership, authentication)
 	ret0, _ := ret[0].(entities.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSession indicates an expected call of CreateSession.
-func (mr *MockSignInSessionServiceMockRecorder) CreateSession(account interface{}) *gomock.Call {
+func (mr *MockSignInSessionServiceMockRecorder) CreateSession(account, membership, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSignInSessionService)(nil).CreateSession), account, membership, authentication)
+}
+
+// ParseToken mocks base method.
+func (m *MockSignInSessionService) ParseToken(token string) (entities.AccessTokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseToken", token)
+	ret0, _ := ret[0].(entities.AccessTokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseToken indicates an expected call of ParseToken.
+func (mr *MockSignInSessionServiceMockRecorder) ParseToken(token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSignInSessionService)(nil).CreateSession), account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockSignInSessionService)(nil).ParseToken), token)
 }
 
 // MockSignInCookieService is a mock of SignInCookieService interface.
@@ -228,270 +288,365 @@ func (mr *MockSignInCookieServiceMockRecorder) Set(w, name, value, expires inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockSignInCookieService)(nil).Set), w, name, value, expires)
 }
 
-// MockSignUpUserRepository is a mock of SignUpUserRepository interface.
-type MockSignUpUserRepository struct {
+// MockSignInOrganizationRepository is a mock of SignInOrganizationRepository interface.
+type MockSignInOrganizationRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockSignUpUserRepositoryMockRecorder
+	recorder *MockSignInOrganizationRepositoryMockRecorder
 }
 
-// MockSignUpUserRepositoryMockRecorder is the mock recorder for MockSignUpUserRepository.
-type MockSignUpUserRepositoryMockRecorder struct {
-	mock *MockSignUpUserRepository
+// MockSignInOrganizationRepositoryMockRecorder is the mock recorder for MockSignInOrganizationRepository.
+type MockSignInOrganizationRepositoryMockRecorder struct {
+	mock *MockSignInOrganizationRepository
 }
 
-// NewMockSignUpUserRepository creates a new mock instance.
-func NewMockSignUpUserRepository(ctrl *gomock.Controller) *MockSignUpUserRepository {
-	mock := &MockSignUpUserRepository{ctrl: ctrl}
-	mock.recorder = &MockSignUpUserRepositoryMockRecorder{mock}
+// NewMockSignInOrganizationRepository creates a new mock instance.
+func NewMockSignInOrganizationRepository(ctrl *gomock.Controller) *MockSignInOrganizationRepository {
+	mock := &MockSignInOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockSignInOrganizationRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSignUpUserRepository) EXPECT() *MockSignUpUserRepositoryMockRecorder {
+func (m *MockSignInOrganizationRepository) EXPECT() *MockSignInOrganizationRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CheckEmailExists mocks base method.
-func (m *MockSignUpUserRepository) CheckEmailExists(arg0 context.Context, arg1 entities.Email) (bool, error) {
+// SelectMember mocks base method.
+func (m *MockSignInOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckEmailExists", arg0, arg1)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CheckEmailExists indicates an expected call of CheckEmailExists.
-func (mr *MockSignUpUserRepositoryMockRecorder) CheckEmailExists(arg0, arg1 interface{}) *gomock.Call {
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockSignInOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEmailExists", reflect.TypeOf((*MockSignUpUserRepository)(nil).CheckEmailExists), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockSignInOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
 }
 
-// Insert mocks base method.
-func (m *MockSignUpUserRepository) Insert(arg0 context.Context, arg1 entities.User) (string, error) {
+// MockSignInMFARepository is a mock of SignInMFARepository interface.
+type MockSignInMFARepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignInMFARepositoryMockRecorder
+}
+
+// MockSignInMFARepositoryMockRecorder is the mock recorder for MockSignInMFARepository.
+type MockSignInMFARepositoryMockRecorder struct {
+	mock *MockSignInMFARepository
+}
+
+// NewMockSignInMFARepository creates a new mock instance.
+func NewMockSignInMFARepository(ctrl *gomock.Controller) *MockSignInMFARepository {
+	mock := &MockSignInMFARepository{ctrl: ctrl}
+	mock.recorder = &MockSignInMFARepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignInMFARepository) EXPECT() *MockSignInMFARepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectTOTP mocks base method.
+func (m *MockSignInMFARepository) SelectTOTP(arg0 context.Context, arg1 string) (entities.TOTP, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "SelectTOTP", arg0, arg1)
+	ret0, _ := ret[0].(entities.TOTP)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Insert indicates an expected call of Insert.
-func (mr *MockSignUpUserRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+// SelectTOTP indicates an expected call of SelectTOTP.
+func (mr *MockSignInMFARepositoryMockRecorder) SelectTOTP(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSignUpUserRepository)(nil).Insert), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectTOTP", reflect.TypeOf((*MockSignInMFARepository)(nil).SelectTOTP), arg0, arg1)
 }
 
-// MockSignUpSessionRepository is a mock of SignUpSessionRepository interface.
-type MockSignUpSessionRepository struct {
+// MockSignInWebAuthnRepository is a mock of SignInWebAuthnRepository interface.
+type MockSignInWebAuthnRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockSignUpSessionRepositoryMockRecorder
+	recorder *MockSignInWebAuthnRepositoryMockRecorder
 }
 
-// MockSignUpSessionRepositoryMockRecorder is the mock recorder for MockSignUpSessionRepository.
-type MockSignUpSessionRepositoryMockRecorder struct {
-	mock *MockSignUpSessionRepository
+// MockSignInWebAuthnRepositoryMockRecorder is the mock recorder for MockSignInWebAuthnRepository.
+type MockSignInWebAuthnRepositoryMockRecorder struct {
+	mock *MockSignInWebAuthnRepository
 }
 
-// NewMockSignUpSessionRepository creates a new mock instance.
-func NewMockSignUpSessionRepository(ctrl *gomock.Controller) *MockSignUpSessionRepository {
-	mock := &MockSignUpSessionRepository{ctrl: ctrl}
-	mock.recorder = &MockSignUpSessionRepositoryMockRecorder{mock}
+// NewMockSignInWebAuthnRepository creates a new mock instance.
+func NewMockSignInWebAuthnRepository(ctrl *gomock.Controller) *MockSignInWebAuthnRepository {
+	mock := &MockSignInWebAuthnRepository{ctrl: ctrl}
+	mock.recorder = &MockSignInWebAuthnRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSignUpSessionRepository) EXPECT() *MockSignUpSessionRepositoryMockRecorder {
+func (m *MockSignInWebAuthnRepository) EXPECT() *MockSignInWebAuthnRepositoryMockRecorder {
 	return m.recorder
 }
 
-// Insert mocks base method.
-func (m *MockSignUpSessionRepository) Insert(arg0 context.Context, arg1 entities.Session) error {
+// SelectCredentials mocks base method.
+func (m *MockSignInWebAuthnRepository) SelectCredentials(arg0 context.Context, arg1 string) ([]entities.WebAuthnCredential, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SelectCredentials", arg0, arg1)
+	ret0, _ := ret[0].([]entities.WebAuthnCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Insert indicates an expected call of Insert.
-func (mr *MockSignUpSessionRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+// SelectCredentials indicates an expected call of SelectCredentials.
+func (mr *MockSignInWebAuthnRepositoryMockRecorder) SelectCredentials(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSignUpSessionRepository)(nil).Insert), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCredentials", reflect.TypeOf((*MockSignInWebAuthnRepository)(nil).SelectCredentials), arg0, arg1)
 }
 
-// MockSignUpSessionService is a mock of SignUpSessionService interface.
-type MockSignUpSessionService struct {
+// MockSignInTrustedDeviceRepository is a mock of SignInTrustedDeviceRepository interface.
+type MockSignInTrustedDeviceRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockSignUpSessionServiceMockRecorder
+	recorder *MockSignInTrustedDeviceRepositoryMockRecorder
 }
 
-// MockSignUpSessionServiceMockRecorder is the mock recorder for MockSignUpSessionService.
-type MockSignUpSessionServiceMockRecorder struct {
-	mock *MockSignUpSessionService
+// MockSignInTrustedDeviceRepositoryMockRecorder is the mock recorder for MockSignInTrustedDeviceRepository.
+type MockSignInTrustedDeviceRepositoryMockRecorder struct {
+	mock *MockSignInTrustedDeviceRepository
 }
 
-// NewMockSignUpSessionService creates a new mock instance.
-func NewMockSignUpSessionService(ctrl *gomock.Controller) *MockSignUpSessionService {
-	mock := &MockSignUpSessionService{ctrl: ctrl}
-	mock.recorder = &MockSignUpSessionServiceMockRecorder{mock}
+// NewMockSignInTrustedDeviceRepository creates a new mock instance.
+func NewMockSignInTrustedDeviceRepository(ctrl *gomock.Controller) *MockSignInTrustedDeviceRepository {
+	mock := &MockSignInTrustedDeviceRepository{ctrl: ctrl}
+	mock.recorder = &MockSignInTrustedDeviceRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSignUpSessionService) EXPECT() *MockSignUpSessionServiceMockRecorder {
+func (m *MockSignInTrustedDeviceRepository) EXPECT() *MockSignInTrustedDeviceRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreateSession mocks base method.
-func (m *MockSignUpSessionService) CreateSession(user entities.User) (entities.Session, error) {
+// Select mocks base method.
+func (m *MockSignInTrustedDeviceRepository) Select(arg0 context.Context, arg1, arg2 string) (entities.TrustedDevice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", user)
-	ret0, _ := ret[0].(entities.Session)
+	ret := m.ctrl.Call(m, "Select", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.TrustedDevice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockSignUpSessionServiceMockRecorder) CreateSession(user interface{}) *gomock.Call {
+// Select indicates an expected call of Select.
+func (mr *MockSignInTrustedDeviceRepositoryMockRecorder) Select(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSignUpSessionService)(nil).CreateSession), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockSignInTrustedDeviceRepository)(nil).Select), arg0, arg1, arg2)
 }
 
-// MockSignUpHashService is a mock of SignUpHashService interface.
-type MockSignUpHashService struct {
+// UpdateUsage mocks base method.
+func (m *MockSignInTrustedDeviceRepository) UpdateUsage(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUsage indicates an expected call of UpdateUsage.
+func (mr *MockSignInTrustedDeviceRepositoryMockRecorder) UpdateUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsage", reflect.TypeOf((*MockSignInTrustedDeviceRepository)(nil).UpdateUsage), arg0, arg1)
+}
+
+// MockSignInLoginAttemptRepository is a mock of SignInLoginAttemptRepository interface.
+type MockSignInLoginAttemptRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockSignUpHashServiceMockRecorder
+	recorder *MockSignInLoginAttemptRepositoryMockRecorder
 }
 
-// MockSignUpHashServiceMockRecorder is the mock recorder for MockSignUpHashService.
-type MockSignUpHashServiceMockRecorder struct {
-	mock *MockSignUpHashService
+// MockSignInLoginAttemptRepositoryMockRecorder is the mock recorder for MockSignInLoginAttemptRepository.
+type MockSignInLoginAttemptRepositoryMockRecorder struct {
+	mock *MockSignInLoginAttemptRepository
 }
 
-// NewMockSignUpHashService creates a new mock instance.
-func NewMockSignUpHashService(ctrl *gomock.Controller) *MockSignUpHashService {
-	mock := &MockSignUpHashService{ctrl: ctrl}
-	mock.recorder = &MockSignUpHashServiceMockRecorder{mock}
+// NewMockSignInLoginAttemptRepository creates a new mock instance.
+func NewMockSignInLoginAttemptRepository(ctrl *gomock.Controller) *MockSignInLoginAttemptRepository {
+	mock := &MockSignInLoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockSignInLoginAttemptRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSignUpHashService) EXPECT() *MockSignUpHashServiceMockRecorder {
+func (m *MockSignInLoginAttemptRepository) EXPECT() *MockSignInLoginAttemptRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GenerateHash mocks base method.
-func (m *MockSignUpHashService) GenerateHash(stringToHash string) ([]byte, error) {
+// Lock mocks base method.
+func (m *MockSignInLoginAttemptRepository) Lock(arg0 context.Context, arg1 string, arg2 time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "Lock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockSignInLoginAttemptRepositoryMockRecorder) Lock(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockSignInLoginAttemptRepository)(nil).Lock), arg0, arg1, arg2)
+}
+
+// RegisterFailure mocks base method.
+func (m *MockSignInLoginAttemptRepository) RegisterFailure(arg0 context.Context, arg1 string, arg2 time.Duration) (entities.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailure", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.LoginAttempts)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GenerateHash indicates an expected call of GenerateHash.
-func (mr *MockSignUpHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+// RegisterFailure indicates an expected call of RegisterFailure.
+func (mr *MockSignInLoginAttemptRepositoryMockRecorder) RegisterFailure(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockSignUpHashService)(nil).GenerateHash), stringToHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailure", reflect.TypeOf((*MockSignInLoginAttemptRepository)(nil).RegisterFailure), arg0, arg1, arg2)
 }
 
-// MockSignUpCookieService is a mock of SignUpCookieService interface.
-type MockSignUpCookieService struct {
+// Reset mocks base method.
+func (m *MockSignInLoginAttemptRepository) Reset(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockSignInLoginAttemptRepositoryMockRecorder) Reset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockSignInLoginAttemptRepository)(nil).Reset), arg0, arg1)
+}
+
+// Select mocks base method.
+func (m *MockSignInLoginAttemptRepository) Select(arg0 context.Context, arg1 string) (entities.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Select", arg0, arg1)
+	ret0, _ := ret[0].(entities.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Select indicates an expected call of Select.
+func (mr *MockSignInLoginAttemptRepositoryMockRecorder) Select(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockSignInLoginAttemptRepository)(nil).Select), arg0, arg1)
+}
+
+// MockSignInRiskEngine is a mock of SignInRiskEngine interface.
+type MockSignInRiskEngine struct {
 	ctrl     *gomock.Controller
-	recorder *MockSignUpCookieServiceMockRecorder
+	recorder *MockSignInRiskEngineMockRecorder
 }
 
-// MockSignUpCookieServiceMockRecorder is the mock recorder for MockSignUpCookieService.
-type MockSignUpCookieServiceMockRecorder struct {
-	mock *MockSignUpCookieService
+// MockSignInRiskEngineMockRecorder is the mock recorder for MockSignInRiskEngine.
+type MockSignInRiskEngineMockRecorder struct {
+	mock *MockSignInRiskEngine
 }
 
-// NewMockSignUpCookieService creates a new mock instance.
-func NewMockSignUpCookieService(ctrl *gomock.Controller) *MockSignUpCookieService {
-	mock := &MockSignUpCookieService{ctrl: ctrl}
-	mock.recorder = &MockSignUpCookieServiceMockRecorder{mock}
+// NewMockSignInRiskEngine creates a new mock instance.
+func NewMockSignInRiskEngine(ctrl *gomock.Controller) *MockSignInRiskEngine {
+	mock := &MockSignInRiskEngine{ctrl: ctrl}
+	mock.recorder = &MockSignInRiskEngineMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSignUpCookieService) EXPECT() *MockSignUpCookieServiceMockRecorder {
+func (m *MockSignInRiskEngine) EXPECT() *MockSignInRiskEngineMockRecorder {
 	return m.recorder
 }
 
-// Set mocks base method.
-func (m *MockSignUpCookieService) Set(w http.ResponseWriter, name, value string, expires time.Time) {
+// Assess mocks base method.
+func (m *MockSignInRiskEngine) Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Set", w, name, value, expires)
+	ret := m.ctrl.Call(m, "Assess", context, userId, userAgent, ip)
+	ret0, _ := ret[0].(entities.RiskAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Set indicates an expected call of Set.
-func (mr *MockSignUpCookieServiceMockRecorder) Set(w, name, value, expires interface{}) *gomock.Call {
+// Assess indicates an expected call of Assess.
+func (mr *MockSignInRiskEngineMockRecorder) Assess(context, userId, userAgent, ip interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockSignUpCookieService)(nil).Set), w, name, value, expires)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assess", reflect.TypeOf((*MockSignInRiskEngine)(nil).Assess), context, userId, userAgent, ip)
 }
 
-// MockGenerateTokensUserRepository is a mock of GenerateTokensUserRepository interface.
-type MockGenerateTokensUserRepository struct {
+// Record mocks base method.
+func (m *MockSignInRiskEngine) Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", context, user, assessment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockSignInRiskEngineMockRecorder) Record(context, user, assessment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockSignInRiskEngine)(nil).Record), context, user, assessment)
+}
+
+// MockSignInAuditLogger is a mock of SignInAuditLogger interface.
+type MockSignInAuditLogger struct {
 	ctrl     *gomock.Controller
-	recorder *MockGenerateTokensUserRepositoryMockRecorder
+	recorder *MockSignInAuditLoggerMockRecorder
 }
 
-// MockGenerateTokensUserRepositoryMockRecorder is the mock recorder for MockGenerateTokensUserRepository.
-type MockGenerateTokensUserRepositoryMockRecorder struct {
-	mock *MockGenerateTokensUserRepository
+// MockSignInAuditLoggerMockRecorder is the mock recorder for MockSignInAuditLogger.
+type MockSignInAuditLoggerMockRecorder struct {
+	mock *MockSignInAuditLogger
 }
 
-// NewMockGenerateTokensUserRepository creates a new mock instance.
-func NewMockGenerateTokensUserRepository(ctrl *gomock.Controller) *MockGenerateTokensUserRepository {
-	mock := &MockGenerateTokensUserRepository{ctrl: ctrl}
-	mock.recorder = &MockGenerateTokensUserRepositoryMockRecorder{mock}
+// NewMockSignInAuditLogger creates a new mock instance.
+func NewMockSignInAuditLogger(ctrl *gomock.Controller) *MockSignInAuditLogger {
+	mock := &MockSignInAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockSignInAuditLoggerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockGenerateTokensUserRepository) EXPECT() *MockGenerateTokensUserRepositoryMockRecorder {
+func (m *MockSignInAuditLogger) EXPECT() *MockSignInAuditLoggerMockRecorder {
 	return m.recorder
 }
 
-// SelectByUserId mocks base method.
-func (m *MockGenerateTokensUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+// Log mocks base method.
+func (m *MockSignInAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
-	ret0, _ := ret[0].(entities.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	m.ctrl.Call(m, "Log", arg0, arg1)
 }
 
-// SelectByUserId indicates an expected call of SelectByUserId.
-func (mr *MockGenerateTokensUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+// Log indicates an expected call of Log.
+func (mr *MockSignInAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockGenerateTokensUserRepository)(nil).SelectByUserId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockSignInAuditLogger)(nil).Log), arg0, arg1)
 }
 
-// MockGenerateTokensSessionRepository is a mock of GenerateTokensSessionRepository interface.
-type MockGenerateTokensSessionRepository struct {
+// MockCompleteSignInSessionRepository is a mock of CompleteSignInSessionRepository interface.
+type MockCompleteSignInSessionRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockGenerateTokensSessionRepositoryMockRecorder
+	recorder *MockCompleteSignInSessionRepositoryMockRecorder
 }
 
-// MockGenerateTokensSessionRepositoryMockRecorder is the mock recorder for MockGenerateTokensSessionRepository.
-type MockGenerateTokensSessionRepositoryMockRecorder struct {
-	mock *MockGenerateTokensSessionRepository
+// MockCompleteSignInSessionRepositoryMockRecorder is the mock recorder for MockCompleteSignInSessionRepository.
+type MockCompleteSignInSessionRepositoryMockRecorder struct {
+	mock *MockCompleteSignInSessionRepository
 }
 
-// NewMockGenerateTokensSessionRepository creates a new mock instance.
-func NewMockGenerateTokensSessionRepository(ctrl *gomock.Controller) *MockGenerateTokensSessionRepository {
-	mock := &MockGenerateTokensSessionRepository{ctrl: ctrl}
-	mock.recorder = &MockGenerateTokensSessionRepositoryMockRecorder{mock}
+// NewMockCompleteSignInSessionRepository creates a new mock instance.
+func NewMockCompleteSignInSessionRepository(ctrl *gomock.Controller) *MockCompleteSignInSessionRepository {
+	mock := &MockCompleteSignInSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockCompleteSignInSessionRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockGenerateTokensSessionRepository) EXPECT() *MockGenerateTokensSessionRepositoryMockRecorder {
+func (m *MockCompleteSignInSessionRepository) EXPECT() *MockCompleteSignInSessionRepositoryMockRecorder {
 	return m.recorder
 }
 
 // DeleteByUserId mocks base method.
-func (m *MockGenerateTokensSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+func (m *MockCompleteSignInSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -499,13 +654,13 @@ func (m *MockGenerateTokensSessionRepository) DeleteByUserId(arg0 context.Contex
 }
 
 // DeleteByUserId indicates an expected call of DeleteByUserId.
-func (mr *MockGenerateTokensSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockCompleteSignInSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockGenerateTokensSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockCompleteSignInSessionRepository)(nil).DeleteByUserId), arg0, arg1)
 }
 
 // Insert mocks base method.
-func (m *MockGenerateTokensSessionRepository) Insert(arg0 context.Context, arg1 entities.Session) error {
+func (m *MockCompleteSignInSessionRepository) Insert(arg0 context.Context, arg1 entities.Session) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -513,403 +668,401 @@ func (m *MockGenerateTokensSessionRepository) Insert(arg0 context.Context, arg1
 }
 
 // Insert indicates an expected call of Insert.
-func (mr *MockGenerateTokensSessionRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockCompleteSignInSessionRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockGenerateTokensSessionRepository)(nil).Insert), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCompleteSignInSessionRepository)(nil).Insert), arg0, arg1)
 }
 
-// MockGenerateTokensHashService is a mock of GenerateTokensHashService interface.
-type MockGenerateTokensHashService struct {
+// MockCompleteSignInOrganizationRepository is a mock of CompleteSignInOrganizationRepository interface.
+type MockCompleteSignInOrganizationRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockGenerateTokensHashServiceMockRecorder
+	recorder *MockCompleteSignInOrganizationRepositoryMockRecorder
 }
 
-// MockGenerateTokensHashServiceMockRecorder is the mock recorder for MockGenerateTokensHashService.
-type MockGenerateTokensHashServiceMockRecorder struct {
-	mock *MockGenerateTokensHashService
+// MockCompleteSignInOrganizationRepositoryMockRecorder is the mock recorder for MockCompleteSignInOrganizationRepository.
+type MockCompleteSignInOrganizationRepositoryMockRecorder struct {
+	mock *MockCompleteSignInOrganizationRepository
 }
 
-// NewMockGenerateTokensHashService creates a new mock instance.
-func NewMockGenerateTokensHashService(ctrl *gomock.Controller) *MockGenerateTokensHashService {
-	mock := &MockGenerateTokensHashService{ctrl: ctrl}
-	mock.recorder = &MockGenerateTokensHashServiceMockRecorder{mock}
+// NewMockCompleteSignInOrganizationRepository creates a new mock instance.
+func NewMockCompleteSignInOrganizationRepository(ctrl *gomock.Controller) *MockCompleteSignInOrganizationRepository {
+	mock := &MockCompleteSignInOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockCompleteSignInOrganizationRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockGenerateTokensHashService) EXPECT() *MockGenerateTokensHashServiceMockRecorder {
+func (m *MockCompleteSignInOrganizationRepository) EXPECT() *MockCompleteSignInOrganizationRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GenerateHash mocks base method.
-func (m *MockGenerateTokensHashService) GenerateHash(stringToHash string) ([]byte, error) {
+// SelectMember mocks base method.
+func (m *MockCompleteSignInOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GenerateHash indicates an expected call of GenerateHash.
-func (mr *MockGenerateTokensHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockCompleteSignInOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockGenerateTokensHashService)(nil).GenerateHash), stringToHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockCompleteSignInOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
 }
 
-// MockGenerateTokensCookieService is a mock of GenerateTokensCookieService interface.
-type MockGenerateTokensCookieService struct {
+// MockCompleteSignInLoginAttemptRepository is a mock of CompleteSignInLoginAttemptRepository interface.
+type MockCompleteSignInLoginAttemptRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockGenerateTokensCookieServiceMockRecorder
+	recorder *MockCompleteSignInLoginAttemptRepositoryMockRecorder
 }
 
-// MockGenerateTokensCookieServiceMockRecorder is the mock recorder for MockGenerateTokensCookieService.
-type MockGenerateTokensCookieServiceMockRecorder struct {
-	mock *MockGenerateTokensCookieService
+// MockCompleteSignInLoginAttemptRepositoryMockRecorder is the mock recorder for MockCompleteSignInLoginAttemptRepository.
+type MockCompleteSignInLoginAttemptRepositoryMockRecorder struct {
+	mock *MockCompleteSignInLoginAttemptRepository
 }
 
-// NewMockGenerateTokensCookieService creates a new mock instance.
-func NewMockGenerateTokensCookieService(ctrl *gomock.Controller) *MockGenerateTokensCookieService {
-	mock := &MockGenerateTokensCookieService{ctrl: ctrl}
-	mock.recorder = &MockGenerateTokensCookieServiceMockRecorder{mock}
+// NewMockCompleteSignInLoginAttemptRepository creates a new mock instance.
+func NewMockCompleteSignInLoginAttemptRepository(ctrl *gomock.Controller) *MockCompleteSignInLoginAttemptRepository {
+	mock := &MockCompleteSignInLoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockCompleteSignInLoginAttemptRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockGenerateTokensCookieService) EXPECT() *MockGenerateTokensCookieServiceMockRecorder {
+func (m *MockCompleteSignInLoginAttemptRepository) EXPECT() *MockCompleteSignInLoginAttemptRepositoryMockRecorder {
 	return m.recorder
 }
 
-// Set mocks base method.
-func (m *MockGenerateTokensCookieService) Set(w http.ResponseWriter, name, value string, expires time.Time) {
+// Reset mocks base method.
+func (m *MockCompleteSignInLoginAttemptRepository) Reset(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Set", w, name, value, expires)
+	ret := m.ctrl.Call(m, "Reset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Set indicates an expected call of Set.
-func (mr *MockGenerateTokensCookieServiceMockRecorder) Set(w, name, value, expires interface{}) *gomock.Call {
+// Reset indicates an expected call of Reset.
+func (mr *MockCompleteSignInLoginAttemptRepositoryMockRecorder) Reset(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockGenerateTokensCookieService)(nil).Set), w, name, value, expires)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockCompleteSignInLoginAttemptRepository)(nil).Reset), arg0, arg1)
 }
 
-// MockGenerateTokensSessionService is a mock of GenerateTokensSessionService interface.
-type MockGenerateTokensSessionService struct {
+// MockCompleteSignInMFARepository is a mock of CompleteSignInMFARepository interface.
+type MockCompleteSignInMFARepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockGenerateTokensSessionServiceMockRecorder
+	recorder *MockCompleteSignInMFARepositoryMockRecorder
 }
 
-// MockGenerateTokensSessionServiceMockRecorder is the mock recorder for MockGenerateTokensSessionService.
-type MockGenerateTokensSessionServiceMockRecorder struct {
-	mock *MockGenerateTokensSessionService
+// MockCompleteSignInMFARepositoryMockRecorder is the mock recorder for MockCompleteSignInMFARepository.
+type MockCompleteSignInMFARepositoryMockRecorder struct {
+	mock *MockCompleteSignInMFARepository
 }
 
-// NewMockGenerateTokensSessionService creates a new mock instance.
-func NewMockGenerateTokensSessionService(ctrl *gomock.Controller) *MockGenerateTokensSessionService {
-	mock := &MockGenerateTokensSessionService{ctrl: ctrl}
-	mock.recorder = &MockGenerateTokensSessionServiceMockRecorder{mock}
+// NewMockCompleteSignInMFARepository creates a new mock instance.
+func NewMockCompleteSignInMFARepository(ctrl *gomock.Controller) *MockCompleteSignInMFARepository {
+	mock := &MockCompleteSignInMFARepository{ctrl: ctrl}
+	mock.recorder = &MockCompleteSignInMFARepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockGenerateTokensSessionService) EXPECT() *MockGenerateTokensSessionServiceMockRecorder {
+func (m *MockCompleteSignInMFARepository) EXPECT() *MockCompleteSignInMFARepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreateSession mocks base method.
-func (m *MockGenerateTokensSessionService) CreateSession(account entities.User) (entities.Session, error) {
+// SelectTOTP mocks base method.
+func (m *MockCompleteSignInMFARepository) SelectTOTP(arg0 context.Context, arg1 string) (entities.TOTP, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", account)
-	ret0, _ := ret[0].(entities.Session)
+	ret := m.ctrl.Call(m, "SelectTOTP", arg0, arg1)
+	ret0, _ := ret[0].(entities.TOTP)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockGenerateTokensSessionServiceMockRecorder) CreateSession(account interface{}) *gomock.Call {
+// SelectTOTP indicates an expected call of SelectTOTP.
+func (mr *MockCompleteSignInMFARepositoryMockRecorder) SelectTOTP(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockGenerateTokensSessionService)(nil).CreateSession), account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectTOTP", reflect.TypeOf((*MockCompleteSignInMFARepository)(nil).SelectTOTP), arg0, arg1)
 }
 
-// MockRefreshSessionUserRepository is a mock of RefreshSessionUserRepository interface.
-type MockRefreshSessionUserRepository struct {
+// MockCompleteSignInWebAuthnRepository is a mock of CompleteSignInWebAuthnRepository interface.
+type MockCompleteSignInWebAuthnRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockRefreshSessionUserRepositoryMockRecorder
+	recorder *MockCompleteSignInWebAuthnRepositoryMockRecorder
 }
 
-// MockRefreshSessionUserRepositoryMockRecorder is the mock recorder for MockRefreshSessionUserRepository.
-type MockRefreshSessionUserRepositoryMockRecorder struct {
-	mock *MockRefreshSessionUserRepository
+// MockCompleteSignInWebAuthnRepositoryMockRecorder is the mock recorder for MockCompleteSignInWebAuthnRepository.
+type MockCompleteSignInWebAuthnRepositoryMockRecorder struct {
+	mock *MockCompleteSignInWebAuthnRepository
 }
 
-// NewMockRefreshSessionUserRepository creates a new mock instance.
-func NewMockRefreshSessionUserRepository(ctrl *gomock.Controller) *MockRefreshSessionUserRepository {
-	mock := &MockRefreshSessionUserRepository{ctrl: ctrl}
-	mock.recorder = &MockRefreshSessionUserRepositoryMockRecorder{mock}
+// NewMockCompleteSignInWebAuthnRepository creates a new mock instance.
+func NewMockCompleteSignInWebAuthnRepository(ctrl *gomock.Controller) *MockCompleteSignInWebAuthnRepository {
+	mock := &MockCompleteSignInWebAuthnRepository{ctrl: ctrl}
+	mock.recorder = &MockCompleteSignInWebAuthnRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRefreshSessionUserRepository) EXPECT() *MockRefreshSessionUserRepositoryMockRecorder {
+func (m *MockCompleteSignInWebAuthnRepository) EXPECT() *MockCompleteSignInWebAuthnRepositoryMockRecorder {
 	return m.recorder
 }
 
-// SelectByUserId mocks base method.
-func (m *MockRefreshSessionUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+// SelectCredentials mocks base method.
+func (m *MockCompleteSignInWebAuthnRepository) SelectCredentials(arg0 context.Context, arg1 string) ([]entities.WebAuthnCredential, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
-	ret0, _ := ret[0].(entities.User)
+	ret := m.ctrl.Call(m, "SelectCredentials", arg0, arg1)
+	ret0, _ := ret[0].([]entities.WebAuthnCredential)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SelectByUserId indicates an expected call of SelectByUserId.
-func (mr *MockRefreshSessionUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+// SelectCredentials indicates an expected call of SelectCredentials.
+func (mr *MockCompleteSignInWebAuthnRepositoryMockRecorder) SelectCredentials(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockRefreshSessionUserRepository)(nil).SelectByUserId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCredentials", reflect.TypeOf((*MockCompleteSignInWebAuthnRepository)(nil).SelectCredentials), arg0, arg1)
 }
 
-// MockRefreshSessionSessionRepository is a mock of RefreshSessionSessionRepository interface.
-type MockRefreshSessionSessionRepository struct {
+// MockCompleteSignInHashService is a mock of CompleteSignInHashService interface.
+type MockCompleteSignInHashService struct {
 	ctrl     *gomock.Controller
-	recorder *MockRefreshSessionSessionRepositoryMockRecorder
+	recorder *MockCompleteSignInHashServiceMockRecorder
 }
 
-// MockRefreshSessionSessionRepositoryMockRecorder is the mock recorder for MockRefreshSessionSessionRepository.
-type MockRefreshSessionSessionRepositoryMockRecorder struct {
-	mock *MockRefreshSessionSessionRepository
+// MockCompleteSignInHashServiceMockRecorder is the mock recorder for MockCompleteSignInHashService.
+type MockCompleteSignInHashServiceMockRecorder struct {
+	mock *MockCompleteSignInHashService
 }
 
-// NewMockRefreshSessionSessionRepository creates a new mock instance.
-func NewMockRefreshSessionSessionRepository(ctrl *gomock.Controller) *MockRefreshSessionSessionRepository {
-	mock := &MockRefreshSessionSessionRepository{ctrl: ctrl}
-	mock.recorder = &MockRefreshSessionSessionRepositoryMockRecorder{mock}
+// NewMockCompleteSignInHashService creates a new mock instance.
+func NewMockCompleteSignInHashService(ctrl *gomock.Controller) *MockCompleteSignInHashService {
+	mock := &MockCompleteSignInHashService{ctrl: ctrl}
+	mock.recorder = &MockCompleteSignInHashServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRefreshSessionSessionRepository) EXPECT() *MockRefreshSessionSessionRepositoryMockRecorder {
+func (m *MockCompleteSignInHashService) EXPECT() *MockCompleteSignInHashServiceMockRecorder {
 	return m.recorder
 }
 
-// DeleteByUserId mocks base method.
-func (m *MockRefreshSessionSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteByUserId indicates an expected call of DeleteByUserId.
-func (mr *MockRefreshSessionSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockRefreshSessionSessionRepository)(nil).DeleteByUserId), arg0, arg1)
-}
-
-// SelectByUserId mocks base method.
-func (m *MockRefreshSessionSessionRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.Session, error) {
+// GenerateHash mocks base method.
+func (m *MockCompleteSignInHashService) GenerateHash(stringToHash string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
-	ret0, _ := ret[0].(entities.Session)
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SelectByUserId indicates an expected call of SelectByUserId.
-func (mr *MockRefreshSessionSessionRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockRefreshSessionSessionRepository)(nil).SelectByUserId), arg0, arg1)
-}
-
-// Update mocks base method.
-func (m *MockRefreshSessionSessionRepository) Update(context context.Context, session entities.Session) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", context, session)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Update indicates an expected call of Update.
-func (mr *MockRefreshSessionSessionRepositoryMockRecorder) Update(context, session interface{}) *gomock.Call {
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockCompleteSignInHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRefreshSessionSessionRepository)(nil).Update), context, session)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockCompleteSignInHashService)(nil).GenerateHash), stringToHash)
 }
 
-// MockRefreshSessionSessionService is a mock of RefreshSessionSessionService interface.
-type MockRefreshSessionSessionService struct {
+// MockCompleteSignInSessionService is a mock of CompleteSignInSessionService interface.
+type MockCompleteSignInSessionService struct {
 	ctrl     *gomock.Controller
-	recorder *MockRefreshSessionSessionServiceMockRecorder
+	recorder *MockCompleteSignInSessionServiceMockRecorder
 }
 
-// MockRefreshSessionSessionServiceMockRecorder is the mock recorder for MockRefreshSessionSessionService.
-type MockRefreshSessionSessionServiceMockRecorder struct {
-	mock *MockRefreshSessionSessionService
+// MockCompleteSignInSessionServiceMockRecorder is the mock recorder for MockCompleteSignInSessionService.
+type MockCompleteSignInSessionServiceMockRecorder struct {
+	mock *MockCompleteSignInSessionService
 }
 
-// NewMockRefreshSessionSessionService creates a new mock instance.
-func NewMockRefreshSessionSessionService(ctrl *gomock.Controller) *MockRefreshSessionSessionService {
-	mock := &MockRefreshSessionSessionService{ctrl: ctrl}
-	mock.recorder = &MockRefreshSessionSessionServiceMockRecorder{mock}
+// NewMockCompleteSignInSessionService creates a new mock instance.
+func NewMockCompleteSignInSessionService(ctrl *gomock.Controller) *MockCompleteSignInSessionService {
+	mock := &MockCompleteSignInSessionService{ctrl: ctrl}
+	mock.recorder = &MockCompleteSignInSessionServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRefreshSessionSessionService) EXPECT() *MockRefreshSessionSessionServiceMockRecorder {
+func (m *MockCompleteSignInSessionService) EXPECT() *MockCompleteSignInSessionServiceMockRecorder {
 	return m.recorder
 }
 
-// CreateSession mocks base method.
-func (m *MockRefreshSessionSessionService) CreateSession(account entities.User) (entities.Session, error) {
+// CreateRestrictedToken mocks base method.
+func (m *MockCompleteSignInSessionService) CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", account)
-	ret0, _ := ret[0].(entities.Session)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateRestrictedToken", account, scope, authentication)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockRefreshSessionSessionServiceMockRecorder) CreateSession(account interface{}) *gomock.Call {
+// CreateRestrictedToken indicates an expected call of CreateRestrictedToken.
+func (mr *MockCompleteSignInSessionServiceMockRecorder) CreateRestrictedToken(account, scope, authentication interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRefreshSessionSessionService)(nil).CreateSession), account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestrictedToken", reflect.TypeOf((*MockCompleteSignInSessionService)(nil).CreateRestrictedToken), account, scope, authentication)
 }
 
-// ParseToken mocks base method.
-func (m *MockRefreshSessionSessionService) ParseToken(token string) (entities.AccessTokenClaims, error) {
+// CreateSession mocks base method.
+func (m *MockCompleteSignInSessionService) CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ParseToken", token)
-	ret0, _ := ret[0].(entities.AccessTokenClaims)
+	ret := m.ctrl.Call(m, "CreateSession", account, membership, authentication)
+	ret0, _ := ret[0].(entities.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ParseToken indicates an expected call of ParseToken.
-func (mr *MockRefreshSessionSessionServiceMockRecorder) ParseToken(token interface{}) *gomock.Call {
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockCompleteSignInSessionServiceMockRecorder) CreateSession(account, membership, authentication interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockRefreshSessionSessionService)(nil).ParseToken), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockCompleteSignInSessionService)(nil).CreateSession), account, membership, authentication)
 }
 
-// MockRefreshSessionCookieService is a mock of RefreshSessionCookieService interface.
-type MockRefreshSessionCookieService struct {
+// MockCompleteSignInCookieService is a mock of CompleteSignInCookieService interface.
+type MockCompleteSignInCookieService struct {
 	ctrl     *gomock.Controller
-	recorder *MockRefreshSessionCookieServiceMockRecorder
+	recorder *MockCompleteSignInCookieServiceMockRecorder
 }
 
-// MockRefreshSessionCookieServiceMockRecorder is the mock recorder for MockRefreshSessionCookieService.
-type MockRefreshSessionCookieServiceMockRecorder struct {
-	mock *MockRefreshSessionCookieService
+// MockCompleteSignInCookieServiceMockRecorder is the mock recorder for MockCompleteSignInCookieService.
+type MockCompleteSignInCookieServiceMockRecorder struct {
+	mock *MockCompleteSignInCookieService
 }
 
-// NewMockRefreshSessionCookieService creates a new mock instance.
-func NewMockRefreshSessionCookieService(ctrl *gomock.Controller) *MockRefreshSessionCookieService {
-	mock := &MockRefreshSessionCookieService{ctrl: ctrl}
-	mock.recorder = &MockRefreshSessionCookieServiceMockRecorder{mock}
+// NewMockCompleteSignInCookieService creates a new mock instance.
+func NewMockCompleteSignInCookieService(ctrl *gomock.Controller) *MockCompleteSignInCookieService {
+	mock := &MockCompleteSignInCookieService{ctrl: ctrl}
+	mock.recorder = &MockCompleteSignInCookieServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRefreshSessionCookieService) EXPECT() *MockRefreshSessionCookieServiceMockRecorder {
+func (m *MockCompleteSignInCookieService) EXPECT() *MockCompleteSignInCookieServiceMockRecorder {
 	return m.recorder
 }
 
-// Clear mocks base method.
-func (m *MockRefreshSessionCookieService) Clear(w http.ResponseWriter, name string) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Clear", w, name)
-}
-
-// Clear indicates an expected call of Clear.
-func (mr *MockRefreshSessionCookieServiceMockRecorder) Clear(w, name interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockRefreshSessionCookieService)(nil).Clear), w, name)
-}
-
 // Set mocks base method.
-func (m *MockRefreshSessionCookieService) Set(w http.ResponseWriter, name, value string, expires time.Time) {
+func (m *MockCompleteSignInCookieService) Set(w http.ResponseWriter, name, value string, expires time.Time) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "Set", w, name, value, expires)
 }
 
 // Set indicates an expected call of Set.
-func (mr *MockRefreshSessionCookieServiceMockRecorder) Set(w, name, value, expires interface{}) *gomock.Call {
+func (mr *MockCompleteSignInCookieServiceMockRecorder) Set(w, name, value, expires interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRefreshSessionCookieService)(nil).Set), w, name, value, expires)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCompleteSignInCookieService)(nil).Set), w, name, value, expires)
 }
 
-// MockRefreshSessionHashProvider is a mock of RefreshSessionHashProvider interface.
-type MockRefreshSessionHashProvider struct {
+// MockCompleteSignInRiskEngine is a mock of CompleteSignInRiskEngine interface.
+type MockCompleteSignInRiskEngine struct {
 	ctrl     *gomock.Controller
-	recorder *MockRefreshSessionHashProviderMockRecorder
+	recorder *MockCompleteSignInRiskEngineMockRecorder
 }
 
-// MockRefreshSessionHashProviderMockRecorder is the mock recorder for MockRefreshSessionHashProvider.
-type MockRefreshSessionHashProviderMockRecorder struct {
-	mock *MockRefreshSessionHashProvider
+// MockCompleteSignInRiskEngineMockRecorder is the mock recorder for MockCompleteSignInRiskEngine.
+type MockCompleteSignInRiskEngineMockRecorder struct {
+	mock *MockCompleteSignInRiskEngine
 }
 
-// NewMockRefreshSessionHashProvider creates a new mock instance.
-func NewMockRefreshSessionHashProvider(ctrl *gomock.Controller) *MockRefreshSessionHashProvider {
-	mock := &MockRefreshSessionHashProvider{ctrl: ctrl}
-	mock.recorder = &MockRefreshSessionHashProviderMockRecorder{mock}
+// NewMockCompleteSignInRiskEngine creates a new mock instance.
+func NewMockCompleteSignInRiskEngine(ctrl *gomock.Controller) *MockCompleteSignInRiskEngine {
+	mock := &MockCompleteSignInRiskEngine{ctrl: ctrl}
+	mock.recorder = &MockCompleteSignInRiskEngineMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRefreshSessionHashProvider) EXPECT() *MockRefreshSessionHashProviderMockRecorder {
+func (m *MockCompleteSignInRiskEngine) EXPECT() *MockCompleteSignInRiskEngineMockRecorder {
 	return m.recorder
 }
 
-// CompareStringAndHash mocks base method.
-func (m *MockRefreshSessionHashProvider) CompareStringAndHash(arg0, arg1 string) bool {
+// Assess mocks base method.
+func (m *MockCompleteSignInRiskEngine) Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CompareStringAndHash", arg0, arg1)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "Assess", context, userId, userAgent, ip)
+	ret0, _ := ret[0].(entities.RiskAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assess indicates an expected call of Assess.
+func (mr *MockCompleteSignInRiskEngineMockRecorder) Assess(context, userId, userAgent, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assess", reflect.TypeOf((*MockCompleteSignInRiskEngine)(nil).Assess), context, userId, userAgent, ip)
+}
+
+// Record mocks base method.
+func (m *MockCompleteSignInRiskEngine) Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", context, user, assessment)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CompareStringAndHash indicates an expected call of CompareStringAndHash.
-func (mr *MockRefreshSessionHashProviderMockRecorder) CompareStringAndHash(arg0, arg1 interface{}) *gomock.Call {
+// Record indicates an expected call of Record.
+func (mr *MockCompleteSignInRiskEngineMockRecorder) Record(context, user, assessment interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareStringAndHash", reflect.TypeOf((*MockRefreshSessionHashProvider)(nil).CompareStringAndHash), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockCompleteSignInRiskEngine)(nil).Record), context, user, assessment)
 }
 
-// GenerateHash mocks base method.
-func (m *MockRefreshSessionHashProvider) GenerateHash(stringToHash string) ([]byte, error) {
+// MockCompleteSignInAuditLogger is a mock of CompleteSignInAuditLogger interface.
+type MockCompleteSignInAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompleteSignInAuditLoggerMockRecorder
+}
+
+// MockCompleteSignInAuditLoggerMockRecorder is the mock recorder for MockCompleteSignInAuditLogger.
+type MockCompleteSignInAuditLoggerMockRecorder struct {
+	mock *MockCompleteSignInAuditLogger
+}
+
+// NewMockCompleteSignInAuditLogger creates a new mock instance.
+func NewMockCompleteSignInAuditLogger(ctrl *gomock.Controller) *MockCompleteSignInAuditLogger {
+	mock := &MockCompleteSignInAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockCompleteSignInAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompleteSignInAuditLogger) EXPECT() *MockCompleteSignInAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockCompleteSignInAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
-	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	m.ctrl.Call(m, "Log", arg0, arg1)
 }
 
-// GenerateHash indicates an expected call of GenerateHash.
-func (mr *MockRefreshSessionHashProviderMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+// Log indicates an expected call of Log.
+func (mr *MockCompleteSignInAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockRefreshSessionHashProvider)(nil).GenerateHash), stringToHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockCompleteSignInAuditLogger)(nil).Log), arg0, arg1)
 }
 
-// MockGetUserUserRepository is a mock of GetUserUserRepository interface.
-type MockGetUserUserRepository struct {
+// MockVerifyMFAUserRepository is a mock of VerifyMFAUserRepository interface.
+type MockVerifyMFAUserRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockGetUserUserRepositoryMockRecorder
+	recorder *MockVerifyMFAUserRepositoryMockRecorder
 }
 
-// MockGetUserUserRepositoryMockRecorder is the mock recorder for MockGetUserUserRepository.
-type MockGetUserUserRepositoryMockRecorder struct {
-	mock *MockGetUserUserRepository
+// MockVerifyMFAUserRepositoryMockRecorder is the mock recorder for MockVerifyMFAUserRepository.
+type MockVerifyMFAUserRepositoryMockRecorder struct {
+	mock *MockVerifyMFAUserRepository
 }
 
-// NewMockGetUserUserRepository creates a new mock instance.
-func NewMockGetUserUserRepository(ctrl *gomock.Controller) *MockGetUserUserRepository {
-	mock := &MockGetUserUserRepository{ctrl: ctrl}
-	mock.recorder = &MockGetUserUserRepositoryMockRecorder{mock}
+// NewMockVerifyMFAUserRepository creates a new mock instance.
+func NewMockVerifyMFAUserRepository(ctrl *gomock.Controller) *MockVerifyMFAUserRepository {
+	mock := &MockVerifyMFAUserRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFAUserRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockGetUserUserRepository) EXPECT() *MockGetUserUserRepositoryMockRecorder {
+func (m *MockVerifyMFAUserRepository) EXPECT() *MockVerifyMFAUserRepositoryMockRecorder {
 	return m.recorder
 }
 
 // SelectByUserId mocks base method.
-func (m *MockGetUserUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+func (m *MockVerifyMFAUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
 	ret0, _ := ret[0].(entities.User)
@@ -918,36 +1071,36 @@ func (m *MockGetUserUserRepository) SelectByUserId(arg0 context.Context, arg1 st
 }
 
 // SelectByUserId indicates an expected call of SelectByUserId.
-func (mr *MockGetUserUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockVerifyMFAUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockGetUserUserRepository)(nil).SelectByUserId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockVerifyMFAUserRepository)(nil).SelectByUserId), arg0, arg1)
 }
 
-// MockLogoutSessionRepository is a mock of LogoutSessionRepository interface.
-type MockLogoutSessionRepository struct {
+// MockVerifyMFASessionRepository is a mock of VerifyMFASessionRepository interface.
+type MockVerifyMFASessionRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockLogoutSessionRepositoryMockRecorder
+	recorder *MockVerifyMFASessionRepositoryMockRecorder
 }
 
-// MockLogoutSessionRepositoryMockRecorder is the mock recorder for MockLogoutSessionRepository.
-type MockLogoutSessionRepositoryMockRecorder struct {
-	mock *MockLogoutSessionRepository
+// MockVerifyMFASessionRepositoryMockRecorder is the mock recorder for MockVerifyMFASessionRepository.
+type MockVerifyMFASessionRepositoryMockRecorder struct {
+	mock *MockVerifyMFASessionRepository
 }
 
-// NewMockLogoutSessionRepository creates a new mock instance.
-func NewMockLogoutSessionRepository(ctrl *gomock.Controller) *MockLogoutSessionRepository {
-	mock := &MockLogoutSessionRepository{ctrl: ctrl}
-	mock.recorder = &MockLogoutSessionRepositoryMockRecorder{mock}
+// NewMockVerifyMFASessionRepository creates a new mock instance.
+func NewMockVerifyMFASessionRepository(ctrl *gomock.Controller) *MockVerifyMFASessionRepository {
+	mock := &MockVerifyMFASessionRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFASessionRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockLogoutSessionRepository) EXPECT() *MockLogoutSessionRepositoryMockRecorder {
+func (m *MockVerifyMFASessionRepository) EXPECT() *MockVerifyMFASessionRepositoryMockRecorder {
 	return m.recorder
 }
 
 // DeleteByUserId mocks base method.
-func (m *MockLogoutSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+func (m *MockVerifyMFASessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -955,71 +1108,148 @@ func (m *MockLogoutSessionRepository) DeleteByUserId(arg0 context.Context, arg1
 }
 
 // DeleteByUserId indicates an expected call of DeleteByUserId.
-func (mr *MockLogoutSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockVerifyMFASessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockLogoutSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockVerifyMFASessionRepository)(nil).DeleteByUserId), arg0, arg1)
 }
 
-// MockLogoutCookieService is a mock of LogoutCookieService interface.
-type MockLogoutCookieService struct {
+// Insert mocks base method.
+func (m *MockVerifyMFASessionRepository) Insert(arg0 context.Context, arg1 entities.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockVerifyMFASessionRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockVerifyMFASessionRepository)(nil).Insert), arg0, arg1)
+}
+
+// MockVerifyMFAHashService is a mock of VerifyMFAHashService interface.
+type MockVerifyMFAHashService struct {
 	ctrl     *gomock.Controller
-	recorder *MockLogoutCookieServiceMockRecorder
+	recorder *MockVerifyMFAHashServiceMockRecorder
 }
 
-// MockLogoutCookieServiceMockRecorder is the mock recorder for MockLogoutCookieService.
-type MockLogoutCookieServiceMockRecorder struct {
-	mock *MockLogoutCookieService
+// MockVerifyMFAHashServiceMockRecorder is the mock recorder for MockVerifyMFAHashService.
+type MockVerifyMFAHashServiceMockRecorder struct {
+	mock *MockVerifyMFAHashService
 }
 
-// NewMockLogoutCookieService creates a new mock instance.
-func NewMockLogoutCookieService(ctrl *gomock.Controller) *MockLogoutCookieService {
-	mock := &MockLogoutCookieService{ctrl: ctrl}
-	mock.recorder = &MockLogoutCookieServiceMockRecorder{mock}
+// NewMockVerifyMFAHashService creates a new mock instance.
+func NewMockVerifyMFAHashService(ctrl *gomock.Controller) *MockVerifyMFAHashService {
+	mock := &MockVerifyMFAHashService{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFAHashServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockLogoutCookieService) EXPECT() *MockLogoutCookieServiceMockRecorder {
+func (m *MockVerifyMFAHashService) EXPECT() *MockVerifyMFAHashServiceMockRecorder {
 	return m.recorder
 }
 
-// Clear mocks base method.
-func (m *MockLogoutCookieService) Clear(w http.ResponseWriter, name string) {
+// CompareStringAndHash mocks base method.
+func (m *MockVerifyMFAHashService) CompareStringAndHash(arg0, arg1 string) bool {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Clear", w, name)
+	ret := m.ctrl.Call(m, "CompareStringAndHash", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
 }
 
-// Clear indicates an expected call of Clear.
-func (mr *MockLogoutCookieServiceMockRecorder) Clear(w, name interface{}) *gomock.Call {
+// CompareStringAndHash indicates an expected call of CompareStringAndHash.
+func (mr *MockVerifyMFAHashServiceMockRecorder) CompareStringAndHash(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockLogoutCookieService)(nil).Clear), w, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareStringAndHash", reflect.TypeOf((*MockVerifyMFAHashService)(nil).CompareStringAndHash), arg0, arg1)
 }
 
-// MockLogoutSessionService is a mock of LogoutSessionService interface.
-type MockLogoutSessionService struct {
-	ctrl     *gomock.Controller
-	recorder *MockLogoutSessionServiceMockRecorder
+// GenerateHash mocks base method.
+func (m *MockVerifyMFAHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockLogoutSessionServiceMockRecorder is the mock recorder for MockLogoutSessionService.
-type MockLogoutSessionServiceMockRecorder struct {
-	mock *MockLogoutSessionService
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockVerifyMFAHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockVerifyMFAHashService)(nil).GenerateHash), stringToHash)
 }
 
-// NewMockLogoutSessionService creates a new mock instance.
-func NewMockLogoutSessionService(ctrl *gomock.Controller) *MockLogoutSessionService {
-	mock := &MockLogoutSessionService{ctrl: ctrl}
-	mock.recorder = &MockLogoutSessionServiceMockRecorder{mock}
+// MockVerifyMFASessionService is a mock of VerifyMFASessionService interface.
+type MockVerifyMFASessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFASessionServiceMockRecorder
+}
+
+// MockVerifyMFASessionServiceMockRecorder is the mock recorder for MockVerifyMFASessionService.
+type MockVerifyMFASessionServiceMockRecorder struct {
+	mock *MockVerifyMFASessionService
+}
+
+// NewMockVerifyMFASessionService creates a new mock instance.
+func NewMockVerifyMFASessionService(ctrl *gomock.Controller) *MockVerifyMFASessionService {
+	mock := &MockVerifyMFASessionService{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFASessionServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockLogoutSessionService) EXPECT() *MockLogoutSessionServiceMockRecorder {
+func (m *MockVerifyMFASessionService) EXPECT() *MockVerifyMFASessionServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateDeviceToken mocks base method.
+func (m *MockVerifyMFASessionService) CreateDeviceToken(account entities.User, deviceId string, expiresAt time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeviceToken", account, deviceId, expiresAt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeviceToken indicates an expected call of CreateDeviceToken.
+func (mr *MockVerifyMFASessionServiceMockRecorder) CreateDeviceToken(account, deviceId, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceToken", reflect.TypeOf((*MockVerifyMFASessionService)(nil).CreateDeviceToken), account, deviceId, expiresAt)
+}
+
+// CreateRestrictedToken mocks base method.
+func (m *MockVerifyMFASessionService) CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRestrictedToken", account, scope, authentication)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRestrictedToken indicates an expected call of CreateRestrictedToken.
+func (mr *MockVerifyMFASessionServiceMockRecorder) CreateRestrictedToken(account, scope, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestrictedToken", reflect.TypeOf((*MockVerifyMFASessionService)(nil).CreateRestrictedToken), account, scope, authentication)
+}
+
+// CreateSession mocks base method.
+func (m *MockVerifyMFASessionService) CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", account, membership, authentication)
+	ret0, _ := ret[0].(entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockVerifyMFASessionServiceMockRecorder) CreateSession(account, membership, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockVerifyMFASessionService)(nil).CreateSession), account, membership, authentication)
+}
+
 // ParseToken mocks base method.
-func (m *MockLogoutSessionService) ParseToken(token string) (entities.AccessTokenClaims, error) {
+func (m *MockVerifyMFASessionService) ParseToken(token string) (entities.AccessTokenClaims, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ParseToken", token)
 	ret0, _ := ret[0].(entities.AccessTokenClaims)
@@ -1028,7 +1258,7840 @@ func (m *MockLogoutSessionService) ParseToken(token string) (entities.AccessToke
 }
 
 // ParseToken indicates an expected call of ParseToken.
-func (mr *MockLogoutSessionServiceMockRecorder) ParseToken(token interface{}) *gomock.Call {
+func (mr *MockVerifyMFASessionServiceMockRecorder) ParseToken(token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockLogoutSessionService)(nil).ParseToken), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockVerifyMFASessionService)(nil).ParseToken), token)
+}
+
+// MockVerifyMFACookieService is a mock of VerifyMFACookieService interface.
+type MockVerifyMFACookieService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFACookieServiceMockRecorder
+}
+
+// MockVerifyMFACookieServiceMockRecorder is the mock recorder for MockVerifyMFACookieService.
+type MockVerifyMFACookieServiceMockRecorder struct {
+	mock *MockVerifyMFACookieService
+}
+
+// NewMockVerifyMFACookieService creates a new mock instance.
+func NewMockVerifyMFACookieService(ctrl *gomock.Controller) *MockVerifyMFACookieService {
+	mock := &MockVerifyMFACookieService{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFACookieServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFACookieService) EXPECT() *MockVerifyMFACookieServiceMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockVerifyMFACookieService) Set(w http.ResponseWriter, name, value string, expires time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", w, name, value, expires)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockVerifyMFACookieServiceMockRecorder) Set(w, name, value, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockVerifyMFACookieService)(nil).Set), w, name, value, expires)
+}
+
+// MockVerifyMFAOrganizationRepository is a mock of VerifyMFAOrganizationRepository interface.
+type MockVerifyMFAOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFAOrganizationRepositoryMockRecorder
+}
+
+// MockVerifyMFAOrganizationRepositoryMockRecorder is the mock recorder for MockVerifyMFAOrganizationRepository.
+type MockVerifyMFAOrganizationRepositoryMockRecorder struct {
+	mock *MockVerifyMFAOrganizationRepository
+}
+
+// NewMockVerifyMFAOrganizationRepository creates a new mock instance.
+func NewMockVerifyMFAOrganizationRepository(ctrl *gomock.Controller) *MockVerifyMFAOrganizationRepository {
+	mock := &MockVerifyMFAOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFAOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFAOrganizationRepository) EXPECT() *MockVerifyMFAOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectMember mocks base method.
+func (m *MockVerifyMFAOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockVerifyMFAOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockVerifyMFAOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
+}
+
+// MockVerifyMFAMFARepository is a mock of VerifyMFAMFARepository interface.
+type MockVerifyMFAMFARepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFAMFARepositoryMockRecorder
+}
+
+// MockVerifyMFAMFARepositoryMockRecorder is the mock recorder for MockVerifyMFAMFARepository.
+type MockVerifyMFAMFARepositoryMockRecorder struct {
+	mock *MockVerifyMFAMFARepository
+}
+
+// NewMockVerifyMFAMFARepository creates a new mock instance.
+func NewMockVerifyMFAMFARepository(ctrl *gomock.Controller) *MockVerifyMFAMFARepository {
+	mock := &MockVerifyMFAMFARepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFAMFARepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFAMFARepository) EXPECT() *MockVerifyMFAMFARepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectTOTP mocks base method.
+func (m *MockVerifyMFAMFARepository) SelectTOTP(arg0 context.Context, arg1 string) (entities.TOTP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectTOTP", arg0, arg1)
+	ret0, _ := ret[0].(entities.TOTP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectTOTP indicates an expected call of SelectTOTP.
+func (mr *MockVerifyMFAMFARepositoryMockRecorder) SelectTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectTOTP", reflect.TypeOf((*MockVerifyMFAMFARepository)(nil).SelectTOTP), arg0, arg1)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockVerifyMFAMFARepository) UseRecoveryCode(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockVerifyMFAMFARepositoryMockRecorder) UseRecoveryCode(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockVerifyMFAMFARepository)(nil).UseRecoveryCode), arg0, arg1, arg2)
+}
+
+// UseTOTPStep mocks base method.
+func (m *MockVerifyMFAMFARepository) UseTOTPStep(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTOTPStep", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseTOTPStep indicates an expected call of UseTOTPStep.
+func (mr *MockVerifyMFAMFARepositoryMockRecorder) UseTOTPStep(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTOTPStep", reflect.TypeOf((*MockVerifyMFAMFARepository)(nil).UseTOTPStep), arg0, arg1, arg2)
+}
+
+// MockVerifyMFAMFAService is a mock of VerifyMFAMFAService interface.
+type MockVerifyMFAMFAService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFAMFAServiceMockRecorder
+}
+
+// MockVerifyMFAMFAServiceMockRecorder is the mock recorder for MockVerifyMFAMFAService.
+type MockVerifyMFAMFAServiceMockRecorder struct {
+	mock *MockVerifyMFAMFAService
+}
+
+// NewMockVerifyMFAMFAService creates a new mock instance.
+func NewMockVerifyMFAMFAService(ctrl *gomock.Controller) *MockVerifyMFAMFAService {
+	mock := &MockVerifyMFAMFAService{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFAMFAServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFAMFAService) EXPECT() *MockVerifyMFAMFAServiceMockRecorder {
+	return m.recorder
+}
+
+// HashRecoveryCode mocks base method.
+func (m *MockVerifyMFAMFAService) HashRecoveryCode(code string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashRecoveryCode", code)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashRecoveryCode indicates an expected call of HashRecoveryCode.
+func (mr *MockVerifyMFAMFAServiceMockRecorder) HashRecoveryCode(code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashRecoveryCode", reflect.TypeOf((*MockVerifyMFAMFAService)(nil).HashRecoveryCode), code)
+}
+
+// Validate mocks base method.
+func (m *MockVerifyMFAMFAService) Validate(secret, code string, lastUsedStep int64) (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", secret, code, lastUsedStep)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockVerifyMFAMFAServiceMockRecorder) Validate(secret, code, lastUsedStep interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockVerifyMFAMFAService)(nil).Validate), secret, code, lastUsedStep)
+}
+
+// MockVerifyMFAEncryptionService is a mock of VerifyMFAEncryptionService interface.
+type MockVerifyMFAEncryptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFAEncryptionServiceMockRecorder
+}
+
+// MockVerifyMFAEncryptionServiceMockRecorder is the mock recorder for MockVerifyMFAEncryptionService.
+type MockVerifyMFAEncryptionServiceMockRecorder struct {
+	mock *MockVerifyMFAEncryptionService
+}
+
+// NewMockVerifyMFAEncryptionService creates a new mock instance.
+func NewMockVerifyMFAEncryptionService(ctrl *gomock.Controller) *MockVerifyMFAEncryptionService {
+	mock := &MockVerifyMFAEncryptionService{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFAEncryptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFAEncryptionService) EXPECT() *MockVerifyMFAEncryptionServiceMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockVerifyMFAEncryptionService) Decrypt(ciphertext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ciphertext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockVerifyMFAEncryptionServiceMockRecorder) Decrypt(ciphertext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockVerifyMFAEncryptionService)(nil).Decrypt), ciphertext)
+}
+
+// MockVerifyMFAWebAuthnRepository is a mock of VerifyMFAWebAuthnRepository interface.
+type MockVerifyMFAWebAuthnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFAWebAuthnRepositoryMockRecorder
+}
+
+// MockVerifyMFAWebAuthnRepositoryMockRecorder is the mock recorder for MockVerifyMFAWebAuthnRepository.
+type MockVerifyMFAWebAuthnRepositoryMockRecorder struct {
+	mock *MockVerifyMFAWebAuthnRepository
+}
+
+// NewMockVerifyMFAWebAuthnRepository creates a new mock instance.
+func NewMockVerifyMFAWebAuthnRepository(ctrl *gomock.Controller) *MockVerifyMFAWebAuthnRepository {
+	mock := &MockVerifyMFAWebAuthnRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFAWebAuthnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFAWebAuthnRepository) EXPECT() *MockVerifyMFAWebAuthnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectCredentials mocks base method.
+func (m *MockVerifyMFAWebAuthnRepository) SelectCredentials(arg0 context.Context, arg1 string) ([]entities.WebAuthnCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCredentials", arg0, arg1)
+	ret0, _ := ret[0].([]entities.WebAuthnCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCredentials indicates an expected call of SelectCredentials.
+func (mr *MockVerifyMFAWebAuthnRepositoryMockRecorder) SelectCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCredentials", reflect.TypeOf((*MockVerifyMFAWebAuthnRepository)(nil).SelectCredentials), arg0, arg1)
+}
+
+// MockVerifyMFATrustedDeviceRepository is a mock of VerifyMFATrustedDeviceRepository interface.
+type MockVerifyMFATrustedDeviceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFATrustedDeviceRepositoryMockRecorder
+}
+
+// MockVerifyMFATrustedDeviceRepositoryMockRecorder is the mock recorder for MockVerifyMFATrustedDeviceRepository.
+type MockVerifyMFATrustedDeviceRepositoryMockRecorder struct {
+	mock *MockVerifyMFATrustedDeviceRepository
+}
+
+// NewMockVerifyMFATrustedDeviceRepository creates a new mock instance.
+func NewMockVerifyMFATrustedDeviceRepository(ctrl *gomock.Controller) *MockVerifyMFATrustedDeviceRepository {
+	mock := &MockVerifyMFATrustedDeviceRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFATrustedDeviceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFATrustedDeviceRepository) EXPECT() *MockVerifyMFATrustedDeviceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockVerifyMFATrustedDeviceRepository) Insert(arg0 context.Context, arg1 entities.TrustedDevice) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockVerifyMFATrustedDeviceRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockVerifyMFATrustedDeviceRepository)(nil).Insert), arg0, arg1)
+}
+
+// MockVerifyMFASMSCodeRepository is a mock of VerifyMFASMSCodeRepository interface.
+type MockVerifyMFASMSCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFASMSCodeRepositoryMockRecorder
+}
+
+// MockVerifyMFASMSCodeRepositoryMockRecorder is the mock recorder for MockVerifyMFASMSCodeRepository.
+type MockVerifyMFASMSCodeRepositoryMockRecorder struct {
+	mock *MockVerifyMFASMSCodeRepository
+}
+
+// NewMockVerifyMFASMSCodeRepository creates a new mock instance.
+func NewMockVerifyMFASMSCodeRepository(ctrl *gomock.Controller) *MockVerifyMFASMSCodeRepository {
+	mock := &MockVerifyMFASMSCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFASMSCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFASMSCodeRepository) EXPECT() *MockVerifyMFASMSCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteCode mocks base method.
+func (m *MockVerifyMFASMSCodeRepository) DeleteCode(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCode indicates an expected call of DeleteCode.
+func (mr *MockVerifyMFASMSCodeRepositoryMockRecorder) DeleteCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCode", reflect.TypeOf((*MockVerifyMFASMSCodeRepository)(nil).DeleteCode), arg0, arg1)
+}
+
+// RegisterCodeFailure mocks base method.
+func (m *MockVerifyMFASMSCodeRepository) RegisterCodeFailure(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterCodeFailure", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterCodeFailure indicates an expected call of RegisterCodeFailure.
+func (mr *MockVerifyMFASMSCodeRepositoryMockRecorder) RegisterCodeFailure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodeFailure", reflect.TypeOf((*MockVerifyMFASMSCodeRepository)(nil).RegisterCodeFailure), arg0, arg1)
+}
+
+// SaveCode mocks base method.
+func (m *MockVerifyMFASMSCodeRepository) SaveCode(arg0 context.Context, arg1 entities.SMSCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCode indicates an expected call of SaveCode.
+func (mr *MockVerifyMFASMSCodeRepositoryMockRecorder) SaveCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCode", reflect.TypeOf((*MockVerifyMFASMSCodeRepository)(nil).SaveCode), arg0, arg1)
+}
+
+// SelectCode mocks base method.
+func (m *MockVerifyMFASMSCodeRepository) SelectCode(arg0 context.Context, arg1, arg2 string) (entities.SMSCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.SMSCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCode indicates an expected call of SelectCode.
+func (mr *MockVerifyMFASMSCodeRepositoryMockRecorder) SelectCode(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCode", reflect.TypeOf((*MockVerifyMFASMSCodeRepository)(nil).SelectCode), arg0, arg1, arg2)
+}
+
+// MockVerifyMFASMSSender is a mock of VerifyMFASMSSender interface.
+type MockVerifyMFASMSSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFASMSSenderMockRecorder
+}
+
+// MockVerifyMFASMSSenderMockRecorder is the mock recorder for MockVerifyMFASMSSender.
+type MockVerifyMFASMSSenderMockRecorder struct {
+	mock *MockVerifyMFASMSSender
+}
+
+// NewMockVerifyMFASMSSender creates a new mock instance.
+func NewMockVerifyMFASMSSender(ctrl *gomock.Controller) *MockVerifyMFASMSSender {
+	mock := &MockVerifyMFASMSSender{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFASMSSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFASMSSender) EXPECT() *MockVerifyMFASMSSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockVerifyMFASMSSender) Send(to, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", to, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockVerifyMFASMSSenderMockRecorder) Send(to, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockVerifyMFASMSSender)(nil).Send), to, message)
+}
+
+// MockVerifyMFARateLimitRepository is a mock of VerifyMFARateLimitRepository interface.
+type MockVerifyMFARateLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFARateLimitRepositoryMockRecorder
+}
+
+// MockVerifyMFARateLimitRepositoryMockRecorder is the mock recorder for MockVerifyMFARateLimitRepository.
+type MockVerifyMFARateLimitRepositoryMockRecorder struct {
+	mock *MockVerifyMFARateLimitRepository
+}
+
+// NewMockVerifyMFARateLimitRepository creates a new mock instance.
+func NewMockVerifyMFARateLimitRepository(ctrl *gomock.Controller) *MockVerifyMFARateLimitRepository {
+	mock := &MockVerifyMFARateLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFARateLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFARateLimitRepository) EXPECT() *MockVerifyMFARateLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Take mocks base method.
+func (m *MockVerifyMFARateLimitRepository) Take(arg0 context.Context, arg1 string, arg2 entities.RateLimit) (entities.RateLimitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.RateLimitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockVerifyMFARateLimitRepositoryMockRecorder) Take(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockVerifyMFARateLimitRepository)(nil).Take), arg0, arg1, arg2)
+}
+
+// MockVerifyMFARandomService is a mock of VerifyMFARandomService interface.
+type MockVerifyMFARandomService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFARandomServiceMockRecorder
+}
+
+// MockVerifyMFARandomServiceMockRecorder is the mock recorder for MockVerifyMFARandomService.
+type MockVerifyMFARandomServiceMockRecorder struct {
+	mock *MockVerifyMFARandomService
+}
+
+// NewMockVerifyMFARandomService creates a new mock instance.
+func NewMockVerifyMFARandomService(ctrl *gomock.Controller) *MockVerifyMFARandomService {
+	mock := &MockVerifyMFARandomService{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFARandomServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFARandomService) EXPECT() *MockVerifyMFARandomServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateCode mocks base method.
+func (m *MockVerifyMFARandomService) GenerateCode(digits int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCode", digits)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCode indicates an expected call of GenerateCode.
+func (mr *MockVerifyMFARandomServiceMockRecorder) GenerateCode(digits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCode", reflect.TypeOf((*MockVerifyMFARandomService)(nil).GenerateCode), digits)
+}
+
+// MockVerifyMFALoginAttemptRepository is a mock of VerifyMFALoginAttemptRepository interface.
+type MockVerifyMFALoginAttemptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFALoginAttemptRepositoryMockRecorder
+}
+
+// MockVerifyMFALoginAttemptRepositoryMockRecorder is the mock recorder for MockVerifyMFALoginAttemptRepository.
+type MockVerifyMFALoginAttemptRepositoryMockRecorder struct {
+	mock *MockVerifyMFALoginAttemptRepository
+}
+
+// NewMockVerifyMFALoginAttemptRepository creates a new mock instance.
+func NewMockVerifyMFALoginAttemptRepository(ctrl *gomock.Controller) *MockVerifyMFALoginAttemptRepository {
+	mock := &MockVerifyMFALoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFALoginAttemptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFALoginAttemptRepository) EXPECT() *MockVerifyMFALoginAttemptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method.
+func (m *MockVerifyMFALoginAttemptRepository) Lock(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockVerifyMFALoginAttemptRepositoryMockRecorder) Lock(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockVerifyMFALoginAttemptRepository)(nil).Lock), arg0, arg1, arg2)
+}
+
+// RegisterFailure mocks base method.
+func (m *MockVerifyMFALoginAttemptRepository) RegisterFailure(arg0 context.Context, arg1 string, arg2 time.Duration) (entities.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailure", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterFailure indicates an expected call of RegisterFailure.
+func (mr *MockVerifyMFALoginAttemptRepositoryMockRecorder) RegisterFailure(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailure", reflect.TypeOf((*MockVerifyMFALoginAttemptRepository)(nil).RegisterFailure), arg0, arg1, arg2)
+}
+
+// Reset mocks base method.
+func (m *MockVerifyMFALoginAttemptRepository) Reset(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockVerifyMFALoginAttemptRepositoryMockRecorder) Reset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockVerifyMFALoginAttemptRepository)(nil).Reset), arg0, arg1)
+}
+
+// Select mocks base method.
+func (m *MockVerifyMFALoginAttemptRepository) Select(arg0 context.Context, arg1 string) (entities.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Select", arg0, arg1)
+	ret0, _ := ret[0].(entities.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Select indicates an expected call of Select.
+func (mr *MockVerifyMFALoginAttemptRepositoryMockRecorder) Select(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockVerifyMFALoginAttemptRepository)(nil).Select), arg0, arg1)
+}
+
+// MockVerifyMFARiskEngine is a mock of VerifyMFARiskEngine interface.
+type MockVerifyMFARiskEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFARiskEngineMockRecorder
+}
+
+// MockVerifyMFARiskEngineMockRecorder is the mock recorder for MockVerifyMFARiskEngine.
+type MockVerifyMFARiskEngineMockRecorder struct {
+	mock *MockVerifyMFARiskEngine
+}
+
+// NewMockVerifyMFARiskEngine creates a new mock instance.
+func NewMockVerifyMFARiskEngine(ctrl *gomock.Controller) *MockVerifyMFARiskEngine {
+	mock := &MockVerifyMFARiskEngine{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFARiskEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFARiskEngine) EXPECT() *MockVerifyMFARiskEngineMockRecorder {
+	return m.recorder
+}
+
+// Assess mocks base method.
+func (m *MockVerifyMFARiskEngine) Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assess", context, userId, userAgent, ip)
+	ret0, _ := ret[0].(entities.RiskAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assess indicates an expected call of Assess.
+func (mr *MockVerifyMFARiskEngineMockRecorder) Assess(context, userId, userAgent, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assess", reflect.TypeOf((*MockVerifyMFARiskEngine)(nil).Assess), context, userId, userAgent, ip)
+}
+
+// Record mocks base method.
+func (m *MockVerifyMFARiskEngine) Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", context, user, assessment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockVerifyMFARiskEngineMockRecorder) Record(context, user, assessment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockVerifyMFARiskEngine)(nil).Record), context, user, assessment)
+}
+
+// MockVerifyMFAAuditLogger is a mock of VerifyMFAAuditLogger interface.
+type MockVerifyMFAAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyMFAAuditLoggerMockRecorder
+}
+
+// MockVerifyMFAAuditLoggerMockRecorder is the mock recorder for MockVerifyMFAAuditLogger.
+type MockVerifyMFAAuditLoggerMockRecorder struct {
+	mock *MockVerifyMFAAuditLogger
+}
+
+// NewMockVerifyMFAAuditLogger creates a new mock instance.
+func NewMockVerifyMFAAuditLogger(ctrl *gomock.Controller) *MockVerifyMFAAuditLogger {
+	mock := &MockVerifyMFAAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockVerifyMFAAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMFAAuditLogger) EXPECT() *MockVerifyMFAAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockVerifyMFAAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockVerifyMFAAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockVerifyMFAAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockWebAuthnLoginUserRepository is a mock of WebAuthnLoginUserRepository interface.
+type MockWebAuthnLoginUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginUserRepositoryMockRecorder
+}
+
+// MockWebAuthnLoginUserRepositoryMockRecorder is the mock recorder for MockWebAuthnLoginUserRepository.
+type MockWebAuthnLoginUserRepositoryMockRecorder struct {
+	mock *MockWebAuthnLoginUserRepository
+}
+
+// NewMockWebAuthnLoginUserRepository creates a new mock instance.
+func NewMockWebAuthnLoginUserRepository(ctrl *gomock.Controller) *MockWebAuthnLoginUserRepository {
+	mock := &MockWebAuthnLoginUserRepository{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginUserRepository) EXPECT() *MockWebAuthnLoginUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUserId mocks base method.
+func (m *MockWebAuthnLoginUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockWebAuthnLoginUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockWebAuthnLoginUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// MockWebAuthnLoginSessionRepository is a mock of WebAuthnLoginSessionRepository interface.
+type MockWebAuthnLoginSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginSessionRepositoryMockRecorder
+}
+
+// MockWebAuthnLoginSessionRepositoryMockRecorder is the mock recorder for MockWebAuthnLoginSessionRepository.
+type MockWebAuthnLoginSessionRepositoryMockRecorder struct {
+	mock *MockWebAuthnLoginSessionRepository
+}
+
+// NewMockWebAuthnLoginSessionRepository creates a new mock instance.
+func NewMockWebAuthnLoginSessionRepository(ctrl *gomock.Controller) *MockWebAuthnLoginSessionRepository {
+	mock := &MockWebAuthnLoginSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginSessionRepository) EXPECT() *MockWebAuthnLoginSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockWebAuthnLoginSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockWebAuthnLoginSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockWebAuthnLoginSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+}
+
+// Insert mocks base method.
+func (m *MockWebAuthnLoginSessionRepository) Insert(arg0 context.Context, arg1 entities.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockWebAuthnLoginSessionRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWebAuthnLoginSessionRepository)(nil).Insert), arg0, arg1)
+}
+
+// MockWebAuthnLoginHashService is a mock of WebAuthnLoginHashService interface.
+type MockWebAuthnLoginHashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginHashServiceMockRecorder
+}
+
+// MockWebAuthnLoginHashServiceMockRecorder is the mock recorder for MockWebAuthnLoginHashService.
+type MockWebAuthnLoginHashServiceMockRecorder struct {
+	mock *MockWebAuthnLoginHashService
+}
+
+// NewMockWebAuthnLoginHashService creates a new mock instance.
+func NewMockWebAuthnLoginHashService(ctrl *gomock.Controller) *MockWebAuthnLoginHashService {
+	mock := &MockWebAuthnLoginHashService{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginHashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginHashService) EXPECT() *MockWebAuthnLoginHashServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateHash mocks base method.
+func (m *MockWebAuthnLoginHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockWebAuthnLoginHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockWebAuthnLoginHashService)(nil).GenerateHash), stringToHash)
+}
+
+// MockWebAuthnLoginSessionService is a mock of WebAuthnLoginSessionService interface.
+type MockWebAuthnLoginSessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginSessionServiceMockRecorder
+}
+
+// MockWebAuthnLoginSessionServiceMockRecorder is the mock recorder for MockWebAuthnLoginSessionService.
+type MockWebAuthnLoginSessionServiceMockRecorder struct {
+	mock *MockWebAuthnLoginSessionService
+}
+
+// NewMockWebAuthnLoginSessionService creates a new mock instance.
+func NewMockWebAuthnLoginSessionService(ctrl *gomock.Controller) *MockWebAuthnLoginSessionService {
+	mock := &MockWebAuthnLoginSessionService{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginSessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginSessionService) EXPECT() *MockWebAuthnLoginSessionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateDeviceToken mocks base method.
+func (m *MockWebAuthnLoginSessionService) CreateDeviceToken(account entities.User, deviceId string, expiresAt time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeviceToken", account, deviceId, expiresAt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeviceToken indicates an expected call of CreateDeviceToken.
+func (mr *MockWebAuthnLoginSessionServiceMockRecorder) CreateDeviceToken(account, deviceId, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceToken", reflect.TypeOf((*MockWebAuthnLoginSessionService)(nil).CreateDeviceToken), account, deviceId, expiresAt)
+}
+
+// CreateRestrictedToken mocks base method.
+func (m *MockWebAuthnLoginSessionService) CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRestrictedToken", account, scope, authentication)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRestrictedToken indicates an expected call of CreateRestrictedToken.
+func (mr *MockWebAuthnLoginSessionServiceMockRecorder) CreateRestrictedToken(account, scope, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestrictedToken", reflect.TypeOf((*MockWebAuthnLoginSessionService)(nil).CreateRestrictedToken), account, scope, authentication)
+}
+
+// CreateSession mocks base method.
+func (m *MockWebAuthnLoginSessionService) CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", account, membership, authentication)
+	ret0, _ := ret[0].(entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockWebAuthnLoginSessionServiceMockRecorder) CreateSession(account, membership, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockWebAuthnLoginSessionService)(nil).CreateSession), account, membership, authentication)
+}
+
+// ParseToken mocks base method.
+func (m *MockWebAuthnLoginSessionService) ParseToken(token string) (entities.AccessTokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseToken", token)
+	ret0, _ := ret[0].(entities.AccessTokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseToken indicates an expected call of ParseToken.
+func (mr *MockWebAuthnLoginSessionServiceMockRecorder) ParseToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockWebAuthnLoginSessionService)(nil).ParseToken), token)
+}
+
+// MockWebAuthnLoginCookieService is a mock of WebAuthnLoginCookieService interface.
+type MockWebAuthnLoginCookieService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginCookieServiceMockRecorder
+}
+
+// MockWebAuthnLoginCookieServiceMockRecorder is the mock recorder for MockWebAuthnLoginCookieService.
+type MockWebAuthnLoginCookieServiceMockRecorder struct {
+	mock *MockWebAuthnLoginCookieService
+}
+
+// NewMockWebAuthnLoginCookieService creates a new mock instance.
+func NewMockWebAuthnLoginCookieService(ctrl *gomock.Controller) *MockWebAuthnLoginCookieService {
+	mock := &MockWebAuthnLoginCookieService{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginCookieServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginCookieService) EXPECT() *MockWebAuthnLoginCookieServiceMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockWebAuthnLoginCookieService) Set(w http.ResponseWriter, name, value string, expires time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", w, name, value, expires)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockWebAuthnLoginCookieServiceMockRecorder) Set(w, name, value, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockWebAuthnLoginCookieService)(nil).Set), w, name, value, expires)
+}
+
+// MockWebAuthnLoginOrganizationRepository is a mock of WebAuthnLoginOrganizationRepository interface.
+type MockWebAuthnLoginOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginOrganizationRepositoryMockRecorder
+}
+
+// MockWebAuthnLoginOrganizationRepositoryMockRecorder is the mock recorder for MockWebAuthnLoginOrganizationRepository.
+type MockWebAuthnLoginOrganizationRepositoryMockRecorder struct {
+	mock *MockWebAuthnLoginOrganizationRepository
+}
+
+// NewMockWebAuthnLoginOrganizationRepository creates a new mock instance.
+func NewMockWebAuthnLoginOrganizationRepository(ctrl *gomock.Controller) *MockWebAuthnLoginOrganizationRepository {
+	mock := &MockWebAuthnLoginOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginOrganizationRepository) EXPECT() *MockWebAuthnLoginOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectMember mocks base method.
+func (m *MockWebAuthnLoginOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockWebAuthnLoginOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockWebAuthnLoginOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
+}
+
+// MockWebAuthnLoginMFARepository is a mock of WebAuthnLoginMFARepository interface.
+type MockWebAuthnLoginMFARepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginMFARepositoryMockRecorder
+}
+
+// MockWebAuthnLoginMFARepositoryMockRecorder is the mock recorder for MockWebAuthnLoginMFARepository.
+type MockWebAuthnLoginMFARepositoryMockRecorder struct {
+	mock *MockWebAuthnLoginMFARepository
+}
+
+// NewMockWebAuthnLoginMFARepository creates a new mock instance.
+func NewMockWebAuthnLoginMFARepository(ctrl *gomock.Controller) *MockWebAuthnLoginMFARepository {
+	mock := &MockWebAuthnLoginMFARepository{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginMFARepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginMFARepository) EXPECT() *MockWebAuthnLoginMFARepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectTOTP mocks base method.
+func (m *MockWebAuthnLoginMFARepository) SelectTOTP(arg0 context.Context, arg1 string) (entities.TOTP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectTOTP", arg0, arg1)
+	ret0, _ := ret[0].(entities.TOTP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectTOTP indicates an expected call of SelectTOTP.
+func (mr *MockWebAuthnLoginMFARepositoryMockRecorder) SelectTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectTOTP", reflect.TypeOf((*MockWebAuthnLoginMFARepository)(nil).SelectTOTP), arg0, arg1)
+}
+
+// MockWebAuthnLoginWebAuthnRepository is a mock of WebAuthnLoginWebAuthnRepository interface.
+type MockWebAuthnLoginWebAuthnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginWebAuthnRepositoryMockRecorder
+}
+
+// MockWebAuthnLoginWebAuthnRepositoryMockRecorder is the mock recorder for MockWebAuthnLoginWebAuthnRepository.
+type MockWebAuthnLoginWebAuthnRepositoryMockRecorder struct {
+	mock *MockWebAuthnLoginWebAuthnRepository
+}
+
+// NewMockWebAuthnLoginWebAuthnRepository creates a new mock instance.
+func NewMockWebAuthnLoginWebAuthnRepository(ctrl *gomock.Controller) *MockWebAuthnLoginWebAuthnRepository {
+	mock := &MockWebAuthnLoginWebAuthnRepository{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginWebAuthnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginWebAuthnRepository) EXPECT() *MockWebAuthnLoginWebAuthnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// InsertSession mocks base method.
+func (m *MockWebAuthnLoginWebAuthnRepository) InsertSession(arg0 context.Context, arg1 entities.WebAuthnSession) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSession", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertSession indicates an expected call of InsertSession.
+func (mr *MockWebAuthnLoginWebAuthnRepositoryMockRecorder) InsertSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSession", reflect.TypeOf((*MockWebAuthnLoginWebAuthnRepository)(nil).InsertSession), arg0, arg1)
+}
+
+// SelectCredentials mocks base method.
+func (m *MockWebAuthnLoginWebAuthnRepository) SelectCredentials(arg0 context.Context, arg1 string) ([]entities.WebAuthnCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCredentials", arg0, arg1)
+	ret0, _ := ret[0].([]entities.WebAuthnCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCredentials indicates an expected call of SelectCredentials.
+func (mr *MockWebAuthnLoginWebAuthnRepositoryMockRecorder) SelectCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCredentials", reflect.TypeOf((*MockWebAuthnLoginWebAuthnRepository)(nil).SelectCredentials), arg0, arg1)
+}
+
+// TakeSession mocks base method.
+func (m *MockWebAuthnLoginWebAuthnRepository) TakeSession(arg0 context.Context, arg1 string) (entities.WebAuthnSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeSession", arg0, arg1)
+	ret0, _ := ret[0].(entities.WebAuthnSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeSession indicates an expected call of TakeSession.
+func (mr *MockWebAuthnLoginWebAuthnRepositoryMockRecorder) TakeSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeSession", reflect.TypeOf((*MockWebAuthnLoginWebAuthnRepository)(nil).TakeSession), arg0, arg1)
+}
+
+// UpdateCredentialUsage mocks base method.
+func (m *MockWebAuthnLoginWebAuthnRepository) UpdateCredentialUsage(arg0 context.Context, arg1 entities.WebAuthnCredential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCredentialUsage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCredentialUsage indicates an expected call of UpdateCredentialUsage.
+func (mr *MockWebAuthnLoginWebAuthnRepositoryMockRecorder) UpdateCredentialUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCredentialUsage", reflect.TypeOf((*MockWebAuthnLoginWebAuthnRepository)(nil).UpdateCredentialUsage), arg0, arg1)
+}
+
+// MockWebAuthnLoginWebAuthnService is a mock of WebAuthnLoginWebAuthnService interface.
+type MockWebAuthnLoginWebAuthnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginWebAuthnServiceMockRecorder
+}
+
+// MockWebAuthnLoginWebAuthnServiceMockRecorder is the mock recorder for MockWebAuthnLoginWebAuthnService.
+type MockWebAuthnLoginWebAuthnServiceMockRecorder struct {
+	mock *MockWebAuthnLoginWebAuthnService
+}
+
+// NewMockWebAuthnLoginWebAuthnService creates a new mock instance.
+func NewMockWebAuthnLoginWebAuthnService(ctrl *gomock.Controller) *MockWebAuthnLoginWebAuthnService {
+	mock := &MockWebAuthnLoginWebAuthnService{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginWebAuthnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginWebAuthnService) EXPECT() *MockWebAuthnLoginWebAuthnServiceMockRecorder {
+	return m.recorder
+}
+
+// BeginDiscoverableLogin mocks base method.
+func (m *MockWebAuthnLoginWebAuthnService) BeginDiscoverableLogin() ([]byte, entities.WebAuthnSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginDiscoverableLogin")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(entities.WebAuthnSession)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BeginDiscoverableLogin indicates an expected call of BeginDiscoverableLogin.
+func (mr *MockWebAuthnLoginWebAuthnServiceMockRecorder) BeginDiscoverableLogin() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginDiscoverableLogin", reflect.TypeOf((*MockWebAuthnLoginWebAuthnService)(nil).BeginDiscoverableLogin))
+}
+
+// BeginLogin mocks base method.
+func (m *MockWebAuthnLoginWebAuthnService) BeginLogin(user entities.User, credentials []entities.WebAuthnCredential) ([]byte, entities.WebAuthnSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginLogin", user, credentials)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(entities.WebAuthnSession)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BeginLogin indicates an expected call of BeginLogin.
+func (mr *MockWebAuthnLoginWebAuthnServiceMockRecorder) BeginLogin(user, credentials interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginLogin", reflect.TypeOf((*MockWebAuthnLoginWebAuthnService)(nil).BeginLogin), user, credentials)
+}
+
+// CredentialOwner mocks base method.
+func (m *MockWebAuthnLoginWebAuthnService) CredentialOwner(response []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CredentialOwner", response)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CredentialOwner indicates an expected call of CredentialOwner.
+func (mr *MockWebAuthnLoginWebAuthnServiceMockRecorder) CredentialOwner(response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CredentialOwner", reflect.TypeOf((*MockWebAuthnLoginWebAuthnService)(nil).CredentialOwner), response)
+}
+
+// FinishLogin mocks base method.
+func (m *MockWebAuthnLoginWebAuthnService) FinishLogin(user entities.User, credentials []entities.WebAuthnCredential, session entities.WebAuthnSession, response []byte) (entities.WebAuthnCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishLogin", user, credentials, session, response)
+	ret0, _ := ret[0].(entities.WebAuthnCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishLogin indicates an expected call of FinishLogin.
+func (mr *MockWebAuthnLoginWebAuthnServiceMockRecorder) FinishLogin(user, credentials, session, response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishLogin", reflect.TypeOf((*MockWebAuthnLoginWebAuthnService)(nil).FinishLogin), user, credentials, session, response)
+}
+
+// MockWebAuthnLoginTrustedDeviceRepository is a mock of WebAuthnLoginTrustedDeviceRepository interface.
+type MockWebAuthnLoginTrustedDeviceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginTrustedDeviceRepositoryMockRecorder
+}
+
+// MockWebAuthnLoginTrustedDeviceRepositoryMockRecorder is the mock recorder for MockWebAuthnLoginTrustedDeviceRepository.
+type MockWebAuthnLoginTrustedDeviceRepositoryMockRecorder struct {
+	mock *MockWebAuthnLoginTrustedDeviceRepository
+}
+
+// NewMockWebAuthnLoginTrustedDeviceRepository creates a new mock instance.
+func NewMockWebAuthnLoginTrustedDeviceRepository(ctrl *gomock.Controller) *MockWebAuthnLoginTrustedDeviceRepository {
+	mock := &MockWebAuthnLoginTrustedDeviceRepository{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginTrustedDeviceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginTrustedDeviceRepository) EXPECT() *MockWebAuthnLoginTrustedDeviceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockWebAuthnLoginTrustedDeviceRepository) Insert(arg0 context.Context, arg1 entities.TrustedDevice) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockWebAuthnLoginTrustedDeviceRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWebAuthnLoginTrustedDeviceRepository)(nil).Insert), arg0, arg1)
+}
+
+// MockWebAuthnLoginLoginAttemptRepository is a mock of WebAuthnLoginLoginAttemptRepository interface.
+type MockWebAuthnLoginLoginAttemptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginLoginAttemptRepositoryMockRecorder
+}
+
+// MockWebAuthnLoginLoginAttemptRepositoryMockRecorder is the mock recorder for MockWebAuthnLoginLoginAttemptRepository.
+type MockWebAuthnLoginLoginAttemptRepositoryMockRecorder struct {
+	mock *MockWebAuthnLoginLoginAttemptRepository
+}
+
+// NewMockWebAuthnLoginLoginAttemptRepository creates a new mock instance.
+func NewMockWebAuthnLoginLoginAttemptRepository(ctrl *gomock.Controller) *MockWebAuthnLoginLoginAttemptRepository {
+	mock := &MockWebAuthnLoginLoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginLoginAttemptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginLoginAttemptRepository) EXPECT() *MockWebAuthnLoginLoginAttemptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method.
+func (m *MockWebAuthnLoginLoginAttemptRepository) Lock(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockWebAuthnLoginLoginAttemptRepositoryMockRecorder) Lock(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockWebAuthnLoginLoginAttemptRepository)(nil).Lock), arg0, arg1, arg2)
+}
+
+// RegisterFailure mocks base method.
+func (m *MockWebAuthnLoginLoginAttemptRepository) RegisterFailure(arg0 context.Context, arg1 string, arg2 time.Duration) (entities.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailure", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterFailure indicates an expected call of RegisterFailure.
+func (mr *MockWebAuthnLoginLoginAttemptRepositoryMockRecorder) RegisterFailure(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailure", reflect.TypeOf((*MockWebAuthnLoginLoginAttemptRepository)(nil).RegisterFailure), arg0, arg1, arg2)
+}
+
+// Reset mocks base method.
+func (m *MockWebAuthnLoginLoginAttemptRepository) Reset(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockWebAuthnLoginLoginAttemptRepositoryMockRecorder) Reset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockWebAuthnLoginLoginAttemptRepository)(nil).Reset), arg0, arg1)
+}
+
+// Select mocks base method.
+func (m *MockWebAuthnLoginLoginAttemptRepository) Select(arg0 context.Context, arg1 string) (entities.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Select", arg0, arg1)
+	ret0, _ := ret[0].(entities.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Select indicates an expected call of Select.
+func (mr *MockWebAuthnLoginLoginAttemptRepositoryMockRecorder) Select(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockWebAuthnLoginLoginAttemptRepository)(nil).Select), arg0, arg1)
+}
+
+// MockWebAuthnLoginRiskEngine is a mock of WebAuthnLoginRiskEngine interface.
+type MockWebAuthnLoginRiskEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginRiskEngineMockRecorder
+}
+
+// MockWebAuthnLoginRiskEngineMockRecorder is the mock recorder for MockWebAuthnLoginRiskEngine.
+type MockWebAuthnLoginRiskEngineMockRecorder struct {
+	mock *MockWebAuthnLoginRiskEngine
+}
+
+// NewMockWebAuthnLoginRiskEngine creates a new mock instance.
+func NewMockWebAuthnLoginRiskEngine(ctrl *gomock.Controller) *MockWebAuthnLoginRiskEngine {
+	mock := &MockWebAuthnLoginRiskEngine{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginRiskEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginRiskEngine) EXPECT() *MockWebAuthnLoginRiskEngineMockRecorder {
+	return m.recorder
+}
+
+// Assess mocks base method.
+func (m *MockWebAuthnLoginRiskEngine) Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assess", context, userId, userAgent, ip)
+	ret0, _ := ret[0].(entities.RiskAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assess indicates an expected call of Assess.
+func (mr *MockWebAuthnLoginRiskEngineMockRecorder) Assess(context, userId, userAgent, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assess", reflect.TypeOf((*MockWebAuthnLoginRiskEngine)(nil).Assess), context, userId, userAgent, ip)
+}
+
+// Record mocks base method.
+func (m *MockWebAuthnLoginRiskEngine) Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", context, user, assessment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockWebAuthnLoginRiskEngineMockRecorder) Record(context, user, assessment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockWebAuthnLoginRiskEngine)(nil).Record), context, user, assessment)
+}
+
+// MockWebAuthnLoginAuditLogger is a mock of WebAuthnLoginAuditLogger interface.
+type MockWebAuthnLoginAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebAuthnLoginAuditLoggerMockRecorder
+}
+
+// MockWebAuthnLoginAuditLoggerMockRecorder is the mock recorder for MockWebAuthnLoginAuditLogger.
+type MockWebAuthnLoginAuditLoggerMockRecorder struct {
+	mock *MockWebAuthnLoginAuditLogger
+}
+
+// NewMockWebAuthnLoginAuditLogger creates a new mock instance.
+func NewMockWebAuthnLoginAuditLogger(ctrl *gomock.Controller) *MockWebAuthnLoginAuditLogger {
+	mock := &MockWebAuthnLoginAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockWebAuthnLoginAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnLoginAuditLogger) EXPECT() *MockWebAuthnLoginAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockWebAuthnLoginAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockWebAuthnLoginAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockWebAuthnLoginAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockPasswordlessUserRepository is a mock of PasswordlessUserRepository interface.
+type MockPasswordlessUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessUserRepositoryMockRecorder
+}
+
+// MockPasswordlessUserRepositoryMockRecorder is the mock recorder for MockPasswordlessUserRepository.
+type MockPasswordlessUserRepositoryMockRecorder struct {
+	mock *MockPasswordlessUserRepository
+}
+
+// NewMockPasswordlessUserRepository creates a new mock instance.
+func NewMockPasswordlessUserRepository(ctrl *gomock.Controller) *MockPasswordlessUserRepository {
+	mock := &MockPasswordlessUserRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessUserRepository) EXPECT() *MockPasswordlessUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockPasswordlessUserRepository) Insert(arg0 context.Context, arg1 entities.User) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockPasswordlessUserRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockPasswordlessUserRepository)(nil).Insert), arg0, arg1)
+}
+
+// SelectByEmail mocks base method.
+func (m *MockPasswordlessUserRepository) SelectByEmail(arg0 context.Context, arg1 entities.Email) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByEmail", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByEmail indicates an expected call of SelectByEmail.
+func (mr *MockPasswordlessUserRepositoryMockRecorder) SelectByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByEmail", reflect.TypeOf((*MockPasswordlessUserRepository)(nil).SelectByEmail), arg0, arg1)
+}
+
+// MockPasswordlessSessionRepository is a mock of PasswordlessSessionRepository interface.
+type MockPasswordlessSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessSessionRepositoryMockRecorder
+}
+
+// MockPasswordlessSessionRepositoryMockRecorder is the mock recorder for MockPasswordlessSessionRepository.
+type MockPasswordlessSessionRepositoryMockRecorder struct {
+	mock *MockPasswordlessSessionRepository
+}
+
+// NewMockPasswordlessSessionRepository creates a new mock instance.
+func NewMockPasswordlessSessionRepository(ctrl *gomock.Controller) *MockPasswordlessSessionRepository {
+	mock := &MockPasswordlessSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessSessionRepository) EXPECT() *MockPasswordlessSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockPasswordlessSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockPasswordlessSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockPasswordlessSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+}
+
+// Insert mocks base method.
+func (m *MockPasswordlessSessionRepository) Insert(arg0 context.Context, arg1 entities.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockPasswordlessSessionRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockPasswordlessSessionRepository)(nil).Insert), arg0, arg1)
+}
+
+// MockPasswordlessHashService is a mock of PasswordlessHashService interface.
+type MockPasswordlessHashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessHashServiceMockRecorder
+}
+
+// MockPasswordlessHashServiceMockRecorder is the mock recorder for MockPasswordlessHashService.
+type MockPasswordlessHashServiceMockRecorder struct {
+	mock *MockPasswordlessHashService
+}
+
+// NewMockPasswordlessHashService creates a new mock instance.
+func NewMockPasswordlessHashService(ctrl *gomock.Controller) *MockPasswordlessHashService {
+	mock := &MockPasswordlessHashService{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessHashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessHashService) EXPECT() *MockPasswordlessHashServiceMockRecorder {
+	return m.recorder
+}
+
+// CompareStringAndHash mocks base method.
+func (m *MockPasswordlessHashService) CompareStringAndHash(arg0, arg1 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareStringAndHash", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CompareStringAndHash indicates an expected call of CompareStringAndHash.
+func (mr *MockPasswordlessHashServiceMockRecorder) CompareStringAndHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareStringAndHash", reflect.TypeOf((*MockPasswordlessHashService)(nil).CompareStringAndHash), arg0, arg1)
+}
+
+// GenerateHash mocks base method.
+func (m *MockPasswordlessHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockPasswordlessHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockPasswordlessHashService)(nil).GenerateHash), stringToHash)
+}
+
+// MockPasswordlessSessionService is a mock of PasswordlessSessionService interface.
+type MockPasswordlessSessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessSessionServiceMockRecorder
+}
+
+// MockPasswordlessSessionServiceMockRecorder is the mock recorder for MockPasswordlessSessionService.
+type MockPasswordlessSessionServiceMockRecorder struct {
+	mock *MockPasswordlessSessionService
+}
+
+// NewMockPasswordlessSessionService creates a new mock instance.
+func NewMockPasswordlessSessionService(ctrl *gomock.Controller) *MockPasswordlessSessionService {
+	mock := &MockPasswordlessSessionService{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessSessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessSessionService) EXPECT() *MockPasswordlessSessionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateRestrictedToken mocks base method.
+func (m *MockPasswordlessSessionService) CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRestrictedToken", account, scope, authentication)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRestrictedToken indicates an expected call of CreateRestrictedToken.
+func (mr *MockPasswordlessSessionServiceMockRecorder) CreateRestrictedToken(account, scope, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestrictedToken", reflect.TypeOf((*MockPasswordlessSessionService)(nil).CreateRestrictedToken), account, scope, authentication)
+}
+
+// CreateSession mocks base method.
+func (m *MockPasswordlessSessionService) CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", account, membership, authentication)
+	ret0, _ := ret[0].(entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockPasswordlessSessionServiceMockRecorder) CreateSession(account, membership, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockPasswordlessSessionService)(nil).CreateSession), account, membership, authentication)
+}
+
+// ParseToken mocks base method.
+func (m *MockPasswordlessSessionService) ParseToken(token string) (entities.AccessTokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseToken", token)
+	ret0, _ := ret[0].(entities.AccessTokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseToken indicates an expected call of ParseToken.
+func (mr *MockPasswordlessSessionServiceMockRecorder) ParseToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockPasswordlessSessionService)(nil).ParseToken), token)
+}
+
+// MockPasswordlessCookieService is a mock of PasswordlessCookieService interface.
+type MockPasswordlessCookieService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessCookieServiceMockRecorder
+}
+
+// MockPasswordlessCookieServiceMockRecorder is the mock recorder for MockPasswordlessCookieService.
+type MockPasswordlessCookieServiceMockRecorder struct {
+	mock *MockPasswordlessCookieService
+}
+
+// NewMockPasswordlessCookieService creates a new mock instance.
+func NewMockPasswordlessCookieService(ctrl *gomock.Controller) *MockPasswordlessCookieService {
+	mock := &MockPasswordlessCookieService{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessCookieServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessCookieService) EXPECT() *MockPasswordlessCookieServiceMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockPasswordlessCookieService) Set(w http.ResponseWriter, name, value string, expires time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", w, name, value, expires)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockPasswordlessCookieServiceMockRecorder) Set(w, name, value, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockPasswordlessCookieService)(nil).Set), w, name, value, expires)
+}
+
+// MockPasswordlessOrganizationRepository is a mock of PasswordlessOrganizationRepository interface.
+type MockPasswordlessOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessOrganizationRepositoryMockRecorder
+}
+
+// MockPasswordlessOrganizationRepositoryMockRecorder is the mock recorder for MockPasswordlessOrganizationRepository.
+type MockPasswordlessOrganizationRepositoryMockRecorder struct {
+	mock *MockPasswordlessOrganizationRepository
+}
+
+// NewMockPasswordlessOrganizationRepository creates a new mock instance.
+func NewMockPasswordlessOrganizationRepository(ctrl *gomock.Controller) *MockPasswordlessOrganizationRepository {
+	mock := &MockPasswordlessOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessOrganizationRepository) EXPECT() *MockPasswordlessOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectMember mocks base method.
+func (m *MockPasswordlessOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockPasswordlessOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockPasswordlessOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
+}
+
+// MockPasswordlessMFARepository is a mock of PasswordlessMFARepository interface.
+type MockPasswordlessMFARepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessMFARepositoryMockRecorder
+}
+
+// MockPasswordlessMFARepositoryMockRecorder is the mock recorder for MockPasswordlessMFARepository.
+type MockPasswordlessMFARepositoryMockRecorder struct {
+	mock *MockPasswordlessMFARepository
+}
+
+// NewMockPasswordlessMFARepository creates a new mock instance.
+func NewMockPasswordlessMFARepository(ctrl *gomock.Controller) *MockPasswordlessMFARepository {
+	mock := &MockPasswordlessMFARepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessMFARepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessMFARepository) EXPECT() *MockPasswordlessMFARepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectTOTP mocks base method.
+func (m *MockPasswordlessMFARepository) SelectTOTP(arg0 context.Context, arg1 string) (entities.TOTP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectTOTP", arg0, arg1)
+	ret0, _ := ret[0].(entities.TOTP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectTOTP indicates an expected call of SelectTOTP.
+func (mr *MockPasswordlessMFARepositoryMockRecorder) SelectTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectTOTP", reflect.TypeOf((*MockPasswordlessMFARepository)(nil).SelectTOTP), arg0, arg1)
+}
+
+// MockPasswordlessWebAuthnRepository is a mock of PasswordlessWebAuthnRepository interface.
+type MockPasswordlessWebAuthnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessWebAuthnRepositoryMockRecorder
+}
+
+// MockPasswordlessWebAuthnRepositoryMockRecorder is the mock recorder for MockPasswordlessWebAuthnRepository.
+type MockPasswordlessWebAuthnRepositoryMockRecorder struct {
+	mock *MockPasswordlessWebAuthnRepository
+}
+
+// NewMockPasswordlessWebAuthnRepository creates a new mock instance.
+func NewMockPasswordlessWebAuthnRepository(ctrl *gomock.Controller) *MockPasswordlessWebAuthnRepository {
+	mock := &MockPasswordlessWebAuthnRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessWebAuthnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessWebAuthnRepository) EXPECT() *MockPasswordlessWebAuthnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectCredentials mocks base method.
+func (m *MockPasswordlessWebAuthnRepository) SelectCredentials(arg0 context.Context, arg1 string) ([]entities.WebAuthnCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCredentials", arg0, arg1)
+	ret0, _ := ret[0].([]entities.WebAuthnCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCredentials indicates an expected call of SelectCredentials.
+func (mr *MockPasswordlessWebAuthnRepositoryMockRecorder) SelectCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCredentials", reflect.TypeOf((*MockPasswordlessWebAuthnRepository)(nil).SelectCredentials), arg0, arg1)
+}
+
+// MockPasswordlessTokenRepository is a mock of PasswordlessTokenRepository interface.
+type MockPasswordlessTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessTokenRepositoryMockRecorder
+}
+
+// MockPasswordlessTokenRepositoryMockRecorder is the mock recorder for MockPasswordlessTokenRepository.
+type MockPasswordlessTokenRepositoryMockRecorder struct {
+	mock *MockPasswordlessTokenRepository
+}
+
+// NewMockPasswordlessTokenRepository creates a new mock instance.
+func NewMockPasswordlessTokenRepository(ctrl *gomock.Controller) *MockPasswordlessTokenRepository {
+	mock := &MockPasswordlessTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessTokenRepository) EXPECT() *MockPasswordlessTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteToken mocks base method.
+func (m *MockPasswordlessTokenRepository) DeleteToken(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteToken indicates an expected call of DeleteToken.
+func (mr *MockPasswordlessTokenRepositoryMockRecorder) DeleteToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteToken", reflect.TypeOf((*MockPasswordlessTokenRepository)(nil).DeleteToken), arg0, arg1)
+}
+
+// InsertToken mocks base method.
+func (m *MockPasswordlessTokenRepository) InsertToken(arg0 context.Context, arg1 entities.PasswordlessToken) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertToken indicates an expected call of InsertToken.
+func (mr *MockPasswordlessTokenRepositoryMockRecorder) InsertToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertToken", reflect.TypeOf((*MockPasswordlessTokenRepository)(nil).InsertToken), arg0, arg1)
+}
+
+// RegisterTokenFailure mocks base method.
+func (m *MockPasswordlessTokenRepository) RegisterTokenFailure(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTokenFailure", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTokenFailure indicates an expected call of RegisterTokenFailure.
+func (mr *MockPasswordlessTokenRepositoryMockRecorder) RegisterTokenFailure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTokenFailure", reflect.TypeOf((*MockPasswordlessTokenRepository)(nil).RegisterTokenFailure), arg0, arg1)
+}
+
+// SelectTokenByDevice mocks base method.
+func (m *MockPasswordlessTokenRepository) SelectTokenByDevice(arg0 context.Context, arg1 string) (entities.PasswordlessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectTokenByDevice", arg0, arg1)
+	ret0, _ := ret[0].(entities.PasswordlessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectTokenByDevice indicates an expected call of SelectTokenByDevice.
+func (mr *MockPasswordlessTokenRepositoryMockRecorder) SelectTokenByDevice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectTokenByDevice", reflect.TypeOf((*MockPasswordlessTokenRepository)(nil).SelectTokenByDevice), arg0, arg1)
+}
+
+// MockPasswordlessTrustedDeviceRepository is a mock of PasswordlessTrustedDeviceRepository interface.
+type MockPasswordlessTrustedDeviceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessTrustedDeviceRepositoryMockRecorder
+}
+
+// MockPasswordlessTrustedDeviceRepositoryMockRecorder is the mock recorder for MockPasswordlessTrustedDeviceRepository.
+type MockPasswordlessTrustedDeviceRepositoryMockRecorder struct {
+	mock *MockPasswordlessTrustedDeviceRepository
+}
+
+// NewMockPasswordlessTrustedDeviceRepository creates a new mock instance.
+func NewMockPasswordlessTrustedDeviceRepository(ctrl *gomock.Controller) *MockPasswordlessTrustedDeviceRepository {
+	mock := &MockPasswordlessTrustedDeviceRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessTrustedDeviceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessTrustedDeviceRepository) EXPECT() *MockPasswordlessTrustedDeviceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Select mocks base method.
+func (m *MockPasswordlessTrustedDeviceRepository) Select(arg0 context.Context, arg1, arg2 string) (entities.TrustedDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Select", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.TrustedDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Select indicates an expected call of Select.
+func (mr *MockPasswordlessTrustedDeviceRepositoryMockRecorder) Select(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockPasswordlessTrustedDeviceRepository)(nil).Select), arg0, arg1, arg2)
+}
+
+// UpdateUsage mocks base method.
+func (m *MockPasswordlessTrustedDeviceRepository) UpdateUsage(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUsage indicates an expected call of UpdateUsage.
+func (mr *MockPasswordlessTrustedDeviceRepositoryMockRecorder) UpdateUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsage", reflect.TypeOf((*MockPasswordlessTrustedDeviceRepository)(nil).UpdateUsage), arg0, arg1)
+}
+
+// MockPasswordlessMailService is a mock of PasswordlessMailService interface.
+type MockPasswordlessMailService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessMailServiceMockRecorder
+}
+
+// MockPasswordlessMailServiceMockRecorder is the mock recorder for MockPasswordlessMailService.
+type MockPasswordlessMailServiceMockRecorder struct {
+	mock *MockPasswordlessMailService
+}
+
+// NewMockPasswordlessMailService creates a new mock instance.
+func NewMockPasswordlessMailService(ctrl *gomock.Controller) *MockPasswordlessMailService {
+	mock := &MockPasswordlessMailService{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessMailServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessMailService) EXPECT() *MockPasswordlessMailServiceMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockPasswordlessMailService) Send(to, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", to, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPasswordlessMailServiceMockRecorder) Send(to, subject, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPasswordlessMailService)(nil).Send), to, subject, body)
+}
+
+// MockPasswordlessRandomService is a mock of PasswordlessRandomService interface.
+type MockPasswordlessRandomService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessRandomServiceMockRecorder
+}
+
+// MockPasswordlessRandomServiceMockRecorder is the mock recorder for MockPasswordlessRandomService.
+type MockPasswordlessRandomServiceMockRecorder struct {
+	mock *MockPasswordlessRandomService
+}
+
+// NewMockPasswordlessRandomService creates a new mock instance.
+func NewMockPasswordlessRandomService(ctrl *gomock.Controller) *MockPasswordlessRandomService {
+	mock := &MockPasswordlessRandomService{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessRandomServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessRandomService) EXPECT() *MockPasswordlessRandomServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateCode mocks base method.
+func (m *MockPasswordlessRandomService) GenerateCode(digits int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCode", digits)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCode indicates an expected call of GenerateCode.
+func (mr *MockPasswordlessRandomServiceMockRecorder) GenerateCode(digits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCode", reflect.TypeOf((*MockPasswordlessRandomService)(nil).GenerateCode), digits)
+}
+
+// GenerateToken mocks base method.
+func (m *MockPasswordlessRandomService) GenerateToken() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateToken indicates an expected call of GenerateToken.
+func (mr *MockPasswordlessRandomServiceMockRecorder) GenerateToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockPasswordlessRandomService)(nil).GenerateToken))
+}
+
+// HashToken mocks base method.
+func (m *MockPasswordlessRandomService) HashToken(token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashToken", token)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashToken indicates an expected call of HashToken.
+func (mr *MockPasswordlessRandomServiceMockRecorder) HashToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashToken", reflect.TypeOf((*MockPasswordlessRandomService)(nil).HashToken), token)
+}
+
+// MockPasswordlessLoginAttemptRepository is a mock of PasswordlessLoginAttemptRepository interface.
+type MockPasswordlessLoginAttemptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessLoginAttemptRepositoryMockRecorder
+}
+
+// MockPasswordlessLoginAttemptRepositoryMockRecorder is the mock recorder for MockPasswordlessLoginAttemptRepository.
+type MockPasswordlessLoginAttemptRepositoryMockRecorder struct {
+	mock *MockPasswordlessLoginAttemptRepository
+}
+
+// NewMockPasswordlessLoginAttemptRepository creates a new mock instance.
+func NewMockPasswordlessLoginAttemptRepository(ctrl *gomock.Controller) *MockPasswordlessLoginAttemptRepository {
+	mock := &MockPasswordlessLoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessLoginAttemptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessLoginAttemptRepository) EXPECT() *MockPasswordlessLoginAttemptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method.
+func (m *MockPasswordlessLoginAttemptRepository) Lock(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockPasswordlessLoginAttemptRepositoryMockRecorder) Lock(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockPasswordlessLoginAttemptRepository)(nil).Lock), arg0, arg1, arg2)
+}
+
+// RegisterFailure mocks base method.
+func (m *MockPasswordlessLoginAttemptRepository) RegisterFailure(arg0 context.Context, arg1 string, arg2 time.Duration) (entities.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailure", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterFailure indicates an expected call of RegisterFailure.
+func (mr *MockPasswordlessLoginAttemptRepositoryMockRecorder) RegisterFailure(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailure", reflect.TypeOf((*MockPasswordlessLoginAttemptRepository)(nil).RegisterFailure), arg0, arg1, arg2)
+}
+
+// Reset mocks base method.
+func (m *MockPasswordlessLoginAttemptRepository) Reset(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockPasswordlessLoginAttemptRepositoryMockRecorder) Reset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockPasswordlessLoginAttemptRepository)(nil).Reset), arg0, arg1)
+}
+
+// Select mocks base method.
+func (m *MockPasswordlessLoginAttemptRepository) Select(arg0 context.Context, arg1 string) (entities.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Select", arg0, arg1)
+	ret0, _ := ret[0].(entities.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Select indicates an expected call of Select.
+func (mr *MockPasswordlessLoginAttemptRepositoryMockRecorder) Select(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockPasswordlessLoginAttemptRepository)(nil).Select), arg0, arg1)
+}
+
+// MockPasswordlessRiskEngine is a mock of PasswordlessRiskEngine interface.
+type MockPasswordlessRiskEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessRiskEngineMockRecorder
+}
+
+// MockPasswordlessRiskEngineMockRecorder is the mock recorder for MockPasswordlessRiskEngine.
+type MockPasswordlessRiskEngineMockRecorder struct {
+	mock *MockPasswordlessRiskEngine
+}
+
+// NewMockPasswordlessRiskEngine creates a new mock instance.
+func NewMockPasswordlessRiskEngine(ctrl *gomock.Controller) *MockPasswordlessRiskEngine {
+	mock := &MockPasswordlessRiskEngine{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessRiskEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessRiskEngine) EXPECT() *MockPasswordlessRiskEngineMockRecorder {
+	return m.recorder
+}
+
+// Assess mocks base method.
+func (m *MockPasswordlessRiskEngine) Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assess", context, userId, userAgent, ip)
+	ret0, _ := ret[0].(entities.RiskAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assess indicates an expected call of Assess.
+func (mr *MockPasswordlessRiskEngineMockRecorder) Assess(context, userId, userAgent, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assess", reflect.TypeOf((*MockPasswordlessRiskEngine)(nil).Assess), context, userId, userAgent, ip)
+}
+
+// Record mocks base method.
+func (m *MockPasswordlessRiskEngine) Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", context, user, assessment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockPasswordlessRiskEngineMockRecorder) Record(context, user, assessment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockPasswordlessRiskEngine)(nil).Record), context, user, assessment)
+}
+
+// MockPasswordlessAuditLogger is a mock of PasswordlessAuditLogger interface.
+type MockPasswordlessAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordlessAuditLoggerMockRecorder
+}
+
+// MockPasswordlessAuditLoggerMockRecorder is the mock recorder for MockPasswordlessAuditLogger.
+type MockPasswordlessAuditLoggerMockRecorder struct {
+	mock *MockPasswordlessAuditLogger
+}
+
+// NewMockPasswordlessAuditLogger creates a new mock instance.
+func NewMockPasswordlessAuditLogger(ctrl *gomock.Controller) *MockPasswordlessAuditLogger {
+	mock := &MockPasswordlessAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockPasswordlessAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordlessAuditLogger) EXPECT() *MockPasswordlessAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockPasswordlessAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockPasswordlessAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockPasswordlessAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockSignUpUserRepository is a mock of SignUpUserRepository interface.
+type MockSignUpUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignUpUserRepositoryMockRecorder
+}
+
+// MockSignUpUserRepositoryMockRecorder is the mock recorder for MockSignUpUserRepository.
+type MockSignUpUserRepositoryMockRecorder struct {
+	mock *MockSignUpUserRepository
+}
+
+// NewMockSignUpUserRepository creates a new mock instance.
+func NewMockSignUpUserRepository(ctrl *gomock.Controller) *MockSignUpUserRepository {
+	mock := &MockSignUpUserRepository{ctrl: ctrl}
+	mock.recorder = &MockSignUpUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignUpUserRepository) EXPECT() *MockSignUpUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CheckEmailExists mocks base method.
+func (m *MockSignUpUserRepository) CheckEmailExists(arg0 context.Context, arg1 entities.Email) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckEmailExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckEmailExists indicates an expected call of CheckEmailExists.
+func (mr *MockSignUpUserRepositoryMockRecorder) CheckEmailExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEmailExists", reflect.TypeOf((*MockSignUpUserRepository)(nil).CheckEmailExists), arg0, arg1)
+}
+
+// Insert mocks base method.
+func (m *MockSignUpUserRepository) Insert(arg0 context.Context, arg1 entities.User) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSignUpUserRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSignUpUserRepository)(nil).Insert), arg0, arg1)
+}
+
+// SelectByUserId mocks base method.
+func (m *MockSignUpUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockSignUpUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockSignUpUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// MockSignUpInvitationRepository is a mock of SignUpInvitationRepository interface.
+type MockSignUpInvitationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignUpInvitationRepositoryMockRecorder
+}
+
+// MockSignUpInvitationRepositoryMockRecorder is the mock recorder for MockSignUpInvitationRepository.
+type MockSignUpInvitationRepositoryMockRecorder struct {
+	mock *MockSignUpInvitationRepository
+}
+
+// NewMockSignUpInvitationRepository creates a new mock instance.
+func NewMockSignUpInvitationRepository(ctrl *gomock.Controller) *MockSignUpInvitationRepository {
+	mock := &MockSignUpInvitationRepository{ctrl: ctrl}
+	mock.recorder = &MockSignUpInvitationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignUpInvitationRepository) EXPECT() *MockSignUpInvitationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectById mocks base method.
+func (m *MockSignUpInvitationRepository) SelectById(arg0 context.Context, arg1 string) (entities.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectById", arg0, arg1)
+	ret0, _ := ret[0].(entities.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectById indicates an expected call of SelectById.
+func (mr *MockSignUpInvitationRepositoryMockRecorder) SelectById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectById", reflect.TypeOf((*MockSignUpInvitationRepository)(nil).SelectById), arg0, arg1)
+}
+
+// SignUp mocks base method.
+func (m *MockSignUpInvitationRepository) SignUp(arg0 context.Context, arg1 entities.Invitation, arg2 entities.User) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignUp", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignUp indicates an expected call of SignUp.
+func (mr *MockSignUpInvitationRepositoryMockRecorder) SignUp(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockSignUpInvitationRepository)(nil).SignUp), arg0, arg1, arg2)
+}
+
+// MockSignUpSessionRepository is a mock of SignUpSessionRepository interface.
+type MockSignUpSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignUpSessionRepositoryMockRecorder
+}
+
+// MockSignUpSessionRepositoryMockRecorder is the mock recorder for MockSignUpSessionRepository.
+type MockSignUpSessionRepositoryMockRecorder struct {
+	mock *MockSignUpSessionRepository
+}
+
+// NewMockSignUpSessionRepository creates a new mock instance.
+func NewMockSignUpSessionRepository(ctrl *gomock.Controller) *MockSignUpSessionRepository {
+	mock := &MockSignUpSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockSignUpSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignUpSessionRepository) EXPECT() *MockSignUpSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockSignUpSessionRepository) Insert(arg0 context.Context, arg1 entities.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSignUpSessionRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSignUpSessionRepository)(nil).Insert), arg0, arg1)
+}
+
+// MockSignUpMailService is a mock of SignUpMailService interface.
+type MockSignUpMailService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignUpMailServiceMockRecorder
+}
+
+// MockSignUpMailServiceMockRecorder is the mock recorder for MockSignUpMailService.
+type MockSignUpMailServiceMockRecorder struct {
+	mock *MockSignUpMailService
+}
+
+// NewMockSignUpMailService creates a new mock instance.
+func NewMockSignUpMailService(ctrl *gomock.Controller) *MockSignUpMailService {
+	mock := &MockSignUpMailService{ctrl: ctrl}
+	mock.recorder = &MockSignUpMailServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignUpMailService) EXPECT() *MockSignUpMailServiceMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockSignUpMailService) Send(to, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", to, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSignUpMailServiceMockRecorder) Send(to, subject, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSignUpMailService)(nil).Send), to, subject, body)
+}
+
+// MockSignUpSessionService is a mock of SignUpSessionService interface.
+type MockSignUpSessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignUpSessionServiceMockRecorder
+}
+
+// MockSignUpSessionServiceMockRecorder is the mock recorder for MockSignUpSessionService.
+type MockSignUpSessionServiceMockRecorder struct {
+	mock *MockSignUpSessionService
+}
+
+// NewMockSignUpSessionService creates a new mock instance.
+func NewMockSignUpSessionService(ctrl *gomock.Controller) *MockSignUpSessionService {
+	mock := &MockSignUpSessionService{ctrl: ctrl}
+	mock.recorder = &MockSignUpSessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignUpSessionService) EXPECT() *MockSignUpSessionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockSignUpSessionService) CreateSession(user entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", user, membership, authentication)
+	ret0, _ := ret[0].(entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockSignUpSessionServiceMockRecorder) CreateSession(user, membership, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSignUpSessionService)(nil).CreateSession), user, membership, authentication)
+}
+
+// MockSignUpHashService is a mock of SignUpHashService interface.
+type MockSignUpHashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignUpHashServiceMockRecorder
+}
+
+// MockSignUpHashServiceMockRecorder is the mock recorder for MockSignUpHashService.
+type MockSignUpHashServiceMockRecorder struct {
+	mock *MockSignUpHashService
+}
+
+// NewMockSignUpHashService creates a new mock instance.
+func NewMockSignUpHashService(ctrl *gomock.Controller) *MockSignUpHashService {
+	mock := &MockSignUpHashService{ctrl: ctrl}
+	mock.recorder = &MockSignUpHashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignUpHashService) EXPECT() *MockSignUpHashServiceMockRecorder {
+	return m.recorder
+}
+
+// CompareStringAndHash mocks base method.
+func (m *MockSignUpHashService) CompareStringAndHash(arg0, arg1 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareStringAndHash", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CompareStringAndHash indicates an expected call of CompareStringAndHash.
+func (mr *MockSignUpHashServiceMockRecorder) CompareStringAndHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareStringAndHash", reflect.TypeOf((*MockSignUpHashService)(nil).CompareStringAndHash), arg0, arg1)
+}
+
+// GenerateHash mocks base method.
+func (m *MockSignUpHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockSignUpHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockSignUpHashService)(nil).GenerateHash), stringToHash)
+}
+
+// MockSignUpBreachedPasswordService is a mock of SignUpBreachedPasswordService interface.
+type MockSignUpBreachedPasswordService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignUpBreachedPasswordServiceMockRecorder
+}
+
+// MockSignUpBreachedPasswordServiceMockRecorder is the mock recorder for MockSignUpBreachedPasswordService.
+type MockSignUpBreachedPasswordServiceMockRecorder struct {
+	mock *MockSignUpBreachedPasswordService
+}
+
+// NewMockSignUpBreachedPasswordService creates a new mock instance.
+func NewMockSignUpBreachedPasswordService(ctrl *gomock.Controller) *MockSignUpBreachedPasswordService {
+	mock := &MockSignUpBreachedPasswordService{ctrl: ctrl}
+	mock.recorder = &MockSignUpBreachedPasswordServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignUpBreachedPasswordService) EXPECT() *MockSignUpBreachedPasswordServiceMockRecorder {
+	return m.recorder
+}
+
+// IsBreached mocks base method.
+func (m *MockSignUpBreachedPasswordService) IsBreached(password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBreached", password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBreached indicates an expected call of IsBreached.
+func (mr *MockSignUpBreachedPasswordServiceMockRecorder) IsBreached(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBreached", reflect.TypeOf((*MockSignUpBreachedPasswordService)(nil).IsBreached), password)
+}
+
+// MockSignUpCookieService is a mock of SignUpCookieService interface.
+type MockSignUpCookieService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignUpCookieServiceMockRecorder
+}
+
+// MockSignUpCookieServiceMockRecorder is the mock recorder for MockSignUpCookieService.
+type MockSignUpCookieServiceMockRecorder struct {
+	mock *MockSignUpCookieService
+}
+
+// NewMockSignUpCookieService creates a new mock instance.
+func NewMockSignUpCookieService(ctrl *gomock.Controller) *MockSignUpCookieService {
+	mock := &MockSignUpCookieService{ctrl: ctrl}
+	mock.recorder = &MockSignUpCookieServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignUpCookieService) EXPECT() *MockSignUpCookieServiceMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockSignUpCookieService) Set(w http.ResponseWriter, name, value string, expires time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", w, name, value, expires)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockSignUpCookieServiceMockRecorder) Set(w, name, value, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockSignUpCookieService)(nil).Set), w, name, value, expires)
+}
+
+// MockSignUpAuditLogger is a mock of SignUpAuditLogger interface.
+type MockSignUpAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignUpAuditLoggerMockRecorder
+}
+
+// MockSignUpAuditLoggerMockRecorder is the mock recorder for MockSignUpAuditLogger.
+type MockSignUpAuditLoggerMockRecorder struct {
+	mock *MockSignUpAuditLogger
+}
+
+// NewMockSignUpAuditLogger creates a new mock instance.
+func NewMockSignUpAuditLogger(ctrl *gomock.Controller) *MockSignUpAuditLogger {
+	mock := &MockSignUpAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockSignUpAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignUpAuditLogger) EXPECT() *MockSignUpAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockSignUpAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockSignUpAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockSignUpAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockChangePasswordUserRepository is a mock of ChangePasswordUserRepository interface.
+type MockChangePasswordUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangePasswordUserRepositoryMockRecorder
+}
+
+// MockChangePasswordUserRepositoryMockRecorder is the mock recorder for MockChangePasswordUserRepository.
+type MockChangePasswordUserRepositoryMockRecorder struct {
+	mock *MockChangePasswordUserRepository
+}
+
+// NewMockChangePasswordUserRepository creates a new mock instance.
+func NewMockChangePasswordUserRepository(ctrl *gomock.Controller) *MockChangePasswordUserRepository {
+	mock := &MockChangePasswordUserRepository{ctrl: ctrl}
+	mock.recorder = &MockChangePasswordUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangePasswordUserRepository) EXPECT() *MockChangePasswordUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ChangePassword mocks base method.
+func (m *MockChangePasswordUserRepository) ChangePassword(arg0 context.Context, arg1 string, arg2 entities.Password, arg3 int, arg4 entities.WebhookEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockChangePasswordUserRepositoryMockRecorder) ChangePassword(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockChangePasswordUserRepository)(nil).ChangePassword), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SelectByUserId mocks base method.
+func (m *MockChangePasswordUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockChangePasswordUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockChangePasswordUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// SelectPasswordHistory mocks base method.
+func (m *MockChangePasswordUserRepository) SelectPasswordHistory(arg0 context.Context, arg1 string, arg2 int) ([]entities.Password, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectPasswordHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]entities.Password)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectPasswordHistory indicates an expected call of SelectPasswordHistory.
+func (mr *MockChangePasswordUserRepositoryMockRecorder) SelectPasswordHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectPasswordHistory", reflect.TypeOf((*MockChangePasswordUserRepository)(nil).SelectPasswordHistory), arg0, arg1, arg2)
+}
+
+// MockEnrollTOTPUserRepository is a mock of EnrollTOTPUserRepository interface.
+type MockEnrollTOTPUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnrollTOTPUserRepositoryMockRecorder
+}
+
+// MockEnrollTOTPUserRepositoryMockRecorder is the mock recorder for MockEnrollTOTPUserRepository.
+type MockEnrollTOTPUserRepositoryMockRecorder struct {
+	mock *MockEnrollTOTPUserRepository
+}
+
+// NewMockEnrollTOTPUserRepository creates a new mock instance.
+func NewMockEnrollTOTPUserRepository(ctrl *gomock.Controller) *MockEnrollTOTPUserRepository {
+	mock := &MockEnrollTOTPUserRepository{ctrl: ctrl}
+	mock.recorder = &MockEnrollTOTPUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnrollTOTPUserRepository) EXPECT() *MockEnrollTOTPUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUserId mocks base method.
+func (m *MockEnrollTOTPUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockEnrollTOTPUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockEnrollTOTPUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// MockEnrollTOTPMFARepository is a mock of EnrollTOTPMFARepository interface.
+type MockEnrollTOTPMFARepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnrollTOTPMFARepositoryMockRecorder
+}
+
+// MockEnrollTOTPMFARepositoryMockRecorder is the mock recorder for MockEnrollTOTPMFARepository.
+type MockEnrollTOTPMFARepositoryMockRecorder struct {
+	mock *MockEnrollTOTPMFARepository
+}
+
+// NewMockEnrollTOTPMFARepository creates a new mock instance.
+func NewMockEnrollTOTPMFARepository(ctrl *gomock.Controller) *MockEnrollTOTPMFARepository {
+	mock := &MockEnrollTOTPMFARepository{ctrl: ctrl}
+	mock.recorder = &MockEnrollTOTPMFARepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnrollTOTPMFARepository) EXPECT() *MockEnrollTOTPMFARepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConfirmTOTP mocks base method.
+func (m *MockEnrollTOTPMFARepository) ConfirmTOTP(arg0 context.Context, arg1 string, arg2 int64, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTOTP", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockEnrollTOTPMFARepositoryMockRecorder) ConfirmTOTP(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockEnrollTOTPMFARepository)(nil).ConfirmTOTP), arg0, arg1, arg2, arg3)
+}
+
+// SaveTOTP mocks base method.
+func (m *MockEnrollTOTPMFARepository) SaveTOTP(arg0 context.Context, arg1 entities.TOTP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTOTP", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTOTP indicates an expected call of SaveTOTP.
+func (mr *MockEnrollTOTPMFARepositoryMockRecorder) SaveTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTOTP", reflect.TypeOf((*MockEnrollTOTPMFARepository)(nil).SaveTOTP), arg0, arg1)
+}
+
+// SelectTOTP mocks base method.
+func (m *MockEnrollTOTPMFARepository) SelectTOTP(arg0 context.Context, arg1 string) (entities.TOTP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectTOTP", arg0, arg1)
+	ret0, _ := ret[0].(entities.TOTP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectTOTP indicates an expected call of SelectTOTP.
+func (mr *MockEnrollTOTPMFARepositoryMockRecorder) SelectTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectTOTP", reflect.TypeOf((*MockEnrollTOTPMFARepository)(nil).SelectTOTP), arg0, arg1)
+}
+
+// MockEnrollTOTPMFAService is a mock of EnrollTOTPMFAService interface.
+type MockEnrollTOTPMFAService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnrollTOTPMFAServiceMockRecorder
+}
+
+// MockEnrollTOTPMFAServiceMockRecorder is the mock recorder for MockEnrollTOTPMFAService.
+type MockEnrollTOTPMFAServiceMockRecorder struct {
+	mock *MockEnrollTOTPMFAService
+}
+
+// NewMockEnrollTOTPMFAService creates a new mock instance.
+func NewMockEnrollTOTPMFAService(ctrl *gomock.Controller) *MockEnrollTOTPMFAService {
+	mock := &MockEnrollTOTPMFAService{ctrl: ctrl}
+	mock.recorder = &MockEnrollTOTPMFAServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnrollTOTPMFAService) EXPECT() *MockEnrollTOTPMFAServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateRecoveryCodes mocks base method.
+func (m *MockEnrollTOTPMFAService) GenerateRecoveryCodes() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateRecoveryCodes")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateRecoveryCodes indicates an expected call of GenerateRecoveryCodes.
+func (mr *MockEnrollTOTPMFAServiceMockRecorder) GenerateRecoveryCodes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRecoveryCodes", reflect.TypeOf((*MockEnrollTOTPMFAService)(nil).GenerateRecoveryCodes))
+}
+
+// GenerateSecret mocks base method.
+func (m *MockEnrollTOTPMFAService) GenerateSecret() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecret")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSecret indicates an expected call of GenerateSecret.
+func (mr *MockEnrollTOTPMFAServiceMockRecorder) GenerateSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecret", reflect.TypeOf((*MockEnrollTOTPMFAService)(nil).GenerateSecret))
+}
+
+// HashRecoveryCode mocks base method.
+func (m *MockEnrollTOTPMFAService) HashRecoveryCode(code string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashRecoveryCode", code)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashRecoveryCode indicates an expected call of HashRecoveryCode.
+func (mr *MockEnrollTOTPMFAServiceMockRecorder) HashRecoveryCode(code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashRecoveryCode", reflect.TypeOf((*MockEnrollTOTPMFAService)(nil).HashRecoveryCode), code)
+}
+
+// URI mocks base method.
+func (m *MockEnrollTOTPMFAService) URI(account, secret string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URI", account, secret)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// URI indicates an expected call of URI.
+func (mr *MockEnrollTOTPMFAServiceMockRecorder) URI(account, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockEnrollTOTPMFAService)(nil).URI), account, secret)
+}
+
+// Validate mocks base method.
+func (m *MockEnrollTOTPMFAService) Validate(secret, code string, lastUsedStep int64) (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", secret, code, lastUsedStep)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockEnrollTOTPMFAServiceMockRecorder) Validate(secret, code, lastUsedStep interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockEnrollTOTPMFAService)(nil).Validate), secret, code, lastUsedStep)
+}
+
+// MockEnrollTOTPEncryptionService is a mock of EnrollTOTPEncryptionService interface.
+type MockEnrollTOTPEncryptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnrollTOTPEncryptionServiceMockRecorder
+}
+
+// MockEnrollTOTPEncryptionServiceMockRecorder is the mock recorder for MockEnrollTOTPEncryptionService.
+type MockEnrollTOTPEncryptionServiceMockRecorder struct {
+	mock *MockEnrollTOTPEncryptionService
+}
+
+// NewMockEnrollTOTPEncryptionService creates a new mock instance.
+func NewMockEnrollTOTPEncryptionService(ctrl *gomock.Controller) *MockEnrollTOTPEncryptionService {
+	mock := &MockEnrollTOTPEncryptionService{ctrl: ctrl}
+	mock.recorder = &MockEnrollTOTPEncryptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnrollTOTPEncryptionService) EXPECT() *MockEnrollTOTPEncryptionServiceMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockEnrollTOTPEncryptionService) Decrypt(ciphertext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ciphertext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockEnrollTOTPEncryptionServiceMockRecorder) Decrypt(ciphertext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockEnrollTOTPEncryptionService)(nil).Decrypt), ciphertext)
+}
+
+// Encrypt mocks base method.
+func (m *MockEnrollTOTPEncryptionService) Encrypt(plaintext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", plaintext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockEnrollTOTPEncryptionServiceMockRecorder) Encrypt(plaintext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockEnrollTOTPEncryptionService)(nil).Encrypt), plaintext)
+}
+
+// MockEnrollTOTPAuditLogger is a mock of EnrollTOTPAuditLogger interface.
+type MockEnrollTOTPAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnrollTOTPAuditLoggerMockRecorder
+}
+
+// MockEnrollTOTPAuditLoggerMockRecorder is the mock recorder for MockEnrollTOTPAuditLogger.
+type MockEnrollTOTPAuditLoggerMockRecorder struct {
+	mock *MockEnrollTOTPAuditLogger
+}
+
+// NewMockEnrollTOTPAuditLogger creates a new mock instance.
+func NewMockEnrollTOTPAuditLogger(ctrl *gomock.Controller) *MockEnrollTOTPAuditLogger {
+	mock := &MockEnrollTOTPAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockEnrollTOTPAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnrollTOTPAuditLogger) EXPECT() *MockEnrollTOTPAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockEnrollTOTPAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockEnrollTOTPAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockEnrollTOTPAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockTrustedDevicesRepository is a mock of TrustedDevicesRepository interface.
+type MockTrustedDevicesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrustedDevicesRepositoryMockRecorder
+}
+
+// MockTrustedDevicesRepositoryMockRecorder is the mock recorder for MockTrustedDevicesRepository.
+type MockTrustedDevicesRepositoryMockRecorder struct {
+	mock *MockTrustedDevicesRepository
+}
+
+// NewMockTrustedDevicesRepository creates a new mock instance.
+func NewMockTrustedDevicesRepository(ctrl *gomock.Controller) *MockTrustedDevicesRepository {
+	mock := &MockTrustedDevicesRepository{ctrl: ctrl}
+	mock.recorder = &MockTrustedDevicesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrustedDevicesRepository) EXPECT() *MockTrustedDevicesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockTrustedDevicesRepository) Delete(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTrustedDevicesRepositoryMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTrustedDevicesRepository)(nil).Delete), arg0, arg1, arg2)
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockTrustedDevicesRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockTrustedDevicesRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockTrustedDevicesRepository)(nil).DeleteByUserId), arg0, arg1)
+}
+
+// SelectByUserId mocks base method.
+func (m *MockTrustedDevicesRepository) SelectByUserId(arg0 context.Context, arg1 string) ([]entities.TrustedDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].([]entities.TrustedDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockTrustedDevicesRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockTrustedDevicesRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// MockTrustedDevicesAuditLogger is a mock of TrustedDevicesAuditLogger interface.
+type MockTrustedDevicesAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrustedDevicesAuditLoggerMockRecorder
+}
+
+// MockTrustedDevicesAuditLoggerMockRecorder is the mock recorder for MockTrustedDevicesAuditLogger.
+type MockTrustedDevicesAuditLoggerMockRecorder struct {
+	mock *MockTrustedDevicesAuditLogger
+}
+
+// NewMockTrustedDevicesAuditLogger creates a new mock instance.
+func NewMockTrustedDevicesAuditLogger(ctrl *gomock.Controller) *MockTrustedDevicesAuditLogger {
+	mock := &MockTrustedDevicesAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockTrustedDevicesAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrustedDevicesAuditLogger) EXPECT() *MockTrustedDevicesAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockTrustedDevicesAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockTrustedDevicesAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockTrustedDevicesAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockVerifyPhoneUserRepository is a mock of VerifyPhoneUserRepository interface.
+type MockVerifyPhoneUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyPhoneUserRepositoryMockRecorder
+}
+
+// MockVerifyPhoneUserRepositoryMockRecorder is the mock recorder for MockVerifyPhoneUserRepository.
+type MockVerifyPhoneUserRepositoryMockRecorder struct {
+	mock *MockVerifyPhoneUserRepository
+}
+
+// NewMockVerifyPhoneUserRepository creates a new mock instance.
+func NewMockVerifyPhoneUserRepository(ctrl *gomock.Controller) *MockVerifyPhoneUserRepository {
+	mock := &MockVerifyPhoneUserRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyPhoneUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyPhoneUserRepository) EXPECT() *MockVerifyPhoneUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUserId mocks base method.
+func (m *MockVerifyPhoneUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockVerifyPhoneUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockVerifyPhoneUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// UpdatePhone mocks base method.
+func (m *MockVerifyPhoneUserRepository) UpdatePhone(arg0 context.Context, arg1 string, arg2 entities.Phone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhone", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhone indicates an expected call of UpdatePhone.
+func (mr *MockVerifyPhoneUserRepositoryMockRecorder) UpdatePhone(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhone", reflect.TypeOf((*MockVerifyPhoneUserRepository)(nil).UpdatePhone), arg0, arg1, arg2)
+}
+
+// MockVerifyPhoneSMSCodeRepository is a mock of VerifyPhoneSMSCodeRepository interface.
+type MockVerifyPhoneSMSCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyPhoneSMSCodeRepositoryMockRecorder
+}
+
+// MockVerifyPhoneSMSCodeRepositoryMockRecorder is the mock recorder for MockVerifyPhoneSMSCodeRepository.
+type MockVerifyPhoneSMSCodeRepositoryMockRecorder struct {
+	mock *MockVerifyPhoneSMSCodeRepository
+}
+
+// NewMockVerifyPhoneSMSCodeRepository creates a new mock instance.
+func NewMockVerifyPhoneSMSCodeRepository(ctrl *gomock.Controller) *MockVerifyPhoneSMSCodeRepository {
+	mock := &MockVerifyPhoneSMSCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyPhoneSMSCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyPhoneSMSCodeRepository) EXPECT() *MockVerifyPhoneSMSCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteCode mocks base method.
+func (m *MockVerifyPhoneSMSCodeRepository) DeleteCode(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCode indicates an expected call of DeleteCode.
+func (mr *MockVerifyPhoneSMSCodeRepositoryMockRecorder) DeleteCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCode", reflect.TypeOf((*MockVerifyPhoneSMSCodeRepository)(nil).DeleteCode), arg0, arg1)
+}
+
+// RegisterCodeFailure mocks base method.
+func (m *MockVerifyPhoneSMSCodeRepository) RegisterCodeFailure(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterCodeFailure", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterCodeFailure indicates an expected call of RegisterCodeFailure.
+func (mr *MockVerifyPhoneSMSCodeRepositoryMockRecorder) RegisterCodeFailure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodeFailure", reflect.TypeOf((*MockVerifyPhoneSMSCodeRepository)(nil).RegisterCodeFailure), arg0, arg1)
+}
+
+// SaveCode mocks base method.
+func (m *MockVerifyPhoneSMSCodeRepository) SaveCode(arg0 context.Context, arg1 entities.SMSCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCode indicates an expected call of SaveCode.
+func (mr *MockVerifyPhoneSMSCodeRepositoryMockRecorder) SaveCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCode", reflect.TypeOf((*MockVerifyPhoneSMSCodeRepository)(nil).SaveCode), arg0, arg1)
+}
+
+// SelectCode mocks base method.
+func (m *MockVerifyPhoneSMSCodeRepository) SelectCode(arg0 context.Context, arg1, arg2 string) (entities.SMSCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.SMSCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCode indicates an expected call of SelectCode.
+func (mr *MockVerifyPhoneSMSCodeRepositoryMockRecorder) SelectCode(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCode", reflect.TypeOf((*MockVerifyPhoneSMSCodeRepository)(nil).SelectCode), arg0, arg1, arg2)
+}
+
+// MockVerifyPhoneRateLimitRepository is a mock of VerifyPhoneRateLimitRepository interface.
+type MockVerifyPhoneRateLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyPhoneRateLimitRepositoryMockRecorder
+}
+
+// MockVerifyPhoneRateLimitRepositoryMockRecorder is the mock recorder for MockVerifyPhoneRateLimitRepository.
+type MockVerifyPhoneRateLimitRepositoryMockRecorder struct {
+	mock *MockVerifyPhoneRateLimitRepository
+}
+
+// NewMockVerifyPhoneRateLimitRepository creates a new mock instance.
+func NewMockVerifyPhoneRateLimitRepository(ctrl *gomock.Controller) *MockVerifyPhoneRateLimitRepository {
+	mock := &MockVerifyPhoneRateLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockVerifyPhoneRateLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyPhoneRateLimitRepository) EXPECT() *MockVerifyPhoneRateLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Take mocks base method.
+func (m *MockVerifyPhoneRateLimitRepository) Take(arg0 context.Context, arg1 string, arg2 entities.RateLimit) (entities.RateLimitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.RateLimitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockVerifyPhoneRateLimitRepositoryMockRecorder) Take(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockVerifyPhoneRateLimitRepository)(nil).Take), arg0, arg1, arg2)
+}
+
+// MockVerifyPhoneHashService is a mock of VerifyPhoneHashService interface.
+type MockVerifyPhoneHashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyPhoneHashServiceMockRecorder
+}
+
+// MockVerifyPhoneHashServiceMockRecorder is the mock recorder for MockVerifyPhoneHashService.
+type MockVerifyPhoneHashServiceMockRecorder struct {
+	mock *MockVerifyPhoneHashService
+}
+
+// NewMockVerifyPhoneHashService creates a new mock instance.
+func NewMockVerifyPhoneHashService(ctrl *gomock.Controller) *MockVerifyPhoneHashService {
+	mock := &MockVerifyPhoneHashService{ctrl: ctrl}
+	mock.recorder = &MockVerifyPhoneHashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyPhoneHashService) EXPECT() *MockVerifyPhoneHashServiceMockRecorder {
+	return m.recorder
+}
+
+// CompareStringAndHash mocks base method.
+func (m *MockVerifyPhoneHashService) CompareStringAndHash(arg0, arg1 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareStringAndHash", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CompareStringAndHash indicates an expected call of CompareStringAndHash.
+func (mr *MockVerifyPhoneHashServiceMockRecorder) CompareStringAndHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareStringAndHash", reflect.TypeOf((*MockVerifyPhoneHashService)(nil).CompareStringAndHash), arg0, arg1)
+}
+
+// GenerateHash mocks base method.
+func (m *MockVerifyPhoneHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockVerifyPhoneHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockVerifyPhoneHashService)(nil).GenerateHash), stringToHash)
+}
+
+// MockVerifyPhoneRandomService is a mock of VerifyPhoneRandomService interface.
+type MockVerifyPhoneRandomService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyPhoneRandomServiceMockRecorder
+}
+
+// MockVerifyPhoneRandomServiceMockRecorder is the mock recorder for MockVerifyPhoneRandomService.
+type MockVerifyPhoneRandomServiceMockRecorder struct {
+	mock *MockVerifyPhoneRandomService
+}
+
+// NewMockVerifyPhoneRandomService creates a new mock instance.
+func NewMockVerifyPhoneRandomService(ctrl *gomock.Controller) *MockVerifyPhoneRandomService {
+	mock := &MockVerifyPhoneRandomService{ctrl: ctrl}
+	mock.recorder = &MockVerifyPhoneRandomServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyPhoneRandomService) EXPECT() *MockVerifyPhoneRandomServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateCode mocks base method.
+func (m *MockVerifyPhoneRandomService) GenerateCode(digits int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCode", digits)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCode indicates an expected call of GenerateCode.
+func (mr *MockVerifyPhoneRandomServiceMockRecorder) GenerateCode(digits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCode", reflect.TypeOf((*MockVerifyPhoneRandomService)(nil).GenerateCode), digits)
+}
+
+// MockVerifyPhoneSMSSender is a mock of VerifyPhoneSMSSender interface.
+type MockVerifyPhoneSMSSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyPhoneSMSSenderMockRecorder
+}
+
+// MockVerifyPhoneSMSSenderMockRecorder is the mock recorder for MockVerifyPhoneSMSSender.
+type MockVerifyPhoneSMSSenderMockRecorder struct {
+	mock *MockVerifyPhoneSMSSender
+}
+
+// NewMockVerifyPhoneSMSSender creates a new mock instance.
+func NewMockVerifyPhoneSMSSender(ctrl *gomock.Controller) *MockVerifyPhoneSMSSender {
+	mock := &MockVerifyPhoneSMSSender{ctrl: ctrl}
+	mock.recorder = &MockVerifyPhoneSMSSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyPhoneSMSSender) EXPECT() *MockVerifyPhoneSMSSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockVerifyPhoneSMSSender) Send(to, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", to, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockVerifyPhoneSMSSenderMockRecorder) Send(to, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockVerifyPhoneSMSSender)(nil).Send), to, message)
+}
+
+// MockVerifyPhoneAuditLogger is a mock of VerifyPhoneAuditLogger interface.
+type MockVerifyPhoneAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyPhoneAuditLoggerMockRecorder
+}
+
+// MockVerifyPhoneAuditLoggerMockRecorder is the mock recorder for MockVerifyPhoneAuditLogger.
+type MockVerifyPhoneAuditLoggerMockRecorder struct {
+	mock *MockVerifyPhoneAuditLogger
+}
+
+// NewMockVerifyPhoneAuditLogger creates a new mock instance.
+func NewMockVerifyPhoneAuditLogger(ctrl *gomock.Controller) *MockVerifyPhoneAuditLogger {
+	mock := &MockVerifyPhoneAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockVerifyPhoneAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyPhoneAuditLogger) EXPECT() *MockVerifyPhoneAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockVerifyPhoneAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockVerifyPhoneAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockVerifyPhoneAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockRegisterWebAuthnUserRepository is a mock of RegisterWebAuthnUserRepository interface.
+type MockRegisterWebAuthnUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegisterWebAuthnUserRepositoryMockRecorder
+}
+
+// MockRegisterWebAuthnUserRepositoryMockRecorder is the mock recorder for MockRegisterWebAuthnUserRepository.
+type MockRegisterWebAuthnUserRepositoryMockRecorder struct {
+	mock *MockRegisterWebAuthnUserRepository
+}
+
+// NewMockRegisterWebAuthnUserRepository creates a new mock instance.
+func NewMockRegisterWebAuthnUserRepository(ctrl *gomock.Controller) *MockRegisterWebAuthnUserRepository {
+	mock := &MockRegisterWebAuthnUserRepository{ctrl: ctrl}
+	mock.recorder = &MockRegisterWebAuthnUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegisterWebAuthnUserRepository) EXPECT() *MockRegisterWebAuthnUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUserId mocks base method.
+func (m *MockRegisterWebAuthnUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockRegisterWebAuthnUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockRegisterWebAuthnUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// MockRegisterWebAuthnRepository is a mock of RegisterWebAuthnRepository interface.
+type MockRegisterWebAuthnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegisterWebAuthnRepositoryMockRecorder
+}
+
+// MockRegisterWebAuthnRepositoryMockRecorder is the mock recorder for MockRegisterWebAuthnRepository.
+type MockRegisterWebAuthnRepositoryMockRecorder struct {
+	mock *MockRegisterWebAuthnRepository
+}
+
+// NewMockRegisterWebAuthnRepository creates a new mock instance.
+func NewMockRegisterWebAuthnRepository(ctrl *gomock.Controller) *MockRegisterWebAuthnRepository {
+	mock := &MockRegisterWebAuthnRepository{ctrl: ctrl}
+	mock.recorder = &MockRegisterWebAuthnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegisterWebAuthnRepository) EXPECT() *MockRegisterWebAuthnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// InsertCredential mocks base method.
+func (m *MockRegisterWebAuthnRepository) InsertCredential(arg0 context.Context, arg1 entities.WebAuthnCredential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertCredential", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertCredential indicates an expected call of InsertCredential.
+func (mr *MockRegisterWebAuthnRepositoryMockRecorder) InsertCredential(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertCredential", reflect.TypeOf((*MockRegisterWebAuthnRepository)(nil).InsertCredential), arg0, arg1)
+}
+
+// InsertSession mocks base method.
+func (m *MockRegisterWebAuthnRepository) InsertSession(arg0 context.Context, arg1 entities.WebAuthnSession) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSession", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertSession indicates an expected call of InsertSession.
+func (mr *MockRegisterWebAuthnRepositoryMockRecorder) InsertSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSession", reflect.TypeOf((*MockRegisterWebAuthnRepository)(nil).InsertSession), arg0, arg1)
+}
+
+// SelectCredentials mocks base method.
+func (m *MockRegisterWebAuthnRepository) SelectCredentials(arg0 context.Context, arg1 string) ([]entities.WebAuthnCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCredentials", arg0, arg1)
+	ret0, _ := ret[0].([]entities.WebAuthnCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCredentials indicates an expected call of SelectCredentials.
+func (mr *MockRegisterWebAuthnRepositoryMockRecorder) SelectCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCredentials", reflect.TypeOf((*MockRegisterWebAuthnRepository)(nil).SelectCredentials), arg0, arg1)
+}
+
+// TakeSession mocks base method.
+func (m *MockRegisterWebAuthnRepository) TakeSession(arg0 context.Context, arg1 string) (entities.WebAuthnSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeSession", arg0, arg1)
+	ret0, _ := ret[0].(entities.WebAuthnSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeSession indicates an expected call of TakeSession.
+func (mr *MockRegisterWebAuthnRepositoryMockRecorder) TakeSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeSession", reflect.TypeOf((*MockRegisterWebAuthnRepository)(nil).TakeSession), arg0, arg1)
+}
+
+// MockRegisterWebAuthnService is a mock of RegisterWebAuthnService interface.
+type MockRegisterWebAuthnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegisterWebAuthnServiceMockRecorder
+}
+
+// MockRegisterWebAuthnServiceMockRecorder is the mock recorder for MockRegisterWebAuthnService.
+type MockRegisterWebAuthnServiceMockRecorder struct {
+	mock *MockRegisterWebAuthnService
+}
+
+// NewMockRegisterWebAuthnService creates a new mock instance.
+func NewMockRegisterWebAuthnService(ctrl *gomock.Controller) *MockRegisterWebAuthnService {
+	mock := &MockRegisterWebAuthnService{ctrl: ctrl}
+	mock.recorder = &MockRegisterWebAuthnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegisterWebAuthnService) EXPECT() *MockRegisterWebAuthnServiceMockRecorder {
+	return m.recorder
+}
+
+// BeginRegistration mocks base method.
+func (m *MockRegisterWebAuthnService) BeginRegistration(user entities.User, credentials []entities.WebAuthnCredential) ([]byte, entities.WebAuthnSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginRegistration", user, credentials)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(entities.WebAuthnSession)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BeginRegistration indicates an expected call of BeginRegistration.
+func (mr *MockRegisterWebAuthnServiceMockRecorder) BeginRegistration(user, credentials interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginRegistration", reflect.TypeOf((*MockRegisterWebAuthnService)(nil).BeginRegistration), user, credentials)
+}
+
+// FinishRegistration mocks base method.
+func (m *MockRegisterWebAuthnService) FinishRegistration(user entities.User, credentials []entities.WebAuthnCredential, session entities.WebAuthnSession, response []byte) (entities.WebAuthnCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRegistration", user, credentials, session, response)
+	ret0, _ := ret[0].(entities.WebAuthnCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishRegistration indicates an expected call of FinishRegistration.
+func (mr *MockRegisterWebAuthnServiceMockRecorder) FinishRegistration(user, credentials, session, response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRegistration", reflect.TypeOf((*MockRegisterWebAuthnService)(nil).FinishRegistration), user, credentials, session, response)
+}
+
+// MockRegisterWebAuthnAuditLogger is a mock of RegisterWebAuthnAuditLogger interface.
+type MockRegisterWebAuthnAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegisterWebAuthnAuditLoggerMockRecorder
+}
+
+// MockRegisterWebAuthnAuditLoggerMockRecorder is the mock recorder for MockRegisterWebAuthnAuditLogger.
+type MockRegisterWebAuthnAuditLoggerMockRecorder struct {
+	mock *MockRegisterWebAuthnAuditLogger
+}
+
+// NewMockRegisterWebAuthnAuditLogger creates a new mock instance.
+func NewMockRegisterWebAuthnAuditLogger(ctrl *gomock.Controller) *MockRegisterWebAuthnAuditLogger {
+	mock := &MockRegisterWebAuthnAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockRegisterWebAuthnAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegisterWebAuthnAuditLogger) EXPECT() *MockRegisterWebAuthnAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockRegisterWebAuthnAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockRegisterWebAuthnAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockRegisterWebAuthnAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockChangePasswordSessionRepository is a mock of ChangePasswordSessionRepository interface.
+type MockChangePasswordSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangePasswordSessionRepositoryMockRecorder
+}
+
+// MockChangePasswordSessionRepositoryMockRecorder is the mock recorder for MockChangePasswordSessionRepository.
+type MockChangePasswordSessionRepositoryMockRecorder struct {
+	mock *MockChangePasswordSessionRepository
+}
+
+// NewMockChangePasswordSessionRepository creates a new mock instance.
+func NewMockChangePasswordSessionRepository(ctrl *gomock.Controller) *MockChangePasswordSessionRepository {
+	mock := &MockChangePasswordSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockChangePasswordSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangePasswordSessionRepository) EXPECT() *MockChangePasswordSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockChangePasswordSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockChangePasswordSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockChangePasswordSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+}
+
+// MockChangePasswordLoginAttemptRepository is a mock of ChangePasswordLoginAttemptRepository interface.
+type MockChangePasswordLoginAttemptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangePasswordLoginAttemptRepositoryMockRecorder
+}
+
+// MockChangePasswordLoginAttemptRepositoryMockRecorder is the mock recorder for MockChangePasswordLoginAttemptRepository.
+type MockChangePasswordLoginAttemptRepositoryMockRecorder struct {
+	mock *MockChangePasswordLoginAttemptRepository
+}
+
+// NewMockChangePasswordLoginAttemptRepository creates a new mock instance.
+func NewMockChangePasswordLoginAttemptRepository(ctrl *gomock.Controller) *MockChangePasswordLoginAttemptRepository {
+	mock := &MockChangePasswordLoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockChangePasswordLoginAttemptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangePasswordLoginAttemptRepository) EXPECT() *MockChangePasswordLoginAttemptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Reset mocks base method.
+func (m *MockChangePasswordLoginAttemptRepository) Reset(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockChangePasswordLoginAttemptRepositoryMockRecorder) Reset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockChangePasswordLoginAttemptRepository)(nil).Reset), arg0, arg1)
+}
+
+// MockChangePasswordHashService is a mock of ChangePasswordHashService interface.
+type MockChangePasswordHashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangePasswordHashServiceMockRecorder
+}
+
+// MockChangePasswordHashServiceMockRecorder is the mock recorder for MockChangePasswordHashService.
+type MockChangePasswordHashServiceMockRecorder struct {
+	mock *MockChangePasswordHashService
+}
+
+// NewMockChangePasswordHashService creates a new mock instance.
+func NewMockChangePasswordHashService(ctrl *gomock.Controller) *MockChangePasswordHashService {
+	mock := &MockChangePasswordHashService{ctrl: ctrl}
+	mock.recorder = &MockChangePasswordHashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangePasswordHashService) EXPECT() *MockChangePasswordHashServiceMockRecorder {
+	return m.recorder
+}
+
+// CompareStringAndHash mocks base method.
+func (m *MockChangePasswordHashService) CompareStringAndHash(arg0, arg1 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareStringAndHash", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CompareStringAndHash indicates an expected call of CompareStringAndHash.
+func (mr *MockChangePasswordHashServiceMockRecorder) CompareStringAndHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareStringAndHash", reflect.TypeOf((*MockChangePasswordHashService)(nil).CompareStringAndHash), arg0, arg1)
+}
+
+// GenerateHash mocks base method.
+func (m *MockChangePasswordHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockChangePasswordHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockChangePasswordHashService)(nil).GenerateHash), stringToHash)
+}
+
+// MockChangePasswordBreachedPasswordService is a mock of ChangePasswordBreachedPasswordService interface.
+type MockChangePasswordBreachedPasswordService struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangePasswordBreachedPasswordServiceMockRecorder
+}
+
+// MockChangePasswordBreachedPasswordServiceMockRecorder is the mock recorder for MockChangePasswordBreachedPasswordService.
+type MockChangePasswordBreachedPasswordServiceMockRecorder struct {
+	mock *MockChangePasswordBreachedPasswordService
+}
+
+// NewMockChangePasswordBreachedPasswordService creates a new mock instance.
+func NewMockChangePasswordBreachedPasswordService(ctrl *gomock.Controller) *MockChangePasswordBreachedPasswordService {
+	mock := &MockChangePasswordBreachedPasswordService{ctrl: ctrl}
+	mock.recorder = &MockChangePasswordBreachedPasswordServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangePasswordBreachedPasswordService) EXPECT() *MockChangePasswordBreachedPasswordServiceMockRecorder {
+	return m.recorder
+}
+
+// IsBreached mocks base method.
+func (m *MockChangePasswordBreachedPasswordService) IsBreached(password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBreached", password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBreached indicates an expected call of IsBreached.
+func (mr *MockChangePasswordBreachedPasswordServiceMockRecorder) IsBreached(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBreached", reflect.TypeOf((*MockChangePasswordBreachedPasswordService)(nil).IsBreached), password)
+}
+
+// MockChangePasswordAuditLogger is a mock of ChangePasswordAuditLogger interface.
+type MockChangePasswordAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangePasswordAuditLoggerMockRecorder
+}
+
+// MockChangePasswordAuditLoggerMockRecorder is the mock recorder for MockChangePasswordAuditLogger.
+type MockChangePasswordAuditLoggerMockRecorder struct {
+	mock *MockChangePasswordAuditLogger
+}
+
+// NewMockChangePasswordAuditLogger creates a new mock instance.
+func NewMockChangePasswordAuditLogger(ctrl *gomock.Controller) *MockChangePasswordAuditLogger {
+	mock := &MockChangePasswordAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockChangePasswordAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangePasswordAuditLogger) EXPECT() *MockChangePasswordAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockChangePasswordAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockChangePasswordAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockChangePasswordAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockGenerateTokensUserRepository is a mock of GenerateTokensUserRepository interface.
+type MockGenerateTokensUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenerateTokensUserRepositoryMockRecorder
+}
+
+// MockGenerateTokensUserRepositoryMockRecorder is the mock recorder for MockGenerateTokensUserRepository.
+type MockGenerateTokensUserRepositoryMockRecorder struct {
+	mock *MockGenerateTokensUserRepository
+}
+
+// NewMockGenerateTokensUserRepository creates a new mock instance.
+func NewMockGenerateTokensUserRepository(ctrl *gomock.Controller) *MockGenerateTokensUserRepository {
+	mock := &MockGenerateTokensUserRepository{ctrl: ctrl}
+	mock.recorder = &MockGenerateTokensUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenerateTokensUserRepository) EXPECT() *MockGenerateTokensUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUserId mocks base method.
+func (m *MockGenerateTokensUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockGenerateTokensUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockGenerateTokensUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// MockGenerateTokensSessionRepository is a mock of GenerateTokensSessionRepository interface.
+type MockGenerateTokensSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenerateTokensSessionRepositoryMockRecorder
+}
+
+// MockGenerateTokensSessionRepositoryMockRecorder is the mock recorder for MockGenerateTokensSessionRepository.
+type MockGenerateTokensSessionRepositoryMockRecorder struct {
+	mock *MockGenerateTokensSessionRepository
+}
+
+// NewMockGenerateTokensSessionRepository creates a new mock instance.
+func NewMockGenerateTokensSessionRepository(ctrl *gomock.Controller) *MockGenerateTokensSessionRepository {
+	mock := &MockGenerateTokensSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockGenerateTokensSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenerateTokensSessionRepository) EXPECT() *MockGenerateTokensSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockGenerateTokensSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockGenerateTokensSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockGenerateTokensSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+}
+
+// Insert mocks base method.
+func (m *MockGenerateTokensSessionRepository) Insert(arg0 context.Context, arg1 entities.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockGenerateTokensSessionRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockGenerateTokensSessionRepository)(nil).Insert), arg0, arg1)
+}
+
+// MockGenerateTokensHashService is a mock of GenerateTokensHashService interface.
+type MockGenerateTokensHashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenerateTokensHashServiceMockRecorder
+}
+
+// MockGenerateTokensHashServiceMockRecorder is the mock recorder for MockGenerateTokensHashService.
+type MockGenerateTokensHashServiceMockRecorder struct {
+	mock *MockGenerateTokensHashService
+}
+
+// NewMockGenerateTokensHashService creates a new mock instance.
+func NewMockGenerateTokensHashService(ctrl *gomock.Controller) *MockGenerateTokensHashService {
+	mock := &MockGenerateTokensHashService{ctrl: ctrl}
+	mock.recorder = &MockGenerateTokensHashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenerateTokensHashService) EXPECT() *MockGenerateTokensHashServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateHash mocks base method.
+func (m *MockGenerateTokensHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockGenerateTokensHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockGenerateTokensHashService)(nil).GenerateHash), stringToHash)
+}
+
+// MockGenerateTokensCookieService is a mock of GenerateTokensCookieService interface.
+type MockGenerateTokensCookieService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenerateTokensCookieServiceMockRecorder
+}
+
+// MockGenerateTokensCookieServiceMockRecorder is the mock recorder for MockGenerateTokensCookieService.
+type MockGenerateTokensCookieServiceMockRecorder struct {
+	mock *MockGenerateTokensCookieService
+}
+
+// NewMockGenerateTokensCookieService creates a new mock instance.
+func NewMockGenerateTokensCookieService(ctrl *gomock.Controller) *MockGenerateTokensCookieService {
+	mock := &MockGenerateTokensCookieService{ctrl: ctrl}
+	mock.recorder = &MockGenerateTokensCookieServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenerateTokensCookieService) EXPECT() *MockGenerateTokensCookieServiceMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockGenerateTokensCookieService) Set(w http.ResponseWriter, name, value string, expires time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", w, name, value, expires)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockGenerateTokensCookieServiceMockRecorder) Set(w, name, value, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockGenerateTokensCookieService)(nil).Set), w, name, value, expires)
+}
+
+// MockGenerateTokensSessionService is a mock of GenerateTokensSessionService interface.
+type MockGenerateTokensSessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenerateTokensSessionServiceMockRecorder
+}
+
+// MockGenerateTokensSessionServiceMockRecorder is the mock recorder for MockGenerateTokensSessionService.
+type MockGenerateTokensSessionServiceMockRecorder struct {
+	mock *MockGenerateTokensSessionService
+}
+
+// NewMockGenerateTokensSessionService creates a new mock instance.
+func NewMockGenerateTokensSessionService(ctrl *gomock.Controller) *MockGenerateTokensSessionService {
+	mock := &MockGenerateTokensSessionService{ctrl: ctrl}
+	mock.recorder = &MockGenerateTokensSessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenerateTokensSessionService) EXPECT() *MockGenerateTokensSessionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockGenerateTokensSessionService) CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", account, membership, authentication)
+	ret0, _ := ret[0].(entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockGenerateTokensSessionServiceMockRecorder) CreateSession(account, membership, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockGenerateTokensSessionService)(nil).CreateSession), account, membership, authentication)
+}
+
+// MockGenerateTokensAuditLogger is a mock of GenerateTokensAuditLogger interface.
+type MockGenerateTokensAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenerateTokensAuditLoggerMockRecorder
+}
+
+// MockGenerateTokensAuditLoggerMockRecorder is the mock recorder for MockGenerateTokensAuditLogger.
+type MockGenerateTokensAuditLoggerMockRecorder struct {
+	mock *MockGenerateTokensAuditLogger
+}
+
+// NewMockGenerateTokensAuditLogger creates a new mock instance.
+func NewMockGenerateTokensAuditLogger(ctrl *gomock.Controller) *MockGenerateTokensAuditLogger {
+	mock := &MockGenerateTokensAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockGenerateTokensAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenerateTokensAuditLogger) EXPECT() *MockGenerateTokensAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockGenerateTokensAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockGenerateTokensAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockGenerateTokensAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockRefreshSessionUserRepository is a mock of RefreshSessionUserRepository interface.
+type MockRefreshSessionUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshSessionUserRepositoryMockRecorder
+}
+
+// MockRefreshSessionUserRepositoryMockRecorder is the mock recorder for MockRefreshSessionUserRepository.
+type MockRefreshSessionUserRepositoryMockRecorder struct {
+	mock *MockRefreshSessionUserRepository
+}
+
+// NewMockRefreshSessionUserRepository creates a new mock instance.
+func NewMockRefreshSessionUserRepository(ctrl *gomock.Controller) *MockRefreshSessionUserRepository {
+	mock := &MockRefreshSessionUserRepository{ctrl: ctrl}
+	mock.recorder = &MockRefreshSessionUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshSessionUserRepository) EXPECT() *MockRefreshSessionUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUserId mocks base method.
+func (m *MockRefreshSessionUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockRefreshSessionUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockRefreshSessionUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// MockRefreshSessionSessionRepository is a mock of RefreshSessionSessionRepository interface.
+type MockRefreshSessionSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshSessionSessionRepositoryMockRecorder
+}
+
+// MockRefreshSessionSessionRepositoryMockRecorder is the mock recorder for MockRefreshSessionSessionRepository.
+type MockRefreshSessionSessionRepositoryMockRecorder struct {
+	mock *MockRefreshSessionSessionRepository
+}
+
+// NewMockRefreshSessionSessionRepository creates a new mock instance.
+func NewMockRefreshSessionSessionRepository(ctrl *gomock.Controller) *MockRefreshSessionSessionRepository {
+	mock := &MockRefreshSessionSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockRefreshSessionSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshSessionSessionRepository) EXPECT() *MockRefreshSessionSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockRefreshSessionSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockRefreshSessionSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockRefreshSessionSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+}
+
+// SelectByUserId mocks base method.
+func (m *MockRefreshSessionSessionRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockRefreshSessionSessionRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockRefreshSessionSessionRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockRefreshSessionSessionRepository) Update(context context.Context, session entities.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", context, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRefreshSessionSessionRepositoryMockRecorder) Update(context, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRefreshSessionSessionRepository)(nil).Update), context, session)
+}
+
+// UpdateWithEvent mocks base method.
+func (m *MockRefreshSessionSessionRepository) UpdateWithEvent(context context.Context, session entities.Session, event entities.WebhookEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithEvent", context, session, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithEvent indicates an expected call of UpdateWithEvent.
+func (mr *MockRefreshSessionSessionRepositoryMockRecorder) UpdateWithEvent(context, session, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithEvent", reflect.TypeOf((*MockRefreshSessionSessionRepository)(nil).UpdateWithEvent), context, session, event)
+}
+
+// MockRefreshSessionSessionService is a mock of RefreshSessionSessionService interface.
+type MockRefreshSessionSessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshSessionSessionServiceMockRecorder
+}
+
+// MockRefreshSessionSessionServiceMockRecorder is the mock recorder for MockRefreshSessionSessionService.
+type MockRefreshSessionSessionServiceMockRecorder struct {
+	mock *MockRefreshSessionSessionService
+}
+
+// NewMockRefreshSessionSessionService creates a new mock instance.
+func NewMockRefreshSessionSessionService(ctrl *gomock.Controller) *MockRefreshSessionSessionService {
+	mock := &MockRefreshSessionSessionService{ctrl: ctrl}
+	mock.recorder = &MockRefreshSessionSessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshSessionSessionService) EXPECT() *MockRefreshSessionSessionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockRefreshSessionSessionService) CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", account, membership, authentication)
+	ret0, _ := ret[0].(entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockRefreshSessionSessionServiceMockRecorder) CreateSession(account, membership, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRefreshSessionSessionService)(nil).CreateSession), account, membership, authentication)
+}
+
+// ParseToken mocks base method.
+func (m *MockRefreshSessionSessionService) ParseToken(token string) (entities.AccessTokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseToken", token)
+	ret0, _ := ret[0].(entities.AccessTokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseToken indicates an expected call of ParseToken.
+func (mr *MockRefreshSessionSessionServiceMockRecorder) ParseToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockRefreshSessionSessionService)(nil).ParseToken), token)
+}
+
+// MockRefreshSessionCookieService is a mock of RefreshSessionCookieService interface.
+type MockRefreshSessionCookieService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshSessionCookieServiceMockRecorder
+}
+
+// MockRefreshSessionCookieServiceMockRecorder is the mock recorder for MockRefreshSessionCookieService.
+type MockRefreshSessionCookieServiceMockRecorder struct {
+	mock *MockRefreshSessionCookieService
+}
+
+// NewMockRefreshSessionCookieService creates a new mock instance.
+func NewMockRefreshSessionCookieService(ctrl *gomock.Controller) *MockRefreshSessionCookieService {
+	mock := &MockRefreshSessionCookieService{ctrl: ctrl}
+	mock.recorder = &MockRefreshSessionCookieServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshSessionCookieService) EXPECT() *MockRefreshSessionCookieServiceMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockRefreshSessionCookieService) Clear(w http.ResponseWriter, name string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Clear", w, name)
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockRefreshSessionCookieServiceMockRecorder) Clear(w, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockRefreshSessionCookieService)(nil).Clear), w, name)
+}
+
+// Set mocks base method.
+func (m *MockRefreshSessionCookieService) Set(w http.ResponseWriter, name, value string, expires time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", w, name, value, expires)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockRefreshSessionCookieServiceMockRecorder) Set(w, name, value, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRefreshSessionCookieService)(nil).Set), w, name, value, expires)
+}
+
+// MockRefreshSessionHashProvider is a mock of RefreshSessionHashProvider interface.
+type MockRefreshSessionHashProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshSessionHashProviderMockRecorder
+}
+
+// MockRefreshSessionHashProviderMockRecorder is the mock recorder for MockRefreshSessionHashProvider.
+type MockRefreshSessionHashProviderMockRecorder struct {
+	mock *MockRefreshSessionHashProvider
+}
+
+// NewMockRefreshSessionHashProvider creates a new mock instance.
+func NewMockRefreshSessionHashProvider(ctrl *gomock.Controller) *MockRefreshSessionHashProvider {
+	mock := &MockRefreshSessionHashProvider{ctrl: ctrl}
+	mock.recorder = &MockRefreshSessionHashProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshSessionHashProvider) EXPECT() *MockRefreshSessionHashProviderMockRecorder {
+	return m.recorder
+}
+
+// CompareStringAndHash mocks base method.
+func (m *MockRefreshSessionHashProvider) CompareStringAndHash(arg0, arg1 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareStringAndHash", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CompareStringAndHash indicates an expected call of CompareStringAndHash.
+func (mr *MockRefreshSessionHashProviderMockRecorder) CompareStringAndHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareStringAndHash", reflect.TypeOf((*MockRefreshSessionHashProvider)(nil).CompareStringAndHash), arg0, arg1)
+}
+
+// GenerateHash mocks base method.
+func (m *MockRefreshSessionHashProvider) GenerateHash(stringToHash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockRefreshSessionHashProviderMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockRefreshSessionHashProvider)(nil).GenerateHash), stringToHash)
+}
+
+// MockRefreshSessionOrganizationRepository is a mock of RefreshSessionOrganizationRepository interface.
+type MockRefreshSessionOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshSessionOrganizationRepositoryMockRecorder
+}
+
+// MockRefreshSessionOrganizationRepositoryMockRecorder is the mock recorder for MockRefreshSessionOrganizationRepository.
+type MockRefreshSessionOrganizationRepositoryMockRecorder struct {
+	mock *MockRefreshSessionOrganizationRepository
+}
+
+// NewMockRefreshSessionOrganizationRepository creates a new mock instance.
+func NewMockRefreshSessionOrganizationRepository(ctrl *gomock.Controller) *MockRefreshSessionOrganizationRepository {
+	mock := &MockRefreshSessionOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockRefreshSessionOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshSessionOrganizationRepository) EXPECT() *MockRefreshSessionOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectMember mocks base method.
+func (m *MockRefreshSessionOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockRefreshSessionOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockRefreshSessionOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
+}
+
+// MockRefreshSessionRiskEngine is a mock of RefreshSessionRiskEngine interface.
+type MockRefreshSessionRiskEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshSessionRiskEngineMockRecorder
+}
+
+// MockRefreshSessionRiskEngineMockRecorder is the mock recorder for MockRefreshSessionRiskEngine.
+type MockRefreshSessionRiskEngineMockRecorder struct {
+	mock *MockRefreshSessionRiskEngine
+}
+
+// NewMockRefreshSessionRiskEngine creates a new mock instance.
+func NewMockRefreshSessionRiskEngine(ctrl *gomock.Controller) *MockRefreshSessionRiskEngine {
+	mock := &MockRefreshSessionRiskEngine{ctrl: ctrl}
+	mock.recorder = &MockRefreshSessionRiskEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshSessionRiskEngine) EXPECT() *MockRefreshSessionRiskEngineMockRecorder {
+	return m.recorder
+}
+
+// Assess mocks base method.
+func (m *MockRefreshSessionRiskEngine) Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assess", context, userId, userAgent, ip)
+	ret0, _ := ret[0].(entities.RiskAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assess indicates an expected call of Assess.
+func (mr *MockRefreshSessionRiskEngineMockRecorder) Assess(context, userId, userAgent, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assess", reflect.TypeOf((*MockRefreshSessionRiskEngine)(nil).Assess), context, userId, userAgent, ip)
+}
+
+// Record mocks base method.
+func (m *MockRefreshSessionRiskEngine) Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", context, user, assessment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockRefreshSessionRiskEngineMockRecorder) Record(context, user, assessment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockRefreshSessionRiskEngine)(nil).Record), context, user, assessment)
+}
+
+// MockRefreshSessionAuditLogger is a mock of RefreshSessionAuditLogger interface.
+type MockRefreshSessionAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshSessionAuditLoggerMockRecorder
+}
+
+// MockRefreshSessionAuditLoggerMockRecorder is the mock recorder for MockRefreshSessionAuditLogger.
+type MockRefreshSessionAuditLoggerMockRecorder struct {
+	mock *MockRefreshSessionAuditLogger
+}
+
+// NewMockRefreshSessionAuditLogger creates a new mock instance.
+func NewMockRefreshSessionAuditLogger(ctrl *gomock.Controller) *MockRefreshSessionAuditLogger {
+	mock := &MockRefreshSessionAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockRefreshSessionAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshSessionAuditLogger) EXPECT() *MockRefreshSessionAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockRefreshSessionAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockRefreshSessionAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockRefreshSessionAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockGetUserUserRepository is a mock of GetUserUserRepository interface.
+type MockGetUserUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUserUserRepositoryMockRecorder
+}
+
+// MockGetUserUserRepositoryMockRecorder is the mock recorder for MockGetUserUserRepository.
+type MockGetUserUserRepositoryMockRecorder struct {
+	mock *MockGetUserUserRepository
+}
+
+// NewMockGetUserUserRepository creates a new mock instance.
+func NewMockGetUserUserRepository(ctrl *gomock.Controller) *MockGetUserUserRepository {
+	mock := &MockGetUserUserRepository{ctrl: ctrl}
+	mock.recorder = &MockGetUserUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserUserRepository) EXPECT() *MockGetUserUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUserId mocks base method.
+func (m *MockGetUserUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockGetUserUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockGetUserUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// MockGetUserOrganizationRepository is a mock of GetUserOrganizationRepository interface.
+type MockGetUserOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUserOrganizationRepositoryMockRecorder
+}
+
+// MockGetUserOrganizationRepositoryMockRecorder is the mock recorder for MockGetUserOrganizationRepository.
+type MockGetUserOrganizationRepositoryMockRecorder struct {
+	mock *MockGetUserOrganizationRepository
+}
+
+// NewMockGetUserOrganizationRepository creates a new mock instance.
+func NewMockGetUserOrganizationRepository(ctrl *gomock.Controller) *MockGetUserOrganizationRepository {
+	mock := &MockGetUserOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockGetUserOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserOrganizationRepository) EXPECT() *MockGetUserOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectMembershipsByUserId mocks base method.
+func (m *MockGetUserOrganizationRepository) SelectMembershipsByUserId(arg0 context.Context, arg1 string) ([]entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMembershipsByUserId", arg0, arg1)
+	ret0, _ := ret[0].([]entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMembershipsByUserId indicates an expected call of SelectMembershipsByUserId.
+func (mr *MockGetUserOrganizationRepositoryMockRecorder) SelectMembershipsByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMembershipsByUserId", reflect.TypeOf((*MockGetUserOrganizationRepository)(nil).SelectMembershipsByUserId), arg0, arg1)
+}
+
+// MockGetUserAuditLogger is a mock of GetUserAuditLogger interface.
+type MockGetUserAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUserAuditLoggerMockRecorder
+}
+
+// MockGetUserAuditLoggerMockRecorder is the mock recorder for MockGetUserAuditLogger.
+type MockGetUserAuditLoggerMockRecorder struct {
+	mock *MockGetUserAuditLogger
+}
+
+// NewMockGetUserAuditLogger creates a new mock instance.
+func NewMockGetUserAuditLogger(ctrl *gomock.Controller) *MockGetUserAuditLogger {
+	mock := &MockGetUserAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockGetUserAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserAuditLogger) EXPECT() *MockGetUserAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockGetUserAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockGetUserAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockGetUserAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockListUsersUserRepository is a mock of ListUsersUserRepository interface.
+type MockListUsersUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockListUsersUserRepositoryMockRecorder
+}
+
+// MockListUsersUserRepositoryMockRecorder is the mock recorder for MockListUsersUserRepository.
+type MockListUsersUserRepositoryMockRecorder struct {
+	mock *MockListUsersUserRepository
+}
+
+// NewMockListUsersUserRepository creates a new mock instance.
+func NewMockListUsersUserRepository(ctrl *gomock.Controller) *MockListUsersUserRepository {
+	mock := &MockListUsersUserRepository{ctrl: ctrl}
+	mock.recorder = &MockListUsersUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListUsersUserRepository) EXPECT() *MockListUsersUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockListUsersUserRepository) Count(arg0 context.Context, arg1 repositories.UserFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockListUsersUserRepositoryMockRecorder) Count(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockListUsersUserRepository)(nil).Count), arg0, arg1)
+}
+
+// SelectList mocks base method.
+func (m *MockListUsersUserRepository) SelectList(arg0 context.Context, arg1 repositories.UserFilter) ([]entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectList", arg0, arg1)
+	ret0, _ := ret[0].([]entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectList indicates an expected call of SelectList.
+func (mr *MockListUsersUserRepositoryMockRecorder) SelectList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectList", reflect.TypeOf((*MockListUsersUserRepository)(nil).SelectList), arg0, arg1)
+}
+
+// MockListUsersAuditLogger is a mock of ListUsersAuditLogger interface.
+type MockListUsersAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockListUsersAuditLoggerMockRecorder
+}
+
+// MockListUsersAuditLoggerMockRecorder is the mock recorder for MockListUsersAuditLogger.
+type MockListUsersAuditLoggerMockRecorder struct {
+	mock *MockListUsersAuditLogger
+}
+
+// NewMockListUsersAuditLogger creates a new mock instance.
+func NewMockListUsersAuditLogger(ctrl *gomock.Controller) *MockListUsersAuditLogger {
+	mock := &MockListUsersAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockListUsersAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListUsersAuditLogger) EXPECT() *MockListUsersAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockListUsersAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockListUsersAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockListUsersAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockUpdateUserUserRepository is a mock of UpdateUserUserRepository interface.
+type MockUpdateUserUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateUserUserRepositoryMockRecorder
+}
+
+// MockUpdateUserUserRepositoryMockRecorder is the mock recorder for MockUpdateUserUserRepository.
+type MockUpdateUserUserRepositoryMockRecorder struct {
+	mock *MockUpdateUserUserRepository
+}
+
+// NewMockUpdateUserUserRepository creates a new mock instance.
+func NewMockUpdateUserUserRepository(ctrl *gomock.Controller) *MockUpdateUserUserRepository {
+	mock := &MockUpdateUserUserRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateUserUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateUserUserRepository) EXPECT() *MockUpdateUserUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CheckEmailExists mocks base method.
+func (m *MockUpdateUserUserRepository) CheckEmailExists(arg0 context.Context, arg1 entities.Email) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckEmailExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckEmailExists indicates an expected call of CheckEmailExists.
+func (mr *MockUpdateUserUserRepositoryMockRecorder) CheckEmailExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEmailExists", reflect.TypeOf((*MockUpdateUserUserRepository)(nil).CheckEmailExists), arg0, arg1)
+}
+
+// SelectByUserId mocks base method.
+func (m *MockUpdateUserUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockUpdateUserUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockUpdateUserUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockUpdateUserUserRepository) Update(arg0 context.Context, arg1 entities.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateUserUserRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateUserUserRepository)(nil).Update), arg0, arg1)
+}
+
+// MockUpdateUserSessionRepository is a mock of UpdateUserSessionRepository interface.
+type MockUpdateUserSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateUserSessionRepositoryMockRecorder
+}
+
+// MockUpdateUserSessionRepositoryMockRecorder is the mock recorder for MockUpdateUserSessionRepository.
+type MockUpdateUserSessionRepositoryMockRecorder struct {
+	mock *MockUpdateUserSessionRepository
+}
+
+// NewMockUpdateUserSessionRepository creates a new mock instance.
+func NewMockUpdateUserSessionRepository(ctrl *gomock.Controller) *MockUpdateUserSessionRepository {
+	mock := &MockUpdateUserSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateUserSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateUserSessionRepository) EXPECT() *MockUpdateUserSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockUpdateUserSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockUpdateUserSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockUpdateUserSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+}
+
+// MockUpdateUserAuditLogger is a mock of UpdateUserAuditLogger interface.
+type MockUpdateUserAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateUserAuditLoggerMockRecorder
+}
+
+// MockUpdateUserAuditLoggerMockRecorder is the mock recorder for MockUpdateUserAuditLogger.
+type MockUpdateUserAuditLoggerMockRecorder struct {
+	mock *MockUpdateUserAuditLogger
+}
+
+// NewMockUpdateUserAuditLogger creates a new mock instance.
+func NewMockUpdateUserAuditLogger(ctrl *gomock.Controller) *MockUpdateUserAuditLogger {
+	mock := &MockUpdateUserAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockUpdateUserAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateUserAuditLogger) EXPECT() *MockUpdateUserAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockUpdateUserAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockUpdateUserAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockUpdateUserAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockDeleteUserUserRepository is a mock of DeleteUserUserRepository interface.
+type MockDeleteUserUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteUserUserRepositoryMockRecorder
+}
+
+// MockDeleteUserUserRepositoryMockRecorder is the mock recorder for MockDeleteUserUserRepository.
+type MockDeleteUserUserRepositoryMockRecorder struct {
+	mock *MockDeleteUserUserRepository
+}
+
+// NewMockDeleteUserUserRepository creates a new mock instance.
+func NewMockDeleteUserUserRepository(ctrl *gomock.Controller) *MockDeleteUserUserRepository {
+	mock := &MockDeleteUserUserRepository{ctrl: ctrl}
+	mock.recorder = &MockDeleteUserUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteUserUserRepository) EXPECT() *MockDeleteUserUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDeleteUserUserRepository) Delete(arg0 context.Context, arg1 string, arg2 entities.WebhookEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDeleteUserUserRepositoryMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteUserUserRepository)(nil).Delete), arg0, arg1, arg2)
+}
+
+// MockDeleteUserAuditLogger is a mock of DeleteUserAuditLogger interface.
+type MockDeleteUserAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteUserAuditLoggerMockRecorder
+}
+
+// MockDeleteUserAuditLoggerMockRecorder is the mock recorder for MockDeleteUserAuditLogger.
+type MockDeleteUserAuditLoggerMockRecorder struct {
+	mock *MockDeleteUserAuditLogger
+}
+
+// NewMockDeleteUserAuditLogger creates a new mock instance.
+func NewMockDeleteUserAuditLogger(ctrl *gomock.Controller) *MockDeleteUserAuditLogger {
+	mock := &MockDeleteUserAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockDeleteUserAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteUserAuditLogger) EXPECT() *MockDeleteUserAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockDeleteUserAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockDeleteUserAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockDeleteUserAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockRevokeSessionsUserRepository is a mock of RevokeSessionsUserRepository interface.
+type MockRevokeSessionsUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeSessionsUserRepositoryMockRecorder
+}
+
+// MockRevokeSessionsUserRepositoryMockRecorder is the mock recorder for MockRevokeSessionsUserRepository.
+type MockRevokeSessionsUserRepositoryMockRecorder struct {
+	mock *MockRevokeSessionsUserRepository
+}
+
+// NewMockRevokeSessionsUserRepository creates a new mock instance.
+func NewMockRevokeSessionsUserRepository(ctrl *gomock.Controller) *MockRevokeSessionsUserRepository {
+	mock := &MockRevokeSessionsUserRepository{ctrl: ctrl}
+	mock.recorder = &MockRevokeSessionsUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeSessionsUserRepository) EXPECT() *MockRevokeSessionsUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUserId mocks base method.
+func (m *MockRevokeSessionsUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockRevokeSessionsUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockRevokeSessionsUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// MockRevokeSessionsSessionRepository is a mock of RevokeSessionsSessionRepository interface.
+type MockRevokeSessionsSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeSessionsSessionRepositoryMockRecorder
+}
+
+// MockRevokeSessionsSessionRepositoryMockRecorder is the mock recorder for MockRevokeSessionsSessionRepository.
+type MockRevokeSessionsSessionRepositoryMockRecorder struct {
+	mock *MockRevokeSessionsSessionRepository
+}
+
+// NewMockRevokeSessionsSessionRepository creates a new mock instance.
+func NewMockRevokeSessionsSessionRepository(ctrl *gomock.Controller) *MockRevokeSessionsSessionRepository {
+	mock := &MockRevokeSessionsSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockRevokeSessionsSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeSessionsSessionRepository) EXPECT() *MockRevokeSessionsSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockRevokeSessionsSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockRevokeSessionsSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockRevokeSessionsSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+}
+
+// MockRevokeSessionsAuditLogger is a mock of RevokeSessionsAuditLogger interface.
+type MockRevokeSessionsAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeSessionsAuditLoggerMockRecorder
+}
+
+// MockRevokeSessionsAuditLoggerMockRecorder is the mock recorder for MockRevokeSessionsAuditLogger.
+type MockRevokeSessionsAuditLoggerMockRecorder struct {
+	mock *MockRevokeSessionsAuditLogger
+}
+
+// NewMockRevokeSessionsAuditLogger creates a new mock instance.
+func NewMockRevokeSessionsAuditLogger(ctrl *gomock.Controller) *MockRevokeSessionsAuditLogger {
+	mock := &MockRevokeSessionsAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockRevokeSessionsAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeSessionsAuditLogger) EXPECT() *MockRevokeSessionsAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockRevokeSessionsAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockRevokeSessionsAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockRevokeSessionsAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockListRolesRoleRepository is a mock of ListRolesRoleRepository interface.
+type MockListRolesRoleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockListRolesRoleRepositoryMockRecorder
+}
+
+// MockListRolesRoleRepositoryMockRecorder is the mock recorder for MockListRolesRoleRepository.
+type MockListRolesRoleRepositoryMockRecorder struct {
+	mock *MockListRolesRoleRepository
+}
+
+// NewMockListRolesRoleRepository creates a new mock instance.
+func NewMockListRolesRoleRepository(ctrl *gomock.Controller) *MockListRolesRoleRepository {
+	mock := &MockListRolesRoleRepository{ctrl: ctrl}
+	mock.recorder = &MockListRolesRoleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListRolesRoleRepository) EXPECT() *MockListRolesRoleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectAll mocks base method.
+func (m *MockListRolesRoleRepository) SelectAll(arg0 context.Context) ([]entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAll", arg0)
+	ret0, _ := ret[0].([]entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAll indicates an expected call of SelectAll.
+func (mr *MockListRolesRoleRepositoryMockRecorder) SelectAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAll", reflect.TypeOf((*MockListRolesRoleRepository)(nil).SelectAll), arg0)
+}
+
+// MockListRolesAuditLogger is a mock of ListRolesAuditLogger interface.
+type MockListRolesAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockListRolesAuditLoggerMockRecorder
+}
+
+// MockListRolesAuditLoggerMockRecorder is the mock recorder for MockListRolesAuditLogger.
+type MockListRolesAuditLoggerMockRecorder struct {
+	mock *MockListRolesAuditLogger
+}
+
+// NewMockListRolesAuditLogger creates a new mock instance.
+func NewMockListRolesAuditLogger(ctrl *gomock.Controller) *MockListRolesAuditLogger {
+	mock := &MockListRolesAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockListRolesAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListRolesAuditLogger) EXPECT() *MockListRolesAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockListRolesAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockListRolesAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockListRolesAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockCreateRoleRoleRepository is a mock of CreateRoleRoleRepository interface.
+type MockCreateRoleRoleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateRoleRoleRepositoryMockRecorder
+}
+
+// MockCreateRoleRoleRepositoryMockRecorder is the mock recorder for MockCreateRoleRoleRepository.
+type MockCreateRoleRoleRepositoryMockRecorder struct {
+	mock *MockCreateRoleRoleRepository
+}
+
+// NewMockCreateRoleRoleRepository creates a new mock instance.
+func NewMockCreateRoleRoleRepository(ctrl *gomock.Controller) *MockCreateRoleRoleRepository {
+	mock := &MockCreateRoleRoleRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateRoleRoleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateRoleRoleRepository) EXPECT() *MockCreateRoleRoleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreateRoleRoleRepository) Insert(arg0 context.Context, arg1 entities.Role) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateRoleRoleRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateRoleRoleRepository)(nil).Insert), arg0, arg1)
+}
+
+// SelectByNames mocks base method.
+func (m *MockCreateRoleRoleRepository) SelectByNames(arg0 context.Context, arg1 []string) ([]entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByNames", arg0, arg1)
+	ret0, _ := ret[0].([]entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByNames indicates an expected call of SelectByNames.
+func (mr *MockCreateRoleRoleRepositoryMockRecorder) SelectByNames(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByNames", reflect.TypeOf((*MockCreateRoleRoleRepository)(nil).SelectByNames), arg0, arg1)
+}
+
+// MockCreateRolePermissionRepository is a mock of CreateRolePermissionRepository interface.
+type MockCreateRolePermissionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateRolePermissionRepositoryMockRecorder
+}
+
+// MockCreateRolePermissionRepositoryMockRecorder is the mock recorder for MockCreateRolePermissionRepository.
+type MockCreateRolePermissionRepositoryMockRecorder struct {
+	mock *MockCreateRolePermissionRepository
+}
+
+// NewMockCreateRolePermissionRepository creates a new mock instance.
+func NewMockCreateRolePermissionRepository(ctrl *gomock.Controller) *MockCreateRolePermissionRepository {
+	mock := &MockCreateRolePermissionRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateRolePermissionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateRolePermissionRepository) EXPECT() *MockCreateRolePermissionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByNames mocks base method.
+func (m *MockCreateRolePermissionRepository) SelectByNames(arg0 context.Context, arg1 []string) ([]entities.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByNames", arg0, arg1)
+	ret0, _ := ret[0].([]entities.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByNames indicates an expected call of SelectByNames.
+func (mr *MockCreateRolePermissionRepositoryMockRecorder) SelectByNames(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByNames", reflect.TypeOf((*MockCreateRolePermissionRepository)(nil).SelectByNames), arg0, arg1)
+}
+
+// MockCreateRoleAuditLogger is a mock of CreateRoleAuditLogger interface.
+type MockCreateRoleAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateRoleAuditLoggerMockRecorder
+}
+
+// MockCreateRoleAuditLoggerMockRecorder is the mock recorder for MockCreateRoleAuditLogger.
+type MockCreateRoleAuditLoggerMockRecorder struct {
+	mock *MockCreateRoleAuditLogger
+}
+
+// NewMockCreateRoleAuditLogger creates a new mock instance.
+func NewMockCreateRoleAuditLogger(ctrl *gomock.Controller) *MockCreateRoleAuditLogger {
+	mock := &MockCreateRoleAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockCreateRoleAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateRoleAuditLogger) EXPECT() *MockCreateRoleAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockCreateRoleAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockCreateRoleAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockCreateRoleAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockUpdateRoleRoleRepository is a mock of UpdateRoleRoleRepository interface.
+type MockUpdateRoleRoleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateRoleRoleRepositoryMockRecorder
+}
+
+// MockUpdateRoleRoleRepositoryMockRecorder is the mock recorder for MockUpdateRoleRoleRepository.
+type MockUpdateRoleRoleRepositoryMockRecorder struct {
+	mock *MockUpdateRoleRoleRepository
+}
+
+// NewMockUpdateRoleRoleRepository creates a new mock instance.
+func NewMockUpdateRoleRoleRepository(ctrl *gomock.Controller) *MockUpdateRoleRoleRepository {
+	mock := &MockUpdateRoleRoleRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateRoleRoleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateRoleRoleRepository) EXPECT() *MockUpdateRoleRoleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectById mocks base method.
+func (m *MockUpdateRoleRoleRepository) SelectById(arg0 context.Context, arg1 int) (entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectById", arg0, arg1)
+	ret0, _ := ret[0].(entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectById indicates an expected call of SelectById.
+func (mr *MockUpdateRoleRoleRepositoryMockRecorder) SelectById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectById", reflect.TypeOf((*MockUpdateRoleRoleRepository)(nil).SelectById), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockUpdateRoleRoleRepository) Update(arg0 context.Context, arg1 entities.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateRoleRoleRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateRoleRoleRepository)(nil).Update), arg0, arg1)
+}
+
+// MockUpdateRolePermissionRepository is a mock of UpdateRolePermissionRepository interface.
+type MockUpdateRolePermissionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateRolePermissionRepositoryMockRecorder
+}
+
+// MockUpdateRolePermissionRepositoryMockRecorder is the mock recorder for MockUpdateRolePermissionRepository.
+type MockUpdateRolePermissionRepositoryMockRecorder struct {
+	mock *MockUpdateRolePermissionRepository
+}
+
+// NewMockUpdateRolePermissionRepository creates a new mock instance.
+func NewMockUpdateRolePermissionRepository(ctrl *gomock.Controller) *MockUpdateRolePermissionRepository {
+	mock := &MockUpdateRolePermissionRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateRolePermissionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateRolePermissionRepository) EXPECT() *MockUpdateRolePermissionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByNames mocks base method.
+func (m *MockUpdateRolePermissionRepository) SelectByNames(arg0 context.Context, arg1 []string) ([]entities.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByNames", arg0, arg1)
+	ret0, _ := ret[0].([]entities.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByNames indicates an expected call of SelectByNames.
+func (mr *MockUpdateRolePermissionRepositoryMockRecorder) SelectByNames(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByNames", reflect.TypeOf((*MockUpdateRolePermissionRepository)(nil).SelectByNames), arg0, arg1)
+}
+
+// MockUpdateRoleSessionRepository is a mock of UpdateRoleSessionRepository interface.
+type MockUpdateRoleSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateRoleSessionRepositoryMockRecorder
+}
+
+// MockUpdateRoleSessionRepositoryMockRecorder is the mock recorder for MockUpdateRoleSessionRepository.
+type MockUpdateRoleSessionRepositoryMockRecorder struct {
+	mock *MockUpdateRoleSessionRepository
+}
+
+// NewMockUpdateRoleSessionRepository creates a new mock instance.
+func NewMockUpdateRoleSessionRepository(ctrl *gomock.Controller) *MockUpdateRoleSessionRepository {
+	mock := &MockUpdateRoleSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateRoleSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateRoleSessionRepository) EXPECT() *MockUpdateRoleSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByRoleId mocks base method.
+func (m *MockUpdateRoleSessionRepository) DeleteByRoleId(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByRoleId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByRoleId indicates an expected call of DeleteByRoleId.
+func (mr *MockUpdateRoleSessionRepositoryMockRecorder) DeleteByRoleId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByRoleId", reflect.TypeOf((*MockUpdateRoleSessionRepository)(nil).DeleteByRoleId), arg0, arg1)
+}
+
+// MockUpdateRoleAuditLogger is a mock of UpdateRoleAuditLogger interface.
+type MockUpdateRoleAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateRoleAuditLoggerMockRecorder
+}
+
+// MockUpdateRoleAuditLoggerMockRecorder is the mock recorder for MockUpdateRoleAuditLogger.
+type MockUpdateRoleAuditLoggerMockRecorder struct {
+	mock *MockUpdateRoleAuditLogger
+}
+
+// NewMockUpdateRoleAuditLogger creates a new mock instance.
+func NewMockUpdateRoleAuditLogger(ctrl *gomock.Controller) *MockUpdateRoleAuditLogger {
+	mock := &MockUpdateRoleAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockUpdateRoleAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateRoleAuditLogger) EXPECT() *MockUpdateRoleAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockUpdateRoleAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockUpdateRoleAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockUpdateRoleAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockDeleteRoleRoleRepository is a mock of DeleteRoleRoleRepository interface.
+type MockDeleteRoleRoleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRoleRoleRepositoryMockRecorder
+}
+
+// MockDeleteRoleRoleRepositoryMockRecorder is the mock recorder for MockDeleteRoleRoleRepository.
+type MockDeleteRoleRoleRepositoryMockRecorder struct {
+	mock *MockDeleteRoleRoleRepository
+}
+
+// NewMockDeleteRoleRoleRepository creates a new mock instance.
+func NewMockDeleteRoleRoleRepository(ctrl *gomock.Controller) *MockDeleteRoleRoleRepository {
+	mock := &MockDeleteRoleRoleRepository{ctrl: ctrl}
+	mock.recorder = &MockDeleteRoleRoleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteRoleRoleRepository) EXPECT() *MockDeleteRoleRoleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDeleteRoleRoleRepository) Delete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDeleteRoleRoleRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteRoleRoleRepository)(nil).Delete), arg0, arg1)
+}
+
+// SelectById mocks base method.
+func (m *MockDeleteRoleRoleRepository) SelectById(arg0 context.Context, arg1 int) (entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectById", arg0, arg1)
+	ret0, _ := ret[0].(entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectById indicates an expected call of SelectById.
+func (mr *MockDeleteRoleRoleRepositoryMockRecorder) SelectById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectById", reflect.TypeOf((*MockDeleteRoleRoleRepository)(nil).SelectById), arg0, arg1)
+}
+
+// MockDeleteRoleSessionRepository is a mock of DeleteRoleSessionRepository interface.
+type MockDeleteRoleSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRoleSessionRepositoryMockRecorder
+}
+
+// MockDeleteRoleSessionRepositoryMockRecorder is the mock recorder for MockDeleteRoleSessionRepository.
+type MockDeleteRoleSessionRepositoryMockRecorder struct {
+	mock *MockDeleteRoleSessionRepository
+}
+
+// NewMockDeleteRoleSessionRepository creates a new mock instance.
+func NewMockDeleteRoleSessionRepository(ctrl *gomock.Controller) *MockDeleteRoleSessionRepository {
+	mock := &MockDeleteRoleSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockDeleteRoleSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteRoleSessionRepository) EXPECT() *MockDeleteRoleSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByRoleId mocks base method.
+func (m *MockDeleteRoleSessionRepository) DeleteByRoleId(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByRoleId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByRoleId indicates an expected call of DeleteByRoleId.
+func (mr *MockDeleteRoleSessionRepositoryMockRecorder) DeleteByRoleId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByRoleId", reflect.TypeOf((*MockDeleteRoleSessionRepository)(nil).DeleteByRoleId), arg0, arg1)
+}
+
+// MockDeleteRoleAuditLogger is a mock of DeleteRoleAuditLogger interface.
+type MockDeleteRoleAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRoleAuditLoggerMockRecorder
+}
+
+// MockDeleteRoleAuditLoggerMockRecorder is the mock recorder for MockDeleteRoleAuditLogger.
+type MockDeleteRoleAuditLoggerMockRecorder struct {
+	mock *MockDeleteRoleAuditLogger
+}
+
+// NewMockDeleteRoleAuditLogger creates a new mock instance.
+func NewMockDeleteRoleAuditLogger(ctrl *gomock.Controller) *MockDeleteRoleAuditLogger {
+	mock := &MockDeleteRoleAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockDeleteRoleAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteRoleAuditLogger) EXPECT() *MockDeleteRoleAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockDeleteRoleAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockDeleteRoleAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockDeleteRoleAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockListPermissionsPermissionRepository is a mock of ListPermissionsPermissionRepository interface.
+type MockListPermissionsPermissionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockListPermissionsPermissionRepositoryMockRecorder
+}
+
+// MockListPermissionsPermissionRepositoryMockRecorder is the mock recorder for MockListPermissionsPermissionRepository.
+type MockListPermissionsPermissionRepositoryMockRecorder struct {
+	mock *MockListPermissionsPermissionRepository
+}
+
+// NewMockListPermissionsPermissionRepository creates a new mock instance.
+func NewMockListPermissionsPermissionRepository(ctrl *gomock.Controller) *MockListPermissionsPermissionRepository {
+	mock := &MockListPermissionsPermissionRepository{ctrl: ctrl}
+	mock.recorder = &MockListPermissionsPermissionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListPermissionsPermissionRepository) EXPECT() *MockListPermissionsPermissionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectAll mocks base method.
+func (m *MockListPermissionsPermissionRepository) SelectAll(arg0 context.Context) ([]entities.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAll", arg0)
+	ret0, _ := ret[0].([]entities.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAll indicates an expected call of SelectAll.
+func (mr *MockListPermissionsPermissionRepositoryMockRecorder) SelectAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAll", reflect.TypeOf((*MockListPermissionsPermissionRepository)(nil).SelectAll), arg0)
+}
+
+// MockListPermissionsAuditLogger is a mock of ListPermissionsAuditLogger interface.
+type MockListPermissionsAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockListPermissionsAuditLoggerMockRecorder
+}
+
+// MockListPermissionsAuditLoggerMockRecorder is the mock recorder for MockListPermissionsAuditLogger.
+type MockListPermissionsAuditLoggerMockRecorder struct {
+	mock *MockListPermissionsAuditLogger
+}
+
+// NewMockListPermissionsAuditLogger creates a new mock instance.
+func NewMockListPermissionsAuditLogger(ctrl *gomock.Controller) *MockListPermissionsAuditLogger {
+	mock := &MockListPermissionsAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockListPermissionsAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListPermissionsAuditLogger) EXPECT() *MockListPermissionsAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockListPermissionsAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockListPermissionsAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockListPermissionsAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockCreatePermissionPermissionRepository is a mock of CreatePermissionPermissionRepository interface.
+type MockCreatePermissionPermissionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreatePermissionPermissionRepositoryMockRecorder
+}
+
+// MockCreatePermissionPermissionRepositoryMockRecorder is the mock recorder for MockCreatePermissionPermissionRepository.
+type MockCreatePermissionPermissionRepositoryMockRecorder struct {
+	mock *MockCreatePermissionPermissionRepository
+}
+
+// NewMockCreatePermissionPermissionRepository creates a new mock instance.
+func NewMockCreatePermissionPermissionRepository(ctrl *gomock.Controller) *MockCreatePermissionPermissionRepository {
+	mock := &MockCreatePermissionPermissionRepository{ctrl: ctrl}
+	mock.recorder = &MockCreatePermissionPermissionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreatePermissionPermissionRepository) EXPECT() *MockCreatePermissionPermissionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreatePermissionPermissionRepository) Insert(arg0 context.Context, arg1 entities.Permission) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreatePermissionPermissionRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreatePermissionPermissionRepository)(nil).Insert), arg0, arg1)
+}
+
+// SelectByNames mocks base method.
+func (m *MockCreatePermissionPermissionRepository) SelectByNames(arg0 context.Context, arg1 []string) ([]entities.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByNames", arg0, arg1)
+	ret0, _ := ret[0].([]entities.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByNames indicates an expected call of SelectByNames.
+func (mr *MockCreatePermissionPermissionRepositoryMockRecorder) SelectByNames(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByNames", reflect.TypeOf((*MockCreatePermissionPermissionRepository)(nil).SelectByNames), arg0, arg1)
+}
+
+// MockCreatePermissionAuditLogger is a mock of CreatePermissionAuditLogger interface.
+type MockCreatePermissionAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreatePermissionAuditLoggerMockRecorder
+}
+
+// MockCreatePermissionAuditLoggerMockRecorder is the mock recorder for MockCreatePermissionAuditLogger.
+type MockCreatePermissionAuditLoggerMockRecorder struct {
+	mock *MockCreatePermissionAuditLogger
+}
+
+// NewMockCreatePermissionAuditLogger creates a new mock instance.
+func NewMockCreatePermissionAuditLogger(ctrl *gomock.Controller) *MockCreatePermissionAuditLogger {
+	mock := &MockCreatePermissionAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockCreatePermissionAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreatePermissionAuditLogger) EXPECT() *MockCreatePermissionAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockCreatePermissionAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockCreatePermissionAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockCreatePermissionAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockDeletePermissionPermissionRepository is a mock of DeletePermissionPermissionRepository interface.
+type MockDeletePermissionPermissionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeletePermissionPermissionRepositoryMockRecorder
+}
+
+// MockDeletePermissionPermissionRepositoryMockRecorder is the mock recorder for MockDeletePermissionPermissionRepository.
+type MockDeletePermissionPermissionRepositoryMockRecorder struct {
+	mock *MockDeletePermissionPermissionRepository
+}
+
+// NewMockDeletePermissionPermissionRepository creates a new mock instance.
+func NewMockDeletePermissionPermissionRepository(ctrl *gomock.Controller) *MockDeletePermissionPermissionRepository {
+	mock := &MockDeletePermissionPermissionRepository{ctrl: ctrl}
+	mock.recorder = &MockDeletePermissionPermissionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeletePermissionPermissionRepository) EXPECT() *MockDeletePermissionPermissionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDeletePermissionPermissionRepository) Delete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDeletePermissionPermissionRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeletePermissionPermissionRepository)(nil).Delete), arg0, arg1)
+}
+
+// MockDeletePermissionAuditLogger is a mock of DeletePermissionAuditLogger interface.
+type MockDeletePermissionAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeletePermissionAuditLoggerMockRecorder
+}
+
+// MockDeletePermissionAuditLoggerMockRecorder is the mock recorder for MockDeletePermissionAuditLogger.
+type MockDeletePermissionAuditLoggerMockRecorder struct {
+	mock *MockDeletePermissionAuditLogger
+}
+
+// NewMockDeletePermissionAuditLogger creates a new mock instance.
+func NewMockDeletePermissionAuditLogger(ctrl *gomock.Controller) *MockDeletePermissionAuditLogger {
+	mock := &MockDeletePermissionAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockDeletePermissionAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeletePermissionAuditLogger) EXPECT() *MockDeletePermissionAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockDeletePermissionAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockDeletePermissionAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockDeletePermissionAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockSetUserRolesUserRepository is a mock of SetUserRolesUserRepository interface.
+type MockSetUserRolesUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetUserRolesUserRepositoryMockRecorder
+}
+
+// MockSetUserRolesUserRepositoryMockRecorder is the mock recorder for MockSetUserRolesUserRepository.
+type MockSetUserRolesUserRepositoryMockRecorder struct {
+	mock *MockSetUserRolesUserRepository
+}
+
+// NewMockSetUserRolesUserRepository creates a new mock instance.
+func NewMockSetUserRolesUserRepository(ctrl *gomock.Controller) *MockSetUserRolesUserRepository {
+	mock := &MockSetUserRolesUserRepository{ctrl: ctrl}
+	mock.recorder = &MockSetUserRolesUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetUserRolesUserRepository) EXPECT() *MockSetUserRolesUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUserId mocks base method.
+func (m *MockSetUserRolesUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockSetUserRolesUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockSetUserRolesUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// UpdateRoles mocks base method.
+func (m *MockSetUserRolesUserRepository) UpdateRoles(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoles", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoles indicates an expected call of UpdateRoles.
+func (mr *MockSetUserRolesUserRepositoryMockRecorder) UpdateRoles(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoles", reflect.TypeOf((*MockSetUserRolesUserRepository)(nil).UpdateRoles), arg0, arg1, arg2)
+}
+
+// MockSetUserRolesRoleRepository is a mock of SetUserRolesRoleRepository interface.
+type MockSetUserRolesRoleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetUserRolesRoleRepositoryMockRecorder
+}
+
+// MockSetUserRolesRoleRepositoryMockRecorder is the mock recorder for MockSetUserRolesRoleRepository.
+type MockSetUserRolesRoleRepositoryMockRecorder struct {
+	mock *MockSetUserRolesRoleRepository
+}
+
+// NewMockSetUserRolesRoleRepository creates a new mock instance.
+func NewMockSetUserRolesRoleRepository(ctrl *gomock.Controller) *MockSetUserRolesRoleRepository {
+	mock := &MockSetUserRolesRoleRepository{ctrl: ctrl}
+	mock.recorder = &MockSetUserRolesRoleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetUserRolesRoleRepository) EXPECT() *MockSetUserRolesRoleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByNames mocks base method.
+func (m *MockSetUserRolesRoleRepository) SelectByNames(arg0 context.Context, arg1 []string) ([]entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByNames", arg0, arg1)
+	ret0, _ := ret[0].([]entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByNames indicates an expected call of SelectByNames.
+func (mr *MockSetUserRolesRoleRepositoryMockRecorder) SelectByNames(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByNames", reflect.TypeOf((*MockSetUserRolesRoleRepository)(nil).SelectByNames), arg0, arg1)
+}
+
+// MockSetUserRolesSessionRepository is a mock of SetUserRolesSessionRepository interface.
+type MockSetUserRolesSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetUserRolesSessionRepositoryMockRecorder
+}
+
+// MockSetUserRolesSessionRepositoryMockRecorder is the mock recorder for MockSetUserRolesSessionRepository.
+type MockSetUserRolesSessionRepositoryMockRecorder struct {
+	mock *MockSetUserRolesSessionRepository
+}
+
+// NewMockSetUserRolesSessionRepository creates a new mock instance.
+func NewMockSetUserRolesSessionRepository(ctrl *gomock.Controller) *MockSetUserRolesSessionRepository {
+	mock := &MockSetUserRolesSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockSetUserRolesSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetUserRolesSessionRepository) EXPECT() *MockSetUserRolesSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockSetUserRolesSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockSetUserRolesSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockSetUserRolesSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+}
+
+// MockSetUserRolesAuditLogger is a mock of SetUserRolesAuditLogger interface.
+type MockSetUserRolesAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetUserRolesAuditLoggerMockRecorder
+}
+
+// MockSetUserRolesAuditLoggerMockRecorder is the mock recorder for MockSetUserRolesAuditLogger.
+type MockSetUserRolesAuditLoggerMockRecorder struct {
+	mock *MockSetUserRolesAuditLogger
+}
+
+// NewMockSetUserRolesAuditLogger creates a new mock instance.
+func NewMockSetUserRolesAuditLogger(ctrl *gomock.Controller) *MockSetUserRolesAuditLogger {
+	mock := &MockSetUserRolesAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockSetUserRolesAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetUserRolesAuditLogger) EXPECT() *MockSetUserRolesAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockSetUserRolesAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockSetUserRolesAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockSetUserRolesAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockCreateOrganizationOrganizationRepository is a mock of CreateOrganizationOrganizationRepository interface.
+type MockCreateOrganizationOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateOrganizationOrganizationRepositoryMockRecorder
+}
+
+// MockCreateOrganizationOrganizationRepositoryMockRecorder is the mock recorder for MockCreateOrganizationOrganizationRepository.
+type MockCreateOrganizationOrganizationRepositoryMockRecorder struct {
+	mock *MockCreateOrganizationOrganizationRepository
+}
+
+// NewMockCreateOrganizationOrganizationRepository creates a new mock instance.
+func NewMockCreateOrganizationOrganizationRepository(ctrl *gomock.Controller) *MockCreateOrganizationOrganizationRepository {
+	mock := &MockCreateOrganizationOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateOrganizationOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateOrganizationOrganizationRepository) EXPECT() *MockCreateOrganizationOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreateOrganizationOrganizationRepository) Insert(arg0 context.Context, arg1 entities.Organization, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateOrganizationOrganizationRepositoryMockRecorder) Insert(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateOrganizationOrganizationRepository)(nil).Insert), arg0, arg1, arg2)
+}
+
+// MockCreateOrganizationAuditLogger is a mock of CreateOrganizationAuditLogger interface.
+type MockCreateOrganizationAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateOrganizationAuditLoggerMockRecorder
+}
+
+// MockCreateOrganizationAuditLoggerMockRecorder is the mock recorder for MockCreateOrganizationAuditLogger.
+type MockCreateOrganizationAuditLoggerMockRecorder struct {
+	mock *MockCreateOrganizationAuditLogger
+}
+
+// NewMockCreateOrganizationAuditLogger creates a new mock instance.
+func NewMockCreateOrganizationAuditLogger(ctrl *gomock.Controller) *MockCreateOrganizationAuditLogger {
+	mock := &MockCreateOrganizationAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockCreateOrganizationAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateOrganizationAuditLogger) EXPECT() *MockCreateOrganizationAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockCreateOrganizationAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockCreateOrganizationAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockCreateOrganizationAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockListMembersOrganizationRepository is a mock of ListMembersOrganizationRepository interface.
+type MockListMembersOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockListMembersOrganizationRepositoryMockRecorder
+}
+
+// MockListMembersOrganizationRepositoryMockRecorder is the mock recorder for MockListMembersOrganizationRepository.
+type MockListMembersOrganizationRepositoryMockRecorder struct {
+	mock *MockListMembersOrganizationRepository
+}
+
+// NewMockListMembersOrganizationRepository creates a new mock instance.
+func NewMockListMembersOrganizationRepository(ctrl *gomock.Controller) *MockListMembersOrganizationRepository {
+	mock := &MockListMembersOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockListMembersOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListMembersOrganizationRepository) EXPECT() *MockListMembersOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectMember mocks base method.
+func (m *MockListMembersOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockListMembersOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockListMembersOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
+}
+
+// SelectMembers mocks base method.
+func (m *MockListMembersOrganizationRepository) SelectMembers(arg0 context.Context, arg1 string) ([]entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMembers", arg0, arg1)
+	ret0, _ := ret[0].([]entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMembers indicates an expected call of SelectMembers.
+func (mr *MockListMembersOrganizationRepositoryMockRecorder) SelectMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMembers", reflect.TypeOf((*MockListMembersOrganizationRepository)(nil).SelectMembers), arg0, arg1)
+}
+
+// MockListMembersAuditLogger is a mock of ListMembersAuditLogger interface.
+type MockListMembersAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockListMembersAuditLoggerMockRecorder
+}
+
+// MockListMembersAuditLoggerMockRecorder is the mock recorder for MockListMembersAuditLogger.
+type MockListMembersAuditLoggerMockRecorder struct {
+	mock *MockListMembersAuditLogger
+}
+
+// NewMockListMembersAuditLogger creates a new mock instance.
+func NewMockListMembersAuditLogger(ctrl *gomock.Controller) *MockListMembersAuditLogger {
+	mock := &MockListMembersAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockListMembersAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListMembersAuditLogger) EXPECT() *MockListMembersAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockListMembersAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockListMembersAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockListMembersAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockRemoveMemberOrganizationRepository is a mock of RemoveMemberOrganizationRepository interface.
+type MockRemoveMemberOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveMemberOrganizationRepositoryMockRecorder
+}
+
+// MockRemoveMemberOrganizationRepositoryMockRecorder is the mock recorder for MockRemoveMemberOrganizationRepository.
+type MockRemoveMemberOrganizationRepositoryMockRecorder struct {
+	mock *MockRemoveMemberOrganizationRepository
+}
+
+// NewMockRemoveMemberOrganizationRepository creates a new mock instance.
+func NewMockRemoveMemberOrganizationRepository(ctrl *gomock.Controller) *MockRemoveMemberOrganizationRepository {
+	mock := &MockRemoveMemberOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockRemoveMemberOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveMemberOrganizationRepository) EXPECT() *MockRemoveMemberOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteMember mocks base method.
+func (m *MockRemoveMemberOrganizationRepository) DeleteMember(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockRemoveMemberOrganizationRepositoryMockRecorder) DeleteMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockRemoveMemberOrganizationRepository)(nil).DeleteMember), arg0, arg1, arg2)
+}
+
+// SelectMember mocks base method.
+func (m *MockRemoveMemberOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockRemoveMemberOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockRemoveMemberOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
+}
+
+// MockRemoveMemberAuditLogger is a mock of RemoveMemberAuditLogger interface.
+type MockRemoveMemberAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveMemberAuditLoggerMockRecorder
+}
+
+// MockRemoveMemberAuditLoggerMockRecorder is the mock recorder for MockRemoveMemberAuditLogger.
+type MockRemoveMemberAuditLoggerMockRecorder struct {
+	mock *MockRemoveMemberAuditLogger
+}
+
+// NewMockRemoveMemberAuditLogger creates a new mock instance.
+func NewMockRemoveMemberAuditLogger(ctrl *gomock.Controller) *MockRemoveMemberAuditLogger {
+	mock := &MockRemoveMemberAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockRemoveMemberAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveMemberAuditLogger) EXPECT() *MockRemoveMemberAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockRemoveMemberAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockRemoveMemberAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockRemoveMemberAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockSetOrganizationPasswordPolicyOrganizationRepository is a mock of SetOrganizationPasswordPolicyOrganizationRepository interface.
+type MockSetOrganizationPasswordPolicyOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetOrganizationPasswordPolicyOrganizationRepositoryMockRecorder
+}
+
+// MockSetOrganizationPasswordPolicyOrganizationRepositoryMockRecorder is the mock recorder for MockSetOrganizationPasswordPolicyOrganizationRepository.
+type MockSetOrganizationPasswordPolicyOrganizationRepositoryMockRecorder struct {
+	mock *MockSetOrganizationPasswordPolicyOrganizationRepository
+}
+
+// NewMockSetOrganizationPasswordPolicyOrganizationRepository creates a new mock instance.
+func NewMockSetOrganizationPasswordPolicyOrganizationRepository(ctrl *gomock.Controller) *MockSetOrganizationPasswordPolicyOrganizationRepository {
+	mock := &MockSetOrganizationPasswordPolicyOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockSetOrganizationPasswordPolicyOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetOrganizationPasswordPolicyOrganizationRepository) EXPECT() *MockSetOrganizationPasswordPolicyOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectMember mocks base method.
+func (m *MockSetOrganizationPasswordPolicyOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockSetOrganizationPasswordPolicyOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockSetOrganizationPasswordPolicyOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
+}
+
+// UpdatePasswordMaxAge mocks base method.
+func (m *MockSetOrganizationPasswordPolicyOrganizationRepository) UpdatePasswordMaxAge(arg0 context.Context, arg1 string, arg2 *time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePasswordMaxAge", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePasswordMaxAge indicates an expected call of UpdatePasswordMaxAge.
+func (mr *MockSetOrganizationPasswordPolicyOrganizationRepositoryMockRecorder) UpdatePasswordMaxAge(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasswordMaxAge", reflect.TypeOf((*MockSetOrganizationPasswordPolicyOrganizationRepository)(nil).UpdatePasswordMaxAge), arg0, arg1, arg2)
+}
+
+// MockSetOrganizationPasswordPolicyAuditLogger is a mock of SetOrganizationPasswordPolicyAuditLogger interface.
+type MockSetOrganizationPasswordPolicyAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetOrganizationPasswordPolicyAuditLoggerMockRecorder
+}
+
+// MockSetOrganizationPasswordPolicyAuditLoggerMockRecorder is the mock recorder for MockSetOrganizationPasswordPolicyAuditLogger.
+type MockSetOrganizationPasswordPolicyAuditLoggerMockRecorder struct {
+	mock *MockSetOrganizationPasswordPolicyAuditLogger
+}
+
+// NewMockSetOrganizationPasswordPolicyAuditLogger creates a new mock instance.
+func NewMockSetOrganizationPasswordPolicyAuditLogger(ctrl *gomock.Controller) *MockSetOrganizationPasswordPolicyAuditLogger {
+	mock := &MockSetOrganizationPasswordPolicyAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockSetOrganizationPasswordPolicyAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetOrganizationPasswordPolicyAuditLogger) EXPECT() *MockSetOrganizationPasswordPolicyAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockSetOrganizationPasswordPolicyAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockSetOrganizationPasswordPolicyAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockSetOrganizationPasswordPolicyAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockCreateInvitationOrganizationRepository is a mock of CreateInvitationOrganizationRepository interface.
+type MockCreateInvitationOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateInvitationOrganizationRepositoryMockRecorder
+}
+
+// MockCreateInvitationOrganizationRepositoryMockRecorder is the mock recorder for MockCreateInvitationOrganizationRepository.
+type MockCreateInvitationOrganizationRepositoryMockRecorder struct {
+	mock *MockCreateInvitationOrganizationRepository
+}
+
+// NewMockCreateInvitationOrganizationRepository creates a new mock instance.
+func NewMockCreateInvitationOrganizationRepository(ctrl *gomock.Controller) *MockCreateInvitationOrganizationRepository {
+	mock := &MockCreateInvitationOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateInvitationOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateInvitationOrganizationRepository) EXPECT() *MockCreateInvitationOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectMember mocks base method.
+func (m *MockCreateInvitationOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockCreateInvitationOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockCreateInvitationOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
+}
+
+// MockCreateInvitationInvitationRepository is a mock of CreateInvitationInvitationRepository interface.
+type MockCreateInvitationInvitationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateInvitationInvitationRepositoryMockRecorder
+}
+
+// MockCreateInvitationInvitationRepositoryMockRecorder is the mock recorder for MockCreateInvitationInvitationRepository.
+type MockCreateInvitationInvitationRepositoryMockRecorder struct {
+	mock *MockCreateInvitationInvitationRepository
+}
+
+// NewMockCreateInvitationInvitationRepository creates a new mock instance.
+func NewMockCreateInvitationInvitationRepository(ctrl *gomock.Controller) *MockCreateInvitationInvitationRepository {
+	mock := &MockCreateInvitationInvitationRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateInvitationInvitationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateInvitationInvitationRepository) EXPECT() *MockCreateInvitationInvitationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreateInvitationInvitationRepository) Insert(arg0 context.Context, arg1 entities.Invitation) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateInvitationInvitationRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateInvitationInvitationRepository)(nil).Insert), arg0, arg1)
+}
+
+// MockCreateInvitationHashService is a mock of CreateInvitationHashService interface.
+type MockCreateInvitationHashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateInvitationHashServiceMockRecorder
+}
+
+// MockCreateInvitationHashServiceMockRecorder is the mock recorder for MockCreateInvitationHashService.
+type MockCreateInvitationHashServiceMockRecorder struct {
+	mock *MockCreateInvitationHashService
+}
+
+// NewMockCreateInvitationHashService creates a new mock instance.
+func NewMockCreateInvitationHashService(ctrl *gomock.Controller) *MockCreateInvitationHashService {
+	mock := &MockCreateInvitationHashService{ctrl: ctrl}
+	mock.recorder = &MockCreateInvitationHashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateInvitationHashService) EXPECT() *MockCreateInvitationHashServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateHash mocks base method.
+func (m *MockCreateInvitationHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockCreateInvitationHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockCreateInvitationHashService)(nil).GenerateHash), stringToHash)
+}
+
+// MockCreateInvitationRandomService is a mock of CreateInvitationRandomService interface.
+type MockCreateInvitationRandomService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateInvitationRandomServiceMockRecorder
+}
+
+// MockCreateInvitationRandomServiceMockRecorder is the mock recorder for MockCreateInvitationRandomService.
+type MockCreateInvitationRandomServiceMockRecorder struct {
+	mock *MockCreateInvitationRandomService
+}
+
+// NewMockCreateInvitationRandomService creates a new mock instance.
+func NewMockCreateInvitationRandomService(ctrl *gomock.Controller) *MockCreateInvitationRandomService {
+	mock := &MockCreateInvitationRandomService{ctrl: ctrl}
+	mock.recorder = &MockCreateInvitationRandomServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateInvitationRandomService) EXPECT() *MockCreateInvitationRandomServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateToken mocks base method.
+func (m *MockCreateInvitationRandomService) GenerateToken() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateToken indicates an expected call of GenerateToken.
+func (mr *MockCreateInvitationRandomServiceMockRecorder) GenerateToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockCreateInvitationRandomService)(nil).GenerateToken))
+}
+
+// MockCreateInvitationAuditLogger is a mock of CreateInvitationAuditLogger interface.
+type MockCreateInvitationAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateInvitationAuditLoggerMockRecorder
+}
+
+// MockCreateInvitationAuditLoggerMockRecorder is the mock recorder for MockCreateInvitationAuditLogger.
+type MockCreateInvitationAuditLoggerMockRecorder struct {
+	mock *MockCreateInvitationAuditLogger
+}
+
+// NewMockCreateInvitationAuditLogger creates a new mock instance.
+func NewMockCreateInvitationAuditLogger(ctrl *gomock.Controller) *MockCreateInvitationAuditLogger {
+	mock := &MockCreateInvitationAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockCreateInvitationAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateInvitationAuditLogger) EXPECT() *MockCreateInvitationAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockCreateInvitationAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockCreateInvitationAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockCreateInvitationAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockCreateUserInvitationUserRepository is a mock of CreateUserInvitationUserRepository interface.
+type MockCreateUserInvitationUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUserInvitationUserRepositoryMockRecorder
+}
+
+// MockCreateUserInvitationUserRepositoryMockRecorder is the mock recorder for MockCreateUserInvitationUserRepository.
+type MockCreateUserInvitationUserRepositoryMockRecorder struct {
+	mock *MockCreateUserInvitationUserRepository
+}
+
+// NewMockCreateUserInvitationUserRepository creates a new mock instance.
+func NewMockCreateUserInvitationUserRepository(ctrl *gomock.Controller) *MockCreateUserInvitationUserRepository {
+	mock := &MockCreateUserInvitationUserRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateUserInvitationUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUserInvitationUserRepository) EXPECT() *MockCreateUserInvitationUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CheckEmailExists mocks base method.
+func (m *MockCreateUserInvitationUserRepository) CheckEmailExists(arg0 context.Context, arg1 entities.Email) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckEmailExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckEmailExists indicates an expected call of CheckEmailExists.
+func (mr *MockCreateUserInvitationUserRepositoryMockRecorder) CheckEmailExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEmailExists", reflect.TypeOf((*MockCreateUserInvitationUserRepository)(nil).CheckEmailExists), arg0, arg1)
+}
+
+// MockCreateUserInvitationRoleRepository is a mock of CreateUserInvitationRoleRepository interface.
+type MockCreateUserInvitationRoleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUserInvitationRoleRepositoryMockRecorder
+}
+
+// MockCreateUserInvitationRoleRepositoryMockRecorder is the mock recorder for MockCreateUserInvitationRoleRepository.
+type MockCreateUserInvitationRoleRepositoryMockRecorder struct {
+	mock *MockCreateUserInvitationRoleRepository
+}
+
+// NewMockCreateUserInvitationRoleRepository creates a new mock instance.
+func NewMockCreateUserInvitationRoleRepository(ctrl *gomock.Controller) *MockCreateUserInvitationRoleRepository {
+	mock := &MockCreateUserInvitationRoleRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateUserInvitationRoleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUserInvitationRoleRepository) EXPECT() *MockCreateUserInvitationRoleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByNames mocks base method.
+func (m *MockCreateUserInvitationRoleRepository) SelectByNames(arg0 context.Context, arg1 []string) ([]entities.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByNames", arg0, arg1)
+	ret0, _ := ret[0].([]entities.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByNames indicates an expected call of SelectByNames.
+func (mr *MockCreateUserInvitationRoleRepositoryMockRecorder) SelectByNames(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByNames", reflect.TypeOf((*MockCreateUserInvitationRoleRepository)(nil).SelectByNames), arg0, arg1)
+}
+
+// MockCreateUserInvitationInvitationRepository is a mock of CreateUserInvitationInvitationRepository interface.
+type MockCreateUserInvitationInvitationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUserInvitationInvitationRepositoryMockRecorder
+}
+
+// MockCreateUserInvitationInvitationRepositoryMockRecorder is the mock recorder for MockCreateUserInvitationInvitationRepository.
+type MockCreateUserInvitationInvitationRepositoryMockRecorder struct {
+	mock *MockCreateUserInvitationInvitationRepository
+}
+
+// NewMockCreateUserInvitationInvitationRepository creates a new mock instance.
+func NewMockCreateUserInvitationInvitationRepository(ctrl *gomock.Controller) *MockCreateUserInvitationInvitationRepository {
+	mock := &MockCreateUserInvitationInvitationRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateUserInvitationInvitationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUserInvitationInvitationRepository) EXPECT() *MockCreateUserInvitationInvitationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreateUserInvitationInvitationRepository) Insert(arg0 context.Context, arg1 entities.Invitation) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateUserInvitationInvitationRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateUserInvitationInvitationRepository)(nil).Insert), arg0, arg1)
+}
+
+// MockCreateUserInvitationHashService is a mock of CreateUserInvitationHashService interface.
+type MockCreateUserInvitationHashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUserInvitationHashServiceMockRecorder
+}
+
+// MockCreateUserInvitationHashServiceMockRecorder is the mock recorder for MockCreateUserInvitationHashService.
+type MockCreateUserInvitationHashServiceMockRecorder struct {
+	mock *MockCreateUserInvitationHashService
+}
+
+// NewMockCreateUserInvitationHashService creates a new mock instance.
+func NewMockCreateUserInvitationHashService(ctrl *gomock.Controller) *MockCreateUserInvitationHashService {
+	mock := &MockCreateUserInvitationHashService{ctrl: ctrl}
+	mock.recorder = &MockCreateUserInvitationHashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUserInvitationHashService) EXPECT() *MockCreateUserInvitationHashServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateHash mocks base method.
+func (m *MockCreateUserInvitationHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHash", stringToHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHash indicates an expected call of GenerateHash.
+func (mr *MockCreateUserInvitationHashServiceMockRecorder) GenerateHash(stringToHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHash", reflect.TypeOf((*MockCreateUserInvitationHashService)(nil).GenerateHash), stringToHash)
+}
+
+// MockCreateUserInvitationRandomService is a mock of CreateUserInvitationRandomService interface.
+type MockCreateUserInvitationRandomService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUserInvitationRandomServiceMockRecorder
+}
+
+// MockCreateUserInvitationRandomServiceMockRecorder is the mock recorder for MockCreateUserInvitationRandomService.
+type MockCreateUserInvitationRandomServiceMockRecorder struct {
+	mock *MockCreateUserInvitationRandomService
+}
+
+// NewMockCreateUserInvitationRandomService creates a new mock instance.
+func NewMockCreateUserInvitationRandomService(ctrl *gomock.Controller) *MockCreateUserInvitationRandomService {
+	mock := &MockCreateUserInvitationRandomService{ctrl: ctrl}
+	mock.recorder = &MockCreateUserInvitationRandomServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUserInvitationRandomService) EXPECT() *MockCreateUserInvitationRandomServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateToken mocks base method.
+func (m *MockCreateUserInvitationRandomService) GenerateToken() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateToken indicates an expected call of GenerateToken.
+func (mr *MockCreateUserInvitationRandomServiceMockRecorder) GenerateToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockCreateUserInvitationRandomService)(nil).GenerateToken))
+}
+
+// MockCreateUserInvitationAuditLogger is a mock of CreateUserInvitationAuditLogger interface.
+type MockCreateUserInvitationAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUserInvitationAuditLoggerMockRecorder
+}
+
+// MockCreateUserInvitationAuditLoggerMockRecorder is the mock recorder for MockCreateUserInvitationAuditLogger.
+type MockCreateUserInvitationAuditLoggerMockRecorder struct {
+	mock *MockCreateUserInvitationAuditLogger
+}
+
+// NewMockCreateUserInvitationAuditLogger creates a new mock instance.
+func NewMockCreateUserInvitationAuditLogger(ctrl *gomock.Controller) *MockCreateUserInvitationAuditLogger {
+	mock := &MockCreateUserInvitationAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockCreateUserInvitationAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUserInvitationAuditLogger) EXPECT() *MockCreateUserInvitationAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockCreateUserInvitationAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockCreateUserInvitationAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockCreateUserInvitationAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockAcceptInvitationUserRepository is a mock of AcceptInvitationUserRepository interface.
+type MockAcceptInvitationUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAcceptInvitationUserRepositoryMockRecorder
+}
+
+// MockAcceptInvitationUserRepositoryMockRecorder is the mock recorder for MockAcceptInvitationUserRepository.
+type MockAcceptInvitationUserRepositoryMockRecorder struct {
+	mock *MockAcceptInvitationUserRepository
+}
+
+// NewMockAcceptInvitationUserRepository creates a new mock instance.
+func NewMockAcceptInvitationUserRepository(ctrl *gomock.Controller) *MockAcceptInvitationUserRepository {
+	mock := &MockAcceptInvitationUserRepository{ctrl: ctrl}
+	mock.recorder = &MockAcceptInvitationUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAcceptInvitationUserRepository) EXPECT() *MockAcceptInvitationUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUserId mocks base method.
+func (m *MockAcceptInvitationUserRepository) SelectByUserId(arg0 context.Context, arg1 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUserId", arg0, arg1)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUserId indicates an expected call of SelectByUserId.
+func (mr *MockAcceptInvitationUserRepositoryMockRecorder) SelectByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUserId", reflect.TypeOf((*MockAcceptInvitationUserRepository)(nil).SelectByUserId), arg0, arg1)
+}
+
+// MockAcceptInvitationOrganizationRepository is a mock of AcceptInvitationOrganizationRepository interface.
+type MockAcceptInvitationOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAcceptInvitationOrganizationRepositoryMockRecorder
+}
+
+// MockAcceptInvitationOrganizationRepositoryMockRecorder is the mock recorder for MockAcceptInvitationOrganizationRepository.
+type MockAcceptInvitationOrganizationRepositoryMockRecorder struct {
+	mock *MockAcceptInvitationOrganizationRepository
+}
+
+// NewMockAcceptInvitationOrganizationRepository creates a new mock instance.
+func NewMockAcceptInvitationOrganizationRepository(ctrl *gomock.Controller) *MockAcceptInvitationOrganizationRepository {
+	mock := &MockAcceptInvitationOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockAcceptInvitationOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAcceptInvitationOrganizationRepository) EXPECT() *MockAcceptInvitationOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectMember mocks base method.
+func (m *MockAcceptInvitationOrganizationRepository) SelectMember(arg0 context.Context, arg1, arg2 string) (entities.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMember indicates an expected call of SelectMember.
+func (mr *MockAcceptInvitationOrganizationRepositoryMockRecorder) SelectMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMember", reflect.TypeOf((*MockAcceptInvitationOrganizationRepository)(nil).SelectMember), arg0, arg1, arg2)
+}
+
+// MockAcceptInvitationInvitationRepository is a mock of AcceptInvitationInvitationRepository interface.
+type MockAcceptInvitationInvitationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAcceptInvitationInvitationRepositoryMockRecorder
+}
+
+// MockAcceptInvitationInvitationRepositoryMockRecorder is the mock recorder for MockAcceptInvitationInvitationRepository.
+type MockAcceptInvitationInvitationRepositoryMockRecorder struct {
+	mock *MockAcceptInvitationInvitationRepository
+}
+
+// NewMockAcceptInvitationInvitationRepository creates a new mock instance.
+func NewMockAcceptInvitationInvitationRepository(ctrl *gomock.Controller) *MockAcceptInvitationInvitationRepository {
+	mock := &MockAcceptInvitationInvitationRepository{ctrl: ctrl}
+	mock.recorder = &MockAcceptInvitationInvitationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAcceptInvitationInvitationRepository) EXPECT() *MockAcceptInvitationInvitationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockAcceptInvitationInvitationRepository) Accept(arg0 context.Context, arg1 entities.Invitation, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockAcceptInvitationInvitationRepositoryMockRecorder) Accept(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockAcceptInvitationInvitationRepository)(nil).Accept), arg0, arg1, arg2)
+}
+
+// SelectById mocks base method.
+func (m *MockAcceptInvitationInvitationRepository) SelectById(arg0 context.Context, arg1 string) (entities.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectById", arg0, arg1)
+	ret0, _ := ret[0].(entities.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectById indicates an expected call of SelectById.
+func (mr *MockAcceptInvitationInvitationRepositoryMockRecorder) SelectById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectById", reflect.TypeOf((*MockAcceptInvitationInvitationRepository)(nil).SelectById), arg0, arg1)
+}
+
+// MockAcceptInvitationHashService is a mock of AcceptInvitationHashService interface.
+type MockAcceptInvitationHashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAcceptInvitationHashServiceMockRecorder
+}
+
+// MockAcceptInvitationHashServiceMockRecorder is the mock recorder for MockAcceptInvitationHashService.
+type MockAcceptInvitationHashServiceMockRecorder struct {
+	mock *MockAcceptInvitationHashService
+}
+
+// NewMockAcceptInvitationHashService creates a new mock instance.
+func NewMockAcceptInvitationHashService(ctrl *gomock.Controller) *MockAcceptInvitationHashService {
+	mock := &MockAcceptInvitationHashService{ctrl: ctrl}
+	mock.recorder = &MockAcceptInvitationHashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAcceptInvitationHashService) EXPECT() *MockAcceptInvitationHashServiceMockRecorder {
+	return m.recorder
+}
+
+// CompareStringAndHash mocks base method.
+func (m *MockAcceptInvitationHashService) CompareStringAndHash(arg0, arg1 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareStringAndHash", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CompareStringAndHash indicates an expected call of CompareStringAndHash.
+func (mr *MockAcceptInvitationHashServiceMockRecorder) CompareStringAndHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareStringAndHash", reflect.TypeOf((*MockAcceptInvitationHashService)(nil).CompareStringAndHash), arg0, arg1)
+}
+
+// MockAcceptInvitationAuditLogger is a mock of AcceptInvitationAuditLogger interface.
+type MockAcceptInvitationAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockAcceptInvitationAuditLoggerMockRecorder
+}
+
+// MockAcceptInvitationAuditLoggerMockRecorder is the mock recorder for MockAcceptInvitationAuditLogger.
+type MockAcceptInvitationAuditLoggerMockRecorder struct {
+	mock *MockAcceptInvitationAuditLogger
+}
+
+// NewMockAcceptInvitationAuditLogger creates a new mock instance.
+func NewMockAcceptInvitationAuditLogger(ctrl *gomock.Controller) *MockAcceptInvitationAuditLogger {
+	mock := &MockAcceptInvitationAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockAcceptInvitationAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAcceptInvitationAuditLogger) EXPECT() *MockAcceptInvitationAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockAcceptInvitationAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockAcceptInvitationAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockAcceptInvitationAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockLogoutSessionRepository is a mock of LogoutSessionRepository interface.
+type MockLogoutSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogoutSessionRepositoryMockRecorder
+}
+
+// MockLogoutSessionRepositoryMockRecorder is the mock recorder for MockLogoutSessionRepository.
+type MockLogoutSessionRepositoryMockRecorder struct {
+	mock *MockLogoutSessionRepository
+}
+
+// NewMockLogoutSessionRepository creates a new mock instance.
+func NewMockLogoutSessionRepository(ctrl *gomock.Controller) *MockLogoutSessionRepository {
+	mock := &MockLogoutSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockLogoutSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogoutSessionRepository) EXPECT() *MockLogoutSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockLogoutSessionRepository) DeleteByUserId(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockLogoutSessionRepositoryMockRecorder) DeleteByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockLogoutSessionRepository)(nil).DeleteByUserId), arg0, arg1)
+}
+
+// MockLogoutCookieService is a mock of LogoutCookieService interface.
+type MockLogoutCookieService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogoutCookieServiceMockRecorder
+}
+
+// MockLogoutCookieServiceMockRecorder is the mock recorder for MockLogoutCookieService.
+type MockLogoutCookieServiceMockRecorder struct {
+	mock *MockLogoutCookieService
+}
+
+// NewMockLogoutCookieService creates a new mock instance.
+func NewMockLogoutCookieService(ctrl *gomock.Controller) *MockLogoutCookieService {
+	mock := &MockLogoutCookieService{ctrl: ctrl}
+	mock.recorder = &MockLogoutCookieServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogoutCookieService) EXPECT() *MockLogoutCookieServiceMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockLogoutCookieService) Clear(w http.ResponseWriter, name string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Clear", w, name)
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockLogoutCookieServiceMockRecorder) Clear(w, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockLogoutCookieService)(nil).Clear), w, name)
+}
+
+// MockLogoutSessionService is a mock of LogoutSessionService interface.
+type MockLogoutSessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogoutSessionServiceMockRecorder
+}
+
+// MockLogoutSessionServiceMockRecorder is the mock recorder for MockLogoutSessionService.
+type MockLogoutSessionServiceMockRecorder struct {
+	mock *MockLogoutSessionService
+}
+
+// NewMockLogoutSessionService creates a new mock instance.
+func NewMockLogoutSessionService(ctrl *gomock.Controller) *MockLogoutSessionService {
+	mock := &MockLogoutSessionService{ctrl: ctrl}
+	mock.recorder = &MockLogoutSessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogoutSessionService) EXPECT() *MockLogoutSessionServiceMockRecorder {
+	return m.recorder
+}
+
+// ParseToken mocks base method.
+func (m *MockLogoutSessionService) ParseToken(token string) (entities.AccessTokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseToken", token)
+	ret0, _ := ret[0].(entities.AccessTokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseToken indicates an expected call of ParseToken.
+func (mr *MockLogoutSessionServiceMockRecorder) ParseToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockLogoutSessionService)(nil).ParseToken), token)
+}
+
+// MockLogoutAuditLogger is a mock of LogoutAuditLogger interface.
+type MockLogoutAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogoutAuditLoggerMockRecorder
+}
+
+// MockLogoutAuditLoggerMockRecorder is the mock recorder for MockLogoutAuditLogger.
+type MockLogoutAuditLoggerMockRecorder struct {
+	mock *MockLogoutAuditLogger
+}
+
+// NewMockLogoutAuditLogger creates a new mock instance.
+func NewMockLogoutAuditLogger(ctrl *gomock.Controller) *MockLogoutAuditLogger {
+	mock := &MockLogoutAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockLogoutAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogoutAuditLogger) EXPECT() *MockLogoutAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockLogoutAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockLogoutAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockLogoutAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockSecurityEventsAuditEventRepository is a mock of SecurityEventsAuditEventRepository interface.
+type MockSecurityEventsAuditEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecurityEventsAuditEventRepositoryMockRecorder
+}
+
+// MockSecurityEventsAuditEventRepositoryMockRecorder is the mock recorder for MockSecurityEventsAuditEventRepository.
+type MockSecurityEventsAuditEventRepositoryMockRecorder struct {
+	mock *MockSecurityEventsAuditEventRepository
+}
+
+// NewMockSecurityEventsAuditEventRepository creates a new mock instance.
+func NewMockSecurityEventsAuditEventRepository(ctrl *gomock.Controller) *MockSecurityEventsAuditEventRepository {
+	mock := &MockSecurityEventsAuditEventRepository{ctrl: ctrl}
+	mock.recorder = &MockSecurityEventsAuditEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecurityEventsAuditEventRepository) EXPECT() *MockSecurityEventsAuditEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockSecurityEventsAuditEventRepository) Count(arg0 context.Context, arg1 repositories.AuditEventFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockSecurityEventsAuditEventRepositoryMockRecorder) Count(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockSecurityEventsAuditEventRepository)(nil).Count), arg0, arg1)
+}
+
+// Select mocks base method.
+func (m *MockSecurityEventsAuditEventRepository) Select(arg0 context.Context, arg1 repositories.AuditEventFilter) ([]entities.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Select", arg0, arg1)
+	ret0, _ := ret[0].([]entities.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Select indicates an expected call of Select.
+func (mr *MockSecurityEventsAuditEventRepositoryMockRecorder) Select(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockSecurityEventsAuditEventRepository)(nil).Select), arg0, arg1)
+}
+
+// MockSecurityEventsAuditLogger is a mock of SecurityEventsAuditLogger interface.
+type MockSecurityEventsAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecurityEventsAuditLoggerMockRecorder
+}
+
+// MockSecurityEventsAuditLoggerMockRecorder is the mock recorder for MockSecurityEventsAuditLogger.
+type MockSecurityEventsAuditLoggerMockRecorder struct {
+	mock *MockSecurityEventsAuditLogger
+}
+
+// NewMockSecurityEventsAuditLogger creates a new mock instance.
+func NewMockSecurityEventsAuditLogger(ctrl *gomock.Controller) *MockSecurityEventsAuditLogger {
+	mock := &MockSecurityEventsAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockSecurityEventsAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecurityEventsAuditLogger) EXPECT() *MockSecurityEventsAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockSecurityEventsAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockSecurityEventsAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockSecurityEventsAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockWebhooksWebhookRepository is a mock of WebhooksWebhookRepository interface.
+type MockWebhooksWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhooksWebhookRepositoryMockRecorder
+}
+
+// MockWebhooksWebhookRepositoryMockRecorder is the mock recorder for MockWebhooksWebhookRepository.
+type MockWebhooksWebhookRepositoryMockRecorder struct {
+	mock *MockWebhooksWebhookRepository
+}
+
+// NewMockWebhooksWebhookRepository creates a new mock instance.
+func NewMockWebhooksWebhookRepository(ctrl *gomock.Controller) *MockWebhooksWebhookRepository {
+	mock := &MockWebhooksWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhooksWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhooksWebhookRepository) EXPECT() *MockWebhooksWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDeliveries mocks base method.
+func (m *MockWebhooksWebhookRepository) ClaimDeliveries(arg0 context.Context, arg1 int, arg2 time.Duration) ([]entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDeliveries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDeliveries indicates an expected call of ClaimDeliveries.
+func (mr *MockWebhooksWebhookRepositoryMockRecorder) ClaimDeliveries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDeliveries", reflect.TypeOf((*MockWebhooksWebhookRepository)(nil).ClaimDeliveries), arg0, arg1, arg2)
+}
+
+// InsertEndpoint mocks base method.
+func (m *MockWebhooksWebhookRepository) InsertEndpoint(arg0 context.Context, arg1 entities.WebhookEndpoint) (entities.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(entities.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertEndpoint indicates an expected call of InsertEndpoint.
+func (mr *MockWebhooksWebhookRepositoryMockRecorder) InsertEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEndpoint", reflect.TypeOf((*MockWebhooksWebhookRepository)(nil).InsertEndpoint), arg0, arg1)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockWebhooksWebhookRepository) UpdateDelivery(arg0 context.Context, arg1 entities.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockWebhooksWebhookRepositoryMockRecorder) UpdateDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockWebhooksWebhookRepository)(nil).UpdateDelivery), arg0, arg1)
+}
+
+// MockWebhooksEncryptionService is a mock of WebhooksEncryptionService interface.
+type MockWebhooksEncryptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhooksEncryptionServiceMockRecorder
+}
+
+// MockWebhooksEncryptionServiceMockRecorder is the mock recorder for MockWebhooksEncryptionService.
+type MockWebhooksEncryptionServiceMockRecorder struct {
+	mock *MockWebhooksEncryptionService
+}
+
+// NewMockWebhooksEncryptionService creates a new mock instance.
+func NewMockWebhooksEncryptionService(ctrl *gomock.Controller) *MockWebhooksEncryptionService {
+	mock := &MockWebhooksEncryptionService{ctrl: ctrl}
+	mock.recorder = &MockWebhooksEncryptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhooksEncryptionService) EXPECT() *MockWebhooksEncryptionServiceMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockWebhooksEncryptionService) Decrypt(ciphertext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ciphertext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockWebhooksEncryptionServiceMockRecorder) Decrypt(ciphertext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockWebhooksEncryptionService)(nil).Decrypt), ciphertext)
+}
+
+// Encrypt mocks base method.
+func (m *MockWebhooksEncryptionService) Encrypt(plaintext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", plaintext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockWebhooksEncryptionServiceMockRecorder) Encrypt(plaintext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockWebhooksEncryptionService)(nil).Encrypt), plaintext)
+}
+
+// MockWebhooksSender is a mock of WebhooksSender interface.
+type MockWebhooksSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhooksSenderMockRecorder
+}
+
+// MockWebhooksSenderMockRecorder is the mock recorder for MockWebhooksSender.
+type MockWebhooksSenderMockRecorder struct {
+	mock *MockWebhooksSender
+}
+
+// NewMockWebhooksSender creates a new mock instance.
+func NewMockWebhooksSender(ctrl *gomock.Controller) *MockWebhooksSender {
+	mock := &MockWebhooksSender{ctrl: ctrl}
+	mock.recorder = &MockWebhooksSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhooksSender) EXPECT() *MockWebhooksSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockWebhooksSender) Send(url, secret string, delivery entities.WebhookDelivery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", url, secret, delivery)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockWebhooksSenderMockRecorder) Send(url, secret, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhooksSender)(nil).Send), url, secret, delivery)
+}
+
+// MockWebhooksAuditLogger is a mock of WebhooksAuditLogger interface.
+type MockWebhooksAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhooksAuditLoggerMockRecorder
+}
+
+// MockWebhooksAuditLoggerMockRecorder is the mock recorder for MockWebhooksAuditLogger.
+type MockWebhooksAuditLoggerMockRecorder struct {
+	mock *MockWebhooksAuditLogger
+}
+
+// NewMockWebhooksAuditLogger creates a new mock instance.
+func NewMockWebhooksAuditLogger(ctrl *gomock.Controller) *MockWebhooksAuditLogger {
+	mock := &MockWebhooksAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockWebhooksAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhooksAuditLogger) EXPECT() *MockWebhooksAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockWebhooksAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockWebhooksAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockWebhooksAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockWebhookEndpointsWebhookRepository is a mock of WebhookEndpointsWebhookRepository interface.
+type MockWebhookEndpointsWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookEndpointsWebhookRepositoryMockRecorder
+}
+
+// MockWebhookEndpointsWebhookRepositoryMockRecorder is the mock recorder for MockWebhookEndpointsWebhookRepository.
+type MockWebhookEndpointsWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookEndpointsWebhookRepository
+}
+
+// NewMockWebhookEndpointsWebhookRepository creates a new mock instance.
+func NewMockWebhookEndpointsWebhookRepository(ctrl *gomock.Controller) *MockWebhookEndpointsWebhookRepository {
+	mock := &MockWebhookEndpointsWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookEndpointsWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookEndpointsWebhookRepository) EXPECT() *MockWebhookEndpointsWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteEndpoint mocks base method.
+func (m *MockWebhookEndpointsWebhookRepository) DeleteEndpoint(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEndpoint indicates an expected call of DeleteEndpoint.
+func (mr *MockWebhookEndpointsWebhookRepositoryMockRecorder) DeleteEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpoint", reflect.TypeOf((*MockWebhookEndpointsWebhookRepository)(nil).DeleteEndpoint), arg0, arg1)
+}
+
+// InsertEndpoint mocks base method.
+func (m *MockWebhookEndpointsWebhookRepository) InsertEndpoint(arg0 context.Context, arg1 entities.WebhookEndpoint) (entities.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(entities.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertEndpoint indicates an expected call of InsertEndpoint.
+func (mr *MockWebhookEndpointsWebhookRepositoryMockRecorder) InsertEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEndpoint", reflect.TypeOf((*MockWebhookEndpointsWebhookRepository)(nil).InsertEndpoint), arg0, arg1)
+}
+
+// ReplayDelivery mocks base method.
+func (m *MockWebhookEndpointsWebhookRepository) ReplayDelivery(arg0 context.Context, arg1 string) (entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDelivery", arg0, arg1)
+	ret0, _ := ret[0].(entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDelivery indicates an expected call of ReplayDelivery.
+func (mr *MockWebhookEndpointsWebhookRepositoryMockRecorder) ReplayDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDelivery", reflect.TypeOf((*MockWebhookEndpointsWebhookRepository)(nil).ReplayDelivery), arg0, arg1)
+}
+
+// SelectDeliveries mocks base method.
+func (m *MockWebhookEndpointsWebhookRepository) SelectDeliveries(arg0 context.Context, arg1 repositories.WebhookDeliveryFilter) ([]entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectDeliveries", arg0, arg1)
+	ret0, _ := ret[0].([]entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectDeliveries indicates an expected call of SelectDeliveries.
+func (mr *MockWebhookEndpointsWebhookRepositoryMockRecorder) SelectDeliveries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectDeliveries", reflect.TypeOf((*MockWebhookEndpointsWebhookRepository)(nil).SelectDeliveries), arg0, arg1)
+}
+
+// SelectEndpoint mocks base method.
+func (m *MockWebhookEndpointsWebhookRepository) SelectEndpoint(arg0 context.Context, arg1 string) (entities.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(entities.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectEndpoint indicates an expected call of SelectEndpoint.
+func (mr *MockWebhookEndpointsWebhookRepositoryMockRecorder) SelectEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectEndpoint", reflect.TypeOf((*MockWebhookEndpointsWebhookRepository)(nil).SelectEndpoint), arg0, arg1)
+}
+
+// SelectEndpoints mocks base method.
+func (m *MockWebhookEndpointsWebhookRepository) SelectEndpoints(arg0 context.Context) ([]entities.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectEndpoints", arg0)
+	ret0, _ := ret[0].([]entities.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectEndpoints indicates an expected call of SelectEndpoints.
+func (mr *MockWebhookEndpointsWebhookRepositoryMockRecorder) SelectEndpoints(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectEndpoints", reflect.TypeOf((*MockWebhookEndpointsWebhookRepository)(nil).SelectEndpoints), arg0)
+}
+
+// UpdateEndpoint mocks base method.
+func (m *MockWebhookEndpointsWebhookRepository) UpdateEndpoint(arg0 context.Context, arg1 entities.WebhookEndpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEndpoint indicates an expected call of UpdateEndpoint.
+func (mr *MockWebhookEndpointsWebhookRepositoryMockRecorder) UpdateEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEndpoint", reflect.TypeOf((*MockWebhookEndpointsWebhookRepository)(nil).UpdateEndpoint), arg0, arg1)
+}
+
+// MockWebhookEndpointsEncryptionService is a mock of WebhookEndpointsEncryptionService interface.
+type MockWebhookEndpointsEncryptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookEndpointsEncryptionServiceMockRecorder
+}
+
+// MockWebhookEndpointsEncryptionServiceMockRecorder is the mock recorder for MockWebhookEndpointsEncryptionService.
+type MockWebhookEndpointsEncryptionServiceMockRecorder struct {
+	mock *MockWebhookEndpointsEncryptionService
+}
+
+// NewMockWebhookEndpointsEncryptionService creates a new mock instance.
+func NewMockWebhookEndpointsEncryptionService(ctrl *gomock.Controller) *MockWebhookEndpointsEncryptionService {
+	mock := &MockWebhookEndpointsEncryptionService{ctrl: ctrl}
+	mock.recorder = &MockWebhookEndpointsEncryptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookEndpointsEncryptionService) EXPECT() *MockWebhookEndpointsEncryptionServiceMockRecorder {
+	return m.recorder
+}
+
+// Encrypt mocks base method.
+func (m *MockWebhookEndpointsEncryptionService) Encrypt(plaintext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", plaintext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockWebhookEndpointsEncryptionServiceMockRecorder) Encrypt(plaintext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockWebhookEndpointsEncryptionService)(nil).Encrypt), plaintext)
+}
+
+// MockWebhookEndpointsRandomService is a mock of WebhookEndpointsRandomService interface.
+type MockWebhookEndpointsRandomService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookEndpointsRandomServiceMockRecorder
+}
+
+// MockWebhookEndpointsRandomServiceMockRecorder is the mock recorder for MockWebhookEndpointsRandomService.
+type MockWebhookEndpointsRandomServiceMockRecorder struct {
+	mock *MockWebhookEndpointsRandomService
+}
+
+// NewMockWebhookEndpointsRandomService creates a new mock instance.
+func NewMockWebhookEndpointsRandomService(ctrl *gomock.Controller) *MockWebhookEndpointsRandomService {
+	mock := &MockWebhookEndpointsRandomService{ctrl: ctrl}
+	mock.recorder = &MockWebhookEndpointsRandomServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookEndpointsRandomService) EXPECT() *MockWebhookEndpointsRandomServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateToken mocks base method.
+func (m *MockWebhookEndpointsRandomService) GenerateToken() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateToken indicates an expected call of GenerateToken.
+func (mr *MockWebhookEndpointsRandomServiceMockRecorder) GenerateToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockWebhookEndpointsRandomService)(nil).GenerateToken))
+}
+
+// MockWebhookEndpointsAuditLogger is a mock of WebhookEndpointsAuditLogger interface.
+type MockWebhookEndpointsAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookEndpointsAuditLoggerMockRecorder
+}
+
+// MockWebhookEndpointsAuditLoggerMockRecorder is the mock recorder for MockWebhookEndpointsAuditLogger.
+type MockWebhookEndpointsAuditLoggerMockRecorder struct {
+	mock *MockWebhookEndpointsAuditLogger
+}
+
+// NewMockWebhookEndpointsAuditLogger creates a new mock instance.
+func NewMockWebhookEndpointsAuditLogger(ctrl *gomock.Controller) *MockWebhookEndpointsAuditLogger {
+	mock := &MockWebhookEndpointsAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockWebhookEndpointsAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookEndpointsAuditLogger) EXPECT() *MockWebhookEndpointsAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockWebhookEndpointsAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockWebhookEndpointsAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockWebhookEndpointsAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockWebhookAlertsAlertRepository is a mock of WebhookAlertsAlertRepository interface.
+type MockWebhookAlertsAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookAlertsAlertRepositoryMockRecorder
+}
+
+// MockWebhookAlertsAlertRepositoryMockRecorder is the mock recorder for MockWebhookAlertsAlertRepository.
+type MockWebhookAlertsAlertRepositoryMockRecorder struct {
+	mock *MockWebhookAlertsAlertRepository
+}
+
+// NewMockWebhookAlertsAlertRepository creates a new mock instance.
+func NewMockWebhookAlertsAlertRepository(ctrl *gomock.Controller) *MockWebhookAlertsAlertRepository {
+	mock := &MockWebhookAlertsAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookAlertsAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookAlertsAlertRepository) EXPECT() *MockWebhookAlertsAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockWebhookAlertsAlertRepository) Count(arg0 context.Context, arg1 repositories.WebhookAlertFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockWebhookAlertsAlertRepositoryMockRecorder) Count(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockWebhookAlertsAlertRepository)(nil).Count), arg0, arg1)
+}
+
+// Insert mocks base method.
+func (m *MockWebhookAlertsAlertRepository) Insert(arg0 context.Context, arg1 entities.WebhookAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockWebhookAlertsAlertRepositoryMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWebhookAlertsAlertRepository)(nil).Insert), arg0, arg1)
+}
+
+// Select mocks base method.
+func (m *MockWebhookAlertsAlertRepository) Select(arg0 context.Context, arg1 repositories.WebhookAlertFilter) ([]entities.WebhookAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Select", arg0, arg1)
+	ret0, _ := ret[0].([]entities.WebhookAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Select indicates an expected call of Select.
+func (mr *MockWebhookAlertsAlertRepositoryMockRecorder) Select(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockWebhookAlertsAlertRepository)(nil).Select), arg0, arg1)
+}
+
+// MockWebhookAlertsNonceRepository is a mock of WebhookAlertsNonceRepository interface.
+type MockWebhookAlertsNonceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookAlertsNonceRepositoryMockRecorder
+}
+
+// MockWebhookAlertsNonceRepositoryMockRecorder is the mock recorder for MockWebhookAlertsNonceRepository.
+type MockWebhookAlertsNonceRepositoryMockRecorder struct {
+	mock *MockWebhookAlertsNonceRepository
+}
+
+// NewMockWebhookAlertsNonceRepository creates a new mock instance.
+func NewMockWebhookAlertsNonceRepository(ctrl *gomock.Controller) *MockWebhookAlertsNonceRepository {
+	mock := &MockWebhookAlertsNonceRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookAlertsNonceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookAlertsNonceRepository) EXPECT() *MockWebhookAlertsNonceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockWebhookAlertsNonceRepository) Insert(context context.Context, nonce string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", context, nonce, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockWebhookAlertsNonceRepositoryMockRecorder) Insert(context, nonce, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWebhookAlertsNonceRepository)(nil).Insert), context, nonce, expiresAt)
+}
+
+// MockWebhookAlertsSignatureService is a mock of WebhookAlertsSignatureService interface.
+type MockWebhookAlertsSignatureService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookAlertsSignatureServiceMockRecorder
+}
+
+// MockWebhookAlertsSignatureServiceMockRecorder is the mock recorder for MockWebhookAlertsSignatureService.
+type MockWebhookAlertsSignatureServiceMockRecorder struct {
+	mock *MockWebhookAlertsSignatureService
+}
+
+// NewMockWebhookAlertsSignatureService creates a new mock instance.
+func NewMockWebhookAlertsSignatureService(ctrl *gomock.Controller) *MockWebhookAlertsSignatureService {
+	mock := &MockWebhookAlertsSignatureService{ctrl: ctrl}
+	mock.recorder = &MockWebhookAlertsSignatureServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookAlertsSignatureService) EXPECT() *MockWebhookAlertsSignatureServiceMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockWebhookAlertsSignatureService) Verify(secret, timestamp string, body []byte, signature string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", secret, timestamp, body, signature)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockWebhookAlertsSignatureServiceMockRecorder) Verify(secret, timestamp, body, signature interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockWebhookAlertsSignatureService)(nil).Verify), secret, timestamp, body, signature)
+}
+
+// MockWebhookAlertsAuditLogger is a mock of WebhookAlertsAuditLogger interface.
+type MockWebhookAlertsAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookAlertsAuditLoggerMockRecorder
+}
+
+// MockWebhookAlertsAuditLoggerMockRecorder is the mock recorder for MockWebhookAlertsAuditLogger.
+type MockWebhookAlertsAuditLoggerMockRecorder struct {
+	mock *MockWebhookAlertsAuditLogger
+}
+
+// NewMockWebhookAlertsAuditLogger creates a new mock instance.
+func NewMockWebhookAlertsAuditLogger(ctrl *gomock.Controller) *MockWebhookAlertsAuditLogger {
+	mock := &MockWebhookAlertsAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockWebhookAlertsAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookAlertsAuditLogger) EXPECT() *MockWebhookAlertsAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockWebhookAlertsAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockWebhookAlertsAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockWebhookAlertsAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockDomainEventsEventRepository is a mock of DomainEventsEventRepository interface.
+type MockDomainEventsEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainEventsEventRepositoryMockRecorder
+}
+
+// MockDomainEventsEventRepositoryMockRecorder is the mock recorder for MockDomainEventsEventRepository.
+type MockDomainEventsEventRepositoryMockRecorder struct {
+	mock *MockDomainEventsEventRepository
+}
+
+// NewMockDomainEventsEventRepository creates a new mock instance.
+func NewMockDomainEventsEventRepository(ctrl *gomock.Controller) *MockDomainEventsEventRepository {
+	mock := &MockDomainEventsEventRepository{ctrl: ctrl}
+	mock.recorder = &MockDomainEventsEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainEventsEventRepository) EXPECT() *MockDomainEventsEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockDomainEventsEventRepository) Purge(context context.Context, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", context, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockDomainEventsEventRepositoryMockRecorder) Purge(context, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDomainEventsEventRepository)(nil).Purge), context, before)
+}
+
+// Relay mocks base method.
+func (m *MockDomainEventsEventRepository) Relay(context context.Context, limit int, publish func(entities.DomainEvent) error) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relay", context, limit, publish)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relay indicates an expected call of Relay.
+func (mr *MockDomainEventsEventRepositoryMockRecorder) Relay(context, limit, publish interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relay", reflect.TypeOf((*MockDomainEventsEventRepository)(nil).Relay), context, limit, publish)
+}
+
+// MockDomainEventsPublisher is a mock of DomainEventsPublisher interface.
+type MockDomainEventsPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainEventsPublisherMockRecorder
+}
+
+// MockDomainEventsPublisherMockRecorder is the mock recorder for MockDomainEventsPublisher.
+type MockDomainEventsPublisherMockRecorder struct {
+	mock *MockDomainEventsPublisher
+}
+
+// NewMockDomainEventsPublisher creates a new mock instance.
+func NewMockDomainEventsPublisher(ctrl *gomock.Controller) *MockDomainEventsPublisher {
+	mock := &MockDomainEventsPublisher{ctrl: ctrl}
+	mock.recorder = &MockDomainEventsPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainEventsPublisher) EXPECT() *MockDomainEventsPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockDomainEventsPublisher) Publish(context context.Context, event entities.DomainEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", context, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockDomainEventsPublisherMockRecorder) Publish(context, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockDomainEventsPublisher)(nil).Publish), context, event)
+}
+
+// MockDomainEventsAuditLogger is a mock of DomainEventsAuditLogger interface.
+type MockDomainEventsAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainEventsAuditLoggerMockRecorder
+}
+
+// MockDomainEventsAuditLoggerMockRecorder is the mock recorder for MockDomainEventsAuditLogger.
+type MockDomainEventsAuditLoggerMockRecorder struct {
+	mock *MockDomainEventsAuditLogger
+}
+
+// NewMockDomainEventsAuditLogger creates a new mock instance.
+func NewMockDomainEventsAuditLogger(ctrl *gomock.Controller) *MockDomainEventsAuditLogger {
+	mock := &MockDomainEventsAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockDomainEventsAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainEventsAuditLogger) EXPECT() *MockDomainEventsAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockDomainEventsAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockDomainEventsAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockDomainEventsAuditLogger)(nil).Log), arg0, arg1)
+}
+
+// MockRiskEngineLoginHistoryRepository is a mock of RiskEngineLoginHistoryRepository interface.
+type MockRiskEngineLoginHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRiskEngineLoginHistoryRepositoryMockRecorder
+}
+
+// MockRiskEngineLoginHistoryRepositoryMockRecorder is the mock recorder for MockRiskEngineLoginHistoryRepository.
+type MockRiskEngineLoginHistoryRepositoryMockRecorder struct {
+	mock *MockRiskEngineLoginHistoryRepository
+}
+
+// NewMockRiskEngineLoginHistoryRepository creates a new mock instance.
+func NewMockRiskEngineLoginHistoryRepository(ctrl *gomock.Controller) *MockRiskEngineLoginHistoryRepository {
+	mock := &MockRiskEngineLoginHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockRiskEngineLoginHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRiskEngineLoginHistoryRepository) EXPECT() *MockRiskEngineLoginHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// History mocks base method.
+func (m *MockRiskEngineLoginHistoryRepository) History(context context.Context, login entities.Login, ipSince time.Time) (entities.LoginHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", context, login, ipSince)
+	ret0, _ := ret[0].(entities.LoginHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockRiskEngineLoginHistoryRepositoryMockRecorder) History(context, login, ipSince interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockRiskEngineLoginHistoryRepository)(nil).History), context, login, ipSince)
+}
+
+// Insert mocks base method.
+func (m *MockRiskEngineLoginHistoryRepository) Insert(context context.Context, login entities.Login, keepSince time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", context, login, keepSince)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockRiskEngineLoginHistoryRepositoryMockRecorder) Insert(context, login, keepSince interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockRiskEngineLoginHistoryRepository)(nil).Insert), context, login, keepSince)
+}
+
+// MockRiskEngineGeoIPService is a mock of RiskEngineGeoIPService interface.
+type MockRiskEngineGeoIPService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRiskEngineGeoIPServiceMockRecorder
+}
+
+// MockRiskEngineGeoIPServiceMockRecorder is the mock recorder for MockRiskEngineGeoIPService.
+type MockRiskEngineGeoIPServiceMockRecorder struct {
+	mock *MockRiskEngineGeoIPService
+}
+
+// NewMockRiskEngineGeoIPService creates a new mock instance.
+func NewMockRiskEngineGeoIPService(ctrl *gomock.Controller) *MockRiskEngineGeoIPService {
+	mock := &MockRiskEngineGeoIPService{ctrl: ctrl}
+	mock.recorder = &MockRiskEngineGeoIPServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRiskEngineGeoIPService) EXPECT() *MockRiskEngineGeoIPServiceMockRecorder {
+	return m.recorder
+}
+
+// Locate mocks base method.
+func (m *MockRiskEngineGeoIPService) Locate(ip string) entities.Location {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Locate", ip)
+	ret0, _ := ret[0].(entities.Location)
+	return ret0
+}
+
+// Locate indicates an expected call of Locate.
+func (mr *MockRiskEngineGeoIPServiceMockRecorder) Locate(ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Locate", reflect.TypeOf((*MockRiskEngineGeoIPService)(nil).Locate), ip)
+}
+
+// MockRiskEngineMailService is a mock of RiskEngineMailService interface.
+type MockRiskEngineMailService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRiskEngineMailServiceMockRecorder
+}
+
+// MockRiskEngineMailServiceMockRecorder is the mock recorder for MockRiskEngineMailService.
+type MockRiskEngineMailServiceMockRecorder struct {
+	mock *MockRiskEngineMailService
+}
+
+// NewMockRiskEngineMailService creates a new mock instance.
+func NewMockRiskEngineMailService(ctrl *gomock.Controller) *MockRiskEngineMailService {
+	mock := &MockRiskEngineMailService{ctrl: ctrl}
+	mock.recorder = &MockRiskEngineMailServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRiskEngineMailService) EXPECT() *MockRiskEngineMailServiceMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockRiskEngineMailService) Send(to, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", to, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockRiskEngineMailServiceMockRecorder) Send(to, subject, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockRiskEngineMailService)(nil).Send), to, subject, body)
+}
+
+// MockRiskEngineAuditLogger is a mock of RiskEngineAuditLogger interface.
+type MockRiskEngineAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockRiskEngineAuditLoggerMockRecorder
+}
+
+// MockRiskEngineAuditLoggerMockRecorder is the mock recorder for MockRiskEngineAuditLogger.
+type MockRiskEngineAuditLoggerMockRecorder struct {
+	mock *MockRiskEngineAuditLogger
+}
+
+// NewMockRiskEngineAuditLogger creates a new mock instance.
+func NewMockRiskEngineAuditLogger(ctrl *gomock.Controller) *MockRiskEngineAuditLogger {
+	mock := &MockRiskEngineAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockRiskEngineAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRiskEngineAuditLogger) EXPECT() *MockRiskEngineAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Log mocks base method.
+func (m *MockRiskEngineAuditLogger) Log(arg0 context.Context, arg1 entities.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Log", arg0, arg1)
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockRiskEngineAuditLoggerMockRecorder) Log(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockRiskEngineAuditLogger)(nil).Log), arg0, arg1)
 }
@@ -0,0 +1,350 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type passwordlessUseCase struct {
+	userRepo          PasswordlessUserRepository
+	loginAttemptRepo  PasswordlessLoginAttemptRepository
+	tokenRepo         PasswordlessTokenRepository
+	trustedDeviceRepo PasswordlessTrustedDeviceRepository
+	hashProvider      PasswordlessHashService
+	sessionManager    PasswordlessSessionService
+	cookieService     PasswordlessCookieService
+	mailService       PasswordlessMailService
+	randomService     PasswordlessRandomService
+	lockoutPolicy     entities.LockoutPolicy
+	policy            entities.PasswordlessPolicy
+	trustedDeviceTTL  time.Duration
+	auditLogger       PasswordlessAuditLogger
+	completion        signInCompletion
+}
+
+// PasswordlessDeviceCookie keeps the device token of the passwordless sign in,
+// so that the magic link completes it in the browser that started it.
+const PasswordlessDeviceCookie = "passwordless_device"
+
+const (
+	passwordlessCodeDigits = 6
+
+	passwordlessCodeMailSubject = "Код для входа"
+	passwordlessCodeMailBody    = "Ваш код для входа: %s. Код действует %d мин. " +
+		"Если вы не запрашивали вход, проигнорируйте это письмо."
+
+	passwordlessLinkMailSubject = "Ссылка для входа"
+	passwordlessLinkMailBody    = "Чтобы войти, перейдите по ссылке: %s\n" +
+		"Ссылка действует %d мин. и работает только на устройстве, с которого был запрошен вход. " +
+		"Если вы не запрашивали вход, проигнорируйте это письмо."
+)
+
+type PasswordlessUseCase interface {
+	StartPasswordless(context context.Context, writer http.ResponseWriter, request *requests.StartPasswordless) (responses.PasswordlessStarted, error)
+	CompletePasswordless(context context.Context, writer http.ResponseWriter, request *requests.CompletePasswordless, userAgent, ip string) (responses.SignIn, error)
+}
+
+func NewPasswordlessUseCase(
+	userRepo PasswordlessUserRepository,
+	sessionRepo PasswordlessSessionRepository,
+	organizationRepo PasswordlessOrganizationRepository,
+	loginAttemptRepo PasswordlessLoginAttemptRepository,
+	mfaRepo PasswordlessMFARepository,
+	webAuthnRepo PasswordlessWebAuthnRepository,
+	tokenRepo PasswordlessTokenRepository,
+	trustedDeviceRepo PasswordlessTrustedDeviceRepository,
+	hashProvider PasswordlessHashService,
+	sessionManager PasswordlessSessionService,
+	cookieService PasswordlessCookieService,
+	mailService PasswordlessMailService,
+	randomService PasswordlessRandomService,
+	lockoutPolicy entities.LockoutPolicy,
+	policy entities.PasswordlessPolicy,
+	trustedDeviceTTL time.Duration,
+	riskEngine PasswordlessRiskEngine,
+	auditLogger PasswordlessAuditLogger,
+) PasswordlessUseCase {
+	return &passwordlessUseCase{
+		userRepo:          userRepo,
+		loginAttemptRepo:  loginAttemptRepo,
+		tokenRepo:         tokenRepo,
+		trustedDeviceRepo: trustedDeviceRepo,
+		hashProvider:      hashProvider,
+		sessionManager:    sessionManager,
+		cookieService:     cookieService,
+		mailService:       mailService,
+		randomService:     randomService,
+		lockoutPolicy:     lockoutPolicy,
+		policy:            policy,
+		trustedDeviceTTL:  trustedDeviceTTL,
+		auditLogger:       auditLogger,
+		// The password takes no part in the sign in, its age is never checked.
+		completion: signInCompletion{
+			sessionRepo:      sessionRepo,
+			organizationRepo: organizationRepo,
+			loginAttemptRepo: loginAttemptRepo,
+			mfaRepo:          mfaRepo,
+			webAuthnRepo:     webAuthnRepo,
+			hashProvider:     hashProvider,
+			sessionManager:   sessionManager,
+			cookieService:    cookieService,
+			riskEngine:       riskEngine,
+			auditLogger:      auditLogger,
+		},
+	}
+}
+
+// StartPasswordless emails a one-time code or a magic link. The answer and its
+// timing are the same whether the account exists or not, the email is only
+// sent when it does or when it can be created on the first sign in. The
+// device token is returned and set in a cookie, the sign in can only be
+// completed with it.
+func (u *passwordlessUseCase) StartPasswordless(context context.Context, writer http.ResponseWriter, request *requests.StartPasswordless) (responses.PasswordlessStarted, error) {
+	response, err := u.startPasswordless(context, writer, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditPasswordlessStart, "", err))
+	return response, err
+}
+
+func (u *passwordlessUseCase) startPasswordless(context context.Context, writer http.ResponseWriter, request *requests.StartPasswordless) (responses.PasswordlessStarted, error) {
+	if !u.policy.Enabled {
+		return responses.PasswordlessStarted{}, ErrPasswordlessDisabled
+	}
+
+	email := entities.Email(request.Email)
+	err := email.Validate()
+	if err != nil {
+		return responses.PasswordlessStarted{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	method := request.Method
+	if method == "" {
+		method = entities.PasswordlessMethodCode
+	}
+
+	deviceToken, err := u.randomService.GenerateToken()
+	if err != nil {
+		return responses.PasswordlessStarted{}, fmt.Errorf("failed to generate device token: %w", err)
+	}
+
+	var secret string
+	if method == entities.PasswordlessMethodLink {
+		secret, err = u.randomService.GenerateToken()
+	} else {
+		secret, err = u.randomService.GenerateCode(passwordlessCodeDigits)
+	}
+	if err != nil {
+		return responses.PasswordlessStarted{}, fmt.Errorf("failed to generate passwordless secret: %w", err)
+	}
+
+	// The secret is hashed before the email check, an unknown email must not
+	// answer faster than a known one.
+	secretHash, err := u.hashProvider.GenerateHash(secret)
+	if err != nil {
+		return responses.PasswordlessStarted{}, fmt.Errorf("%w: failed to hash passwordless secret", err)
+	}
+
+	token := entities.PasswordlessToken{
+		Email:      email,
+		Method:     method,
+		SecretHash: string(secretHash),
+		DeviceHash: u.randomService.HashToken(deviceToken),
+		ExpiresAt:  time.Now().Add(u.policy.TTL(method)),
+	}
+
+	_, err = u.userRepo.SelectByEmail(context, email)
+	if err != nil && !errors.Is(err, repositories.ErrEntityNotFound) {
+		return responses.PasswordlessStarted{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	send := err == nil || u.policy.SignUp
+
+	// The token of an unknown email is stored as well and can never complete
+	// the sign in, nobody gets its secret. The mail is sent in the background,
+	// so neither the storage nor the mail server tells the emails apart.
+	_, err = u.tokenRepo.InsertToken(context, token)
+	if err != nil {
+		return responses.PasswordlessStarted{}, fmt.Errorf("failed to insert passwordless token: %w", err)
+	}
+	if send {
+		go u.sendPasswordlessMailInBackground(token, secret)
+	}
+
+	u.cookieService.Set(writer, PasswordlessDeviceCookie, deviceToken, token.ExpiresAt)
+
+	return responses.PasswordlessStarted{
+		DeviceToken: deviceToken,
+		Method:      method,
+		ExpiresAt:   token.ExpiresAt,
+	}, nil
+}
+
+// CompletePasswordless checks the emailed code or the token from the link on
+// the device that started the sign in and issues the same session as the
+// sign in with the password. Wrong secrets count as failed sign in attempts,
+// the pending sign in is dropped after too many of them.
+func (u *passwordlessUseCase) CompletePasswordless(context context.Context, writer http.ResponseWriter, request *requests.CompletePasswordless, userAgent, ip string) (responses.SignIn, error) {
+	response, userId, err := u.completePasswordless(context, writer, request, userAgent, ip)
+	u.auditLogger.Log(context, signInAuditEvent(entities.AuditPasswordlessComplete, userId, userAgent, ip, response, err))
+	return response, err
+}
+
+func (u *passwordlessUseCase) completePasswordless(context context.Context, writer http.ResponseWriter, request *requests.CompletePasswordless, userAgent, ip string) (responses.SignIn, string, error) {
+	if !u.policy.Enabled {
+		return responses.SignIn{}, "", ErrPasswordlessDisabled
+	}
+	if request.DeviceToken == "" {
+		return responses.SignIn{}, "", fmt.Errorf("%w: the device token is missing", ErrInvalidPasswordlessCode)
+	}
+
+	token, err := u.tokenRepo.SelectTokenByDevice(context, u.randomService.HashToken(request.DeviceToken))
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.SignIn{}, "", fmt.Errorf("%w: the sign in has expired", ErrInvalidPasswordlessCode)
+		}
+		return responses.SignIn{}, "", fmt.Errorf("failed to select passwordless token: %w", err)
+	}
+
+	err = checkAttempts(context, u.loginAttemptRepo, u.lockoutPolicy, token.Email, ip)
+	if err != nil {
+		return responses.SignIn{}, "", err
+	}
+
+	if !u.hashProvider.CompareStringAndHash(strings.TrimSpace(request.Code), token.SecretHash) {
+		if err := u.registerPasswordlessFailure(context, token, ip); err != nil {
+			return responses.SignIn{}, "", err
+		}
+		return responses.SignIn{}, "", ErrInvalidPasswordlessCode
+	}
+
+	err = u.tokenRepo.DeleteToken(context, token.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.SignIn{}, "", fmt.Errorf("%w: the code has already been used", ErrInvalidPasswordlessCode)
+		}
+		return responses.SignIn{}, "", fmt.Errorf("failed to delete passwordless token: %w", err)
+	}
+
+	user, err := u.passwordlessUser(context, token.Email)
+	if err != nil {
+		return responses.SignIn{}, user.Id, err
+	}
+
+	err = CheckUserStatus(user)
+	if err != nil {
+		return responses.SignIn{}, user.Id, fmt.Errorf("user can't sign in: %w", err)
+	}
+
+	mfaMethods, err := u.completion.mfaMethods(context, user)
+	if err != nil {
+		return responses.SignIn{}, user.Id, err
+	}
+	authentication := entities.NewAuthentication(entities.AuthMethodEmail)
+	if len(mfaMethods) > 0 {
+		trusted, err := isTrustedDevice(context, u.trustedDeviceRepo, u.sessionManager, u.trustedDeviceTTL, user, request.TrustedDeviceToken)
+		if err != nil {
+			return responses.SignIn{}, user.Id, err
+		}
+		if !trusted {
+			response, err := u.completion.mfaChallenge(user, mfaMethods, authentication)
+			return response, user.Id, err
+		}
+	}
+
+	response, err := u.completion.completeSignIn(context, writer, user, request.OrganizationId, userAgent, ip, authentication)
+	return response, user.Id, err
+}
+
+// sendPasswordlessMailInBackground sends the mail after the request has been
+// answered, the failure is recorded as a failed start of the sign in.
+func (u *passwordlessUseCase) sendPasswordlessMailInBackground(token entities.PasswordlessToken, secret string) {
+	err := u.sendPasswordlessMail(token, secret)
+	if err != nil {
+		u.auditLogger.Log(context.Background(), newAuditEvent(entities.AuditPasswordlessStart, "", err))
+	}
+}
+
+// sendPasswordlessMail sends the code or the link with the token to the
+// client page that completes the sign in.
+func (u *passwordlessUseCase) sendPasswordlessMail(token entities.PasswordlessToken, secret string) error {
+	minutes := int(u.policy.TTL(token.Method).Minutes())
+
+	var subject, body string
+	if token.Method == entities.PasswordlessMethodLink {
+		link, err := url.Parse(u.policy.LinkURL)
+		if err != nil {
+			return fmt.Errorf("invalid passwordless link url: %w", err)
+		}
+		query := link.Query()
+		query.Set("code", secret)
+		link.RawQuery = query.Encode()
+
+		subject = passwordlessLinkMailSubject
+		body = fmt.Sprintf(passwordlessLinkMailBody, link.String(), minutes)
+	} else {
+		subject = passwordlessCodeMailSubject
+		body = fmt.Sprintf(passwordlessCodeMailBody, secret, minutes)
+	}
+
+	err := u.mailService.Send(string(token.Email), subject, body)
+	if err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+	return nil
+}
+
+// registerPasswordlessFailure counts the wrong secret for the pending sign in
+// and for the brute-force protection of the account and the IP address.
+func (u *passwordlessUseCase) registerPasswordlessFailure(context context.Context, token entities.PasswordlessToken, ip string) error {
+	attempts, err := u.tokenRepo.RegisterTokenFailure(context, token.Id)
+	if err != nil && !errors.Is(err, repositories.ErrEntityNotFound) {
+		return fmt.Errorf("failed to register passwordless failure: %w", err)
+	}
+	if err == nil && attempts >= u.policy.MaxAttempts {
+		err = u.tokenRepo.DeleteToken(context, token.Id)
+		if err != nil && !errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to delete passwordless token: %w", err)
+		}
+	}
+	return registerFailure(context, u.loginAttemptRepo, u.lockoutPolicy, token.Email, ip)
+}
+
+// passwordlessUser returns the user signing in without the password and
+// creates the account on the first sign in when the policy allows it. The
+// account gets a random password nobody knows, it can be replaced later.
+func (u *passwordlessUseCase) passwordlessUser(context context.Context, email entities.Email) (entities.User, error) {
+	user, err := u.userRepo.SelectByEmail(context, email)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, repositories.ErrEntityNotFound) {
+		return entities.User{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	if !u.policy.SignUp {
+		return entities.User{}, ErrInvalidPasswordlessCode
+	}
+
+	password, err := u.randomService.GenerateToken()
+	if err != nil {
+		return entities.User{}, fmt.Errorf("failed to generate password: %w", err)
+	}
+	hashedPassword, err := u.hashProvider.GenerateHash(password)
+	if err != nil {
+		return entities.User{}, fmt.Errorf("%w: failed to hash the password", err)
+	}
+
+	user = entities.NewUser(string(email), password)
+	user.Password = entities.Password(hashedPassword)
+	user.Id, err = u.userRepo.Insert(context, user)
+	if err != nil {
+		return entities.User{}, fmt.Errorf("%w: failed to insert user", err)
+	}
+	return user, nil
+}
@@ -0,0 +1,404 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockPasswordlessUserRepo       *MockPasswordlessUserRepository
+	mockPasswordlessSessionRepo    *MockPasswordlessSessionRepository
+	mockPasswordlessOrgRepo        *MockPasswordlessOrganizationRepository
+	mockPasswordlessAttemptRepo    *MockPasswordlessLoginAttemptRepository
+	mockPasswordlessMFARepo        *MockPasswordlessMFARepository
+	mockPasswordlessWebAuthnRepo   *MockPasswordlessWebAuthnRepository
+	mockPasswordlessTokens         *MockPasswordlessTokenRepository
+	mockPasswordlessTrustedDevices *MockPasswordlessTrustedDeviceRepository
+	mockPasswordlessHashService    *MockPasswordlessHashService
+	mockPasswordlessSessionService *MockPasswordlessSessionService
+	mockPasswordlessCookieService  *MockPasswordlessCookieService
+	mockPasswordlessMailService    *MockPasswordlessMailService
+	mockPasswordlessRandomService  *MockPasswordlessRandomService
+	mockPasswordlessRiskEngine     *MockPasswordlessRiskEngine
+	mockPasswordlessAuditLogger    *MockPasswordlessAuditLogger
+)
+
+var passwordlessEmailPolicy = entities.PasswordlessPolicy{
+	Enabled:     true,
+	CodeTTL:     10 * time.Minute,
+	LinkTTL:     15 * time.Minute,
+	MaxAttempts: 3,
+	LinkURL:     "https://app.example.com/signin/link",
+}
+
+func initPasswordlessMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPasswordlessUserRepo = NewMockPasswordlessUserRepository(ctrl)
+	mockPasswordlessSessionRepo = NewMockPasswordlessSessionRepository(ctrl)
+	mockPasswordlessOrgRepo = NewMockPasswordlessOrganizationRepository(ctrl)
+	mockPasswordlessAttemptRepo = NewMockPasswordlessLoginAttemptRepository(ctrl)
+	mockPasswordlessMFARepo = NewMockPasswordlessMFARepository(ctrl)
+	mockPasswordlessWebAuthnRepo = NewMockPasswordlessWebAuthnRepository(ctrl)
+	mockPasswordlessTokens = NewMockPasswordlessTokenRepository(ctrl)
+	mockPasswordlessTrustedDevices = NewMockPasswordlessTrustedDeviceRepository(ctrl)
+	mockPasswordlessHashService = NewMockPasswordlessHashService(ctrl)
+	mockPasswordlessSessionService = NewMockPasswordlessSessionService(ctrl)
+	mockPasswordlessCookieService = NewMockPasswordlessCookieService(ctrl)
+	mockPasswordlessMailService = NewMockPasswordlessMailService(ctrl)
+	mockPasswordlessRandomService = NewMockPasswordlessRandomService(ctrl)
+	mockPasswordlessRiskEngine = NewMockPasswordlessRiskEngine(ctrl)
+	mockPasswordlessRiskEngine.EXPECT().Assess(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(entities.RiskAssessment{}, nil).AnyTimes()
+	mockPasswordlessRiskEngine.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockPasswordlessAuditLogger = NewMockPasswordlessAuditLogger(ctrl)
+	mockPasswordlessAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func newPasswordlessUseCase() PasswordlessUseCase {
+	return NewPasswordlessUseCase(
+		mockPasswordlessUserRepo,
+		mockPasswordlessSessionRepo,
+		mockPasswordlessOrgRepo,
+		mockPasswordlessAttemptRepo,
+		mockPasswordlessMFARepo,
+		mockPasswordlessWebAuthnRepo,
+		mockPasswordlessTokens,
+		mockPasswordlessTrustedDevices,
+		mockPasswordlessHashService,
+		mockPasswordlessSessionService,
+		mockPasswordlessCookieService,
+		mockPasswordlessMailService,
+		mockPasswordlessRandomService,
+		signInLockoutPolicy,
+		passwordlessEmailPolicy,
+		signInTrustedDeviceTTL,
+		mockPasswordlessRiskEngine,
+		mockPasswordlessAuditLogger)
+}
+
+func expectPasswordlessAttempts(ctx context.Context, email, ip string) {
+	mockPasswordlessAttemptRepo.EXPECT().Select(ctx, entities.AccountAttemptsKey(entities.Email(email))).
+		Return(entities.LoginAttempts{}, nil)
+	mockPasswordlessAttemptRepo.EXPECT().Select(ctx, entities.IPAttemptsKey(ip)).
+		Return(entities.LoginAttempts{}, nil)
+}
+
+func expectPasswordlessAttemptsReset(ctx context.Context, email, ip string) {
+	expectPasswordlessAttempts(ctx, email, ip)
+	mockPasswordlessMFARepo.EXPECT().SelectTOTP(ctx, gomock.Any()).Return(entities.TOTP{}, repositories.ErrEntityNotFound)
+	mockPasswordlessWebAuthnRepo.EXPECT().SelectCredentials(ctx, gomock.Any()).Return(nil, nil)
+	mockPasswordlessAttemptRepo.EXPECT().Reset(ctx, entities.AccountAttemptsKey(entities.Email(email))).Return(nil)
+}
+
+func expectPasswordlessFailure(ctx context.Context, email, ip string) {
+	expectPasswordlessAttempts(ctx, email, ip)
+	mockPasswordlessAttemptRepo.EXPECT().RegisterFailure(ctx, entities.AccountAttemptsKey(entities.Email(email)), signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 1}, nil)
+	mockPasswordlessAttemptRepo.EXPECT().RegisterFailure(ctx, entities.IPAttemptsKey(ip), signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 1}, nil)
+}
+
+// waitPasswordlessMail waits for the mail sent in the background.
+func waitPasswordlessMail(t *testing.T, sent <-chan struct{}) {
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("the passwordless mail has not been sent")
+	}
+}
+
+func TestPasswordlessUseCase_StartPasswordless_SendsCode(t *testing.T) {
+	ctx := context.Background()
+	initPasswordlessMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru"}
+	sent := make(chan struct{})
+
+	mockPasswordlessRandomService.EXPECT().GenerateToken().Return("device-token", nil)
+	mockPasswordlessRandomService.EXPECT().GenerateCode(6).Return("012345", nil)
+	mockPasswordlessRandomService.EXPECT().HashToken("device-token").Return("device-hash")
+	mockPasswordlessHashService.EXPECT().GenerateHash("012345").Return([]byte("code-hash"), nil)
+	mockPasswordlessUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockPasswordlessTokens.EXPECT().InsertToken(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, token entities.PasswordlessToken) (string, error) {
+			assert.Equal(t, entities.PasswordlessMethodCode, token.Method)
+			assert.Equal(t, "code-hash", token.SecretHash)
+			assert.Equal(t, "device-hash", token.DeviceHash)
+			return "token-id", nil
+		})
+	mockPasswordlessMailService.EXPECT().Send("test@mail.ru", passwordlessCodeMailSubject, gomock.Any()).
+		DoAndReturn(func(_, _, body string) error {
+			assert.Contains(t, body, "012345")
+			close(sent)
+			return nil
+		})
+	mockPasswordlessCookieService.EXPECT().Set(nil, PasswordlessDeviceCookie, "device-token", gomock.Any())
+
+	response, err := newPasswordlessUseCase().StartPasswordless(ctx, nil, &requests.StartPasswordless{Email: "test@mail.ru"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "device-token", response.DeviceToken)
+	assert.Equal(t, entities.PasswordlessMethodCode, response.Method)
+	waitPasswordlessMail(t, sent)
+}
+
+func TestPasswordlessUseCase_StartPasswordless_SendsLink(t *testing.T) {
+	ctx := context.Background()
+	initPasswordlessMocks(t)
+	sent := make(chan struct{})
+
+	mockPasswordlessRandomService.EXPECT().GenerateToken().Return("device-token", nil)
+	mockPasswordlessRandomService.EXPECT().GenerateToken().Return("link-token", nil)
+	mockPasswordlessRandomService.EXPECT().HashToken("device-token").Return("device-hash")
+	mockPasswordlessHashService.EXPECT().GenerateHash("link-token").Return([]byte("link-hash"), nil)
+	mockPasswordlessUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(entities.User{Id: "user-id"}, nil)
+	mockPasswordlessTokens.EXPECT().InsertToken(ctx, gomock.Any()).Return("token-id", nil)
+	mockPasswordlessMailService.EXPECT().Send("test@mail.ru", passwordlessLinkMailSubject, gomock.Any()).
+		DoAndReturn(func(_, _, body string) error {
+			assert.Contains(t, body, "https://app.example.com/signin/link?code=link-token")
+			close(sent)
+			return nil
+		})
+	mockPasswordlessCookieService.EXPECT().Set(nil, PasswordlessDeviceCookie, "device-token", gomock.Any())
+
+	response, err := newPasswordlessUseCase().StartPasswordless(ctx, nil, &requests.StartPasswordless{
+		Email:  "test@mail.ru",
+		Method: entities.PasswordlessMethodLink,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.PasswordlessMethodLink, response.Method)
+	waitPasswordlessMail(t, sent)
+}
+
+func TestPasswordlessUseCase_StartPasswordless_UnknownEmail(t *testing.T) {
+	ctx := context.Background()
+	initPasswordlessMocks(t)
+
+	mockPasswordlessRandomService.EXPECT().GenerateToken().Return("device-token", nil)
+	mockPasswordlessRandomService.EXPECT().GenerateCode(6).Return("012345", nil)
+	mockPasswordlessRandomService.EXPECT().HashToken("device-token").Return("device-hash")
+	mockPasswordlessHashService.EXPECT().GenerateHash("012345").Return([]byte("code-hash"), nil)
+	mockPasswordlessUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("unknown@mail.ru")).
+		Return(entities.User{}, repositories.ErrEntityNotFound)
+	// The token is stored like the one of a known email, only the mail is not
+	// sent.
+	mockPasswordlessTokens.EXPECT().InsertToken(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, token entities.PasswordlessToken) (string, error) {
+			assert.Equal(t, entities.Email("unknown@mail.ru"), token.Email)
+			return "token-id", nil
+		})
+	mockPasswordlessCookieService.EXPECT().Set(nil, PasswordlessDeviceCookie, "device-token", gomock.Any())
+
+	response, err := newPasswordlessUseCase().StartPasswordless(ctx, nil, &requests.StartPasswordless{Email: "unknown@mail.ru"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "device-token", response.DeviceToken)
+}
+
+func TestPasswordlessUseCase_StartPasswordless_Disabled(t *testing.T) {
+	ctx := context.Background()
+	initPasswordlessMocks(t)
+
+	useCase := passwordlessUseCase{auditLogger: mockPasswordlessAuditLogger}
+
+	_, err := useCase.StartPasswordless(ctx, nil, &requests.StartPasswordless{Email: "test@mail.ru"})
+
+	assert.ErrorIs(t, err, ErrPasswordlessDisabled)
+}
+
+func expectPasswordlessToken(ctx context.Context) entities.PasswordlessToken {
+	token := entities.PasswordlessToken{
+		Id:         "token-id",
+		Email:      "test@mail.ru",
+		Method:     entities.PasswordlessMethodCode,
+		SecretHash: "code-hash",
+		DeviceHash: "device-hash",
+		ExpiresAt:  time.Now().Add(10 * time.Minute),
+	}
+	mockPasswordlessRandomService.EXPECT().HashToken("device-token").Return("device-hash")
+	mockPasswordlessTokens.EXPECT().SelectTokenByDevice(ctx, "device-hash").Return(token, nil)
+	return token
+}
+
+func TestPasswordlessUseCase_CompletePasswordless_Success(t *testing.T) {
+	ctx := context.Background()
+	initPasswordlessMocks(t)
+	expectPasswordlessToken(ctx)
+	expectPasswordlessAttemptsReset(ctx, "test@mail.ru", "10.0.0.1")
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru"}
+	session := entities.Session{AccessToken: "access-token", RefreshToken: "refresh-token", UserId: "user-id"}
+
+	mockPasswordlessHashService.EXPECT().CompareStringAndHash("012345", "code-hash").Return(true)
+	mockPasswordlessTokens.EXPECT().DeleteToken(ctx, "token-id").Return(nil)
+	mockPasswordlessUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockPasswordlessSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	mockPasswordlessSessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodEmail)).Return(session, nil)
+	mockPasswordlessHashService.EXPECT().GenerateHash("refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockPasswordlessSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockPasswordlessCookieService.EXPECT().Set(nil, "access_token", "access-token", session.AccessExpiresAt)
+
+	useCase := NewPasswordlessUseCase(
+		mockPasswordlessUserRepo,
+		mockPasswordlessSessionRepo,
+		mockPasswordlessOrgRepo,
+		mockPasswordlessAttemptRepo,
+		mockPasswordlessMFARepo,
+		mockPasswordlessWebAuthnRepo,
+		mockPasswordlessTokens,
+		mockPasswordlessTrustedDevices,
+		mockPasswordlessHashService,
+		mockPasswordlessSessionService,
+		mockPasswordlessCookieService,
+		mockPasswordlessMailService,
+		mockPasswordlessRandomService,
+		signInLockoutPolicy,
+		passwordlessEmailPolicy,
+		signInTrustedDeviceTTL,
+		mockPasswordlessRiskEngine,
+		mockPasswordlessAuditLogger)
+
+	result, err := useCase.CompletePasswordless(ctx, nil, &requests.CompletePasswordless{
+		DeviceToken: "device-token",
+		Code:        " 012345 ",
+	}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access-token", result.Session.AccessToken)
+}
+
+func TestPasswordlessUseCase_CompletePasswordless_WrongCode(t *testing.T) {
+	ctx := context.Background()
+	initPasswordlessMocks(t)
+	expectPasswordlessToken(ctx)
+	expectPasswordlessFailure(ctx, "test@mail.ru", "10.0.0.1")
+
+	mockPasswordlessHashService.EXPECT().CompareStringAndHash("999999", "code-hash").Return(false)
+	mockPasswordlessTokens.EXPECT().RegisterTokenFailure(ctx, "token-id").Return(1, nil)
+
+	_, err := newPasswordlessUseCase().CompletePasswordless(ctx, nil, &requests.CompletePasswordless{
+		DeviceToken: "device-token",
+		Code:        "999999",
+	}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrInvalidPasswordlessCode)
+}
+
+func TestPasswordlessUseCase_CompletePasswordless_DropsTokenAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	initPasswordlessMocks(t)
+	expectPasswordlessToken(ctx)
+	expectPasswordlessFailure(ctx, "test@mail.ru", "10.0.0.1")
+
+	mockPasswordlessHashService.EXPECT().CompareStringAndHash("999999", "code-hash").Return(false)
+	mockPasswordlessTokens.EXPECT().RegisterTokenFailure(ctx, "token-id").Return(passwordlessEmailPolicy.MaxAttempts, nil)
+	mockPasswordlessTokens.EXPECT().DeleteToken(ctx, "token-id").Return(nil)
+
+	_, err := newPasswordlessUseCase().CompletePasswordless(ctx, nil, &requests.CompletePasswordless{
+		DeviceToken: "device-token",
+		Code:        "999999",
+	}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrInvalidPasswordlessCode)
+}
+
+func TestPasswordlessUseCase_CompletePasswordless_OtherDevice(t *testing.T) {
+	ctx := context.Background()
+	initPasswordlessMocks(t)
+
+	mockPasswordlessRandomService.EXPECT().HashToken("other-device").Return("other-hash")
+	mockPasswordlessTokens.EXPECT().SelectTokenByDevice(ctx, "other-hash").
+		Return(entities.PasswordlessToken{}, repositories.ErrEntityNotFound)
+
+	_, err := newPasswordlessUseCase().CompletePasswordless(ctx, nil, &requests.CompletePasswordless{
+		DeviceToken: "other-device",
+		Code:        "012345",
+	}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrInvalidPasswordlessCode)
+}
+
+func TestPasswordlessUseCase_CompletePasswordless_CreatesUser(t *testing.T) {
+	ctx := context.Background()
+	initPasswordlessMocks(t)
+	expectPasswordlessToken(ctx)
+	expectPasswordlessAttemptsReset(ctx, "test@mail.ru", "10.0.0.1")
+
+	session := entities.Session{AccessToken: "access-token", RefreshToken: "refresh-token", UserId: "new-user-id"}
+
+	mockPasswordlessHashService.EXPECT().CompareStringAndHash("012345", "code-hash").Return(true)
+	mockPasswordlessTokens.EXPECT().DeleteToken(ctx, "token-id").Return(nil)
+	mockPasswordlessUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).
+		Return(entities.User{}, repositories.ErrEntityNotFound)
+	mockPasswordlessRandomService.EXPECT().GenerateToken().Return("random-password", nil)
+	mockPasswordlessHashService.EXPECT().GenerateHash("random-password").Return([]byte("hashed-password"), nil)
+	mockPasswordlessUserRepo.EXPECT().Insert(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, user entities.User) (string, error) {
+			assert.Equal(t, entities.Email("test@mail.ru"), user.Email)
+			assert.Equal(t, entities.Password("hashed-password"), user.Password)
+			return "new-user-id", nil
+		})
+	// The account has just been created and has no session yet.
+	mockPasswordlessSessionRepo.EXPECT().DeleteByUserId(ctx, "new-user-id").Return(repositories.ErrSessionNotFound)
+	mockPasswordlessSessionService.EXPECT().CreateSession(gomock.Any(), entities.Membership{}, authenticatedWith(entities.AuthMethodEmail)).Return(session, nil)
+	mockPasswordlessHashService.EXPECT().GenerateHash("refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockPasswordlessSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockPasswordlessCookieService.EXPECT().Set(nil, "access_token", "access-token", session.AccessExpiresAt)
+
+	policy := passwordlessEmailPolicy
+	policy.SignUp = true
+	useCase := NewPasswordlessUseCase(
+		mockPasswordlessUserRepo,
+		mockPasswordlessSessionRepo,
+		mockPasswordlessOrgRepo,
+		mockPasswordlessAttemptRepo,
+		mockPasswordlessMFARepo,
+		mockPasswordlessWebAuthnRepo,
+		mockPasswordlessTokens,
+		mockPasswordlessTrustedDevices,
+		mockPasswordlessHashService,
+		mockPasswordlessSessionService,
+		mockPasswordlessCookieService,
+		mockPasswordlessMailService,
+		mockPasswordlessRandomService,
+		signInLockoutPolicy,
+		policy,
+		signInTrustedDeviceTTL,
+		mockPasswordlessRiskEngine,
+		mockPasswordlessAuditLogger)
+
+	result, err := useCase.CompletePasswordless(ctx, nil, &requests.CompletePasswordless{
+		DeviceToken: "device-token",
+		Code:        "012345",
+	}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new-user-id", result.Id)
+}
+
+func TestPasswordlessUseCase_CompletePasswordless_UnknownUserWithoutSignUp(t *testing.T) {
+	ctx := context.Background()
+	initPasswordlessMocks(t)
+	expectPasswordlessToken(ctx)
+	expectPasswordlessAttempts(ctx, "test@mail.ru", "10.0.0.1")
+
+	mockPasswordlessHashService.EXPECT().CompareStringAndHash("012345", "code-hash").Return(true)
+	mockPasswordlessTokens.EXPECT().DeleteToken(ctx, "token-id").Return(nil)
+	mockPasswordlessUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).
+		Return(entities.User{}, repositories.ErrEntityNotFound)
+
+	_, err := newPasswordlessUseCase().CompletePasswordless(ctx, nil, &requests.CompletePasswordless{
+		DeviceToken: "device-token",
+		Code:        "012345",
+	}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrInvalidPasswordlessCode)
+}
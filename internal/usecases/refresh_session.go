@@ -3,7 +3,9 @@ package usecases
 import (
 	"auth/internal/controllers/requests"
 	"auth/internal/controllers/responses"
+	"auth/internal/entities"
 	"auth/internal/repositories"
+	"context"
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
@@ -11,11 +13,14 @@ import (
 )
 
 type refreshSessionUseCase struct {
-	userRepository    RefreshSessionUserRepository
-	sessionRepository RefreshSessionSessionRepository
-	sessionService    RefreshSessionSessionService
-	cookieService     RefreshSessionCookieService
-	hashProvider      RefreshSessionHashProvider
+	userRepository         RefreshSessionUserRepository
+	sessionRepository      RefreshSessionSessionRepository
+	organizationRepository RefreshSessionOrganizationRepository
+	sessionService         RefreshSessionSessionService
+	cookieService          RefreshSessionCookieService
+	hashProvider           RefreshSessionHashProvider
+	riskEngine             RefreshSessionRiskEngine
+	auditLogger            RefreshSessionAuditLogger
 }
 
 type RefreshSessionUseCase interface {
@@ -25,75 +30,107 @@ type RefreshSessionUseCase interface {
 func NewRefreshSessionUseCase(
 	userRepository RefreshSessionUserRepository,
 	sessionRepository RefreshSessionSessionRepository,
+	organizationRepository RefreshSessionOrganizationRepository,
 	sessionService RefreshSessionSessionService,
 	cookieService RefreshSessionCookieService,
-	hashProvider RefreshSessionHashProvider) RefreshSessionUseCase {
+	hashProvider RefreshSessionHashProvider,
+	riskEngine RefreshSessionRiskEngine,
+	auditLogger RefreshSessionAuditLogger,
+) RefreshSessionUseCase {
 	return &refreshSessionUseCase{
-		userRepository:    userRepository,
-		sessionRepository: sessionRepository,
-		sessionService:    sessionService,
-		cookieService:     cookieService,
-		hashProvider:      hashProvider,
+		userRepository:         userRepository,
+		sessionRepository:      sessionRepository,
+		organizationRepository: organizationRepository,
+		sessionService:         sessionService,
+		cookieService:          cookieService,
+		hashProvider:           hashProvider,
+		riskEngine:             riskEngine,
+		auditLogger:            auditLogger,
 	}
 }
 
 func (r refreshSessionUseCase) RefreshSession(context *gin.Context, writer http.ResponseWriter, request requests.RefreshSession, ip, userAgent string) (responses.Session, error) {
+	response, userId, err := r.refreshSession(context, writer, request, ip, userAgent)
+	event := newAuditEvent(entities.AuditTokenRefresh, userId, err)
+	event.IP = ip
+	event.UserAgent = userAgent
+	if err == nil {
+		event.ActorId = userId
+	}
+	r.auditLogger.Log(context, event)
+	return response, err
+}
+
+func (r refreshSessionUseCase) refreshSession(context *gin.Context, writer http.ResponseWriter, request requests.RefreshSession, ip, userAgent string) (responses.Session, string, error) {
 	accessToken := request.AccessToken
 	refreshToken := request.RefreshToken
 
 	claims, err := r.sessionService.ParseToken(accessToken)
 	if err != nil {
-		return responses.Session{}, err
+		return responses.Session{}, "", err
 	}
 
 	userId, ok := claims["sub"].(string)
 	if !ok || userId == "" {
-		return responses.Session{}, fmt.Errorf("invalid token claims: %w", ErrInvalidInput)
+		return responses.Session{}, "", fmt.Errorf("invalid token claims: %w", ErrInvalidInput)
 	}
 	session, err := r.sessionRepository.SelectByUserId(context, userId)
 	if err != nil {
-		return responses.Session{}, fmt.Errorf("failed to select session: %s", ErrSessionNotFound)
+		return responses.Session{}, userId, fmt.Errorf("failed to select session: %s", ErrSessionNotFound)
 	}
 
 	if userAgent != session.UserAgent {
 		err = r.sessionRepository.DeleteByUserId(context, session.UserId)
 		r.cookieService.Clear(writer, "access_token")
 		if err != nil {
-			return responses.Session{}, fmt.Errorf("failed to delete session: %w", err)
+			return responses.Session{}, userId, fmt.Errorf("failed to delete session: %w", err)
 		}
-		return responses.Session{}, fmt.Errorf("user-agent mismatch: %w", ErrInvalidUserAgent)
+		return responses.Session{}, userId, fmt.Errorf("user-agent mismatch: %w", ErrInvalidUserAgent)
 	}
 
-	go func() {
-		if session.IP != ip {
-			sendWebhook(session.UserId, ip)
-		}
-	}()
-
 	cookieAccessToken, err := context.Cookie("access_token")
 	if err != nil {
-		return responses.Session{}, fmt.Errorf("failed to get access token from cookie: %w", err)
+		return responses.Session{}, userId, fmt.Errorf("failed to get access token from cookie: %w", err)
 	}
 	if accessToken != cookieAccessToken {
-		return responses.Session{}, fmt.Errorf("can not refresh session: %w", ErrNotAValidAccessToken)
+		return responses.Session{}, userId, fmt.Errorf("can not refresh session: %w", ErrNotAValidAccessToken)
 	}
 
 	valid := r.hashProvider.CompareStringAndHash(refreshToken, session.RefreshToken)
 	if !valid {
-		return responses.Session{}, fmt.Errorf("can not refresh session: %w", ErrNotAValidRefreshToken)
+		return responses.Session{}, userId, fmt.Errorf("can not refresh session: %w", ErrNotAValidRefreshToken)
 	}
 
 	user, err := r.userRepository.SelectByUserId(context, session.UserId)
 	if err != nil {
 		if errors.Is(err, repositories.ErrEntityNotFound) {
-			return responses.Session{}, fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+			return responses.Session{}, userId, fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+		}
+		return responses.Session{}, userId, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	err = CheckUserStatus(user)
+	if err != nil {
+		return responses.Session{}, userId, fmt.Errorf("user can't refresh session: %w", err)
+	}
+
+	if session.IP != ip {
+		err = r.assessNewAddress(context, writer, user, userAgent, ip)
+		if err != nil {
+			return responses.Session{}, userId, err
 		}
-		return responses.Session{}, fmt.Errorf("failed to find user: %w", err)
 	}
 
-	newSession, err := r.sessionService.CreateSession(user)
+	membership, err := r.selectMembership(context, request.OrganizationId, session)
 	if err != nil {
-		return responses.Session{}, fmt.Errorf("failed to create session: %w", err)
+		return responses.Session{}, userId, err
+	}
+
+	// The refreshed tokens report the original sign in, refreshing is not an
+	// authentication.
+	newSession, err := r.sessionService.CreateSession(user, membership, session.Authentication)
+	if err != nil {
+		return responses.Session{}, userId, fmt.Errorf("failed to create session: %w", err)
 	}
 
 	newSession.IP = ip
@@ -101,14 +138,26 @@ func (r refreshSessionUseCase) RefreshSession(context *gin.Context, writer http.
 
 	hashedRefreshToken, err := r.hashProvider.GenerateHash(newSession.RefreshToken)
 	if err != nil {
-		return responses.Session{}, fmt.Errorf("failed to hash refresh token: %w", err)
+		return responses.Session{}, userId, fmt.Errorf("failed to hash refresh token: %w", err)
 	}
 	rawRefreshToken := newSession.RefreshToken
 	newSession.RefreshToken = string(hashedRefreshToken)
 
-	err = r.sessionRepository.Update(context, newSession)
+	// The webhook subscribers are told about the session used from a new
+	// address, the event is queued together with the session update.
+	if session.IP != ip {
+		event := entities.NewWebhookEvent(entities.WebhookSessionIPChanged, map[string]string{
+			"userId":     session.UserId,
+			"ip":         ip,
+			"previousIp": session.IP,
+			"userAgent":  userAgent,
+		})
+		err = r.sessionRepository.UpdateWithEvent(context, newSession, event)
+	} else {
+		err = r.sessionRepository.Update(context, newSession)
+	}
 	if err != nil {
-		return responses.Session{}, fmt.Errorf("failed to save session: %w", err)
+		return responses.Session{}, userId, fmt.Errorf("failed to save session: %w", err)
 	}
 
 	r.cookieService.Set(
@@ -118,5 +167,44 @@ func (r refreshSessionUseCase) RefreshSession(context *gin.Context, writer http.
 		newSession.AccessExpiresAt,
 	)
 
-	return responses.NewSession(newSession.AccessToken, rawRefreshToken, newSession.AccessExpiresAt.Unix()), nil
+	return responses.NewSession(newSession.AccessToken, rawRefreshToken, newSession.AccessExpiresAt.Unix()), userId, nil
+}
+
+// assessNewAddress runs the risk engine on the session used from a new
+// address. The refresh can't ask for the second factor, so the risky session
+// is ended and the user has to sign in again.
+func (r refreshSessionUseCase) assessNewAddress(context context.Context, writer http.ResponseWriter, user entities.User, userAgent, ip string) error {
+	assessment, err := r.riskEngine.Assess(context, user.Id, userAgent, ip)
+	if err != nil {
+		return err
+	}
+	if assessment.Score > 0 {
+		r.auditLogger.Log(context, newRiskAuditEvent(user.Id, assessment))
+	}
+
+	if assessment.Block || assessment.Challenge {
+		err = r.sessionRepository.DeleteByUserId(context, user.Id)
+		r.cookieService.Clear(writer, "access_token")
+		if err != nil {
+			return fmt.Errorf("failed to delete session: %w", err)
+		}
+		return ErrSuspiciousSession
+	}
+
+	return r.riskEngine.Record(context, user, assessment)
+}
+
+// selectMembership switches the session to the requested organization or
+// keeps the current one. A member removed from the current organization
+// silently loses it instead of failing the refresh.
+func (r refreshSessionUseCase) selectMembership(context context.Context, organizationId string, session entities.Session) (entities.Membership, error) {
+	if organizationId != "" {
+		return selectMembership(context, r.organizationRepository, organizationId, session.UserId)
+	}
+
+	membership, err := selectMembership(context, r.organizationRepository, session.OrganizationId, session.UserId)
+	if errors.Is(err, ErrOrganizationAccessDenied) {
+		return entities.Membership{}, nil
+	}
+	return membership, err
 }
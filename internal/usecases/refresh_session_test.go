@@ -15,20 +15,30 @@ import (
 )
 
 var (
-	mockRefreshUserRepo       *MockRefreshSessionUserRepository
-	mockRefreshSessionRepo    *MockRefreshSessionSessionRepository
-	mockRefreshSessionService *MockRefreshSessionSessionService
-	mockRefreshCookieService  *MockRefreshSessionCookieService
-	mockRefreshHashProvider   *MockRefreshSessionHashProvider
+	mockRefreshUserRepo           *MockRefreshSessionUserRepository
+	mockRefreshSessionRepo        *MockRefreshSessionSessionRepository
+	mockRefreshOrgRepo            *MockRefreshSessionOrganizationRepository
+	mockRefreshSessionService     *MockRefreshSessionSessionService
+	mockRefreshCookieService      *MockRefreshSessionCookieService
+	mockRefreshHashProvider       *MockRefreshSessionHashProvider
+	mockRefreshRiskEngine         *MockRefreshSessionRiskEngine
+	mockRefreshSessionAuditLogger *MockRefreshSessionAuditLogger
 )
 
 func initRefreshMocks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockRefreshUserRepo = NewMockRefreshSessionUserRepository(ctrl)
 	mockRefreshSessionRepo = NewMockRefreshSessionSessionRepository(ctrl)
+	mockRefreshOrgRepo = NewMockRefreshSessionOrganizationRepository(ctrl)
 	mockRefreshSessionService = NewMockRefreshSessionSessionService(ctrl)
 	mockRefreshCookieService = NewMockRefreshSessionCookieService(ctrl)
 	mockRefreshHashProvider = NewMockRefreshSessionHashProvider(ctrl)
+	mockRefreshRiskEngine = NewMockRefreshSessionRiskEngine(ctrl)
+	mockRefreshRiskEngine.EXPECT().Assess(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(entities.RiskAssessment{}, nil).AnyTimes()
+	mockRefreshRiskEngine.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRefreshSessionAuditLogger = NewMockRefreshSessionAuditLogger(ctrl)
+	mockRefreshSessionAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
 }
 
 func TestRefreshSessionUseCase_RefreshSession_Success(t *testing.T) {
@@ -49,6 +59,8 @@ func TestRefreshSessionUseCase_RefreshSession_Success(t *testing.T) {
 		UserAgent:    userAgent,
 		IP:           "127.0.0.1",
 		RefreshToken: "hashed-refresh-token",
+		// The refreshed tokens keep the time of the original sign in.
+		Authentication: entities.NewAuthentication(entities.AuthMethodPassword),
 	}
 	user := entities.User{
 		Id:    "user-id",
@@ -67,7 +79,7 @@ func TestRefreshSessionUseCase_RefreshSession_Success(t *testing.T) {
 	ctx.Request, _ = http.NewRequest("GET", "/", nil)
 	ctx.Request.AddCookie(&http.Cookie{Name: "access_token", Value: "access-token"})
 	mockRefreshUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
-	mockRefreshSessionService.EXPECT().CreateSession(user).Return(newSession, nil)
+	mockRefreshSessionService.EXPECT().CreateSession(user, entities.Membership{}, oldSession.Authentication).Return(newSession, nil)
 	mockRefreshHashProvider.EXPECT().GenerateHash("new-refresh-token").Return([]byte("hashed-new-refresh"), nil)
 	mockRefreshSessionRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
 	mockRefreshCookieService.EXPECT().Set(writer, "access_token", newSession.AccessToken, newSession.AccessExpiresAt)
@@ -75,9 +87,12 @@ func TestRefreshSessionUseCase_RefreshSession_Success(t *testing.T) {
 	useCase := NewRefreshSessionUseCase(
 		mockRefreshUserRepo,
 		mockRefreshSessionRepo,
+		mockRefreshOrgRepo,
 		mockRefreshSessionService,
 		mockRefreshCookieService,
-		mockRefreshHashProvider)
+		mockRefreshHashProvider,
+		mockRefreshRiskEngine,
+		mockRefreshSessionAuditLogger)
 
 	result, err := useCase.RefreshSession(ctx, writer, request, ip, userAgent)
 
@@ -86,6 +101,59 @@ func TestRefreshSessionUseCase_RefreshSession_Success(t *testing.T) {
 	assert.Equal(t, "new-refresh-token", result.RefreshToken)
 }
 
+func TestRefreshSessionUseCase_RefreshSession_QueuesWebhookOnNewIP(t *testing.T) {
+	ctx := &gin.Context{}
+	initRefreshMocks(t)
+
+	request := requests.RefreshSession{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+	}
+	claims := map[string]interface{}{"sub": "user-id"}
+	oldSession := entities.Session{
+		UserId:       "user-id",
+		UserAgent:    "test-agent",
+		IP:           "127.0.0.1",
+		RefreshToken: "hashed-refresh-token",
+	}
+	user := entities.User{Id: "user-id"}
+	newSession := entities.Session{
+		AccessToken:  "new-access-token",
+		RefreshToken: "new-refresh-token",
+		UserId:       "user-id",
+	}
+
+	mockRefreshSessionService.EXPECT().ParseToken("access-token").Return(claims, nil)
+	mockRefreshSessionRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(oldSession, nil)
+	mockRefreshHashProvider.EXPECT().CompareStringAndHash("refresh-token", oldSession.RefreshToken).Return(true)
+	ctx.Request, _ = http.NewRequest("GET", "/", nil)
+	ctx.Request.AddCookie(&http.Cookie{Name: "access_token", Value: "access-token"})
+	mockRefreshUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	mockRefreshSessionService.EXPECT().CreateSession(user, entities.Membership{}, entities.Authentication{}).Return(newSession, nil)
+	mockRefreshHashProvider.EXPECT().GenerateHash("new-refresh-token").Return([]byte("hashed-new-refresh"), nil)
+	mockRefreshSessionRepo.EXPECT().UpdateWithEvent(ctx, gomock.Any(), webhookEventOf(entities.WebhookSessionIPChanged, map[string]string{
+		"userId":     "user-id",
+		"ip":         "10.0.0.2",
+		"previousIp": "127.0.0.1",
+		"userAgent":  "test-agent",
+	})).Return(nil)
+	mockRefreshCookieService.EXPECT().Set(gomock.Any(), "access_token", newSession.AccessToken, newSession.AccessExpiresAt)
+
+	useCase := NewRefreshSessionUseCase(
+		mockRefreshUserRepo,
+		mockRefreshSessionRepo,
+		mockRefreshOrgRepo,
+		mockRefreshSessionService,
+		mockRefreshCookieService,
+		mockRefreshHashProvider,
+		mockRefreshRiskEngine,
+		mockRefreshSessionAuditLogger)
+
+	_, err := useCase.RefreshSession(ctx, nil, request, "10.0.0.2", "test-agent")
+
+	assert.NoError(t, err)
+}
+
 func TestRefreshSessionUseCase_RefreshSession_InvalidToken(t *testing.T) {
 	ctx := &gin.Context{}
 	initRefreshMocks(t)
@@ -99,9 +167,12 @@ func TestRefreshSessionUseCase_RefreshSession_InvalidToken(t *testing.T) {
 	useCase := NewRefreshSessionUseCase(
 		mockRefreshUserRepo,
 		mockRefreshSessionRepo,
+		mockRefreshOrgRepo,
 		mockRefreshSessionService,
 		mockRefreshCookieService,
-		mockRefreshHashProvider)
+		mockRefreshHashProvider,
+		mockRefreshRiskEngine,
+		mockRefreshSessionAuditLogger)
 
 	_, err := useCase.RefreshSession(ctx, nil, request, "", "")
 
@@ -129,9 +200,12 @@ func TestRefreshSessionUseCase_RefreshSession_UserAgentMismatch(t *testing.T) {
 	useCase := NewRefreshSessionUseCase(
 		mockRefreshUserRepo,
 		mockRefreshSessionRepo,
+		mockRefreshOrgRepo,
 		mockRefreshSessionService,
 		mockRefreshCookieService,
-		mockRefreshHashProvider)
+		mockRefreshHashProvider,
+		mockRefreshRiskEngine,
+		mockRefreshSessionAuditLogger)
 
 	_, err := useCase.RefreshSession(ctx, nil, request, "", "test-agent")
 
@@ -167,9 +241,12 @@ func TestRefreshSessionUseCase_RefreshSession_InvalidRefreshToken(t *testing.T)
 	useCase := NewRefreshSessionUseCase(
 		mockRefreshUserRepo,
 		mockRefreshSessionRepo,
+		mockRefreshOrgRepo,
 		mockRefreshSessionService,
 		mockRefreshCookieService,
-		mockRefreshHashProvider)
+		mockRefreshHashProvider,
+		mockRefreshRiskEngine,
+		mockRefreshSessionAuditLogger)
 
 	_, err := useCase.RefreshSession(ctx, nil, request, "", "test-agent")
 
@@ -202,9 +279,12 @@ func TestRefreshSessionUseCase_RefreshSession_UserNotFound(t *testing.T) {
 	useCase := NewRefreshSessionUseCase(
 		mockRefreshUserRepo,
 		mockRefreshSessionRepo,
+		mockRefreshOrgRepo,
 		mockRefreshSessionService,
 		mockRefreshCookieService,
-		mockRefreshHashProvider)
+		mockRefreshHashProvider,
+		mockRefreshRiskEngine,
+		mockRefreshSessionAuditLogger)
 
 	_, err := useCase.RefreshSession(ctx, nil, request, "", "test-agent")
 
@@ -212,3 +292,190 @@ func TestRefreshSessionUseCase_RefreshSession_UserNotFound(t *testing.T) {
 	assert.ErrorIs(t, err, ErrEntityNotFound)
 	assert.Contains(t, err.Error(), "failed to find user")
 }
+
+func TestRefreshSessionUseCase_RefreshSession_UserDisabled(t *testing.T) {
+	ctx := &gin.Context{}
+	initRefreshMocks(t)
+
+	request := requests.RefreshSession{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+	}
+	claims := map[string]interface{}{"sub": "user-id"}
+	oldSession := entities.Session{
+		UserId:       "user-id",
+		UserAgent:    "test-agent",
+		RefreshToken: "hashed-valid-refresh-token",
+	}
+	user := entities.User{
+		Id:     "user-id",
+		Status: entities.UserStatusDisabled,
+	}
+
+	mockRefreshSessionService.EXPECT().ParseToken("access-token").Return(claims, nil)
+	mockRefreshSessionRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(oldSession, nil)
+	mockRefreshHashProvider.EXPECT().CompareStringAndHash(request.RefreshToken, "hashed-valid-refresh-token").Return(true)
+	ctx.Request, _ = http.NewRequest("GET", "/", nil)
+	ctx.Request.AddCookie(&http.Cookie{Name: "access_token", Value: request.AccessToken})
+	mockRefreshUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+
+	useCase := NewRefreshSessionUseCase(
+		mockRefreshUserRepo,
+		mockRefreshSessionRepo,
+		mockRefreshOrgRepo,
+		mockRefreshSessionService,
+		mockRefreshCookieService,
+		mockRefreshHashProvider,
+		mockRefreshRiskEngine,
+		mockRefreshSessionAuditLogger)
+
+	_, err := useCase.RefreshSession(ctx, nil, request, "", "test-agent")
+
+	assert.ErrorIs(t, err, ErrUserDisabled)
+}
+
+func TestRefreshSessionUseCase_RefreshSession_SwitchOrganization(t *testing.T) {
+	ctx := &gin.Context{}
+	initRefreshMocks(t)
+
+	request := requests.RefreshSession{
+		AccessToken:    "access-token",
+		RefreshToken:   "refresh-token",
+		OrganizationId: "new-org-id",
+	}
+	claims := map[string]interface{}{"sub": "user-id"}
+	oldSession := entities.Session{
+		UserId:         "user-id",
+		OrganizationId: "old-org-id",
+		UserAgent:      "test-agent",
+		RefreshToken:   "hashed-valid-refresh-token",
+	}
+	user := entities.User{Id: "user-id"}
+	membership := entities.Membership{OrganizationId: "new-org-id", UserId: "user-id", Role: entities.OrganizationRoleOwner}
+	newSession := entities.Session{
+		AccessToken:    "new-access-token",
+		RefreshToken:   "new-refresh-token",
+		UserId:         "user-id",
+		OrganizationId: "new-org-id",
+	}
+
+	mockRefreshSessionService.EXPECT().ParseToken("access-token").Return(claims, nil)
+	mockRefreshSessionRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(oldSession, nil)
+	mockRefreshHashProvider.EXPECT().CompareStringAndHash(request.RefreshToken, "hashed-valid-refresh-token").Return(true)
+	ctx.Request, _ = http.NewRequest("GET", "/", nil)
+	ctx.Request.AddCookie(&http.Cookie{Name: "access_token", Value: request.AccessToken})
+	mockRefreshUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	mockRefreshOrgRepo.EXPECT().SelectMember(ctx, "new-org-id", "user-id").Return(membership, nil)
+	mockRefreshSessionService.EXPECT().CreateSession(user, membership, entities.Authentication{}).Return(newSession, nil)
+	mockRefreshHashProvider.EXPECT().GenerateHash("new-refresh-token").Return([]byte("hashed-new-refresh"), nil)
+	mockRefreshSessionRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+	mockRefreshCookieService.EXPECT().Set(gomock.Any(), "access_token", newSession.AccessToken, newSession.AccessExpiresAt)
+
+	useCase := NewRefreshSessionUseCase(
+		mockRefreshUserRepo,
+		mockRefreshSessionRepo,
+		mockRefreshOrgRepo,
+		mockRefreshSessionService,
+		mockRefreshCookieService,
+		mockRefreshHashProvider,
+		mockRefreshRiskEngine,
+		mockRefreshSessionAuditLogger)
+
+	result, err := useCase.RefreshSession(ctx, nil, request, "", "test-agent")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new-access-token", result.AccessToken)
+}
+
+func TestRefreshSessionUseCase_RefreshSession_RemovedFromOrganization(t *testing.T) {
+	ctx := &gin.Context{}
+	initRefreshMocks(t)
+
+	request := requests.RefreshSession{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+	}
+	claims := map[string]interface{}{"sub": "user-id"}
+	oldSession := entities.Session{
+		UserId:         "user-id",
+		OrganizationId: "org-id",
+		UserAgent:      "test-agent",
+		RefreshToken:   "hashed-valid-refresh-token",
+	}
+	user := entities.User{Id: "user-id"}
+	newSession := entities.Session{
+		AccessToken:  "new-access-token",
+		RefreshToken: "new-refresh-token",
+		UserId:       "user-id",
+	}
+
+	mockRefreshSessionService.EXPECT().ParseToken("access-token").Return(claims, nil)
+	mockRefreshSessionRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(oldSession, nil)
+	mockRefreshHashProvider.EXPECT().CompareStringAndHash(request.RefreshToken, "hashed-valid-refresh-token").Return(true)
+	ctx.Request, _ = http.NewRequest("GET", "/", nil)
+	ctx.Request.AddCookie(&http.Cookie{Name: "access_token", Value: request.AccessToken})
+	mockRefreshUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	mockRefreshOrgRepo.EXPECT().SelectMember(ctx, "org-id", "user-id").Return(entities.Membership{}, repositories.ErrEntityNotFound)
+	mockRefreshSessionService.EXPECT().CreateSession(user, entities.Membership{}, entities.Authentication{}).Return(newSession, nil)
+	mockRefreshHashProvider.EXPECT().GenerateHash("new-refresh-token").Return([]byte("hashed-new-refresh"), nil)
+	mockRefreshSessionRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+	mockRefreshCookieService.EXPECT().Set(gomock.Any(), "access_token", newSession.AccessToken, newSession.AccessExpiresAt)
+
+	useCase := NewRefreshSessionUseCase(
+		mockRefreshUserRepo,
+		mockRefreshSessionRepo,
+		mockRefreshOrgRepo,
+		mockRefreshSessionService,
+		mockRefreshCookieService,
+		mockRefreshHashProvider,
+		mockRefreshRiskEngine,
+		mockRefreshSessionAuditLogger)
+
+	_, err := useCase.RefreshSession(ctx, nil, request, "", "test-agent")
+
+	assert.NoError(t, err)
+}
+
+func TestRefreshSessionUseCase_RefreshSession_RiskEndsSessionOnNewIP(t *testing.T) {
+	ctx := &gin.Context{}
+	initRefreshMocks(t)
+
+	request := requests.RefreshSession{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+	}
+	claims := map[string]interface{}{"sub": "user-id"}
+	oldSession := entities.Session{
+		UserId:       "user-id",
+		UserAgent:    "test-agent",
+		IP:           "127.0.0.1",
+		RefreshToken: "hashed-refresh-token",
+	}
+	user := entities.User{Id: "user-id"}
+
+	mockRefreshSessionService.EXPECT().ParseToken("access-token").Return(claims, nil)
+	mockRefreshSessionRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(oldSession, nil)
+	mockRefreshHashProvider.EXPECT().CompareStringAndHash("refresh-token", oldSession.RefreshToken).Return(true)
+	ctx.Request, _ = http.NewRequest("GET", "/", nil)
+	ctx.Request.AddCookie(&http.Cookie{Name: "access_token", Value: "access-token"})
+	mockRefreshUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	mockRefreshRiskEngine = NewMockRefreshSessionRiskEngine(gomock.NewController(t))
+	mockRefreshRiskEngine.EXPECT().Assess(ctx, "user-id", "test-agent", "10.0.0.2").
+		Return(entities.RiskAssessment{Score: 60, Signals: []string{entities.RiskImpossibleTravel}, Challenge: true}, nil)
+	mockRefreshSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	mockRefreshCookieService.EXPECT().Clear(gomock.Any(), "access_token")
+
+	useCase := NewRefreshSessionUseCase(
+		mockRefreshUserRepo,
+		mockRefreshSessionRepo,
+		mockRefreshOrgRepo,
+		mockRefreshSessionService,
+		mockRefreshCookieService,
+		mockRefreshHashProvider,
+		mockRefreshRiskEngine,
+		mockRefreshSessionAuditLogger)
+
+	_, err := useCase.RefreshSession(ctx, nil, request, "10.0.0.2", "test-agent")
+
+	assert.ErrorIs(t, err, ErrSuspiciousSession)
+}
@@ -0,0 +1,125 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type registerWebAuthnUseCase struct {
+	userRepo        RegisterWebAuthnUserRepository
+	webAuthnRepo    RegisterWebAuthnRepository
+	webAuthnService RegisterWebAuthnService
+	auditLogger     RegisterWebAuthnAuditLogger
+}
+
+type RegisterWebAuthnUseCase interface {
+	Begin(context context.Context, userId string) (responses.WebAuthnOptions, error)
+	Finish(context context.Context, userId string, request requests.FinishWebAuthnRegistration) (responses.WebAuthnCredential, error)
+}
+
+func NewRegisterWebAuthnUseCase(
+	userRepo RegisterWebAuthnUserRepository,
+	webAuthnRepo RegisterWebAuthnRepository,
+	webAuthnService RegisterWebAuthnService,
+	auditLogger RegisterWebAuthnAuditLogger,
+) RegisterWebAuthnUseCase {
+	return &registerWebAuthnUseCase{
+		userRepo:        userRepo,
+		webAuthnRepo:    webAuthnRepo,
+		webAuthnService: webAuthnService,
+		auditLogger:     auditLogger,
+	}
+}
+
+// Begin starts the registration ceremony, the credentials the user already
+// has are excluded so the same authenticator is not registered twice.
+func (u *registerWebAuthnUseCase) Begin(context context.Context, userId string) (responses.WebAuthnOptions, error) {
+	user, credentials, err := u.selectUser(context, userId)
+	if err != nil {
+		return responses.WebAuthnOptions{}, err
+	}
+
+	options, session, err := u.webAuthnService.BeginRegistration(user, credentials)
+	if err != nil {
+		return responses.WebAuthnOptions{}, fmt.Errorf("failed to begin webauthn registration: %w", err)
+	}
+	session.UserId = user.Id
+	session.Purpose = entities.WebAuthnRegistration
+
+	id, err := u.webAuthnRepo.InsertSession(context, session)
+	if err != nil {
+		return responses.WebAuthnOptions{}, fmt.Errorf("failed to save webauthn session: %w", err)
+	}
+
+	return responses.WebAuthnOptions{SessionId: id, Options: options}, nil
+}
+
+// Finish verifies the attestation and saves the credential. Once saved, it
+// is required as the second factor at sign in and, if the authenticator keeps
+// it as a passkey, can be used to sign in without the password.
+func (u *registerWebAuthnUseCase) Finish(context context.Context, userId string, request requests.FinishWebAuthnRegistration) (responses.WebAuthnCredential, error) {
+	response, err := u.finish(context, userId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditWebAuthnRegister, userId, err))
+	return response, err
+}
+
+func (u *registerWebAuthnUseCase) finish(context context.Context, userId string, request requests.FinishWebAuthnRegistration) (responses.WebAuthnCredential, error) {
+	session, err := u.webAuthnRepo.TakeSession(context, request.SessionId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.WebAuthnCredential{}, fmt.Errorf("%w: the session has expired", ErrInvalidWebAuthnCredential)
+		}
+		return responses.WebAuthnCredential{}, fmt.Errorf("failed to take webauthn session: %w", err)
+	}
+	if session.Purpose != entities.WebAuthnRegistration || session.UserId != userId {
+		return responses.WebAuthnCredential{}, fmt.Errorf("%w: not a registration session of the user", ErrInvalidWebAuthnCredential)
+	}
+
+	user, credentials, err := u.selectUser(context, userId)
+	if err != nil {
+		return responses.WebAuthnCredential{}, err
+	}
+
+	credential, err := u.webAuthnService.FinishRegistration(user, credentials, session, request.Credential)
+	if err != nil {
+		return responses.WebAuthnCredential{}, fmt.Errorf("%w: %s", ErrInvalidWebAuthnCredential, err)
+	}
+	credential.Name = request.Name
+
+	err = u.webAuthnRepo.InsertCredential(context, credential)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityAlreadyExists) {
+			return responses.WebAuthnCredential{}, fmt.Errorf("%w: the credential is already registered", ErrEntityAlreadyExists)
+		}
+		return responses.WebAuthnCredential{}, fmt.Errorf("failed to save webauthn credential: %w", err)
+	}
+
+	return responses.WebAuthnCredential{
+		Id:        base64.RawURLEncoding.EncodeToString(credential.Id),
+		Name:      credential.Name,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (u *registerWebAuthnUseCase) selectUser(context context.Context, userId string) (entities.User, []entities.WebAuthnCredential, error) {
+	user, err := u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return entities.User{}, nil, fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+		}
+		return entities.User{}, nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	credentials, err := u.webAuthnRepo.SelectCredentials(context, userId)
+	if err != nil {
+		return entities.User{}, nil, fmt.Errorf("failed to select webauthn credentials: %w", err)
+	}
+	return user, credentials, nil
+}
@@ -0,0 +1,125 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockRegisterWebAuthnUserRepo    *MockRegisterWebAuthnUserRepository
+	mockRegisterWebAuthnRepo        *MockRegisterWebAuthnRepository
+	mockRegisterWebAuthnService     *MockRegisterWebAuthnService
+	mockRegisterWebAuthnAuditLogger *MockRegisterWebAuthnAuditLogger
+)
+
+func initRegisterWebAuthnMocks(t *testing.T) RegisterWebAuthnUseCase {
+	ctrl := gomock.NewController(t)
+	mockRegisterWebAuthnUserRepo = NewMockRegisterWebAuthnUserRepository(ctrl)
+	mockRegisterWebAuthnRepo = NewMockRegisterWebAuthnRepository(ctrl)
+	mockRegisterWebAuthnService = NewMockRegisterWebAuthnService(ctrl)
+	mockRegisterWebAuthnAuditLogger = NewMockRegisterWebAuthnAuditLogger(ctrl)
+	mockRegisterWebAuthnAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return NewRegisterWebAuthnUseCase(
+		mockRegisterWebAuthnUserRepo,
+		mockRegisterWebAuthnRepo,
+		mockRegisterWebAuthnService,
+		mockRegisterWebAuthnAuditLogger)
+}
+
+var registerWebAuthnUser = entities.User{Id: "user-id", Email: "test@mail.ru"}
+
+func TestRegisterWebAuthnUseCase_Begin_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := initRegisterWebAuthnMocks(t)
+
+	credentials := []entities.WebAuthnCredential{{Id: []byte("existing"), UserId: "user-id"}}
+
+	mockRegisterWebAuthnUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(registerWebAuthnUser, nil)
+	mockRegisterWebAuthnRepo.EXPECT().SelectCredentials(ctx, "user-id").Return(credentials, nil)
+	mockRegisterWebAuthnService.EXPECT().BeginRegistration(registerWebAuthnUser, credentials).
+		Return([]byte(`{"publicKey":{}}`), entities.WebAuthnSession{Data: []byte("session")}, nil)
+	mockRegisterWebAuthnRepo.EXPECT().InsertSession(ctx, entities.WebAuthnSession{
+		UserId:  "user-id",
+		Purpose: entities.WebAuthnRegistration,
+		Data:    []byte("session"),
+	}).Return("session-id", nil)
+
+	response, err := useCase.Begin(ctx, "user-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "session-id", response.SessionId)
+}
+
+func TestRegisterWebAuthnUseCase_Finish_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := initRegisterWebAuthnMocks(t)
+
+	session := entities.WebAuthnSession{Id: "session-id", UserId: "user-id", Purpose: entities.WebAuthnRegistration}
+	credential := entities.WebAuthnCredential{Id: []byte{0xfb, 0xff}, UserId: "user-id", PublicKey: []byte("key")}
+	named := credential
+	named.Name = "MacBook"
+
+	mockRegisterWebAuthnRepo.EXPECT().TakeSession(ctx, "session-id").Return(session, nil)
+	mockRegisterWebAuthnUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(registerWebAuthnUser, nil)
+	mockRegisterWebAuthnRepo.EXPECT().SelectCredentials(ctx, "user-id").Return(nil, nil)
+	mockRegisterWebAuthnService.EXPECT().FinishRegistration(registerWebAuthnUser, nil, session, gomock.Any()).Return(credential, nil)
+	mockRegisterWebAuthnRepo.EXPECT().InsertCredential(ctx, named).Return(nil)
+
+	response, err := useCase.Finish(ctx, "user-id", requests.FinishWebAuthnRegistration{
+		SessionId:  "session-id",
+		Name:       "MacBook",
+		Credential: []byte(`{}`),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "-_8", response.Id)
+	assert.Equal(t, "MacBook", response.Name)
+}
+
+func TestRegisterWebAuthnUseCase_Finish_SessionOfAnotherUser(t *testing.T) {
+	ctx := context.Background()
+	useCase := initRegisterWebAuthnMocks(t)
+
+	mockRegisterWebAuthnRepo.EXPECT().TakeSession(ctx, "session-id").
+		Return(entities.WebAuthnSession{Id: "session-id", UserId: "another-user-id", Purpose: entities.WebAuthnRegistration}, nil)
+
+	_, err := useCase.Finish(ctx, "user-id", requests.FinishWebAuthnRegistration{SessionId: "session-id"})
+
+	assert.ErrorIs(t, err, ErrInvalidWebAuthnCredential)
+}
+
+func TestRegisterWebAuthnUseCase_Finish_Expired(t *testing.T) {
+	ctx := context.Background()
+	useCase := initRegisterWebAuthnMocks(t)
+
+	mockRegisterWebAuthnRepo.EXPECT().TakeSession(ctx, "session-id").Return(entities.WebAuthnSession{}, repositories.ErrEntityNotFound)
+
+	_, err := useCase.Finish(ctx, "user-id", requests.FinishWebAuthnRegistration{SessionId: "session-id"})
+
+	assert.ErrorIs(t, err, ErrInvalidWebAuthnCredential)
+}
+
+func TestRegisterWebAuthnUseCase_Finish_InvalidAttestation(t *testing.T) {
+	ctx := context.Background()
+	useCase := initRegisterWebAuthnMocks(t)
+
+	session := entities.WebAuthnSession{Id: "session-id", UserId: "user-id", Purpose: entities.WebAuthnRegistration}
+
+	mockRegisterWebAuthnRepo.EXPECT().TakeSession(ctx, "session-id").Return(session, nil)
+	mockRegisterWebAuthnUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(registerWebAuthnUser, nil)
+	mockRegisterWebAuthnRepo.EXPECT().SelectCredentials(ctx, "user-id").Return(nil, nil)
+	mockRegisterWebAuthnService.EXPECT().FinishRegistration(registerWebAuthnUser, nil, session, gomock.Any()).
+		Return(entities.WebAuthnCredential{}, fmt.Errorf("challenge mismatch"))
+
+	_, err := useCase.Finish(ctx, "user-id", requests.FinishWebAuthnRegistration{SessionId: "session-id"})
+
+	assert.ErrorIs(t, err, ErrInvalidWebAuthnCredential)
+}
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type removeMemberUseCase struct {
+	organizationRepo RemoveMemberOrganizationRepository
+	auditLogger      RemoveMemberAuditLogger
+}
+
+type RemoveMemberUseCase interface {
+	RemoveMember(context context.Context, organizationId, actorId, userId string) error
+}
+
+func NewRemoveMemberUseCase(
+	organizationRepo RemoveMemberOrganizationRepository,
+	auditLogger RemoveMemberAuditLogger,
+) RemoveMemberUseCase {
+	return &removeMemberUseCase{
+		organizationRepo: organizationRepo,
+		auditLogger:      auditLogger,
+	}
+}
+
+func (u *removeMemberUseCase) RemoveMember(context context.Context, organizationId, actorId, userId string) error {
+	err := u.removeMember(context, organizationId, actorId, userId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditMemberRemove, userId, err))
+	return err
+}
+
+func (u *removeMemberUseCase) removeMember(context context.Context, organizationId, actorId, userId string) error {
+	actor, err := selectMembership(context, u.organizationRepo, organizationId, actorId)
+	if err != nil {
+		return err
+	}
+	if !actor.CanManageMembers() {
+		return fmt.Errorf("%w: only owners and admins can remove members", ErrOrganizationAccessDenied)
+	}
+
+	member, err := u.organizationRepo.SelectMember(context, organizationId, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to find member: %w", ErrEntityNotFound)
+		}
+		return fmt.Errorf("failed to find member: %w", err)
+	}
+	if member.Role == entities.OrganizationRoleOwner {
+		return fmt.Errorf("%w: organization owner can't be removed", ErrInvalidEntity)
+	}
+
+	err = u.organizationRepo.DeleteMember(context, organizationId, userId)
+	if err != nil {
+		return fmt.Errorf("failed to delete member: %w", err)
+	}
+	return nil
+}
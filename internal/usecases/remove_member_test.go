@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockRemoveMemberRepo        *MockRemoveMemberOrganizationRepository
+	mockRemoveMemberAuditLogger *MockRemoveMemberAuditLogger
+)
+
+func initRemoveMemberMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRemoveMemberRepo = NewMockRemoveMemberOrganizationRepository(ctrl)
+	mockRemoveMemberAuditLogger = NewMockRemoveMemberAuditLogger(ctrl)
+	mockRemoveMemberAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestRemoveMemberUseCase_RemoveMember_Success(t *testing.T) {
+	ctx := context.Background()
+	initRemoveMemberMocks(t)
+
+	actor := entities.Membership{OrganizationId: "org-id", UserId: "admin-id", Role: entities.OrganizationRoleAdmin}
+	member := entities.Membership{OrganizationId: "org-id", UserId: "user-id", Role: entities.OrganizationRoleMember}
+
+	mockRemoveMemberRepo.EXPECT().SelectMember(ctx, "org-id", "admin-id").Return(actor, nil)
+	mockRemoveMemberRepo.EXPECT().SelectMember(ctx, "org-id", "user-id").Return(member, nil)
+	mockRemoveMemberRepo.EXPECT().DeleteMember(ctx, "org-id", "user-id").Return(nil)
+
+	useCase := NewRemoveMemberUseCase(mockRemoveMemberRepo, mockRemoveMemberAuditLogger)
+
+	err := useCase.RemoveMember(ctx, "org-id", "admin-id", "user-id")
+
+	assert.NoError(t, err)
+}
+
+func TestRemoveMemberUseCase_RemoveMember_ActorIsMember(t *testing.T) {
+	ctx := context.Background()
+	initRemoveMemberMocks(t)
+
+	actor := entities.Membership{OrganizationId: "org-id", UserId: "member-id", Role: entities.OrganizationRoleMember}
+
+	mockRemoveMemberRepo.EXPECT().SelectMember(ctx, "org-id", "member-id").Return(actor, nil)
+
+	useCase := NewRemoveMemberUseCase(mockRemoveMemberRepo, mockRemoveMemberAuditLogger)
+
+	err := useCase.RemoveMember(ctx, "org-id", "member-id", "user-id")
+
+	assert.ErrorIs(t, err, ErrOrganizationAccessDenied)
+}
+
+func TestRemoveMemberUseCase_RemoveMember_Owner(t *testing.T) {
+	ctx := context.Background()
+	initRemoveMemberMocks(t)
+
+	actor := entities.Membership{OrganizationId: "org-id", UserId: "admin-id", Role: entities.OrganizationRoleAdmin}
+	owner := entities.Membership{OrganizationId: "org-id", UserId: "owner-id", Role: entities.OrganizationRoleOwner}
+
+	mockRemoveMemberRepo.EXPECT().SelectMember(ctx, "org-id", "admin-id").Return(actor, nil)
+	mockRemoveMemberRepo.EXPECT().SelectMember(ctx, "org-id", "owner-id").Return(owner, nil)
+
+	useCase := NewRemoveMemberUseCase(mockRemoveMemberRepo, mockRemoveMemberAuditLogger)
+
+	err := useCase.RemoveMember(ctx, "org-id", "admin-id", "owner-id")
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestRemoveMemberUseCase_RemoveMember_NotFound(t *testing.T) {
+	ctx := context.Background()
+	initRemoveMemberMocks(t)
+
+	actor := entities.Membership{OrganizationId: "org-id", UserId: "owner-id", Role: entities.OrganizationRoleOwner}
+
+	mockRemoveMemberRepo.EXPECT().SelectMember(ctx, "org-id", "owner-id").Return(actor, nil)
+	mockRemoveMemberRepo.EXPECT().SelectMember(ctx, "org-id", "user-id").Return(entities.Membership{}, repositories.ErrEntityNotFound)
+
+	useCase := NewRemoveMemberUseCase(mockRemoveMemberRepo, mockRemoveMemberAuditLogger)
+
+	err := useCase.RemoveMember(ctx, "org-id", "owner-id", "user-id")
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type revokeSessionsUseCase struct {
+	userRepo    RevokeSessionsUserRepository
+	sessionRepo RevokeSessionsSessionRepository
+	auditLogger RevokeSessionsAuditLogger
+}
+
+type RevokeSessionsUseCase interface {
+	RevokeSessions(context context.Context, userId string) error
+}
+
+func NewRevokeSessionsUseCase(
+	userRepo RevokeSessionsUserRepository,
+	sessionRepo RevokeSessionsSessionRepository,
+	auditLogger RevokeSessionsAuditLogger,
+) RevokeSessionsUseCase {
+	return &revokeSessionsUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (u *revokeSessionsUseCase) RevokeSessions(context context.Context, userId string) error {
+	err := u.revokeSessions(context, userId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditSessionsRevoke, userId, err))
+	return err
+}
+
+func (u *revokeSessionsUseCase) revokeSessions(context context.Context, userId string) error {
+	_, err := u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+		}
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	err = u.sessionRepo.DeleteByUserId(context, userId)
+	if err != nil && !errors.Is(err, repositories.ErrSessionNotFound) {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return nil
+}
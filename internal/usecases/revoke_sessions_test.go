@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockRevokeUserRepo            *MockRevokeSessionsUserRepository
+	mockRevokeSessionRepo         *MockRevokeSessionsSessionRepository
+	mockRevokeSessionsAuditLogger *MockRevokeSessionsAuditLogger
+)
+
+func initRevokeSessionsMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRevokeUserRepo = NewMockRevokeSessionsUserRepository(ctrl)
+	mockRevokeSessionRepo = NewMockRevokeSessionsSessionRepository(ctrl)
+	mockRevokeSessionsAuditLogger = NewMockRevokeSessionsAuditLogger(ctrl)
+	mockRevokeSessionsAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestRevokeSessionsUseCase_RevokeSessions_Success(t *testing.T) {
+	ctx := context.Background()
+	initRevokeSessionsMocks(t)
+
+	mockRevokeUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{Id: "user-id"}, nil)
+	mockRevokeSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(repositories.ErrSessionNotFound)
+
+	useCase := NewRevokeSessionsUseCase(mockRevokeUserRepo, mockRevokeSessionRepo, mockRevokeSessionsAuditLogger)
+
+	err := useCase.RevokeSessions(ctx, "user-id")
+
+	assert.NoError(t, err)
+}
+
+func TestRevokeSessionsUseCase_RevokeSessions_UserNotFound(t *testing.T) {
+	ctx := context.Background()
+	initRevokeSessionsMocks(t)
+
+	mockRevokeUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{}, repositories.ErrEntityNotFound)
+
+	useCase := NewRevokeSessionsUseCase(mockRevokeUserRepo, mockRevokeSessionRepo, mockRevokeSessionsAuditLogger)
+
+	err := useCase.RevokeSessions(ctx, "user-id")
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestRevokeSessionsUseCase_RevokeSessions_DeleteError(t *testing.T) {
+	ctx := context.Background()
+	initRevokeSessionsMocks(t)
+
+	mockRevokeUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{Id: "user-id"}, nil)
+	mockRevokeSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(fmt.Errorf("database error"))
+
+	useCase := NewRevokeSessionsUseCase(mockRevokeUserRepo, mockRevokeSessionRepo, mockRevokeSessionsAuditLogger)
+
+	err := useCase.RevokeSessions(ctx, "user-id")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to revoke sessions")
+}
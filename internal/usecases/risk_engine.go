@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	riskMailSubject = "Новый вход в аккаунт"
+	riskMailBody    = "В ваш аккаунт выполнен вход.\n\nВремя: %s\nIP-адрес: %s\nСтрана: %s\nУстройство: %s\n\n" +
+		"Если это были не вы, смените пароль и завершите все сеансы."
+	riskMailUnknown = "неизвестно"
+)
+
+type riskEngine struct {
+	historyRepo  RiskEngineLoginHistoryRepository
+	geoIPService RiskEngineGeoIPService
+	mailService  RiskEngineMailService
+	policy       entities.RiskPolicy
+	auditLogger  RiskEngineAuditLogger
+}
+
+// RiskEngine scores a sign in or a refresh from a new address against the
+// previous logins of the user. The caller reacts to the assessment and
+// records the login it let through.
+type RiskEngine interface {
+	Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error)
+	Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error
+}
+
+func NewRiskEngine(
+	historyRepo RiskEngineLoginHistoryRepository,
+	geoIPService RiskEngineGeoIPService,
+	mailService RiskEngineMailService,
+	policy entities.RiskPolicy,
+	auditLogger RiskEngineAuditLogger,
+) RiskEngine {
+	return &riskEngine{
+		historyRepo:  historyRepo,
+		geoIPService: geoIPService,
+		mailService:  mailService,
+		policy:       policy,
+		auditLogger:  auditLogger,
+	}
+}
+
+// Assess locates the address and compares the login with the history, the
+// disabled engine finds every login safe without looking.
+func (e *riskEngine) Assess(context context.Context, userId, userAgent, ip string) (entities.RiskAssessment, error) {
+	login := entities.Login{
+		UserId:    userId,
+		IP:        ip,
+		UserAgent: userAgent,
+		CreatedAt: time.Now().UTC(),
+	}
+	if !e.policy.Enabled() {
+		return entities.RiskAssessment{Login: login}, nil
+	}
+
+	login.Location = e.geoIPService.Locate(ip)
+	history, err := e.historyRepo.History(context, login, login.CreatedAt.Add(-e.policy.AccountsPerIPWindow))
+	if err != nil {
+		return entities.RiskAssessment{}, fmt.Errorf("failed to select login history: %w", err)
+	}
+
+	return e.policy.Assess(login, e.policy.Signals(login, history)), nil
+}
+
+// Record adds the login to the history and emails the user when the score
+// asks for it. The mail is sent in the background, the sign in doesn't wait
+// for the mail server and doesn't fail with it.
+func (e *riskEngine) Record(context context.Context, user entities.User, assessment entities.RiskAssessment) error {
+	if !e.policy.Enabled() {
+		return nil
+	}
+
+	login := assessment.Login
+	err := e.historyRepo.Insert(context, login, login.CreatedAt.Add(-e.policy.HistoryRetention))
+	if err != nil {
+		return fmt.Errorf("failed to insert login: %w", err)
+	}
+
+	if assessment.Notify {
+		go e.notifyInBackground(user, login)
+	}
+	return nil
+}
+
+// notifyInBackground emails the user about the login, the failure is recorded
+// in the audit log.
+func (e *riskEngine) notifyInBackground(user entities.User, login entities.Login) {
+	country := login.Location.Country
+	if country == "" {
+		country = riskMailUnknown
+	}
+	body := fmt.Sprintf(riskMailBody, login.CreatedAt.Format(time.RFC1123), login.IP, country, login.UserAgent)
+	err := e.mailService.Send(string(user.Email), riskMailSubject, body)
+	if err != nil {
+		event := newAuditEvent(entities.AuditRiskNotify, user.Id, fmt.Errorf("failed to send mail: %w", err))
+		event.IP = login.IP
+		event.UserAgent = login.UserAgent
+		e.auditLogger.Log(context.Background(), event)
+	}
+}
+
+// newRiskAuditEvent records the signals of the risky login, the blocked one
+// is a failure.
+func newRiskAuditEvent(userId string, assessment entities.RiskAssessment) entities.AuditEvent {
+	event := newAuditEvent(entities.AuditRiskDetected, userId, nil)
+	event.IP = assessment.Login.IP
+	event.UserAgent = assessment.Login.UserAgent
+	event.Reason = fmt.Sprintf("score %d: %s", assessment.Score, strings.Join(assessment.Signals, ", "))
+	if assessment.Block {
+		event.Outcome = entities.AuditOutcomeFailure
+	}
+	return event
+}
@@ -0,0 +1,253 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockRiskHistoryRepo  *MockRiskEngineLoginHistoryRepository
+	mockRiskGeoIPService *MockRiskEngineGeoIPService
+	mockRiskMailService  *MockRiskEngineMailService
+	mockRiskAuditLogger  *MockRiskEngineAuditLogger
+)
+
+var testRiskPolicy = entities.RiskPolicy{
+	NewDeviceScore:          20,
+	NewCountryScore:         40,
+	ImpossibleTravelScore:   60,
+	ManyAccountsFromIPScore: 30,
+	MaxTravelSpeed:          900,
+	AccountsPerIP:           5,
+	AccountsPerIPWindow:     time.Hour,
+	HistoryRetention:        90 * 24 * time.Hour,
+	NotifyThreshold:         20,
+	ChallengeThreshold:      40,
+	BlockThreshold:          90,
+}
+
+var (
+	moscow = entities.Location{Country: "RU", Latitude: 55.75, Longitude: 37.62}
+	berlin = entities.Location{Country: "DE", Latitude: 52.52, Longitude: 13.40}
+)
+
+func initRiskMocks(t *testing.T, policy entities.RiskPolicy) RiskEngine {
+	ctrl := gomock.NewController(t)
+	mockRiskHistoryRepo = NewMockRiskEngineLoginHistoryRepository(ctrl)
+	mockRiskGeoIPService = NewMockRiskEngineGeoIPService(ctrl)
+	mockRiskMailService = NewMockRiskEngineMailService(ctrl)
+	mockRiskAuditLogger = NewMockRiskEngineAuditLogger(ctrl)
+	return NewRiskEngine(mockRiskHistoryRepo, mockRiskGeoIPService, mockRiskMailService, policy, mockRiskAuditLogger)
+}
+
+func TestRiskEngine_Assess_KnownLogin(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, testRiskPolicy)
+
+	mockRiskGeoIPService.EXPECT().Locate("10.0.0.1").Return(moscow)
+	mockRiskHistoryRepo.EXPECT().History(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, login entities.Login, ipSince time.Time) (entities.LoginHistory, error) {
+			assert.Equal(t, "user-id", login.UserId)
+			assert.Equal(t, moscow, login.Location)
+			assert.WithinDuration(t, time.Now().Add(-time.Hour), ipSince, time.Minute)
+			return entities.LoginHistory{
+				Logins:       3,
+				KnownDevice:  true,
+				KnownCountry: true,
+				Last:         entities.Login{Location: moscow, CreatedAt: time.Now().Add(-time.Hour)},
+			}, nil
+		})
+
+	assessment, err := engine.Assess(ctx, "user-id", "test-agent", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Zero(t, assessment.Score)
+	assert.Empty(t, assessment.Signals)
+	assert.False(t, assessment.Notify || assessment.Challenge || assessment.Block)
+}
+
+func TestRiskEngine_Assess_FirstLoginIsNotNew(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, testRiskPolicy)
+
+	mockRiskGeoIPService.EXPECT().Locate("10.0.0.1").Return(berlin)
+	mockRiskHistoryRepo.EXPECT().History(ctx, gomock.Any(), gomock.Any()).Return(entities.LoginHistory{}, nil)
+
+	assessment, err := engine.Assess(ctx, "user-id", "test-agent", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Zero(t, assessment.Score)
+}
+
+func TestRiskEngine_Assess_NewDeviceNotifies(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, testRiskPolicy)
+
+	mockRiskGeoIPService.EXPECT().Locate("10.0.0.1").Return(moscow)
+	mockRiskHistoryRepo.EXPECT().History(ctx, gomock.Any(), gomock.Any()).
+		Return(entities.LoginHistory{Logins: 1, KnownCountry: true}, nil)
+
+	assessment, err := engine.Assess(ctx, "user-id", "new-agent", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 20, assessment.Score)
+	assert.Equal(t, []string{entities.RiskNewDevice}, assessment.Signals)
+	assert.True(t, assessment.Notify)
+	assert.False(t, assessment.Challenge)
+}
+
+func TestRiskEngine_Assess_ImpossibleTravelBlocks(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, testRiskPolicy)
+
+	// Moscow and Berlin are 1600 km apart, half an hour is not enough.
+	mockRiskGeoIPService.EXPECT().Locate("10.0.0.1").Return(berlin)
+	mockRiskHistoryRepo.EXPECT().History(ctx, gomock.Any(), gomock.Any()).Return(entities.LoginHistory{
+		Logins:      5,
+		KnownDevice: true,
+		Last:        entities.Login{Location: moscow, CreatedAt: time.Now().Add(-30 * time.Minute)},
+	}, nil)
+
+	assessment, err := engine.Assess(ctx, "user-id", "test-agent", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100, assessment.Score)
+	assert.Equal(t, []string{entities.RiskNewCountry, entities.RiskImpossibleTravel}, assessment.Signals)
+	assert.True(t, assessment.Block)
+}
+
+func TestRiskEngine_Assess_TravelInTime(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, testRiskPolicy)
+
+	mockRiskGeoIPService.EXPECT().Locate("10.0.0.1").Return(berlin)
+	mockRiskHistoryRepo.EXPECT().History(ctx, gomock.Any(), gomock.Any()).Return(entities.LoginHistory{
+		Logins:       5,
+		KnownDevice:  true,
+		KnownCountry: true,
+		Last:         entities.Login{Location: moscow, CreatedAt: time.Now().Add(-5 * time.Hour)},
+	}, nil)
+
+	assessment, err := engine.Assess(ctx, "user-id", "test-agent", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Zero(t, assessment.Score)
+}
+
+func TestRiskEngine_Assess_ManyAccountsFromIP(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, testRiskPolicy)
+
+	mockRiskGeoIPService.EXPECT().Locate("10.0.0.1").Return(entities.Location{})
+	mockRiskHistoryRepo.EXPECT().History(ctx, gomock.Any(), gomock.Any()).
+		Return(entities.LoginHistory{AccountsFromIP: 5}, nil)
+
+	assessment, err := engine.Assess(ctx, "user-id", "test-agent", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 30, assessment.Score)
+	assert.Equal(t, []string{entities.RiskManyAccountsFromIP}, assessment.Signals)
+	assert.True(t, assessment.Notify)
+	assert.False(t, assessment.Challenge)
+}
+
+func TestRiskEngine_Assess_Disabled(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, entities.RiskPolicy{NewDeviceScore: 20})
+
+	assessment, err := engine.Assess(ctx, "user-id", "test-agent", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Zero(t, assessment.Score)
+	assert.Equal(t, "10.0.0.1", assessment.Login.IP)
+}
+
+func TestRiskEngine_Assess_HistoryError(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, testRiskPolicy)
+
+	mockRiskGeoIPService.EXPECT().Locate("10.0.0.1").Return(moscow)
+	mockRiskHistoryRepo.EXPECT().History(ctx, gomock.Any(), gomock.Any()).Return(entities.LoginHistory{}, errors.New("db error"))
+
+	_, err := engine.Assess(ctx, "user-id", "test-agent", "10.0.0.1")
+
+	assert.ErrorContains(t, err, "failed to select login history")
+}
+
+func TestRiskEngine_Record_Notifies(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, testRiskPolicy)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru"}
+	login := entities.Login{UserId: "user-id", IP: "10.0.0.1", UserAgent: "test-agent", Location: berlin, CreatedAt: time.Now()}
+
+	sent := make(chan struct{})
+
+	mockRiskHistoryRepo.EXPECT().Insert(ctx, login, login.CreatedAt.Add(-testRiskPolicy.HistoryRetention)).Return(nil)
+	mockRiskMailService.EXPECT().Send("test@mail.ru", riskMailSubject, gomock.Any()).
+		DoAndReturn(func(_, _, body string) error {
+			assert.Contains(t, body, "10.0.0.1")
+			assert.Contains(t, body, "DE")
+			assert.Contains(t, body, "test-agent")
+			close(sent)
+			return nil
+		})
+
+	err := engine.Record(ctx, user, entities.RiskAssessment{Login: login, Score: 40, Notify: true})
+
+	assert.NoError(t, err)
+	waitRiskMail(t, sent)
+}
+
+func TestRiskEngine_Record_MailErrorIsRecorded(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, testRiskPolicy)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru"}
+	login := entities.Login{UserId: "user-id", IP: "10.0.0.1", UserAgent: "test-agent", CreatedAt: time.Now()}
+	logged := make(chan struct{})
+
+	mockRiskHistoryRepo.EXPECT().Insert(ctx, login, gomock.Any()).Return(nil)
+	mockRiskMailService.EXPECT().Send("test@mail.ru", riskMailSubject, gomock.Any()).Return(errors.New("smtp down"))
+	mockRiskAuditLogger.EXPECT().Log(gomock.Any(), entities.AuditEvent{
+		Type:      entities.AuditRiskNotify,
+		SubjectId: "user-id",
+		IP:        "10.0.0.1",
+		UserAgent: "test-agent",
+		Outcome:   entities.AuditOutcomeFailure,
+		Reason:    auditInternalError,
+	}).Do(func(context.Context, entities.AuditEvent) { close(logged) })
+
+	// The sign in goes on while the mail server fails.
+	err := engine.Record(ctx, user, entities.RiskAssessment{Login: login, Score: 40, Notify: true})
+
+	assert.NoError(t, err)
+	waitRiskMail(t, logged)
+}
+
+// waitRiskMail waits for the notification sent in the background.
+func waitRiskMail(t *testing.T, done <-chan struct{}) {
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the risk notification has not been sent")
+	}
+}
+
+func TestRiskEngine_Record_Silent(t *testing.T) {
+	ctx := context.Background()
+	engine := initRiskMocks(t, testRiskPolicy)
+
+	login := entities.Login{UserId: "user-id", IP: "10.0.0.1", CreatedAt: time.Now()}
+	mockRiskHistoryRepo.EXPECT().Insert(ctx, login, gomock.Any()).Return(nil)
+
+	err := engine.Record(ctx, entities.User{Id: "user-id"}, entities.RiskAssessment{Login: login})
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"fmt"
+)
+
+const (
+	defaultAuditPageLimit = 20
+	maxAuditPageLimit     = 100
+)
+
+type securityEventsUseCase struct {
+	auditEventRepo SecurityEventsAuditEventRepository
+	auditLogger    SecurityEventsAuditLogger
+}
+
+// SecurityEventsUseCase reads the audit trail: the user sees the events where
+// they are either the actor or the subject, the administrator queries all of
+// them.
+type SecurityEventsUseCase interface {
+	ListOwn(context context.Context, userId string, request requests.ListSecurityEvents) (responses.AuditEventList, error)
+	List(context context.Context, request requests.ListAuditEvents) (responses.AuditEventList, error)
+}
+
+func NewSecurityEventsUseCase(
+	auditEventRepo SecurityEventsAuditEventRepository,
+	auditLogger SecurityEventsAuditLogger,
+) SecurityEventsUseCase {
+	return &securityEventsUseCase{auditEventRepo: auditEventRepo, auditLogger: auditLogger}
+}
+
+func (u *securityEventsUseCase) ListOwn(context context.Context, userId string, request requests.ListSecurityEvents) (responses.AuditEventList, error) {
+	filter := repositories.AuditEventFilter{
+		UserId:  userId,
+		Type:    request.Type,
+		Outcome: request.Outcome,
+		From:    request.From,
+		To:      request.To,
+	}
+	response, err := u.list(context, filter, request.Page, request.Limit)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditSecurityEventsRead, userId, err))
+	return response, err
+}
+
+func (u *securityEventsUseCase) List(context context.Context, request requests.ListAuditEvents) (responses.AuditEventList, error) {
+	filter := repositories.AuditEventFilter{
+		UserId:    request.UserId,
+		ActorId:   request.ActorId,
+		SubjectId: request.SubjectId,
+		Type:      request.Type,
+		Outcome:   request.Outcome,
+		From:      request.From,
+		To:        request.To,
+	}
+	response, err := u.list(context, filter, request.Page, request.Limit)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditAuditEventsRead, request.UserId, err))
+	return response, err
+}
+
+// list returns the page of the events matching the filter, the newest first.
+func (u *securityEventsUseCase) list(context context.Context, filter repositories.AuditEventFilter, page, limit int) (responses.AuditEventList, error) {
+	if filter.Outcome != "" && filter.Outcome != entities.AuditOutcomeSuccess && filter.Outcome != entities.AuditOutcomeFailure {
+		return responses.AuditEventList{}, fmt.Errorf("%w: unknown outcome %q", ErrInvalidEntity, filter.Outcome)
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return responses.AuditEventList{}, fmt.Errorf("%w: the end of the period is before its start", ErrInvalidEntity)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultAuditPageLimit
+	}
+	if limit > maxAuditPageLimit {
+		limit = maxAuditPageLimit
+	}
+	filter.Offset = (page - 1) * limit
+	filter.Limit = limit
+
+	total, err := u.auditEventRepo.Count(context, filter)
+	if err != nil {
+		return responses.AuditEventList{}, fmt.Errorf("failed to count audit events: %w", err)
+	}
+
+	events, err := u.auditEventRepo.Select(context, filter)
+	if err != nil {
+		return responses.AuditEventList{}, fmt.Errorf("failed to select audit events: %w", err)
+	}
+
+	result := responses.AuditEventList{
+		Events: make([]responses.AuditEvent, 0, len(events)),
+		Total:  total,
+		Page:   page,
+		Limit:  limit,
+	}
+	for _, event := range events {
+		result.Events = append(result.Events, newAuditEventResponse(event))
+	}
+
+	return result, nil
+}
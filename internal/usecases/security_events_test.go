@@ -0,0 +1,122 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockSecurityEventsRepo        *MockSecurityEventsAuditEventRepository
+	mockSecurityEventsAuditLogger *MockSecurityEventsAuditLogger
+)
+
+func initSecurityEventsMocks(t *testing.T) SecurityEventsUseCase {
+	ctrl := gomock.NewController(t)
+	mockSecurityEventsRepo = NewMockSecurityEventsAuditEventRepository(ctrl)
+	mockSecurityEventsAuditLogger = NewMockSecurityEventsAuditLogger(ctrl)
+	mockSecurityEventsAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+	return NewSecurityEventsUseCase(mockSecurityEventsRepo, mockSecurityEventsAuditLogger)
+}
+
+func TestSecurityEventsUseCase_ListOwn_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := initSecurityEventsMocks(t)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	request := requests.ListSecurityEvents{Page: 2, Limit: 10, Type: entities.AuditSignIn, Outcome: "failure", From: from}
+	filter := repositories.AuditEventFilter{
+		UserId:  "user-id",
+		Type:    entities.AuditSignIn,
+		Outcome: entities.AuditOutcomeFailure,
+		From:    from,
+		Offset:  10,
+		Limit:   10,
+	}
+	createdAt := time.Now()
+	events := []entities.AuditEvent{{
+		Id:        "event-id",
+		Type:      entities.AuditSignIn,
+		SubjectId: "user-id",
+		IP:        "10.0.0.1",
+		UserAgent: "test-agent",
+		Outcome:   entities.AuditOutcomeFailure,
+		Reason:    ErrInvalidCredentials.Error(),
+		CreatedAt: createdAt,
+	}}
+
+	mockSecurityEventsRepo.EXPECT().Count(ctx, filter).Return(11, nil)
+	mockSecurityEventsRepo.EXPECT().Select(ctx, filter).Return(events, nil)
+
+	result, err := useCase.ListOwn(ctx, "user-id", request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 11, result.Total)
+	assert.Equal(t, 2, result.Page)
+	assert.Equal(t, 10, result.Limit)
+	assert.Len(t, result.Events, 1)
+	assert.Equal(t, "event-id", result.Events[0].Id)
+	assert.Equal(t, "10.0.0.1", result.Events[0].IP)
+	assert.Equal(t, ErrInvalidCredentials.Error(), result.Events[0].Reason)
+	assert.Equal(t, createdAt, result.Events[0].CreatedAt)
+}
+
+func TestSecurityEventsUseCase_List_Filters(t *testing.T) {
+	ctx := context.Background()
+	useCase := initSecurityEventsMocks(t)
+
+	request := requests.ListAuditEvents{Limit: 500, ActorId: "admin-id", SubjectId: "user-id", Type: entities.AuditUserDelete}
+	filter := repositories.AuditEventFilter{
+		ActorId:   "admin-id",
+		SubjectId: "user-id",
+		Type:      entities.AuditUserDelete,
+		Limit:     maxAuditPageLimit,
+	}
+
+	mockSecurityEventsRepo.EXPECT().Count(ctx, filter).Return(0, nil)
+	mockSecurityEventsRepo.EXPECT().Select(ctx, filter).Return(nil, nil)
+
+	result, err := useCase.List(ctx, request)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result.Events)
+	assert.Empty(t, result.Events)
+	assert.Equal(t, 1, result.Page)
+	assert.Equal(t, maxAuditPageLimit, result.Limit)
+}
+
+func TestSecurityEventsUseCase_List_InvalidOutcome(t *testing.T) {
+	useCase := initSecurityEventsMocks(t)
+
+	_, err := useCase.List(context.Background(), requests.ListAuditEvents{Outcome: "maybe"})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestSecurityEventsUseCase_ListOwn_InvalidPeriod(t *testing.T) {
+	useCase := initSecurityEventsMocks(t)
+
+	to := time.Now()
+	request := requests.ListSecurityEvents{From: to.Add(time.Hour), To: to}
+	_, err := useCase.ListOwn(context.Background(), "user-id", request)
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestSecurityEventsUseCase_ListOwn_CountError(t *testing.T) {
+	ctx := context.Background()
+	useCase := initSecurityEventsMocks(t)
+
+	mockSecurityEventsRepo.EXPECT().Count(ctx, gomock.Any()).Return(0, fmt.Errorf("db error"))
+
+	_, err := useCase.ListOwn(ctx, "user-id", requests.ListSecurityEvents{})
+
+	assert.ErrorContains(t, err, "failed to count audit events")
+}
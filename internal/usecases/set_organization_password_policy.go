@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+type setOrganizationPasswordPolicyUseCase struct {
+	organizationRepo SetOrganizationPasswordPolicyOrganizationRepository
+	auditLogger      SetOrganizationPasswordPolicyAuditLogger
+}
+
+// SetOrganizationPasswordPolicyUseCase overrides the password max age for
+// the sign ins into the organization, the members whose passwords are older
+// have to change them on the next sign in.
+type SetOrganizationPasswordPolicyUseCase interface {
+	SetPasswordPolicy(context context.Context, organizationId, actorId string, request requests.SetOrganizationPasswordPolicy) error
+}
+
+func NewSetOrganizationPasswordPolicyUseCase(
+	organizationRepo SetOrganizationPasswordPolicyOrganizationRepository,
+	auditLogger SetOrganizationPasswordPolicyAuditLogger,
+) SetOrganizationPasswordPolicyUseCase {
+	return &setOrganizationPasswordPolicyUseCase{
+		organizationRepo: organizationRepo,
+		auditLogger:      auditLogger,
+	}
+}
+
+func (u *setOrganizationPasswordPolicyUseCase) SetPasswordPolicy(context context.Context, organizationId, actorId string, request requests.SetOrganizationPasswordPolicy) error {
+	err := u.setPasswordPolicy(context, organizationId, actorId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditOrganizationPolicySet, organizationId, err))
+	return err
+}
+
+func (u *setOrganizationPasswordPolicyUseCase) setPasswordPolicy(context context.Context, organizationId, actorId string, request requests.SetOrganizationPasswordPolicy) error {
+	actor, err := selectMembership(context, u.organizationRepo, organizationId, actorId)
+	if err != nil {
+		return err
+	}
+	if !actor.CanManageSettings() {
+		return fmt.Errorf("%w: only owners can change the password policy", ErrOrganizationAccessDenied)
+	}
+
+	var maxAge *time.Duration
+	if request.MaxAge != nil {
+		if *request.MaxAge < 0 || *request.MaxAge > int64(math.MaxInt64/time.Second) {
+			return fmt.Errorf("%w: wrong password max age %d", ErrInvalidEntity, *request.MaxAge)
+		}
+		value := time.Duration(*request.MaxAge) * time.Second
+		maxAge = &value
+	}
+
+	err = u.organizationRepo.UpdatePasswordMaxAge(context, organizationId, maxAge)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to update organization: %w", ErrEntityNotFound)
+		}
+		return fmt.Errorf("failed to update organization: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockOrgPasswordPolicyRepo        *MockSetOrganizationPasswordPolicyOrganizationRepository
+	mockOrgPasswordPolicyAuditLogger *MockSetOrganizationPasswordPolicyAuditLogger
+)
+
+func initSetOrganizationPasswordPolicyMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgPasswordPolicyRepo = NewMockSetOrganizationPasswordPolicyOrganizationRepository(ctrl)
+	mockOrgPasswordPolicyAuditLogger = NewMockSetOrganizationPasswordPolicyAuditLogger(ctrl)
+	mockOrgPasswordPolicyAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestSetOrganizationPasswordPolicyUseCase_SetPasswordPolicy_Success(t *testing.T) {
+	ctx := context.Background()
+	initSetOrganizationPasswordPolicyMocks(t)
+
+	maxAge := int64(90 * 24 * 60 * 60)
+	expected := 90 * 24 * time.Hour
+
+	mockOrgPasswordPolicyRepo.EXPECT().SelectMember(ctx, "org-id", "owner-id").
+		Return(entities.Membership{OrganizationId: "org-id", UserId: "owner-id", Role: entities.OrganizationRoleOwner}, nil)
+	mockOrgPasswordPolicyRepo.EXPECT().UpdatePasswordMaxAge(ctx, "org-id", &expected).Return(nil)
+
+	useCase := NewSetOrganizationPasswordPolicyUseCase(mockOrgPasswordPolicyRepo, mockOrgPasswordPolicyAuditLogger)
+
+	err := useCase.SetPasswordPolicy(ctx, "org-id", "owner-id", requests.SetOrganizationPasswordPolicy{MaxAge: &maxAge})
+
+	assert.NoError(t, err)
+}
+
+func TestSetOrganizationPasswordPolicyUseCase_SetPasswordPolicy_Reset(t *testing.T) {
+	ctx := context.Background()
+	initSetOrganizationPasswordPolicyMocks(t)
+
+	mockOrgPasswordPolicyRepo.EXPECT().SelectMember(ctx, "org-id", "owner-id").
+		Return(entities.Membership{OrganizationId: "org-id", UserId: "owner-id", Role: entities.OrganizationRoleOwner}, nil)
+	mockOrgPasswordPolicyRepo.EXPECT().UpdatePasswordMaxAge(ctx, "org-id", (*time.Duration)(nil)).Return(nil)
+
+	useCase := NewSetOrganizationPasswordPolicyUseCase(mockOrgPasswordPolicyRepo, mockOrgPasswordPolicyAuditLogger)
+
+	err := useCase.SetPasswordPolicy(ctx, "org-id", "owner-id", requests.SetOrganizationPasswordPolicy{})
+
+	assert.NoError(t, err)
+}
+
+func TestSetOrganizationPasswordPolicyUseCase_SetPasswordPolicy_NotOwner(t *testing.T) {
+	ctx := context.Background()
+	initSetOrganizationPasswordPolicyMocks(t)
+
+	maxAge := int64(3600)
+
+	mockOrgPasswordPolicyRepo.EXPECT().SelectMember(ctx, "org-id", "admin-id").
+		Return(entities.Membership{OrganizationId: "org-id", UserId: "admin-id", Role: entities.OrganizationRoleAdmin}, nil)
+
+	useCase := NewSetOrganizationPasswordPolicyUseCase(mockOrgPasswordPolicyRepo, mockOrgPasswordPolicyAuditLogger)
+
+	err := useCase.SetPasswordPolicy(ctx, "org-id", "admin-id", requests.SetOrganizationPasswordPolicy{MaxAge: &maxAge})
+
+	assert.ErrorIs(t, err, ErrOrganizationAccessDenied)
+}
+
+func TestSetOrganizationPasswordPolicyUseCase_SetPasswordPolicy_Negative(t *testing.T) {
+	ctx := context.Background()
+	initSetOrganizationPasswordPolicyMocks(t)
+
+	maxAge := int64(-1)
+
+	mockOrgPasswordPolicyRepo.EXPECT().SelectMember(ctx, "org-id", "owner-id").
+		Return(entities.Membership{OrganizationId: "org-id", UserId: "owner-id", Role: entities.OrganizationRoleOwner}, nil)
+
+	useCase := NewSetOrganizationPasswordPolicyUseCase(mockOrgPasswordPolicyRepo, mockOrgPasswordPolicyAuditLogger)
+
+	err := useCase.SetPasswordPolicy(ctx, "org-id", "owner-id", requests.SetOrganizationPasswordPolicy{MaxAge: &maxAge})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
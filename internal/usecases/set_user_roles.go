@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type setUserRolesUseCase struct {
+	userRepo    SetUserRolesUserRepository
+	roleRepo    SetUserRolesRoleRepository
+	sessionRepo SetUserRolesSessionRepository
+	auditLogger SetUserRolesAuditLogger
+}
+
+type SetUserRolesUseCase interface {
+	SetUserRoles(context context.Context, userId string, request requests.SetUserRoles) (responses.User, error)
+}
+
+func NewSetUserRolesUseCase(
+	userRepo SetUserRolesUserRepository,
+	roleRepo SetUserRolesRoleRepository,
+	sessionRepo SetUserRolesSessionRepository,
+	auditLogger SetUserRolesAuditLogger,
+) SetUserRolesUseCase {
+	return &setUserRolesUseCase{
+		userRepo:    userRepo,
+		roleRepo:    roleRepo,
+		sessionRepo: sessionRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (u *setUserRolesUseCase) SetUserRoles(context context.Context, userId string, request requests.SetUserRoles) (responses.User, error) {
+	response, err := u.setUserRoles(context, userId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditUserRolesSet, userId, err))
+	return response, err
+}
+
+func (u *setUserRolesUseCase) setUserRoles(context context.Context, userId string, request requests.SetUserRoles) (responses.User, error) {
+	user, err := u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.User{}, fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+		}
+		return responses.User{}, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if len(request.Roles) > 0 {
+		roles, err := u.roleRepo.SelectByNames(context, request.Roles)
+		if err != nil {
+			return responses.User{}, fmt.Errorf("failed to select roles: %w", err)
+		}
+
+		found := make([]string, 0, len(roles))
+		for _, role := range roles {
+			found = append(found, role.Name)
+		}
+		if missing := missingNames(request.Roles, found); len(missing) > 0 {
+			return responses.User{}, fmt.Errorf("%w: unknown roles %s", ErrInvalidEntity, strings.Join(missing, ", "))
+		}
+	}
+
+	err = u.userRepo.UpdateRoles(context, userId, request.Roles)
+	if err != nil {
+		if errors.Is(err, repositories.ErrLastAdmin) {
+			return responses.User{}, fmt.Errorf("failed to update user roles: %w", ErrLastAdmin)
+		}
+		return responses.User{}, fmt.Errorf("failed to update user roles: %w", err)
+	}
+
+	// The roles are in the claims of the issued tokens, the user signs in
+	// again to get the new ones.
+	if !sameNames(user.Roles, request.Roles) {
+		err = u.sessionRepo.DeleteByUserId(context, userId)
+		if err != nil && !errors.Is(err, repositories.ErrSessionNotFound) {
+			return responses.User{}, fmt.Errorf("failed to delete sessions: %w", err)
+		}
+	}
+
+	user, err = u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		return responses.User{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	return newUserResponse(user), nil
+}
@@ -0,0 +1,129 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockSetUserRolesUserRepo    *MockSetUserRolesUserRepository
+	mockSetUserRolesRoleRepo    *MockSetUserRolesRoleRepository
+	mockSetUserRolesSessionRepo *MockSetUserRolesSessionRepository
+	mockSetUserRolesAuditLogger *MockSetUserRolesAuditLogger
+)
+
+func initSetUserRolesMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSetUserRolesUserRepo = NewMockSetUserRolesUserRepository(ctrl)
+	mockSetUserRolesRoleRepo = NewMockSetUserRolesRoleRepository(ctrl)
+	mockSetUserRolesSessionRepo = NewMockSetUserRolesSessionRepository(ctrl)
+	mockSetUserRolesAuditLogger = NewMockSetUserRolesAuditLogger(ctrl)
+	mockSetUserRolesAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestSetUserRolesUseCase_SetUserRoles_Success(t *testing.T) {
+	ctx := context.Background()
+	initSetUserRolesMocks(t)
+
+	roles := []string{entities.RoleUser, entities.RoleAdmin}
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Roles: []string{entities.RoleUser}}
+	updated := user
+	updated.Roles = roles
+
+	gomock.InOrder(
+		mockSetUserRolesUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil),
+		mockSetUserRolesRoleRepo.EXPECT().SelectByNames(ctx, roles).Return([]entities.Role{
+			{Id: 1, Name: entities.RoleUser},
+			{Id: 2, Name: entities.RoleAdmin},
+		}, nil),
+		mockSetUserRolesUserRepo.EXPECT().UpdateRoles(ctx, "user-id", roles).Return(nil),
+		mockSetUserRolesSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(repositories.ErrSessionNotFound),
+		mockSetUserRolesUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(updated, nil),
+	)
+
+	useCase := NewSetUserRolesUseCase(mockSetUserRolesUserRepo, mockSetUserRolesRoleRepo, mockSetUserRolesSessionRepo, mockSetUserRolesAuditLogger)
+
+	result, err := useCase.SetUserRoles(ctx, "user-id", requests.SetUserRoles{Roles: roles})
+
+	assert.NoError(t, err)
+	assert.Equal(t, roles, result.Roles)
+}
+
+func TestSetUserRolesUseCase_SetUserRoles_SameRoles(t *testing.T) {
+	ctx := context.Background()
+	initSetUserRolesMocks(t)
+
+	roles := []string{entities.RoleAdmin, entities.RoleUser}
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Roles: []string{entities.RoleUser, entities.RoleAdmin}}
+
+	gomock.InOrder(
+		mockSetUserRolesUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil),
+		mockSetUserRolesRoleRepo.EXPECT().SelectByNames(ctx, roles).Return([]entities.Role{
+			{Id: 1, Name: entities.RoleUser},
+			{Id: 2, Name: entities.RoleAdmin},
+		}, nil),
+		mockSetUserRolesUserRepo.EXPECT().UpdateRoles(ctx, "user-id", roles).Return(nil),
+		mockSetUserRolesUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil),
+	)
+
+	useCase := NewSetUserRolesUseCase(mockSetUserRolesUserRepo, mockSetUserRolesRoleRepo, mockSetUserRolesSessionRepo, mockSetUserRolesAuditLogger)
+
+	_, err := useCase.SetUserRoles(ctx, "user-id", requests.SetUserRoles{Roles: roles})
+
+	assert.NoError(t, err)
+}
+
+func TestSetUserRolesUseCase_SetUserRoles_LastAdmin(t *testing.T) {
+	ctx := context.Background()
+	initSetUserRolesMocks(t)
+
+	roles := []string{entities.RoleUser}
+	user := entities.User{Id: "user-id", Roles: []string{entities.RoleUser, entities.RoleAdmin}}
+
+	mockSetUserRolesUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	mockSetUserRolesRoleRepo.EXPECT().SelectByNames(ctx, roles).Return([]entities.Role{{Id: 1, Name: entities.RoleUser}}, nil)
+	mockSetUserRolesUserRepo.EXPECT().UpdateRoles(ctx, "user-id", roles).Return(repositories.ErrLastAdmin)
+
+	useCase := NewSetUserRolesUseCase(mockSetUserRolesUserRepo, mockSetUserRolesRoleRepo, mockSetUserRolesSessionRepo, mockSetUserRolesAuditLogger)
+
+	_, err := useCase.SetUserRoles(ctx, "user-id", requests.SetUserRoles{Roles: roles})
+
+	assert.ErrorIs(t, err, ErrLastAdmin)
+}
+
+func TestSetUserRolesUseCase_SetUserRoles_UnknownRole(t *testing.T) {
+	ctx := context.Background()
+	initSetUserRolesMocks(t)
+
+	roles := []string{entities.RoleUser, "support"}
+
+	mockSetUserRolesUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{Id: "user-id"}, nil)
+	mockSetUserRolesRoleRepo.EXPECT().SelectByNames(ctx, roles).
+		Return([]entities.Role{{Id: 1, Name: entities.RoleUser}}, nil)
+
+	useCase := NewSetUserRolesUseCase(mockSetUserRolesUserRepo, mockSetUserRolesRoleRepo, mockSetUserRolesSessionRepo, mockSetUserRolesAuditLogger)
+
+	_, err := useCase.SetUserRoles(ctx, "user-id", requests.SetUserRoles{Roles: roles})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+	assert.ErrorContains(t, err, "support")
+}
+
+func TestSetUserRolesUseCase_SetUserRoles_UserNotFound(t *testing.T) {
+	ctx := context.Background()
+	initSetUserRolesMocks(t)
+
+	mockSetUserRolesUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{}, repositories.ErrEntityNotFound)
+
+	useCase := NewSetUserRolesUseCase(mockSetUserRolesUserRepo, mockSetUserRolesRoleRepo, mockSetUserRolesSessionRepo, mockSetUserRolesAuditLogger)
+
+	_, err := useCase.SetUserRoles(ctx, "user-id", requests.SetUserRoles{Roles: []string{entities.RoleUser}})
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
@@ -9,16 +9,29 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
+	"time"
 )
 
 type signInUseCase struct {
-	userRepo       SignInUserRepository
-	sessionRepo    SignInSessionRepository
-	hashProvider   SignInHashService
-	cookieService  SignInCookieService
-	sessionManager SignInSessionService
+	userRepo          SignInUserRepository
+	loginAttemptRepo  SignInLoginAttemptRepository
+	trustedDeviceRepo SignInTrustedDeviceRepository
+	hashProvider      SignInHashService
+	sessionManager    SignInSessionService
+	lockoutPolicy     entities.LockoutPolicy
+	trustedDeviceTTL  time.Duration
+	auditLogger       SignInAuditLogger
+	completion        signInCompletion
+
+	dummyHashOnce sync.Once
+	dummyPassword string
 }
 
+// TrustedDeviceCookie keeps the signed token of the device the user has
+// trusted, the sign in from it skips the second factor.
+const TrustedDeviceCookie = "trusted_device"
+
 type SignInUseCase interface {
 	SignIn(context context.Context, writer http.ResponseWriter, request *requests.SignIn, userAgent, ip string) (responses.SignIn, error)
 }
@@ -26,68 +39,143 @@ type SignInUseCase interface {
 func NewSignInUseCase(
 	userRepo SignInUserRepository,
 	sessionRepo SignInSessionRepository,
+	organizationRepo SignInOrganizationRepository,
+	loginAttemptRepo SignInLoginAttemptRepository,
+	mfaRepo SignInMFARepository,
+	webAuthnRepo SignInWebAuthnRepository,
+	trustedDeviceRepo SignInTrustedDeviceRepository,
 	hashProvider SignInHashService,
 	sessionManager SignInSessionService,
 	cookieService SignInCookieService,
+	passwordMaxAge time.Duration,
+	lockoutPolicy entities.LockoutPolicy,
+	trustedDeviceTTL time.Duration,
+	riskEngine SignInRiskEngine,
+	auditLogger SignInAuditLogger,
 ) SignInUseCase {
 	return &signInUseCase{
-		userRepo:       userRepo,
-		sessionRepo:    sessionRepo,
-		hashProvider:   hashProvider,
-		sessionManager: sessionManager,
-		cookieService:  cookieService,
+		userRepo:          userRepo,
+		loginAttemptRepo:  loginAttemptRepo,
+		trustedDeviceRepo: trustedDeviceRepo,
+		hashProvider:      hashProvider,
+		sessionManager:    sessionManager,
+		lockoutPolicy:     lockoutPolicy,
+		trustedDeviceTTL:  trustedDeviceTTL,
+		auditLogger:       auditLogger,
+		completion: signInCompletion{
+			sessionRepo:      sessionRepo,
+			organizationRepo: organizationRepo,
+			loginAttemptRepo: loginAttemptRepo,
+			mfaRepo:          mfaRepo,
+			webAuthnRepo:     webAuthnRepo,
+			hashProvider:     hashProvider,
+			sessionManager:   sessionManager,
+			cookieService:    cookieService,
+			riskEngine:       riskEngine,
+			auditLogger:      auditLogger,
+			passwordMaxAge:   passwordMaxAge,
+		},
 	}
 }
 
 func (u *signInUseCase) SignIn(context context.Context, writer http.ResponseWriter, request *requests.SignIn, userAgent, ip string) (responses.SignIn, error) {
-	email := request.Email
-	user, err := u.userRepo.SelectByEmail(context, entities.Email(email))
+	response, userId, err := u.signIn(context, writer, request, userAgent, ip)
+	u.auditLogger.Log(context, signInAuditEvent(entities.AuditSignIn, userId, userAgent, ip, response, err))
+	return response, err
+}
+
+func (u *signInUseCase) signIn(context context.Context, writer http.ResponseWriter, request *requests.SignIn, userAgent, ip string) (responses.SignIn, string, error) {
+	email := entities.Email(request.Email)
+	err := checkAttempts(context, u.loginAttemptRepo, u.lockoutPolicy, email, ip)
 	if err != nil {
-		if errors.Is(err, repositories.ErrEntityNotFound) {
-			return responses.SignIn{}, fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+		return responses.SignIn{}, "", err
+	}
+
+	// Unknown emails go through the same password check against a dummy hash
+	// and get the same error as a wrong password, so neither the response nor
+	// its timing tells whether the account exists.
+	found := true
+	user, err := u.userRepo.SelectByEmail(context, email)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.SignIn{}, "", fmt.Errorf("failed to find user: %w", err)
 		}
-		return responses.SignIn{}, fmt.Errorf("failed to find user: %w", err)
+		found = false
+		user = entities.User{Password: entities.Password(u.dummyHash())}
 	}
 
-	match := u.hashProvider.CompareStringAndHash(request.Password, string(user.Password))
-	if !match {
-		return responses.SignIn{}, fmt.Errorf("failed to compare password: %w", ErrWrongPassword)
+	password := entities.NewPassword(request.Password)
+	match := u.hashProvider.CompareStringAndHash(string(password), string(user.Password))
+	// Passwords set before the NFKC normalisation was introduced were hashed
+	// as typed, they are rehashed in the normalised form below.
+	legacyMatch := !match && string(password) != request.Password &&
+		u.hashProvider.CompareStringAndHash(request.Password, string(user.Password))
+	if !found || (!match && !legacyMatch) {
+		if err := registerFailure(context, u.loginAttemptRepo, u.lockoutPolicy, email, ip); err != nil {
+			return responses.SignIn{}, user.Id, err
+		}
+		return responses.SignIn{}, user.Id, ErrInvalidCredentials
 	}
 
-	err = u.sessionRepo.DeleteByUserId(context, user.Id)
+	err = CheckUserStatus(user)
 	if err != nil {
-		return responses.SignIn{}, fmt.Errorf("failed to delete session: %w", err)
+		return responses.SignIn{}, user.Id, fmt.Errorf("user can't sign in: %w", err)
 	}
 
-	session, err := u.sessionManager.CreateSession(user)
+	err = u.rehashPassword(context, user, password, legacyMatch)
 	if err != nil {
-		return responses.SignIn{}, fmt.Errorf("%w: couldn't create session", err)
+		return responses.SignIn{}, user.Id, err
 	}
 
-	session.IP = ip
-	session.UserAgent = userAgent
-
-	hashedRefreshToken, err := u.hashProvider.GenerateHash(session.RefreshToken)
+	mfaMethods, err := u.completion.mfaMethods(context, user)
 	if err != nil {
-		return responses.SignIn{}, fmt.Errorf("%w: failed to hash refresh token", err)
+		return responses.SignIn{}, user.Id, err
+	}
+	authentication := entities.NewAuthentication(entities.AuthMethodPassword)
+	if len(mfaMethods) > 0 {
+		trusted, err := isTrustedDevice(context, u.trustedDeviceRepo, u.sessionManager, u.trustedDeviceTTL, user, request.TrustedDeviceToken)
+		if err != nil {
+			return responses.SignIn{}, user.Id, err
+		}
+		if !trusted {
+			response, err := u.completion.mfaChallenge(user, mfaMethods, authentication)
+			return response, user.Id, err
+		}
 	}
 
-	refreshToken := session.RefreshToken
-	session.RefreshToken = string(hashedRefreshToken)
+	response, err := u.completion.completeSignIn(context, writer, user, request.OrganizationId, userAgent, ip, authentication)
+	return response, user.Id, err
+}
 
-	err = u.sessionRepo.Insert(context, session)
-	if err != nil {
-		return responses.SignIn{}, fmt.Errorf("%w: failed to insert session", err)
-	}
+// dummyHash returns a hash made by the current hash service, comparing with
+// it takes as long as comparing with a password of a real user.
+func (u *signInUseCase) dummyHash() string {
+	u.dummyHashOnce.Do(func() {
+		hash, err := u.hashProvider.GenerateHash("dummy password of an unknown user")
+		if err == nil {
+			u.dummyPassword = string(hash)
+		}
+	})
+	return u.dummyPassword
+}
 
-	u.cookieService.Set(
-		writer,
-		"access_token",
-		session.AccessToken,
-		session.AccessExpiresAt,
-	)
+// rehashPassword upgrades the stored hash when it was produced by an outdated
+// algorithm or cost, or from a not normalised password. The plain password
+// is only known at sign in, so this is the way to migrate existing users
+// without a password reset.
+func (u *signInUseCase) rehashPassword(context context.Context, user entities.User, password entities.Password, force bool) error {
+	if !force && !u.hashProvider.NeedsRehash(string(user.Password)) {
+		return nil
+	}
 
-	refreshSessionResponse := responses.NewSession(session.AccessToken, refreshToken, session.AccessExpiresAt.Unix())
+	hashedPassword, err := u.hashProvider.GenerateHash(string(password))
+	if err != nil {
+		return fmt.Errorf("%w: failed to rehash the password", err)
+	}
 
-	return responses.NewSignIn(user.Id, refreshSessionResponse), nil
+	err = u.userRepo.UpdatePassword(context, user.Id, entities.Password(hashedPassword))
+	if err != nil {
+		return fmt.Errorf("%w: failed to update the password hash", err)
+	}
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"testing"
 	"time"
 
@@ -17,23 +18,76 @@ import (
 var (
 	mockSignInUserRepo       *MockSignInUserRepository
 	mockSignInSessionRepo    *MockSignInSessionRepository
+	mockSignInOrgRepo        *MockSignInOrganizationRepository
 	mockSignInHashService    *MockSignInHashService
 	mockSignInSessionService *MockSignInSessionService
 	mockSignInCookieService  *MockSignInCookieService
+	mockSignInAttemptRepo    *MockSignInLoginAttemptRepository
+	mockSignInMFARepo        *MockSignInMFARepository
+	mockSignInWebAuthnRepo   *MockSignInWebAuthnRepository
+	mockSignInTrustedDevices *MockSignInTrustedDeviceRepository
+	mockSignInRiskEngine     *MockSignInRiskEngine
+	mockSignInAuditLogger    *MockSignInAuditLogger
 )
 
+var signInLockoutPolicy = entities.LockoutPolicy{
+	Window:             15 * time.Minute,
+	FreeAttempts:       3,
+	BaseDelay:          time.Second,
+	MaxDelay:           30 * time.Second,
+	AccountMaxFailures: 5,
+	IPMaxFailures:      20,
+	LockoutDuration:    15 * time.Minute,
+}
+
+const signInTrustedDeviceTTL = 30 * 24 * time.Hour
+
 func initSignInMocks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockSignInUserRepo = NewMockSignInUserRepository(ctrl)
 	mockSignInSessionRepo = NewMockSignInSessionRepository(ctrl)
+	mockSignInOrgRepo = NewMockSignInOrganizationRepository(ctrl)
 	mockSignInHashService = NewMockSignInHashService(ctrl)
 	mockSignInSessionService = NewMockSignInSessionService(ctrl)
 	mockSignInCookieService = NewMockSignInCookieService(ctrl)
+	mockSignInAttemptRepo = NewMockSignInLoginAttemptRepository(ctrl)
+	mockSignInMFARepo = NewMockSignInMFARepository(ctrl)
+	mockSignInWebAuthnRepo = NewMockSignInWebAuthnRepository(ctrl)
+	mockSignInTrustedDevices = NewMockSignInTrustedDeviceRepository(ctrl)
+	mockSignInRiskEngine = NewMockSignInRiskEngine(ctrl)
+	mockSignInRiskEngine.EXPECT().Assess(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(entities.RiskAssessment{}, nil).AnyTimes()
+	mockSignInRiskEngine.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockSignInAuditLogger = NewMockSignInAuditLogger(ctrl)
+	mockSignInAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func expectSignInAttempts(ctx context.Context, email, ip string) {
+	mockSignInAttemptRepo.EXPECT().Select(ctx, entities.AccountAttemptsKey(entities.Email(email))).
+		Return(entities.LoginAttempts{}, nil)
+	mockSignInAttemptRepo.EXPECT().Select(ctx, entities.IPAttemptsKey(ip)).
+		Return(entities.LoginAttempts{}, nil)
+}
+
+func expectSignInAttemptsReset(ctx context.Context, email, ip string) {
+	expectSignInAttempts(ctx, email, ip)
+	mockSignInMFARepo.EXPECT().SelectTOTP(ctx, gomock.Any()).Return(entities.TOTP{}, repositories.ErrEntityNotFound)
+	mockSignInWebAuthnRepo.EXPECT().SelectCredentials(ctx, gomock.Any()).Return(nil, nil)
+	mockSignInAttemptRepo.EXPECT().Reset(ctx, entities.AccountAttemptsKey(entities.Email(email))).Return(nil)
+}
+
+func expectSignInFailure(ctx context.Context, email, ip string) {
+	expectSignInAttempts(ctx, email, ip)
+	mockSignInAttemptRepo.EXPECT().RegisterFailure(ctx, entities.AccountAttemptsKey(entities.Email(email)), signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 1}, nil)
+	mockSignInAttemptRepo.EXPECT().RegisterFailure(ctx, entities.IPAttemptsKey(ip), signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 1}, nil)
 }
 
 func TestSignInUseCase_SignIn_Success(t *testing.T) {
 	ctx := context.Background()
 	initSignInMocks(t)
+	expectSignInAttemptsReset(ctx, "test@mail.ru", "127.0.0.1")
 
 	request := &requests.SignIn{
 		Email:    "test@mail.ru",
@@ -63,8 +117,9 @@ func TestSignInUseCase_SignIn_Success(t *testing.T) {
 
 	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
 	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(false)
 	mockSignInSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
-	mockSignInSessionService.EXPECT().CreateSession(user).Return(session, nil)
+	mockSignInSessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodPassword)).Return(session, nil)
 	mockSignInHashService.EXPECT().GenerateHash("new-refresh-token").Return([]byte("hashed-refresh-token"), nil)
 	mockSignInSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
 	mockSignInCookieService.EXPECT().Set(writer, "access_token", expectedAccessToken, session.AccessExpiresAt)
@@ -72,9 +127,19 @@ func TestSignInUseCase_SignIn_Success(t *testing.T) {
 	useCase := NewSignInUseCase(
 		mockSignInUserRepo,
 		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
 		mockSignInHashService,
 		mockSignInSessionService,
-		mockSignInCookieService)
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
 
 	response, err := useCase.SignIn(ctx, writer, request, userAgent, ip)
 
@@ -87,6 +152,7 @@ func TestSignInUseCase_SignIn_Success(t *testing.T) {
 func TestSignInUseCase_SignIn_UserNotFound(t *testing.T) {
 	ctx := context.Background()
 	initSignInMocks(t)
+	expectSignInFailure(ctx, "nobody@mail.ru", "")
 
 	request := &requests.SignIn{
 		Email:    "nobody@mail.ru",
@@ -94,24 +160,37 @@ func TestSignInUseCase_SignIn_UserNotFound(t *testing.T) {
 	}
 
 	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("nobody@mail.ru")).Return(entities.User{}, repositories.ErrEntityNotFound)
+	mockSignInHashService.EXPECT().GenerateHash(gomock.Any()).Return([]byte("dummy-hash"), nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", "dummy-hash").Return(false)
 
 	useCase := NewSignInUseCase(
 		mockSignInUserRepo,
 		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
 		mockSignInHashService,
 		mockSignInSessionService,
-		mockSignInCookieService)
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
 
 	response, err := useCase.SignIn(ctx, nil, request, "", "")
 
 	assert.Error(t, err)
 	assert.Empty(t, response.Id)
-	assert.ErrorIs(t, err, ErrEntityNotFound)
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
 }
 
 func TestSignInUseCase_SignIn_WrongPassword(t *testing.T) {
 	ctx := context.Background()
 	initSignInMocks(t)
+	expectSignInFailure(ctx, "test@mail.ru", "")
 
 	request := &requests.SignIn{
 		Email:    "test@mail.ru",
@@ -125,24 +204,42 @@ func TestSignInUseCase_SignIn_WrongPassword(t *testing.T) {
 
 	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
 	mockSignInHashService.EXPECT().CompareStringAndHash("wrong-password", string(user.Password)).Return(false)
+	mockSignInAuditLogger = NewMockSignInAuditLogger(gomock.NewController(t))
+	mockSignInAuditLogger.EXPECT().Log(ctx, entities.AuditEvent{
+		Type:      entities.AuditSignIn,
+		SubjectId: "user-id",
+		Outcome:   entities.AuditOutcomeFailure,
+		Reason:    ErrInvalidCredentials.Error(),
+	})
 
 	useCase := NewSignInUseCase(
 		mockSignInUserRepo,
 		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
 		mockSignInHashService,
 		mockSignInSessionService,
-		mockSignInCookieService)
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
 
 	response, err := useCase.SignIn(ctx, nil, request, "", "")
 
 	assert.Error(t, err)
 	assert.Empty(t, response.Id)
-	assert.ErrorIs(t, err, ErrWrongPassword)
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
 }
 
 func TestSignInUseCase_SignIn_DeleteSessionError(t *testing.T) {
 	ctx := context.Background()
 	initSignInMocks(t)
+	expectSignInAttemptsReset(ctx, "test@mail.ru", "")
 
 	request := &requests.SignIn{
 		Email:    "test@mail.ru",
@@ -156,14 +253,25 @@ func TestSignInUseCase_SignIn_DeleteSessionError(t *testing.T) {
 
 	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
 	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(false)
 	mockSignInSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(fmt.Errorf("database error"))
 
 	useCase := NewSignInUseCase(
 		mockSignInUserRepo,
 		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
 		mockSignInHashService,
 		mockSignInSessionService,
-		mockSignInCookieService)
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
 
 	response, err := useCase.SignIn(ctx, nil, request, "", "")
 
@@ -172,9 +280,65 @@ func TestSignInUseCase_SignIn_DeleteSessionError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to delete session")
 }
 
+func TestSignInUseCase_SignIn_NoPreviousSession(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttemptsReset(ctx, "test@mail.ru", "")
+
+	request := &requests.SignIn{
+		Email:    "test@mail.ru",
+		Password: "password123",
+	}
+	user := entities.User{
+		Id:       "user-id",
+		Email:    "test@mail.ru",
+		Password: "hashed-password",
+	}
+	session := entities.Session{
+		AccessToken:     "access-token",
+		RefreshToken:    "refresh-token",
+		AccessExpiresAt: time.Now().Add(time.Hour),
+		UserId:          "user-id",
+	}
+
+	// The user has logged out or the sessions of the user were revoked.
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(false)
+	mockSignInSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(repositories.ErrSessionNotFound)
+	mockSignInSessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodPassword)).Return(session, nil)
+	mockSignInHashService.EXPECT().GenerateHash("refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockSignInSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockSignInCookieService.EXPECT().Set(nil, "access_token", "access-token", session.AccessExpiresAt)
+
+	useCase := NewSignInUseCase(
+		mockSignInUserRepo,
+		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
+		mockSignInHashService,
+		mockSignInSessionService,
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
+
+	response, err := useCase.SignIn(ctx, nil, request, "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "user-id", response.Id)
+	assert.Equal(t, "access-token", response.Session.AccessToken)
+}
+
 func TestSignInUseCase_SignIn_CreateSessionError(t *testing.T) {
 	ctx := context.Background()
 	initSignInMocks(t)
+	expectSignInAttemptsReset(ctx, "test@mail.ru", "")
 
 	request := &requests.SignIn{
 		Email:    "test@mail.ru",
@@ -188,15 +352,26 @@ func TestSignInUseCase_SignIn_CreateSessionError(t *testing.T) {
 
 	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
 	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(false)
 	mockSignInSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
-	mockSignInSessionService.EXPECT().CreateSession(user).Return(entities.Session{}, fmt.Errorf("session error"))
+	mockSignInSessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodPassword)).Return(entities.Session{}, fmt.Errorf("session error"))
 
 	useCase := NewSignInUseCase(
 		mockSignInUserRepo,
 		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
 		mockSignInHashService,
 		mockSignInSessionService,
-		mockSignInCookieService)
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
 
 	response, err := useCase.SignIn(ctx, nil, request, "", "")
 
@@ -204,3 +379,750 @@ func TestSignInUseCase_SignIn_CreateSessionError(t *testing.T) {
 	assert.Empty(t, response.Id)
 	assert.Contains(t, err.Error(), "couldn't create session")
 }
+
+func TestSignInUseCase_SignIn_UserBanned(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttempts(ctx, "test@mail.ru", "")
+
+	request := &requests.SignIn{
+		Email:    "test@mail.ru",
+		Password: "password123",
+	}
+	user := entities.User{
+		Id:           "user-id",
+		Email:        "test@mail.ru",
+		Password:     "hashed-password",
+		Status:       entities.UserStatusBanned,
+		StatusReason: "spam",
+	}
+
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+
+	useCase := NewSignInUseCase(
+		mockSignInUserRepo,
+		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
+		mockSignInHashService,
+		mockSignInSessionService,
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
+
+	response, err := useCase.SignIn(ctx, nil, request, "", "")
+
+	assert.Error(t, err)
+	assert.Empty(t, response.Id)
+	assert.ErrorIs(t, err, ErrUserBanned)
+	assert.Contains(t, err.Error(), "spam")
+}
+
+func TestSignInUseCase_SignIn_UserLocked(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttempts(ctx, "test@mail.ru", "")
+
+	request := &requests.SignIn{
+		Email:    "test@mail.ru",
+		Password: "password123",
+	}
+	user := entities.User{
+		Id:          "user-id",
+		Email:       "test@mail.ru",
+		Password:    "hashed-password",
+		Status:      entities.UserStatusLocked,
+		LockedUntil: time.Now().Add(time.Hour),
+	}
+
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+
+	useCase := NewSignInUseCase(
+		mockSignInUserRepo,
+		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
+		mockSignInHashService,
+		mockSignInSessionService,
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
+
+	_, err := useCase.SignIn(ctx, nil, request, "", "")
+
+	assert.ErrorIs(t, err, ErrUserLocked)
+}
+
+func TestSignInUseCase_SignIn_WithOrganization(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttemptsReset(ctx, "test@mail.ru", "")
+
+	request := &requests.SignIn{
+		Email:          "test@mail.ru",
+		Password:       "password123",
+		OrganizationId: "org-id",
+	}
+	writer := http.ResponseWriter(nil)
+
+	user := entities.User{
+		Id:       "user-id",
+		Email:    entities.Email("test@mail.ru"),
+		Password: entities.Password("hashed-password"),
+	}
+	membership := entities.Membership{OrganizationId: "org-id", UserId: "user-id", Role: entities.OrganizationRoleMember}
+	session := entities.Session{
+		AccessToken:     "new-access-token",
+		RefreshToken:    "new-refresh-token",
+		AccessExpiresAt: time.Now().Add(1 * time.Hour),
+		UserId:          "user-id",
+		OrganizationId:  "org-id",
+	}
+
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(false)
+	mockSignInOrgRepo.EXPECT().SelectMember(ctx, "org-id", "user-id").Return(membership, nil)
+	mockSignInSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	mockSignInSessionService.EXPECT().CreateSession(user, membership, authenticatedWith(entities.AuthMethodPassword)).Return(session, nil)
+	mockSignInHashService.EXPECT().GenerateHash("new-refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockSignInSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockSignInCookieService.EXPECT().Set(writer, "access_token", session.AccessToken, session.AccessExpiresAt)
+
+	useCase := NewSignInUseCase(
+		mockSignInUserRepo,
+		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
+		mockSignInHashService,
+		mockSignInSessionService,
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
+
+	response, err := useCase.SignIn(ctx, writer, request, "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new-access-token", response.Session.AccessToken)
+}
+
+func TestSignInUseCase_SignIn_NotOrganizationMember(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttemptsReset(ctx, "test@mail.ru", "")
+
+	request := &requests.SignIn{
+		Email:          "test@mail.ru",
+		Password:       "password123",
+		OrganizationId: "org-id",
+	}
+	user := entities.User{
+		Id:       "user-id",
+		Email:    entities.Email("test@mail.ru"),
+		Password: entities.Password("hashed-password"),
+	}
+
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(false)
+	mockSignInOrgRepo.EXPECT().SelectMember(ctx, "org-id", "user-id").Return(entities.Membership{}, repositories.ErrEntityNotFound)
+
+	useCase := NewSignInUseCase(
+		mockSignInUserRepo,
+		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
+		mockSignInHashService,
+		mockSignInSessionService,
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
+
+	_, err := useCase.SignIn(ctx, nil, request, "", "")
+
+	assert.ErrorIs(t, err, ErrOrganizationAccessDenied)
+}
+
+func TestSignInUseCase_SignIn_RehashesOutdatedPassword(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttemptsReset(ctx, "test@mail.ru", "")
+
+	request := &requests.SignIn{
+		Email:    "test@mail.ru",
+		Password: "password123",
+	}
+	user := entities.User{
+		Id:       "user-id",
+		Email:    entities.Email("test@mail.ru"),
+		Password: entities.Password("$2a$10$bcrypt-hash"),
+	}
+	session := entities.Session{
+		AccessToken:     "new-access-token",
+		RefreshToken:    "new-refresh-token",
+		AccessExpiresAt: time.Now().Add(time.Hour),
+		UserId:          "user-id",
+	}
+
+	gomock.InOrder(
+		mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil),
+		mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true),
+		mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(true),
+		mockSignInHashService.EXPECT().GenerateHash("password123").Return([]byte("$argon2id$new-hash"), nil),
+		mockSignInUserRepo.EXPECT().UpdatePassword(ctx, "user-id", entities.Password("$argon2id$new-hash")).Return(nil),
+		mockSignInSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil),
+	)
+	mockSignInSessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodPassword)).Return(session, nil)
+	mockSignInHashService.EXPECT().GenerateHash("new-refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockSignInSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockSignInCookieService.EXPECT().Set(nil, "access_token", session.AccessToken, session.AccessExpiresAt)
+
+	useCase := NewSignInUseCase(
+		mockSignInUserRepo,
+		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
+		mockSignInHashService,
+		mockSignInSessionService,
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
+
+	response, err := useCase.SignIn(ctx, nil, request, "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "user-id", response.Id)
+}
+
+func TestSignInUseCase_SignIn_RehashUpdateError(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttempts(ctx, "test@mail.ru", "")
+
+	user := entities.User{
+		Id:       "user-id",
+		Email:    entities.Email("test@mail.ru"),
+		Password: entities.Password("$2a$10$bcrypt-hash"),
+	}
+
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().GenerateHash("password123").Return([]byte("$argon2id$new-hash"), nil)
+	mockSignInUserRepo.EXPECT().UpdatePassword(ctx, "user-id", entities.Password("$argon2id$new-hash")).Return(fmt.Errorf("db error"))
+
+	useCase := signInUseCase{
+		userRepo:         mockSignInUserRepo,
+		loginAttemptRepo: mockSignInAttemptRepo,
+		hashProvider:     mockSignInHashService,
+		auditLogger:      mockSignInAuditLogger,
+	}
+
+	_, err := useCase.SignIn(ctx, nil, &requests.SignIn{Email: "test@mail.ru", Password: "password123"}, "", "")
+
+	assert.ErrorContains(t, err, "failed to update the password hash")
+}
+
+func TestSignInUseCase_SignIn_RehashesNotNormalizedPassword(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttempts(ctx, "test@mail.ru", "")
+
+	user := entities.User{
+		Id:       "user-id",
+		Email:    entities.Email("test@mail.ru"),
+		Password: entities.Password("legacy-hash"),
+	}
+
+	gomock.InOrder(
+		mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil),
+		mockSignInHashService.EXPECT().CompareStringAndHash("password123", "legacy-hash").Return(false),
+		mockSignInHashService.EXPECT().CompareStringAndHash("ｐａｓｓｗｏｒｄ123", "legacy-hash").Return(true),
+		mockSignInHashService.EXPECT().GenerateHash("password123").Return([]byte("normalized-hash"), nil),
+		mockSignInUserRepo.EXPECT().UpdatePassword(ctx, "user-id", entities.Password("normalized-hash")).Return(fmt.Errorf("db error")),
+	)
+
+	useCase := signInUseCase{
+		userRepo:         mockSignInUserRepo,
+		loginAttemptRepo: mockSignInAttemptRepo,
+		hashProvider:     mockSignInHashService,
+		auditLogger:      mockSignInAuditLogger,
+	}
+
+	_, err := useCase.SignIn(ctx, nil, &requests.SignIn{Email: "test@mail.ru", Password: "ｐａｓｓｗｏｒｄ123"}, "", "")
+
+	assert.ErrorContains(t, err, "failed to update the password hash")
+}
+
+func TestSignInUseCase_SignIn_PasswordExpired(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttemptsReset(ctx, "test@mail.ru", "127.0.0.1")
+
+	request := &requests.SignIn{
+		Email:    "test@mail.ru",
+		Password: "password123",
+	}
+	writer := http.ResponseWriter(nil)
+
+	user := entities.User{
+		Id:                "user-id",
+		Email:             entities.Email("test@mail.ru"),
+		Password:          entities.Password("hashed-password"),
+		PasswordChangedAt: time.Now().Add(-48 * time.Hour),
+	}
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(false)
+	mockSignInSessionService.EXPECT().CreateRestrictedToken(user, entities.ScopePasswordChange, authenticatedWith(entities.AuthMethodPassword)).Return("restricted-token", expiresAt, nil)
+
+	useCase := NewSignInUseCase(
+		mockSignInUserRepo,
+		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
+		mockSignInHashService,
+		mockSignInSessionService,
+		mockSignInCookieService,
+		24*time.Hour,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
+
+	response, err := useCase.SignIn(ctx, writer, request, "test-agent", "127.0.0.1")
+
+	assert.NoError(t, err)
+	assert.True(t, response.PasswordChangeRequired)
+	assert.Nil(t, response.Session)
+	assert.Equal(t, "restricted-token", response.RestrictedToken.Token)
+	assert.Equal(t, entities.ScopePasswordChange, response.RestrictedToken.Scope)
+}
+
+func TestSignInUseCase_SignIn_OrganizationPasswordMaxAge(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttemptsReset(ctx, "test@mail.ru", "127.0.0.1")
+
+	request := &requests.SignIn{
+		Email:          "test@mail.ru",
+		Password:       "password123",
+		OrganizationId: "org-id",
+	}
+
+	user := entities.User{
+		Id:                "user-id",
+		Email:             entities.Email("test@mail.ru"),
+		Password:          entities.Password("hashed-password"),
+		PasswordChangedAt: time.Now().Add(-48 * time.Hour),
+	}
+	maxAge := 24 * time.Hour
+	membership := entities.Membership{OrganizationId: "org-id", UserId: "user-id", Role: entities.OrganizationRoleMember, PasswordMaxAge: &maxAge}
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(false)
+	mockSignInOrgRepo.EXPECT().SelectMember(ctx, "org-id", "user-id").Return(membership, nil)
+	mockSignInSessionService.EXPECT().CreateRestrictedToken(user, entities.ScopePasswordChange, authenticatedWith(entities.AuthMethodPassword)).Return("restricted-token", expiresAt, nil)
+
+	// The policy max age is disabled, only the organization one expires the password.
+	useCase := newLockoutSignInUseCase()
+
+	response, err := useCase.SignIn(ctx, http.ResponseWriter(nil), request, "test-agent", "127.0.0.1")
+
+	assert.NoError(t, err)
+	assert.True(t, response.PasswordChangeRequired)
+	assert.Equal(t, "restricted-token", response.RestrictedToken.Token)
+}
+
+func newLockoutSignInUseCase() SignInUseCase {
+	return NewSignInUseCase(
+		mockSignInUserRepo,
+		mockSignInSessionRepo,
+		mockSignInOrgRepo,
+		mockSignInAttemptRepo,
+		mockSignInMFARepo,
+		mockSignInWebAuthnRepo,
+		mockSignInTrustedDevices,
+		mockSignInHashService,
+		mockSignInSessionService,
+		mockSignInCookieService,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		mockSignInRiskEngine,
+		mockSignInAuditLogger)
+}
+
+func TestSignInUseCase_SignIn_AccountLockedOut(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+
+	mockSignInAttemptRepo.EXPECT().Select(ctx, "account:test@mail.ru").Return(entities.LoginAttempts{
+		Failures:      5,
+		LastFailureAt: time.Now(),
+		LockedUntil:   time.Now().Add(10 * time.Minute),
+	}, nil)
+
+	_, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{Email: "test@mail.ru", Password: "password123"}, "", "10.0.0.1")
+
+	var retryErr *RetryAfterError
+	assert.ErrorIs(t, err, ErrAccountTemporarilyLocked)
+	assert.ErrorAs(t, err, &retryErr)
+	assert.InDelta(t, (10 * time.Minute).Seconds(), retryErr.RetryAfter.Seconds(), 1)
+}
+
+func TestSignInUseCase_SignIn_IPThrottled(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+
+	mockSignInAttemptRepo.EXPECT().Select(ctx, "account:test@mail.ru").Return(entities.LoginAttempts{}, nil)
+	mockSignInAttemptRepo.EXPECT().Select(ctx, "ip:10.0.0.1").Return(entities.LoginAttempts{
+		Failures:      6,
+		LastFailureAt: time.Now(),
+	}, nil)
+
+	_, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{Email: "test@mail.ru", Password: "password123"}, "", "10.0.0.1")
+
+	var retryErr *RetryAfterError
+	assert.ErrorIs(t, err, ErrTooManyAttempts)
+	assert.ErrorAs(t, err, &retryErr)
+	assert.InDelta(t, (4 * time.Second).Seconds(), retryErr.RetryAfter.Seconds(), 1)
+}
+
+func TestSignInUseCase_SignIn_LocksAccountAfterMaxFailures(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttempts(ctx, "test@mail.ru", "10.0.0.1")
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("wrong-password", "hashed-password").Return(false)
+	mockSignInAttemptRepo.EXPECT().RegisterFailure(ctx, "account:test@mail.ru", signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 5}, nil)
+	mockSignInAttemptRepo.EXPECT().Lock(ctx, "account:test@mail.ru", gomock.Any()).Return(nil)
+	mockSignInAttemptRepo.EXPECT().RegisterFailure(ctx, "ip:10.0.0.1", signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 5}, nil)
+
+	_, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{Email: "test@mail.ru", Password: "wrong-password"}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestSignInUseCase_SignIn_MFARequired(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttempts(ctx, "test@mail.ru", "10.0.0.1")
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", "hashed-password").Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash("hashed-password").Return(false)
+	mockSignInMFARepo.EXPECT().SelectTOTP(ctx, "user-id").
+		Return(entities.TOTP{UserId: "user-id", Secret: "encrypted", ConfirmedAt: time.Now()}, nil)
+	mockSignInWebAuthnRepo.EXPECT().SelectCredentials(ctx, "user-id").Return(nil, nil)
+	mockSignInSessionService.EXPECT().CreateRestrictedToken(user, entities.ScopeMFA, authenticatedWith(entities.AuthMethodPassword)).Return("mfa-token", expiresAt, nil)
+	mockSignInAuditLogger = NewMockSignInAuditLogger(gomock.NewController(t))
+	mockSignInAuditLogger.EXPECT().Log(ctx, entities.AuditEvent{
+		Type:      entities.AuditSignIn,
+		ActorId:   "user-id",
+		SubjectId: "user-id",
+		IP:        "10.0.0.1",
+		Outcome:   entities.AuditOutcomeSuccess,
+		Reason:    ErrMFARequired.Error(),
+	})
+
+	response, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{Email: "test@mail.ru", Password: "password123"}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.True(t, response.MFARequired)
+	assert.Equal(t, []string{entities.MFAMethodTOTP}, response.MFAMethods)
+	assert.Nil(t, response.Session)
+	assert.Equal(t, "mfa-token", response.RestrictedToken.Token)
+	assert.Equal(t, entities.ScopeMFA, response.RestrictedToken.Scope)
+}
+
+// authenticationMatcher compares the authentication methods, the time is only
+// required to be set.
+type authenticationMatcher []string
+
+func authenticatedWith(methods ...string) gomock.Matcher {
+	return authenticationMatcher(methods)
+}
+
+func (m authenticationMatcher) Matches(x any) bool {
+	authentication, ok := x.(entities.Authentication)
+	return ok && !authentication.Time.IsZero() && slices.Equal(authentication.Methods, []string(m))
+}
+
+func (m authenticationMatcher) String() string {
+	return fmt.Sprintf("is authenticated with %v", []string(m))
+}
+
+func TestSignInUseCase_SignIn_MFARequiredWithVerifiedPhone(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+	expectSignInAttempts(ctx, "test@mail.ru", "10.0.0.1")
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password", Phone: "+79991234567", PhoneVerifiedAt: time.Now()}
+
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, entities.Email("test@mail.ru")).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", "hashed-password").Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash("hashed-password").Return(false)
+	mockSignInMFARepo.EXPECT().SelectTOTP(ctx, "user-id").Return(entities.TOTP{}, repositories.ErrEntityNotFound)
+	mockSignInWebAuthnRepo.EXPECT().SelectCredentials(ctx, "user-id").Return(nil, nil)
+	mockSignInSessionService.EXPECT().CreateRestrictedToken(user, entities.ScopeMFA, authenticatedWith(entities.AuthMethodPassword)).
+		Return("mfa-token", time.Now().Add(10*time.Minute), nil)
+
+	response, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{Email: "test@mail.ru", Password: "password123"}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.True(t, response.MFARequired)
+	assert.Equal(t, []string{entities.MFAMethodSMS}, response.MFAMethods)
+}
+
+func expectSignInWithTOTP(ctx context.Context, user entities.User) {
+	expectSignInAttempts(ctx, string(user.Email), "10.0.0.1")
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, user.Email).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(false)
+	mockSignInMFARepo.EXPECT().SelectTOTP(ctx, user.Id).
+		Return(entities.TOTP{UserId: user.Id, Secret: "encrypted", ConfirmedAt: time.Now()}, nil)
+	mockSignInWebAuthnRepo.EXPECT().SelectCredentials(ctx, user.Id).Return(nil, nil)
+}
+
+func TestSignInUseCase_SignIn_TrustedDeviceSkipsMFA(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	session := entities.Session{AccessToken: "access-token", RefreshToken: "refresh-token", UserId: "user-id"}
+	expectSignInWithTOTP(ctx, user)
+
+	mockSignInSessionService.EXPECT().ParseToken("device-token").Return(entities.AccessTokenClaims{
+		entities.UserIdClaimName:   "user-id",
+		entities.ScopeClaimName:    entities.ScopeTrustedDevice,
+		entities.DeviceIdClaimName: "device-id",
+	}, nil)
+	mockSignInTrustedDevices.EXPECT().Select(ctx, "user-id", "device-id").
+		Return(entities.TrustedDevice{Id: "device-id", UserId: "user-id"}, nil)
+	mockSignInTrustedDevices.EXPECT().UpdateUsage(ctx, "device-id").Return(nil)
+	mockSignInAttemptRepo.EXPECT().Reset(ctx, "account:test@mail.ru").Return(nil)
+	mockSignInSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	// Skipping the second factor does not make the sign in a multi-factor one.
+	mockSignInSessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodPassword)).Return(session, nil)
+	mockSignInHashService.EXPECT().GenerateHash("refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockSignInSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockSignInCookieService.EXPECT().Set(nil, "access_token", "access-token", session.AccessExpiresAt)
+
+	response, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{
+		Email:              "test@mail.ru",
+		Password:           "password123",
+		TrustedDeviceToken: "device-token",
+	}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.False(t, response.MFARequired)
+	assert.Equal(t, "access-token", response.Session.AccessToken)
+}
+
+func TestSignInUseCase_SignIn_DeviceOfAnotherUser(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	expectSignInWithTOTP(ctx, user)
+
+	mockSignInSessionService.EXPECT().ParseToken("device-token").Return(entities.AccessTokenClaims{
+		entities.UserIdClaimName:   "other-user-id",
+		entities.ScopeClaimName:    entities.ScopeTrustedDevice,
+		entities.DeviceIdClaimName: "device-id",
+	}, nil)
+	mockSignInSessionService.EXPECT().CreateRestrictedToken(user, entities.ScopeMFA, authenticatedWith(entities.AuthMethodPassword)).
+		Return("mfa-token", time.Now().Add(10*time.Minute), nil)
+
+	response, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{
+		Email:              "test@mail.ru",
+		Password:           "password123",
+		TrustedDeviceToken: "device-token",
+	}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.True(t, response.MFARequired)
+}
+
+func TestSignInUseCase_SignIn_RevokedDevice(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	expectSignInWithTOTP(ctx, user)
+
+	mockSignInSessionService.EXPECT().ParseToken("device-token").Return(entities.AccessTokenClaims{
+		entities.UserIdClaimName:   "user-id",
+		entities.ScopeClaimName:    entities.ScopeTrustedDevice,
+		entities.DeviceIdClaimName: "device-id",
+	}, nil)
+	mockSignInTrustedDevices.EXPECT().Select(ctx, "user-id", "device-id").
+		Return(entities.TrustedDevice{}, repositories.ErrEntityNotFound)
+	mockSignInSessionService.EXPECT().CreateRestrictedToken(user, entities.ScopeMFA, authenticatedWith(entities.AuthMethodPassword)).
+		Return("mfa-token", time.Now().Add(10*time.Minute), nil)
+
+	response, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{
+		Email:              "test@mail.ru",
+		Password:           "password123",
+		TrustedDeviceToken: "device-token",
+	}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.True(t, response.MFARequired)
+}
+
+func expectSignInRisk(t *testing.T, ctx context.Context, assessment entities.RiskAssessment) {
+	mockSignInRiskEngine = NewMockSignInRiskEngine(gomock.NewController(t))
+	mockSignInRiskEngine.EXPECT().Assess(ctx, "user-id", "", "10.0.0.1").Return(assessment, nil)
+}
+
+func expectSignInWithoutMFA(ctx context.Context, user entities.User) {
+	expectSignInAttempts(ctx, string(user.Email), "10.0.0.1")
+	mockSignInUserRepo.EXPECT().SelectByEmail(ctx, user.Email).Return(user, nil)
+	mockSignInHashService.EXPECT().CompareStringAndHash("password123", string(user.Password)).Return(true)
+	mockSignInHashService.EXPECT().NeedsRehash(string(user.Password)).Return(false)
+	mockSignInMFARepo.EXPECT().SelectTOTP(ctx, user.Id).Return(entities.TOTP{}, repositories.ErrEntityNotFound)
+	mockSignInWebAuthnRepo.EXPECT().SelectCredentials(ctx, user.Id).Return(nil, nil)
+}
+
+func TestSignInUseCase_SignIn_RiskBlocks(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	expectSignInWithoutMFA(ctx, user)
+	expectSignInRisk(t, ctx, entities.RiskAssessment{
+		Login:   entities.Login{UserId: "user-id", IP: "10.0.0.1"},
+		Score:   100,
+		Signals: []string{entities.RiskImpossibleTravel, entities.RiskNewCountry},
+		Block:   true,
+	})
+	mockSignInAuditLogger = NewMockSignInAuditLogger(gomock.NewController(t))
+	mockSignInAuditLogger.EXPECT().Log(ctx, entities.AuditEvent{
+		Type:      entities.AuditRiskDetected,
+		SubjectId: "user-id",
+		IP:        "10.0.0.1",
+		Outcome:   entities.AuditOutcomeFailure,
+		Reason:    "score 100: impossible_travel, new_country",
+	})
+	mockSignInAuditLogger.EXPECT().Log(ctx, gomock.Any())
+
+	_, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{Email: "test@mail.ru", Password: "password123"}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrSuspiciousLogin)
+}
+
+func TestSignInUseCase_SignIn_RiskChallengesTrustedDevice(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	expectSignInWithTOTP(ctx, user)
+	expectSignInRisk(t, ctx, entities.RiskAssessment{Score: 40, Signals: []string{entities.RiskNewCountry}, Challenge: true})
+
+	mockSignInSessionService.EXPECT().ParseToken("device-token").Return(entities.AccessTokenClaims{
+		entities.UserIdClaimName:   "user-id",
+		entities.ScopeClaimName:    entities.ScopeTrustedDevice,
+		entities.DeviceIdClaimName: "device-id",
+	}, nil)
+	mockSignInTrustedDevices.EXPECT().Select(ctx, "user-id", "device-id").
+		Return(entities.TrustedDevice{Id: "device-id", UserId: "user-id"}, nil)
+	mockSignInTrustedDevices.EXPECT().UpdateUsage(ctx, "device-id").Return(nil)
+	mockSignInMFARepo.EXPECT().SelectTOTP(ctx, "user-id").
+		Return(entities.TOTP{UserId: "user-id", Secret: "encrypted", ConfirmedAt: time.Now()}, nil)
+	mockSignInWebAuthnRepo.EXPECT().SelectCredentials(ctx, "user-id").Return(nil, nil)
+	mockSignInSessionService.EXPECT().CreateRestrictedToken(user, entities.ScopeMFA, authenticatedWith(entities.AuthMethodPassword)).
+		Return("mfa-token", time.Now().Add(10*time.Minute), nil)
+
+	response, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{
+		Email:              "test@mail.ru",
+		Password:           "password123",
+		TrustedDeviceToken: "device-token",
+	}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.True(t, response.MFARequired)
+	assert.Nil(t, response.Session)
+	assert.Equal(t, "mfa-token", response.RestrictedToken.Token)
+}
+
+func TestSignInUseCase_SignIn_RiskChallengeWithoutMFANotifies(t *testing.T) {
+	ctx := context.Background()
+	initSignInMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	session := entities.Session{AccessToken: "access-token", RefreshToken: "refresh-token", UserId: "user-id"}
+	expectSignInWithoutMFA(ctx, user)
+	expectSignInRisk(t, ctx, entities.RiskAssessment{Score: 40, Signals: []string{entities.RiskNewCountry}, Challenge: true})
+	// The user without a second factor can't be challenged, they are told
+	// about the sign in instead.
+	mockSignInRiskEngine.EXPECT().Record(ctx, user, entities.RiskAssessment{
+		Score:     40,
+		Signals:   []string{entities.RiskNewCountry},
+		Notify:    true,
+		Challenge: true,
+	}).Return(nil)
+
+	mockSignInMFARepo.EXPECT().SelectTOTP(ctx, "user-id").Return(entities.TOTP{}, repositories.ErrEntityNotFound)
+	mockSignInWebAuthnRepo.EXPECT().SelectCredentials(ctx, "user-id").Return(nil, nil)
+	mockSignInAttemptRepo.EXPECT().Reset(ctx, "account:test@mail.ru").Return(nil)
+	mockSignInSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	mockSignInSessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodPassword)).Return(session, nil)
+	mockSignInHashService.EXPECT().GenerateHash("refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockSignInSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockSignInCookieService.EXPECT().Set(nil, "access_token", "access-token", session.AccessExpiresAt)
+
+	response, err := newLockoutSignInUseCase().SignIn(ctx, nil, &requests.SignIn{Email: "test@mail.ru", Password: "password123"}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.False(t, response.MFARequired)
+	assert.Equal(t, "access-token", response.Session.AccessToken)
+}
@@ -4,68 +4,213 @@ import (
 	"auth/internal/controllers/requests"
 	"auth/internal/controllers/responses"
 	"auth/internal/entities"
+	"auth/internal/repositories"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 )
 
 type signUpUseCase struct {
 	userRepo       SignUpUserRepository
+	invitationRepo SignUpInvitationRepository
 	sessionRepo    SignUpSessionRepository
 	sessionManager SignUpSessionService
 	hashService    SignUpHashService
+	breachService  SignUpBreachedPasswordService
 	cookieService  SignUpCookieService
+	mailService    SignUpMailService
+	passwordPolicy entities.PasswordPolicy
+	inviteOnly     bool
+	uniform        bool
+	auditLogger    SignUpAuditLogger
 }
 
+const (
+	welcomeMailSubject = "Добро пожаловать"
+	welcomeMailBody    = "Ваш аккаунт создан. Войдите с email и паролем, указанными при регистрации."
+
+	accountExistsMailSubject = "Попытка регистрации"
+	accountExistsMailBody    = "Кто-то попытался зарегистрироваться с вашим email, но аккаунт с ним уже существует. " +
+		"Если это были вы, просто войдите в аккаунт. Если нет, проигнорируйте это письмо."
+)
+
 type SignUpUseCase interface {
 	CreateUser(context context.Context, writer http.ResponseWriter, request requests.SignUp, userAgent, ip string) (responses.SignUp, error)
+	CreateInvitedUser(context context.Context, writer http.ResponseWriter, request requests.SignUpByInvitation, userAgent, ip string) (responses.SignUp, error)
 }
 
 func NewSignUpUseCase(
 	userRepo SignUpUserRepository,
+	invitationRepo SignUpInvitationRepository,
 	sessionRepo SignUpSessionRepository,
 	sessionService SignUpSessionService,
 	hashService SignUpHashService,
+	breachService SignUpBreachedPasswordService,
 	cookieService SignInCookieService,
+	mailService SignUpMailService,
+	passwordPolicy entities.PasswordPolicy,
+	inviteOnly bool,
+	uniform bool,
+	auditLogger SignUpAuditLogger,
 ) SignUpUseCase {
 	return &signUpUseCase{
 		userRepo:       userRepo,
+		invitationRepo: invitationRepo,
 		sessionManager: sessionService,
 		sessionRepo:    sessionRepo,
 		hashService:    hashService,
+		breachService:  breachService,
 		cookieService:  cookieService,
+		mailService:    mailService,
+		passwordPolicy: passwordPolicy,
+		inviteOnly:     inviteOnly,
+		uniform:        uniform,
+		auditLogger:    auditLogger,
 	}
 }
 
 func (u *signUpUseCase) CreateUser(context context.Context, writer http.ResponseWriter, request requests.SignUp, userAgent, ip string) (responses.SignUp, error) {
-	user := entities.NewUser(request.Email, request.Password)
-	err := user.Validate()
+	response, err := u.createUser(context, writer, request, userAgent, ip)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditSignUp, response.Id, err))
+	return response, err
+}
+
+func (u *signUpUseCase) createUser(context context.Context, writer http.ResponseWriter, request requests.SignUp, userAgent, ip string) (responses.SignUp, error) {
+	if u.inviteOnly {
+		return responses.SignUp{}, ErrInviteOnly
+	}
+	if u.uniform {
+		return u.createUserUniformly(context, request)
+	}
+
+	user, err := u.newUser(context, request.Email, request.Password)
 	if err != nil {
-		return responses.SignUp{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+		return responses.SignUp{}, err
 	}
 
-	exists, err := u.userRepo.CheckEmailExists(context, user.Email)
+	user.Id, err = u.userRepo.Insert(context, user)
 	if err != nil {
-		return responses.SignUp{}, fmt.Errorf("%w: failed to check if the email is already taken", err)
+		return responses.SignUp{}, fmt.Errorf("%w: failed to insert user", err)
 	}
-	if exists {
-		return responses.SignUp{}, fmt.Errorf("%w: email has already been taken", ErrEntityAlreadyExists)
+
+	return u.startSession(context, writer, user, entities.Membership{}, userAgent, ip)
+}
+
+// CreateInvitedUser registers the user with the email the invitation was
+// issued for and grants the role and the organization membership from it.
+// It works regardless of the invite-only mode.
+func (u *signUpUseCase) CreateInvitedUser(context context.Context, writer http.ResponseWriter, request requests.SignUpByInvitation, userAgent, ip string) (responses.SignUp, error) {
+	response, err := u.createInvitedUser(context, writer, request, userAgent, ip)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditSignUp, response.Id, err))
+	return response, err
+}
+
+func (u *signUpUseCase) createInvitedUser(context context.Context, writer http.ResponseWriter, request requests.SignUpByInvitation, userAgent, ip string) (responses.SignUp, error) {
+	invitation, err := u.invitationRepo.SelectById(context, request.InvitationId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.SignUp{}, fmt.Errorf("%w: invitation not found", ErrInvalidInvitation)
+		}
+		return responses.SignUp{}, fmt.Errorf("failed to find invitation: %w", err)
 	}
 
-	hashedPassword, err := u.hashService.GenerateHash(request.Password)
+	err = checkInvitation(invitation, request.Token, u.hashService)
 	if err != nil {
-		return responses.SignUp{}, fmt.Errorf("%w: failed to hash the password", err)
+		return responses.SignUp{}, err
 	}
 
-	user.Password = entities.Password(hashedPassword)
+	user, err := u.newUser(context, string(invitation.Email), request.Password)
+	if err != nil {
+		return responses.SignUp{}, err
+	}
+	if invitation.UserRole != "" && invitation.UserRole != entities.RoleUser {
+		user.Roles = append(user.Roles, invitation.UserRole)
+	}
 
-	user.Id, err = u.userRepo.Insert(context, user)
+	userId, err := u.invitationRepo.SignUp(context, invitation, user)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.SignUp{}, fmt.Errorf("%w: invitation has already been used", ErrInvalidInvitation)
+		}
+		return responses.SignUp{}, fmt.Errorf("%w: failed to insert user", err)
+	}
+
+	user, err = u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		return responses.SignUp{}, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	return u.startSession(context, writer, user, invitation.Membership(userId), userAgent, ip)
+}
+
+// createUserUniformly answers the same way whether the email is free or
+// taken, so sign up can't be used to find out who has an account. The new
+// user gets a welcome email and signs in as usual, the owner of the existing
+// account is told about the attempt instead. Both mails are sent in the
+// background, the answer doesn't wait for either of them.
+func (u *signUpUseCase) createUserUniformly(context context.Context, request requests.SignUp) (responses.SignUp, error) {
+	user, err := u.newUser(context, request.Email, request.Password)
+	if errors.Is(err, ErrEntityAlreadyExists) {
+		go u.sendMailInBackground(request.Email, accountExistsMailSubject, accountExistsMailBody)
+		return responses.NewSignUpCheckEmail(), nil
+	}
+	if err != nil {
+		return responses.SignUp{}, err
+	}
+
+	_, err = u.userRepo.Insert(context, user)
 	if err != nil {
 		return responses.SignUp{}, fmt.Errorf("%w: failed to insert user", err)
 	}
 
-	session, err := u.sessionManager.CreateSession(user)
+	go u.sendMailInBackground(string(user.Email), welcomeMailSubject, welcomeMailBody)
+	return responses.NewSignUpCheckEmail(), nil
+}
+
+// sendMailInBackground sends the mail after the request has been answered,
+// the failure is recorded as a failed sign up.
+func (u *signUpUseCase) sendMailInBackground(to, subject, body string) {
+	err := u.mailService.Send(to, subject, body)
+	if err != nil {
+		u.auditLogger.Log(context.Background(), newAuditEvent(entities.AuditSignUp, "", fmt.Errorf("failed to send mail: %w", err)))
+	}
+}
+
+// newUser validates the credentials and returns the user with the hashed
+// password ready to be inserted.
+func (u *signUpUseCase) newUser(context context.Context, email, password string) (entities.User, error) {
+	user := entities.NewUser(email, password)
+	err := user.Validate(u.passwordPolicy)
+	if err != nil {
+		return entities.User{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+	err = checkBreachedPassword(user.Password, u.breachService)
+	if err != nil {
+		return entities.User{}, err
+	}
+
+	// The password is hashed before the email check, a taken email must not
+	// answer faster than a free one.
+	hashedPassword, err := u.hashService.GenerateHash(string(user.Password))
+	if err != nil {
+		return entities.User{}, fmt.Errorf("%w: failed to hash the password", err)
+	}
+	user.Password = entities.Password(hashedPassword)
+
+	exists, err := u.userRepo.CheckEmailExists(context, user.Email)
+	if err != nil {
+		return entities.User{}, fmt.Errorf("%w: failed to check if the email is already taken", err)
+	}
+	if exists {
+		return entities.User{}, fmt.Errorf("%w: email has already been taken", ErrEntityAlreadyExists)
+	}
+	return user, nil
+}
+
+func (u *signUpUseCase) startSession(context context.Context, writer http.ResponseWriter, user entities.User, membership entities.Membership, userAgent, ip string) (responses.SignUp, error) {
+	session, err := u.sessionManager.CreateSession(user, membership, entities.NewAuthentication(entities.AuthMethodPassword))
 	if err != nil {
 		return responses.SignUp{}, fmt.Errorf("%w: failed to create session", err)
 	}
@@ -9,26 +9,37 @@ import (
 	"time"
 
 	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
 	"auth/internal/entities"
+	"auth/internal/repositories"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
 var (
 	mockSignUpUserRepo       *MockSignUpUserRepository
+	mockSignUpInvitationRepo *MockSignUpInvitationRepository
 	mockSignUpSessionRepo    *MockSignUpSessionRepository
 	mockSignUpHashService    *MockSignUpHashService
 	mockSignUpSessionService *MockSignUpSessionService
 	mockSignUpCookieService  *MockSignUpCookieService
+	mockSignUpBreachService  *MockSignUpBreachedPasswordService
+	mockSignUpMailService    *MockSignUpMailService
+	mockSignUpAuditLogger    *MockSignUpAuditLogger
 )
 
 func initSignUpMocks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockSignUpUserRepo = NewMockSignUpUserRepository(ctrl)
+	mockSignUpInvitationRepo = NewMockSignUpInvitationRepository(ctrl)
 	mockSignUpSessionRepo = NewMockSignUpSessionRepository(ctrl)
 	mockSignUpHashService = NewMockSignUpHashService(ctrl)
 	mockSignUpSessionService = NewMockSignUpSessionService(ctrl)
 	mockSignUpCookieService = NewMockSignUpCookieService(ctrl)
+	mockSignUpBreachService = NewMockSignUpBreachedPasswordService(ctrl)
+	mockSignUpMailService = NewMockSignUpMailService(ctrl)
+	mockSignUpAuditLogger = NewMockSignUpAuditLogger(ctrl)
+	mockSignUpAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
 }
 
 func TestSignUpUseCase_CreateUser_Success(t *testing.T) {
@@ -56,20 +67,28 @@ func TestSignUpUseCase_CreateUser_Success(t *testing.T) {
 	expectedRefreshToken := "new-refresh-token"
 	writer := http.ResponseWriter(nil)
 
+	mockSignUpBreachService.EXPECT().IsBreached(gomock.Any()).Return(false, nil)
 	mockSignUpUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email(request.Email)).Return(false, nil)
 	mockSignUpHashService.EXPECT().GenerateHash(request.Password).Return([]byte("hashedpassword"), nil)
 	mockSignUpUserRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.User{})).Return(expectedUserId, nil)
-	mockSignUpSessionService.EXPECT().CreateSession(gomock.AssignableToTypeOf(entities.User{})).Return(session, nil)
+	mockSignUpSessionService.EXPECT().CreateSession(gomock.AssignableToTypeOf(entities.User{}), entities.Membership{}, authenticatedWith(entities.AuthMethodPassword)).Return(session, nil)
 	mockSignUpHashService.EXPECT().GenerateHash(session.RefreshToken).Return([]byte("hashed-refresh-token"), nil)
 	mockSignUpSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
 	mockSignUpCookieService.EXPECT().Set(writer, "access_token", expectedAccessToken, session.AccessExpiresAt)
 
 	useCase := NewSignUpUseCase(
 		mockSignUpUserRepo,
+		mockSignUpInvitationRepo,
 		mockSignUpSessionRepo,
 		mockSignUpSessionService,
 		mockSignUpHashService,
-		mockSignUpCookieService)
+		mockSignUpBreachService,
+		mockSignUpCookieService,
+		mockSignUpMailService,
+		entities.DefaultPasswordPolicy(),
+		false,
+		false,
+		mockSignUpAuditLogger)
 
 	response, err := useCase.CreateUser(ctx, writer, request, userAgent, ip)
 
@@ -87,7 +106,7 @@ func TestSignUpUseCase_CreateUser_InvalidEntity(t *testing.T) {
 		Password: "password123",
 	}
 
-	useCase := signUpUseCase{}
+	useCase := signUpUseCase{auditLogger: mockSignUpAuditLogger}
 
 	response, err := useCase.CreateUser(ctx, nil, request, "", "")
 
@@ -107,11 +126,20 @@ func TestSignUpUseCase_CreateUser_EmailExists(t *testing.T) {
 
 	useCase := NewSignUpUseCase(
 		mockSignUpUserRepo,
+		mockSignUpInvitationRepo,
 		mockSignUpSessionRepo,
 		mockSignUpSessionService,
 		mockSignUpHashService,
-		mockSignUpCookieService)
-
+		mockSignUpBreachService,
+		mockSignUpCookieService,
+		mockSignUpMailService,
+		entities.DefaultPasswordPolicy(),
+		false,
+		false,
+		mockSignUpAuditLogger)
+
+	mockSignUpBreachService.EXPECT().IsBreached(gomock.Any()).Return(false, nil)
+	mockSignUpHashService.EXPECT().GenerateHash("password123").Return([]byte("hashedpassword"), nil)
 	mockSignUpUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("exists@mail.ru")).Return(true, nil)
 
 	response, err := useCase.CreateUser(ctx, nil, request, "", "")
@@ -130,15 +158,22 @@ func TestSignUpUseCase_CreateUser_HashError(t *testing.T) {
 		Password: "password123",
 	}
 
-	mockSignUpUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("test@mail.ru")).Return(false, nil)
+	mockSignUpBreachService.EXPECT().IsBreached(gomock.Any()).Return(false, nil)
 	mockSignUpHashService.EXPECT().GenerateHash("password123").Return(nil, fmt.Errorf("hash error"))
 
 	useCase := NewSignUpUseCase(
 		mockSignUpUserRepo,
+		mockSignUpInvitationRepo,
 		mockSignUpSessionRepo,
 		mockSignUpSessionService,
 		mockSignUpHashService,
-		mockSignUpCookieService)
+		mockSignUpBreachService,
+		mockSignUpCookieService,
+		mockSignUpMailService,
+		entities.DefaultPasswordPolicy(),
+		false,
+		false,
+		mockSignUpAuditLogger)
 
 	response, err := useCase.CreateUser(ctx, nil, request, "", "")
 
@@ -152,8 +187,10 @@ func TestSignUpUseCase_CreateUser_InsertError(t *testing.T) {
 	initSignUpMocks(t)
 
 	useCase := signUpUseCase{
-		userRepo:    mockSignUpUserRepo,
-		hashService: mockSignUpHashService,
+		userRepo:      mockSignUpUserRepo,
+		hashService:   mockSignUpHashService,
+		breachService: mockSignUpBreachService,
+		auditLogger:   mockSignUpAuditLogger,
 	}
 
 	request := requests.SignUp{
@@ -161,6 +198,7 @@ func TestSignUpUseCase_CreateUser_InsertError(t *testing.T) {
 		Password: "password123",
 	}
 
+	mockSignUpBreachService.EXPECT().IsBreached(gomock.Any()).Return(false, nil)
 	mockSignUpUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("test@mail.ru")).Return(false, nil)
 	mockSignUpHashService.EXPECT().GenerateHash("password123").Return([]byte("hashedpassword"), nil)
 	mockSignUpUserRepo.EXPECT().Insert(ctx, gomock.Any()).Return("", fmt.Errorf("insert error"))
@@ -171,3 +209,330 @@ func TestSignUpUseCase_CreateUser_InsertError(t *testing.T) {
 	assert.Empty(t, response.Id)
 	assert.Contains(t, err.Error(), "failed to insert user")
 }
+
+func TestSignUpUseCase_CreateUser_InviteOnly(t *testing.T) {
+	ctx := context.Background()
+	initSignUpMocks(t)
+
+	useCase := signUpUseCase{
+		userRepo:    mockSignUpUserRepo,
+		inviteOnly:  true,
+		auditLogger: mockSignUpAuditLogger,
+	}
+
+	response, err := useCase.CreateUser(ctx, nil, requests.SignUp{Email: "test@mail.ru", Password: "password123"}, "", "")
+
+	assert.ErrorIs(t, err, ErrInviteOnly)
+	assert.Empty(t, response.Id)
+}
+
+func TestSignUpUseCase_CreateInvitedUser_Success(t *testing.T) {
+	ctx := context.Background()
+	initSignUpMocks(t)
+
+	request := requests.SignUpByInvitation{
+		InvitationId: "invitation-id",
+		Token:        "raw-token",
+		Password:     "password123",
+	}
+	invitation := entities.Invitation{
+		Id:             "invitation-id",
+		OrganizationId: "org-id",
+		Email:          "invited@mail.ru",
+		Role:           entities.OrganizationRoleMember,
+		UserRole:       "support",
+		TokenHash:      "hashed-token",
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+	user := entities.User{
+		Id:          "new-user-id",
+		Email:       "invited@mail.ru",
+		Roles:       []string{entities.RoleUser, "support"},
+		Permissions: []string{"tickets:read"},
+	}
+	session := entities.Session{
+		AccessToken:     "new-access-token",
+		RefreshToken:    "new-refresh-token",
+		AccessExpiresAt: time.Now().Add(time.Hour),
+		UserId:          "new-user-id",
+	}
+	writer := http.ResponseWriter(nil)
+
+	mockSignUpInvitationRepo.EXPECT().SelectById(ctx, "invitation-id").Return(invitation, nil)
+	mockSignUpHashService.EXPECT().CompareStringAndHash("raw-token", "hashed-token").Return(true)
+	mockSignUpBreachService.EXPECT().IsBreached(gomock.Any()).Return(false, nil)
+	mockSignUpUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("invited@mail.ru")).Return(false, nil)
+	mockSignUpHashService.EXPECT().GenerateHash("password123").Return([]byte("hashedpassword"), nil)
+	mockSignUpInvitationRepo.EXPECT().SignUp(ctx, invitation, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ entities.Invitation, user entities.User) (string, error) {
+			assert.Equal(t, entities.Email("invited@mail.ru"), user.Email)
+			assert.Equal(t, []string{entities.RoleUser, "support"}, user.Roles)
+			assert.Equal(t, entities.Password("hashedpassword"), user.Password)
+			return "new-user-id", nil
+		})
+	mockSignUpUserRepo.EXPECT().SelectByUserId(ctx, "new-user-id").Return(user, nil)
+	mockSignUpSessionService.EXPECT().CreateSession(user, entities.Membership{
+		OrganizationId: "org-id",
+		UserId:         "new-user-id",
+		Role:           entities.OrganizationRoleMember,
+	}, authenticatedWith(entities.AuthMethodPassword)).Return(session, nil)
+	mockSignUpHashService.EXPECT().GenerateHash(session.RefreshToken).Return([]byte("hashed-refresh-token"), nil)
+	mockSignUpSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockSignUpCookieService.EXPECT().Set(writer, "access_token", session.AccessToken, session.AccessExpiresAt)
+
+	useCase := NewSignUpUseCase(
+		mockSignUpUserRepo,
+		mockSignUpInvitationRepo,
+		mockSignUpSessionRepo,
+		mockSignUpSessionService,
+		mockSignUpHashService,
+		mockSignUpBreachService,
+		mockSignUpCookieService,
+		mockSignUpMailService,
+		entities.DefaultPasswordPolicy(),
+		true,
+		false,
+		mockSignUpAuditLogger)
+
+	response, err := useCase.CreateInvitedUser(ctx, writer, request, "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new-user-id", response.Id)
+	assert.Equal(t, session.AccessToken, response.Session.AccessToken)
+}
+
+func TestSignUpUseCase_CreateInvitedUser_WrongToken(t *testing.T) {
+	ctx := context.Background()
+	initSignUpMocks(t)
+
+	invitation := entities.Invitation{
+		Id:        "invitation-id",
+		Email:     "invited@mail.ru",
+		TokenHash: "hashed-token",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	mockSignUpInvitationRepo.EXPECT().SelectById(ctx, "invitation-id").Return(invitation, nil)
+	mockSignUpHashService.EXPECT().CompareStringAndHash("wrong-token", "hashed-token").Return(false)
+
+	useCase := signUpUseCase{
+		invitationRepo: mockSignUpInvitationRepo,
+		hashService:    mockSignUpHashService,
+		auditLogger:    mockSignUpAuditLogger,
+	}
+
+	_, err := useCase.CreateInvitedUser(ctx, nil, requests.SignUpByInvitation{
+		InvitationId: "invitation-id",
+		Token:        "wrong-token",
+		Password:     "password123",
+	}, "", "")
+
+	assert.ErrorIs(t, err, ErrInvalidInvitation)
+}
+
+func TestSignUpUseCase_CreateInvitedUser_AlreadyUsed(t *testing.T) {
+	ctx := context.Background()
+	initSignUpMocks(t)
+
+	invitation := entities.Invitation{
+		Id:        "invitation-id",
+		Email:     "invited@mail.ru",
+		TokenHash: "hashed-token",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	mockSignUpInvitationRepo.EXPECT().SelectById(ctx, "invitation-id").Return(invitation, nil)
+	mockSignUpHashService.EXPECT().CompareStringAndHash("raw-token", "hashed-token").Return(true)
+	mockSignUpBreachService.EXPECT().IsBreached(gomock.Any()).Return(false, nil)
+	mockSignUpUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("invited@mail.ru")).Return(false, nil)
+	mockSignUpHashService.EXPECT().GenerateHash("password123").Return([]byte("hashedpassword"), nil)
+	mockSignUpInvitationRepo.EXPECT().SignUp(ctx, invitation, gomock.Any()).Return("", repositories.ErrEntityNotFound)
+
+	useCase := signUpUseCase{
+		userRepo:       mockSignUpUserRepo,
+		invitationRepo: mockSignUpInvitationRepo,
+		hashService:    mockSignUpHashService,
+		breachService:  mockSignUpBreachService,
+		auditLogger:    mockSignUpAuditLogger,
+	}
+
+	_, err := useCase.CreateInvitedUser(ctx, nil, requests.SignUpByInvitation{
+		InvitationId: "invitation-id",
+		Token:        "raw-token",
+		Password:     "password123",
+	}, "", "")
+
+	assert.ErrorIs(t, err, ErrInvalidInvitation)
+}
+
+func TestSignUpUseCase_CreateUser_PasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	initSignUpMocks(t)
+
+	policy := entities.DefaultPasswordPolicy()
+	policy.MinLength = 12
+	policy.RequireSymbol = true
+
+	useCase := signUpUseCase{passwordPolicy: policy, auditLogger: mockSignUpAuditLogger}
+
+	testCases := []struct {
+		name     string
+		password string
+	}{
+		{name: "too short", password: "short1!"},
+		{name: "no digit", password: "long-passphrase-only"},
+		{name: "no symbol", password: "longpassphrase123"},
+		{name: "contains email", password: "Test@Mail.ru-2024!"},
+		{name: "contains email local part", password: "my-test-password-1"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := useCase.CreateUser(ctx, nil, requests.SignUp{Email: "test@mail.ru", Password: testCase.password}, "", "")
+
+			assert.ErrorIs(t, err, ErrInvalidEntity)
+		})
+	}
+}
+
+func TestSignUpUseCase_CreateUser_NormalizesPassword(t *testing.T) {
+	ctx := context.Background()
+	initSignUpMocks(t)
+
+	useCase := signUpUseCase{
+		userRepo:       mockSignUpUserRepo,
+		hashService:    mockSignUpHashService,
+		breachService:  mockSignUpBreachService,
+		passwordPolicy: entities.DefaultPasswordPolicy(),
+		auditLogger:    mockSignUpAuditLogger,
+	}
+
+	mockSignUpBreachService.EXPECT().IsBreached(gomock.Any()).Return(false, nil)
+	mockSignUpHashService.EXPECT().GenerateHash("passphrase123").Return(nil, fmt.Errorf("hash error"))
+
+	_, err := useCase.CreateUser(ctx, nil, requests.SignUp{Email: "test@mail.ru", Password: "ｐａｓｓｐｈｒａｓｅ１２３"}, "", "")
+
+	assert.ErrorContains(t, err, "failed to hash the password")
+}
+
+func TestSignUpUseCase_CreateUser_BreachedPassword(t *testing.T) {
+	ctx := context.Background()
+	initSignUpMocks(t)
+
+	useCase := signUpUseCase{
+		userRepo:       mockSignUpUserRepo,
+		breachService:  mockSignUpBreachService,
+		passwordPolicy: entities.DefaultPasswordPolicy(),
+		auditLogger:    mockSignUpAuditLogger,
+	}
+
+	mockSignUpBreachService.EXPECT().IsBreached("password123").Return(true, nil)
+
+	_, err := useCase.CreateUser(ctx, nil, requests.SignUp{Email: "test@mail.ru", Password: "password123"}, "", "")
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+	assert.ErrorContains(t, err, "data breach")
+}
+
+func newUniformSignUpUseCase() SignUpUseCase {
+	return NewSignUpUseCase(
+		mockSignUpUserRepo,
+		mockSignUpInvitationRepo,
+		mockSignUpSessionRepo,
+		mockSignUpSessionService,
+		mockSignUpHashService,
+		mockSignUpBreachService,
+		mockSignUpCookieService,
+		mockSignUpMailService,
+		entities.DefaultPasswordPolicy(),
+		false,
+		true,
+		mockSignUpAuditLogger)
+}
+
+func TestSignUpUseCase_CreateUser_UniformNewEmail(t *testing.T) {
+	ctx := context.Background()
+	initSignUpMocks(t)
+
+	request := requests.SignUp{Email: "new@mail.ru", Password: "password123"}
+	sent := make(chan struct{})
+
+	mockSignUpBreachService.EXPECT().IsBreached("password123").Return(false, nil)
+	mockSignUpHashService.EXPECT().GenerateHash("password123").Return([]byte("hashedpassword"), nil)
+	mockSignUpUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("new@mail.ru")).Return(false, nil)
+	mockSignUpUserRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.User{})).Return("new-user-id", nil)
+	mockSignUpMailService.EXPECT().Send("new@mail.ru", welcomeMailSubject, welcomeMailBody).
+		DoAndReturn(func(_, _, _ string) error {
+			close(sent)
+			return nil
+		})
+
+	response, err := newUniformSignUpUseCase().CreateUser(ctx, nil, request, "", "")
+
+	assert.NoError(t, err)
+	assert.True(t, response.CheckEmail)
+	assert.Empty(t, response.Id)
+	assert.Nil(t, response.Session)
+	waitSignUpMail(t, sent)
+}
+
+func TestSignUpUseCase_CreateUser_UniformTakenEmail(t *testing.T) {
+	ctx := context.Background()
+	initSignUpMocks(t)
+
+	request := requests.SignUp{Email: "exists@mail.ru", Password: "password123"}
+	sent := make(chan struct{})
+
+	mockSignUpBreachService.EXPECT().IsBreached("password123").Return(false, nil)
+	mockSignUpHashService.EXPECT().GenerateHash("password123").Return([]byte("hashedpassword"), nil)
+	mockSignUpUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("exists@mail.ru")).Return(true, nil)
+	mockSignUpMailService.EXPECT().Send("exists@mail.ru", accountExistsMailSubject, accountExistsMailBody).
+		DoAndReturn(func(_, _, _ string) error {
+			close(sent)
+			return nil
+		})
+
+	response, err := newUniformSignUpUseCase().CreateUser(ctx, nil, request, "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, responses.NewSignUpCheckEmail(), response)
+	waitSignUpMail(t, sent)
+}
+
+func TestSignUpUseCase_CreateUser_UniformMailErrorIsRecorded(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	initSignUpMocks(t)
+	auditLogger := NewMockSignUpAuditLogger(ctrl)
+	recorded := make(chan struct{})
+
+	mockSignUpBreachService.EXPECT().IsBreached("password123").Return(false, nil)
+	mockSignUpHashService.EXPECT().GenerateHash("password123").Return([]byte("hashedpassword"), nil)
+	mockSignUpUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("exists@mail.ru")).Return(true, nil)
+	mockSignUpMailService.EXPECT().Send("exists@mail.ru", accountExistsMailSubject, accountExistsMailBody).
+		Return(fmt.Errorf("smtp is down"))
+	auditLogger.EXPECT().Log(ctx, entities.AuditEvent{Type: entities.AuditSignUp, Outcome: entities.AuditOutcomeSuccess})
+	auditLogger.EXPECT().Log(gomock.Any(), entities.AuditEvent{
+		Type:    entities.AuditSignUp,
+		Outcome: entities.AuditOutcomeFailure,
+		Reason:  auditInternalError,
+	}).Do(func(context.Context, entities.AuditEvent) { close(recorded) })
+
+	// The answer is the same while the mail server fails.
+	useCase := NewSignUpUseCase(mockSignUpUserRepo, mockSignUpInvitationRepo, mockSignUpSessionRepo, mockSignUpSessionService,
+		mockSignUpHashService, mockSignUpBreachService, mockSignUpCookieService, mockSignUpMailService,
+		entities.DefaultPasswordPolicy(), false, true, auditLogger)
+	response, err := useCase.CreateUser(ctx, nil, requests.SignUp{Email: "exists@mail.ru", Password: "password123"}, "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, responses.NewSignUpCheckEmail(), response)
+	waitSignUpMail(t, recorded)
+}
+
+// waitSignUpMail waits for the mail sent in the background.
+func waitSignUpMail(t *testing.T, sent <-chan struct{}) {
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("the sign up mail has not been sent")
+	}
+}
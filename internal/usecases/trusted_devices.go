@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type trustedDevicesUseCase struct {
+	trustedDeviceRepo TrustedDevicesRepository
+	auditLogger       TrustedDevicesAuditLogger
+}
+
+// TrustedDevicesUseCase lets the user see the devices that skip the second
+// factor at sign in and revoke them.
+type TrustedDevicesUseCase interface {
+	List(context context.Context, userId string) ([]responses.TrustedDevice, error)
+	Revoke(context context.Context, userId, deviceId string) error
+	RevokeAll(context context.Context, userId string) error
+}
+
+func NewTrustedDevicesUseCase(
+	trustedDeviceRepo TrustedDevicesRepository,
+	auditLogger TrustedDevicesAuditLogger,
+) TrustedDevicesUseCase {
+	return &trustedDevicesUseCase{
+		trustedDeviceRepo: trustedDeviceRepo,
+		auditLogger:       auditLogger,
+	}
+}
+
+func (u *trustedDevicesUseCase) List(context context.Context, userId string) ([]responses.TrustedDevice, error) {
+	devices, err := u.trustedDeviceRepo.SelectByUserId(context, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select trusted devices: %w", err)
+	}
+
+	result := make([]responses.TrustedDevice, 0, len(devices))
+	for _, device := range devices {
+		result = append(result, responses.NewTrustedDevice(device))
+	}
+	return result, nil
+}
+
+func (u *trustedDevicesUseCase) Revoke(context context.Context, userId, deviceId string) error {
+	err := u.revoke(context, userId, deviceId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditTrustedDeviceRevoke, userId, err))
+	return err
+}
+
+func (u *trustedDevicesUseCase) revoke(context context.Context, userId, deviceId string) error {
+	err := u.trustedDeviceRepo.Delete(context, userId, deviceId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to find trusted device: %w", ErrEntityNotFound)
+		}
+		return fmt.Errorf("failed to delete trusted device: %w", err)
+	}
+	return nil
+}
+
+func (u *trustedDevicesUseCase) RevokeAll(context context.Context, userId string) error {
+	err := u.revokeAll(context, userId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditTrustedDeviceRevoke, userId, err))
+	return err
+}
+
+func (u *trustedDevicesUseCase) revokeAll(context context.Context, userId string) error {
+	err := u.trustedDeviceRepo.DeleteByUserId(context, userId)
+	if err != nil {
+		return fmt.Errorf("failed to delete trusted devices: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrustedDevicesUseCase_List(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	repo := NewMockTrustedDevicesRepository(ctrl)
+	auditLogger := NewMockTrustedDevicesAuditLogger(ctrl)
+	auditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	createdAt := time.Now().Add(-time.Hour)
+	repo.EXPECT().SelectByUserId(ctx, "user-id").Return([]entities.TrustedDevice{
+		{Id: "device-id", UserId: "user-id", UserAgent: "test-agent", IP: "10.0.0.1", CreatedAt: createdAt},
+	}, nil)
+
+	result, err := NewTrustedDevicesUseCase(repo, auditLogger).List(ctx, "user-id")
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "device-id", result[0].Id)
+	assert.Equal(t, "test-agent", result[0].UserAgent)
+	assert.Equal(t, createdAt, result[0].CreatedAt)
+}
+
+func TestTrustedDevicesUseCase_List_Empty(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	repo := NewMockTrustedDevicesRepository(ctrl)
+	auditLogger := NewMockTrustedDevicesAuditLogger(ctrl)
+	auditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo.EXPECT().SelectByUserId(ctx, "user-id").Return(nil, nil)
+
+	result, err := NewTrustedDevicesUseCase(repo, auditLogger).List(ctx, "user-id")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
+}
+
+func TestTrustedDevicesUseCase_Revoke(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	repo := NewMockTrustedDevicesRepository(ctrl)
+	auditLogger := NewMockTrustedDevicesAuditLogger(ctrl)
+	auditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo.EXPECT().Delete(ctx, "user-id", "device-id").Return(nil)
+
+	err := NewTrustedDevicesUseCase(repo, auditLogger).Revoke(ctx, "user-id", "device-id")
+
+	assert.NoError(t, err)
+}
+
+func TestTrustedDevicesUseCase_Revoke_NotFound(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	repo := NewMockTrustedDevicesRepository(ctrl)
+	auditLogger := NewMockTrustedDevicesAuditLogger(ctrl)
+	auditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo.EXPECT().Delete(ctx, "user-id", "device-id").Return(repositories.ErrEntityNotFound)
+
+	err := NewTrustedDevicesUseCase(repo, auditLogger).Revoke(ctx, "user-id", "device-id")
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestTrustedDevicesUseCase_RevokeAll(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	repo := NewMockTrustedDevicesRepository(ctrl)
+	auditLogger := NewMockTrustedDevicesAuditLogger(ctrl)
+	auditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo.EXPECT().DeleteByUserId(ctx, "user-id").Return(fmt.Errorf("db error"))
+
+	err := NewTrustedDevicesUseCase(repo, auditLogger).RevokeAll(ctx, "user-id")
+
+	assert.ErrorContains(t, err, "db error")
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+type updateRoleUseCase struct {
+	roleRepo       UpdateRoleRoleRepository
+	permissionRepo UpdateRolePermissionRepository
+	sessionRepo    UpdateRoleSessionRepository
+	auditLogger    UpdateRoleAuditLogger
+}
+
+type UpdateRoleUseCase interface {
+	UpdateRole(context context.Context, roleId int, request requests.UpdateRole) (responses.Role, error)
+}
+
+func NewUpdateRoleUseCase(
+	roleRepo UpdateRoleRoleRepository,
+	permissionRepo UpdateRolePermissionRepository,
+	sessionRepo UpdateRoleSessionRepository,
+	auditLogger UpdateRoleAuditLogger,
+) UpdateRoleUseCase {
+	return &updateRoleUseCase{
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+		sessionRepo:    sessionRepo,
+		auditLogger:    auditLogger,
+	}
+}
+
+func (u *updateRoleUseCase) UpdateRole(context context.Context, roleId int, request requests.UpdateRole) (responses.Role, error) {
+	response, err := u.updateRole(context, roleId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditRoleUpdate, strconv.Itoa(roleId), err))
+	return response, err
+}
+
+func (u *updateRoleUseCase) updateRole(context context.Context, roleId int, request requests.UpdateRole) (responses.Role, error) {
+	role, err := u.roleRepo.SelectById(context, roleId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.Role{}, fmt.Errorf("failed to find role: %w", ErrEntityNotFound)
+		}
+		return responses.Role{}, fmt.Errorf("failed to find role: %w", err)
+	}
+
+	permissions := role.Permissions
+	if request.Description != nil {
+		role.Description = *request.Description
+	}
+	if request.Permissions != nil {
+		role.Permissions = *request.Permissions
+	}
+
+	err = role.Validate()
+	if err != nil {
+		return responses.Role{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	err = checkPermissionsExist(context, u.permissionRepo, role.Permissions)
+	if err != nil {
+		return responses.Role{}, err
+	}
+
+	err = u.roleRepo.Update(context, role)
+	if err != nil {
+		return responses.Role{}, fmt.Errorf("failed to update role: %w", err)
+	}
+
+	// The permissions are in the claims of the issued tokens, the holders of
+	// the role sign in again to get the new ones.
+	if !sameNames(permissions, role.Permissions) {
+		err = u.sessionRepo.DeleteByRoleId(context, roleId)
+		if err != nil {
+			return responses.Role{}, fmt.Errorf("failed to delete sessions: %w", err)
+		}
+	}
+
+	return newRoleResponse(role), nil
+}
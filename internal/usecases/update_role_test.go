@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockUpdateRoleRoleRepo       *MockUpdateRoleRoleRepository
+	mockUpdateRolePermissionRepo *MockUpdateRolePermissionRepository
+	mockUpdateRoleSessionRepo    *MockUpdateRoleSessionRepository
+	mockUpdateRoleAuditLogger    *MockUpdateRoleAuditLogger
+)
+
+func initUpdateRoleMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUpdateRoleRoleRepo = NewMockUpdateRoleRoleRepository(ctrl)
+	mockUpdateRolePermissionRepo = NewMockUpdateRolePermissionRepository(ctrl)
+	mockUpdateRoleSessionRepo = NewMockUpdateRoleSessionRepository(ctrl)
+	mockUpdateRoleAuditLogger = NewMockUpdateRoleAuditLogger(ctrl)
+	mockUpdateRoleAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func TestUpdateRoleUseCase_UpdateRole_Success(t *testing.T) {
+	ctx := context.Background()
+	initUpdateRoleMocks(t)
+
+	permissions := []string{entities.PermissionUsersRead, entities.PermissionSessionsRevoke}
+	request := requests.UpdateRole{Permissions: &permissions}
+	role := entities.Role{Id: 3, Name: "support", Description: "support team"}
+	updated := role
+	updated.Permissions = permissions
+
+	mockUpdateRoleRoleRepo.EXPECT().SelectById(ctx, 3).Return(role, nil)
+	mockUpdateRolePermissionRepo.EXPECT().SelectByNames(ctx, permissions).Return([]entities.Permission{
+		{Id: 1, Name: entities.PermissionUsersRead},
+		{Id: 4, Name: entities.PermissionSessionsRevoke},
+	}, nil)
+	mockUpdateRoleRoleRepo.EXPECT().Update(ctx, updated).Return(nil)
+	mockUpdateRoleSessionRepo.EXPECT().DeleteByRoleId(ctx, 3).Return(nil)
+
+	useCase := NewUpdateRoleUseCase(mockUpdateRoleRoleRepo, mockUpdateRolePermissionRepo, mockUpdateRoleSessionRepo, mockUpdateRoleAuditLogger)
+
+	result, err := useCase.UpdateRole(ctx, 3, request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "support team", result.Description)
+	assert.Equal(t, permissions, result.Permissions)
+}
+
+func TestUpdateRoleUseCase_UpdateRole_SamePermissions(t *testing.T) {
+	ctx := context.Background()
+	initUpdateRoleMocks(t)
+
+	description := "support team"
+	request := requests.UpdateRole{Description: &description}
+	role := entities.Role{Id: 3, Name: "support", Permissions: []string{entities.PermissionUsersRead}}
+	updated := role
+	updated.Description = description
+
+	mockUpdateRoleRoleRepo.EXPECT().SelectById(ctx, 3).Return(role, nil)
+	mockUpdateRolePermissionRepo.EXPECT().SelectByNames(ctx, role.Permissions).
+		Return([]entities.Permission{{Id: 1, Name: entities.PermissionUsersRead}}, nil)
+	mockUpdateRoleRoleRepo.EXPECT().Update(ctx, updated).Return(nil)
+
+	useCase := NewUpdateRoleUseCase(mockUpdateRoleRoleRepo, mockUpdateRolePermissionRepo, mockUpdateRoleSessionRepo, mockUpdateRoleAuditLogger)
+
+	result, err := useCase.UpdateRole(ctx, 3, request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, description, result.Description)
+}
+
+func TestUpdateRoleUseCase_UpdateRole_NotFound(t *testing.T) {
+	ctx := context.Background()
+	initUpdateRoleMocks(t)
+
+	mockUpdateRoleRoleRepo.EXPECT().SelectById(ctx, 3).Return(entities.Role{}, repositories.ErrEntityNotFound)
+
+	useCase := NewUpdateRoleUseCase(mockUpdateRoleRoleRepo, mockUpdateRolePermissionRepo, mockUpdateRoleSessionRepo, mockUpdateRoleAuditLogger)
+
+	_, err := useCase.UpdateRole(ctx, 3, requests.UpdateRole{})
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type updateUserUseCase struct {
+	userRepo    UpdateUserUserRepository
+	sessionRepo UpdateUserSessionRepository
+	auditLogger UpdateUserAuditLogger
+}
+
+type UpdateUserUseCase interface {
+	UpdateUser(context context.Context, userId string, request requests.UpdateUser) (responses.User, error)
+}
+
+func NewUpdateUserUseCase(
+	userRepo UpdateUserUserRepository,
+	sessionRepo UpdateUserSessionRepository,
+	auditLogger UpdateUserAuditLogger,
+) UpdateUserUseCase {
+	return &updateUserUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (u *updateUserUseCase) UpdateUser(context context.Context, userId string, request requests.UpdateUser) (responses.User, error) {
+	response, err := u.updateUser(context, userId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditUserUpdate, userId, err))
+	return response, err
+}
+
+func (u *updateUserUseCase) updateUser(context context.Context, userId string, request requests.UpdateUser) (responses.User, error) {
+	user, err := u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.User{}, fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+		}
+		return responses.User{}, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if request.Email != nil && entities.Email(*request.Email) != user.Email {
+		email := entities.Email(*request.Email)
+		err = email.Validate()
+		if err != nil {
+			return responses.User{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+		}
+
+		exists, err := u.userRepo.CheckEmailExists(context, email)
+		if err != nil {
+			return responses.User{}, fmt.Errorf("%w: failed to check if the email is already taken", err)
+		}
+		if exists {
+			return responses.User{}, fmt.Errorf("%w: email has already been taken", ErrEntityAlreadyExists)
+		}
+		user.Email = email
+	}
+
+	if request.Status != nil {
+		err = user.SetStatus(entities.UserStatus(*request.Status), request.Reason, request.LockedUntil)
+		if err != nil {
+			return responses.User{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+		}
+	}
+
+	err = u.userRepo.Update(context, user)
+	if err != nil {
+		return responses.User{}, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	if user.Status != entities.UserStatusActive {
+		err = u.sessionRepo.DeleteByUserId(context, user.Id)
+		if err != nil && !errors.Is(err, repositories.ErrSessionNotFound) {
+			return responses.User{}, fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+	}
+
+	return newUserResponse(user), nil
+}
@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockUpdateUserRepo        *MockUpdateUserUserRepository
+	mockUpdateUserSessionRepo *MockUpdateUserSessionRepository
+	mockUpdateUserAuditLogger *MockUpdateUserAuditLogger
+)
+
+func initUpdateUserMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUpdateUserRepo = NewMockUpdateUserUserRepository(ctrl)
+	mockUpdateUserSessionRepo = NewMockUpdateUserSessionRepository(ctrl)
+	mockUpdateUserAuditLogger = NewMockUpdateUserAuditLogger(ctrl)
+	mockUpdateUserAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestUpdateUserUseCase_UpdateUser_BanRevokesSessions(t *testing.T) {
+	ctx := context.Background()
+	initUpdateUserMocks(t)
+
+	user := entities.User{
+		Id:     "user-id",
+		Email:  "test@mail.ru",
+		Status: entities.UserStatusActive,
+	}
+	request := requests.UpdateUser{
+		Status: strPtr("banned"),
+		Reason: "spam",
+	}
+
+	mockUpdateUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	mockUpdateUserRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, updated entities.User) error {
+			assert.Equal(t, entities.UserStatusBanned, updated.Status)
+			assert.Equal(t, "spam", updated.StatusReason)
+			return nil
+		})
+	mockUpdateUserSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(repositories.ErrSessionNotFound)
+
+	useCase := NewUpdateUserUseCase(mockUpdateUserRepo, mockUpdateUserSessionRepo, mockUpdateUserAuditLogger)
+
+	result, err := useCase.UpdateUser(ctx, "user-id", request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "banned", result.Status)
+}
+
+func TestUpdateUserUseCase_UpdateUser_ActivateKeepsSessions(t *testing.T) {
+	ctx := context.Background()
+	initUpdateUserMocks(t)
+
+	user := entities.User{
+		Id:           "user-id",
+		Status:       entities.UserStatusDisabled,
+		StatusReason: "requested by user",
+	}
+	request := requests.UpdateUser{Status: strPtr("active"), Reason: "ignored"}
+
+	mockUpdateUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	mockUpdateUserRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, updated entities.User) error {
+			assert.Equal(t, entities.UserStatusActive, updated.Status)
+			assert.Empty(t, updated.StatusReason)
+			return nil
+		})
+
+	useCase := NewUpdateUserUseCase(mockUpdateUserRepo, mockUpdateUserSessionRepo, mockUpdateUserAuditLogger)
+
+	result, err := useCase.UpdateUser(ctx, "user-id", request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "active", result.Status)
+}
+
+func TestUpdateUserUseCase_UpdateUser_EmailTaken(t *testing.T) {
+	ctx := context.Background()
+	initUpdateUserMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "old@mail.ru"}
+
+	mockUpdateUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	mockUpdateUserRepo.EXPECT().CheckEmailExists(ctx, entities.Email("new@mail.ru")).Return(true, nil)
+
+	useCase := NewUpdateUserUseCase(mockUpdateUserRepo, mockUpdateUserSessionRepo, mockUpdateUserAuditLogger)
+
+	_, err := useCase.UpdateUser(ctx, "user-id", requests.UpdateUser{Email: strPtr("new@mail.ru")})
+
+	assert.ErrorIs(t, err, ErrEntityAlreadyExists)
+}
+
+func TestUpdateUserUseCase_UpdateUser_InvalidValues(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		request requests.UpdateUser
+	}{
+		{name: "email", request: requests.UpdateUser{Email: strPtr("not-an-email")}},
+		{name: "status", request: requests.UpdateUser{Status: strPtr("deleted")}},
+		{name: "lock without deadline", request: requests.UpdateUser{Status: strPtr("locked")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initUpdateUserMocks(t)
+			mockUpdateUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{Id: "user-id"}, nil)
+
+			useCase := NewUpdateUserUseCase(mockUpdateUserRepo, mockUpdateUserSessionRepo, mockUpdateUserAuditLogger)
+
+			_, err := useCase.UpdateUser(ctx, "user-id", tt.request)
+
+			assert.ErrorIs(t, err, ErrInvalidEntity)
+		})
+	}
+}
+
+func TestUpdateUserUseCase_UpdateUser_RevokeError(t *testing.T) {
+	ctx := context.Background()
+	initUpdateUserMocks(t)
+
+	request := requests.UpdateUser{Status: strPtr("locked"), LockedUntil: time.Now().Add(time.Hour)}
+
+	mockUpdateUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{Id: "user-id"}, nil)
+	mockUpdateUserRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+	mockUpdateUserSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(fmt.Errorf("database error"))
+
+	useCase := NewUpdateUserUseCase(mockUpdateUserRepo, mockUpdateUserSessionRepo, mockUpdateUserAuditLogger)
+
+	_, err := useCase.UpdateUser(ctx, "user-id", request)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to revoke sessions")
+}
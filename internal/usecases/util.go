@@ -1,18 +1,690 @@
 package usecases
 
 import (
-	"encoding/json"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
 )
 
-func sendWebhook(userId, ip string) {
-	message := map[string]string{
-		"user_id":    userId,
-		"ip_address": ip,
-		"timestamp":  time.Now().Format("2006-01-02 15:04:05"),
+func newUserResponse(user entities.User) responses.User {
+	return responses.User{
+		Id:               user.Id,
+		Email:            string(user.Email),
+		RegistrationDate: user.RegistrationDate,
+		Roles:            user.Roles,
+		Permissions:      user.Permissions,
+		Status:           string(user.Status),
+		StatusReason:     user.StatusReason,
+		LockedUntil:      user.LockedUntil,
+		Phone:            string(user.Phone),
+		PhoneVerifiedAt:  user.PhoneVerifiedAt,
 	}
-	body, _ := json.Marshal(message)
-	http.Post("http://localhost:8080/auth/webhook", "application/json", strings.NewReader(string(body)))
+}
+
+func newAuditEventResponse(event entities.AuditEvent) responses.AuditEvent {
+	return responses.AuditEvent{
+		Id:        event.Id,
+		Type:      event.Type,
+		ActorId:   event.ActorId,
+		SubjectId: event.SubjectId,
+		IP:        event.IP,
+		UserAgent: event.UserAgent,
+		Outcome:   event.Outcome,
+		Reason:    event.Reason,
+		CreatedAt: event.CreatedAt,
+	}
+}
+
+func newRoleResponse(role entities.Role) responses.Role {
+	return responses.Role{
+		Id:          role.Id,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+	}
+}
+
+func newPermissionResponse(permission entities.Permission) responses.Permission {
+	return responses.Permission{
+		Id:          permission.Id,
+		Name:        permission.Name,
+		Description: permission.Description,
+	}
+}
+
+func newMembershipResponse(membership entities.Membership) responses.Membership {
+	return responses.Membership{
+		OrganizationId:   membership.OrganizationId,
+		OrganizationName: membership.OrganizationName,
+		Role:             membership.Role,
+		JoinedAt:         membership.JoinedAt,
+	}
+}
+
+func newMemberResponse(membership entities.Membership) responses.Member {
+	return responses.Member{
+		UserId:   membership.UserId,
+		Email:    string(membership.Email),
+		Role:     membership.Role,
+		JoinedAt: membership.JoinedAt,
+	}
+}
+
+// selectMembership returns the membership of the user in the organization.
+// An empty organization id yields an empty membership, i.e. a session
+// without an active organization.
+func selectMembership(context context.Context, organizationRepo SignInOrganizationRepository, organizationId, userId string) (entities.Membership, error) {
+	if organizationId == "" {
+		return entities.Membership{}, nil
+	}
+
+	membership, err := organizationRepo.SelectMember(context, organizationId, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return entities.Membership{}, fmt.Errorf("%w: not a member of organization %s", ErrOrganizationAccessDenied, organizationId)
+		}
+		return entities.Membership{}, fmt.Errorf("failed to find membership: %w", err)
+	}
+	return membership, nil
+}
+
+// checkBreachedPassword rejects passwords found in the breached passwords
+// corpus.
+func checkBreachedPassword(password entities.Password, breachService SignUpBreachedPasswordService) error {
+	breached, err := breachService.IsBreached(string(password))
+	if err != nil {
+		return fmt.Errorf("failed to check the password against breached passwords: %w", err)
+	}
+	if breached {
+		return fmt.Errorf("%w: password has appeared in a data breach or is too common, choose another one", ErrInvalidEntity)
+	}
+	return nil
+}
+
+// checkInvitation verifies the invitation token and that the invitation can
+// still be used.
+func checkInvitation(invitation entities.Invitation, token string, hashService AcceptInvitationHashService) error {
+	if !hashService.CompareStringAndHash(token, invitation.TokenHash) {
+		return fmt.Errorf("%w: wrong token", ErrInvalidInvitation)
+	}
+	if invitation.IsAccepted() {
+		return fmt.Errorf("%w: invitation has already been used", ErrInvalidInvitation)
+	}
+	if invitation.IsExpired(time.Now()) {
+		return fmt.Errorf("%w: invitation is expired", ErrInvalidInvitation)
+	}
+	return nil
+}
+
+// issueInvitation stores the invitation with a hash of a fresh token and
+// returns the raw token only once, just like it is done for refresh tokens.
+func issueInvitation(
+	context context.Context,
+	invitationRepo CreateInvitationInvitationRepository,
+	hashService CreateInvitationHashService,
+	randomService CreateInvitationRandomService,
+	invitation entities.Invitation,
+) (responses.Invitation, error) {
+	err := invitation.Validate()
+	if err != nil {
+		return responses.Invitation{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	token, err := randomService.GenerateToken()
+	if err != nil {
+		return responses.Invitation{}, fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+	tokenHash, err := hashService.GenerateHash(token)
+	if err != nil {
+		return responses.Invitation{}, fmt.Errorf("failed to hash invitation token: %w", err)
+	}
+	invitation.TokenHash = string(tokenHash)
+
+	invitation.Id, err = invitationRepo.Insert(context, invitation)
+	if err != nil {
+		return responses.Invitation{}, fmt.Errorf("failed to insert invitation: %w", err)
+	}
+
+	return responses.Invitation{
+		Id:             invitation.Id,
+		OrganizationId: invitation.OrganizationId,
+		Email:          string(invitation.Email),
+		Role:           invitation.Role,
+		UserRole:       invitation.UserRole,
+		Token:          token,
+		ExpiresAt:      invitation.ExpiresAt,
+	}, nil
+}
+
+const (
+	smsCodeDigits  = 6
+	smsCodeMessage = "Код подтверждения: %s. Код действует %d мин. Никому его не сообщайте."
+)
+
+// sendSMSCode sends a new code to the phone, it replaces the pending code of
+// the user for the same purpose. The codes sent to one number are limited
+// whoever requests them, so the service can't be used to flood a phone.
+func sendSMSCode(
+	context context.Context,
+	codeRepo VerifyPhoneSMSCodeRepository,
+	rateLimitRepo VerifyPhoneRateLimitRepository,
+	hashService VerifyPhoneHashService,
+	randomService VerifyPhoneRandomService,
+	sender VerifyPhoneSMSSender,
+	policy entities.SMSPolicy,
+	userId string,
+	phone entities.Phone,
+	purpose string,
+) (responses.SMSCodeSent, error) {
+	if policy.NumberLimit.Enabled() {
+		result, err := rateLimitRepo.Take(context, entities.SMSNumberKey(phone), policy.NumberLimit)
+		if err != nil {
+			return responses.SMSCodeSent{}, fmt.Errorf("failed to take sms rate limit: %w", err)
+		}
+		if !result.Allowed {
+			return responses.SMSCodeSent{}, &RetryAfterError{Err: ErrTooManySMS, RetryAfter: result.RetryAfter}
+		}
+	}
+
+	code, err := randomService.GenerateCode(smsCodeDigits)
+	if err != nil {
+		return responses.SMSCodeSent{}, fmt.Errorf("failed to generate sms code: %w", err)
+	}
+	codeHash, err := hashService.GenerateHash(code)
+	if err != nil {
+		return responses.SMSCodeSent{}, fmt.Errorf("%w: failed to hash sms code", err)
+	}
+
+	smsCode := entities.SMSCode{
+		UserId:    userId,
+		Phone:     phone,
+		Purpose:   purpose,
+		CodeHash:  string(codeHash),
+		ExpiresAt: time.Now().Add(policy.CodeTTL),
+	}
+	err = codeRepo.SaveCode(context, smsCode)
+	if err != nil {
+		return responses.SMSCodeSent{}, fmt.Errorf("failed to save sms code: %w", err)
+	}
+
+	err = sender.Send(string(phone), fmt.Sprintf(smsCodeMessage, code, int(policy.CodeTTL.Minutes())))
+	if err != nil {
+		return responses.SMSCodeSent{}, fmt.Errorf("failed to send sms: %w", err)
+	}
+
+	return responses.SMSCodeSent{Phone: phone.Masked(), ExpiresAt: smsCode.ExpiresAt}, nil
+}
+
+// checkSMSCode compares the code with the pending one and uses it up. The
+// pending code is dropped after too many wrong attempts, a new one has to be
+// requested then.
+func checkSMSCode(
+	context context.Context,
+	codeRepo VerifyPhoneSMSCodeRepository,
+	hashService VerifyPhoneHashService,
+	policy entities.SMSPolicy,
+	userId, purpose, code string,
+) (entities.SMSCode, error) {
+	smsCode, err := codeRepo.SelectCode(context, userId, purpose)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return entities.SMSCode{}, fmt.Errorf("%w: the code has expired", ErrInvalidSMSCode)
+		}
+		return entities.SMSCode{}, fmt.Errorf("failed to select sms code: %w", err)
+	}
+
+	if !hashService.CompareStringAndHash(strings.TrimSpace(code), smsCode.CodeHash) {
+		attempts, err := codeRepo.RegisterCodeFailure(context, smsCode.Id)
+		if err != nil && !errors.Is(err, repositories.ErrEntityNotFound) {
+			return entities.SMSCode{}, fmt.Errorf("failed to register sms code failure: %w", err)
+		}
+		if err == nil && attempts >= policy.MaxAttempts {
+			err = codeRepo.DeleteCode(context, smsCode.Id)
+			if err != nil && !errors.Is(err, repositories.ErrEntityNotFound) {
+				return entities.SMSCode{}, fmt.Errorf("failed to delete sms code: %w", err)
+			}
+		}
+		return entities.SMSCode{}, ErrInvalidSMSCode
+	}
+
+	err = codeRepo.DeleteCode(context, smsCode.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return entities.SMSCode{}, fmt.Errorf("%w: the code has already been used", ErrInvalidSMSCode)
+		}
+		return entities.SMSCode{}, fmt.Errorf("failed to delete sms code: %w", err)
+	}
+	return smsCode, nil
+}
+
+// signInCompletion issues the session at the end of every way to sign in,
+// each of them builds it from its own dependencies.
+type signInCompletion struct {
+	sessionRepo      CompleteSignInSessionRepository
+	organizationRepo CompleteSignInOrganizationRepository
+	loginAttemptRepo CompleteSignInLoginAttemptRepository
+	mfaRepo          CompleteSignInMFARepository
+	webAuthnRepo     CompleteSignInWebAuthnRepository
+	hashProvider     CompleteSignInHashService
+	sessionManager   CompleteSignInSessionService
+	cookieService    CompleteSignInCookieService
+	riskEngine       CompleteSignInRiskEngine
+	auditLogger      CompleteSignInAuditLogger
+	passwordMaxAge   time.Duration
+}
+
+// completeSignIn issues the session once all the factors have been checked,
+// or the restricted token if the password has expired. The organization the
+// user signs into may have its own password max age. The password age is
+// not checked when the password took no part in the sign in, the user may
+// not even know it. The risk engine may block the sign in or ask for the
+// second factor first, the login it lets through is recorded in the history.
+func (c signInCompletion) completeSignIn(context context.Context, writer http.ResponseWriter, user entities.User, organizationId, userAgent, ip string, authentication entities.Authentication) (responses.SignIn, error) {
+	assessment, err := c.riskEngine.Assess(context, user.Id, userAgent, ip)
+	if err != nil {
+		return responses.SignIn{}, err
+	}
+	if assessment.Score > 0 {
+		c.auditLogger.Log(context, newRiskAuditEvent(user.Id, assessment))
+	}
+	if assessment.Block {
+		return responses.SignIn{}, ErrSuspiciousLogin
+	}
+	// The risky sign in with a single factor is asked for the second one, the
+	// user without a second factor can only be told about it.
+	if assessment.Challenge && authentication.ACR() != entities.ACRMultiFactor {
+		mfaMethods, err := c.mfaMethods(context, user)
+		if err != nil {
+			return responses.SignIn{}, err
+		}
+		if len(mfaMethods) > 0 {
+			return c.mfaChallenge(user, mfaMethods, authentication)
+		}
+		assessment.Notify = true
+	}
+
+	// Only the account counter is reset, otherwise a valid account would let
+	// an attacker clear the counter of their IP address.
+	err = c.loginAttemptRepo.Reset(context, entities.AccountAttemptsKey(user.Email))
+	if err != nil {
+		return responses.SignIn{}, fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+
+	membership, err := selectMembership(context, c.organizationRepo, organizationId, user.Id)
+	if err != nil {
+		return responses.SignIn{}, err
+	}
+
+	passwordMaxAge := membership.PasswordMaxAgeOr(c.passwordMaxAge)
+	if authentication.Has(entities.AuthMethodPassword) && user.PasswordExpired(passwordMaxAge, time.Now()) {
+		token, expiresAt, err := c.sessionManager.CreateRestrictedToken(user, entities.ScopePasswordChange, authentication)
+		if err != nil {
+			return responses.SignIn{}, fmt.Errorf("%w: couldn't create restricted token", err)
+		}
+		return responses.NewPasswordChangeRequired(user.Id, responses.RestrictedToken{
+			Token:     token,
+			Scope:     entities.ScopePasswordChange,
+			ExpiresAt: expiresAt,
+		}), nil
+	}
+
+	err = c.riskEngine.Record(context, user, assessment)
+	if err != nil {
+		return responses.SignIn{}, err
+	}
+
+	// The user who has logged out or whose sessions were revoked has none.
+	err = c.sessionRepo.DeleteByUserId(context, user.Id)
+	if err != nil && !errors.Is(err, repositories.ErrSessionNotFound) {
+		return responses.SignIn{}, fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	session, err := c.sessionManager.CreateSession(user, membership, authentication)
+	if err != nil {
+		return responses.SignIn{}, fmt.Errorf("%w: couldn't create session", err)
+	}
+
+	session.IP = ip
+	session.UserAgent = userAgent
+
+	hashedRefreshToken, err := c.hashProvider.GenerateHash(session.RefreshToken)
+	if err != nil {
+		return responses.SignIn{}, fmt.Errorf("%w: failed to hash refresh token", err)
+	}
+
+	refreshToken := session.RefreshToken
+	session.RefreshToken = string(hashedRefreshToken)
+
+	err = c.sessionRepo.Insert(context, session)
+	if err != nil {
+		return responses.SignIn{}, fmt.Errorf("%w: failed to insert session", err)
+	}
+
+	c.cookieService.Set(
+		writer,
+		"access_token",
+		session.AccessToken,
+		session.AccessExpiresAt,
+	)
+
+	refreshSessionResponse := responses.NewSession(session.AccessToken, refreshToken, session.AccessExpiresAt.Unix())
+
+	return responses.NewSignIn(user.Id, refreshSessionResponse), nil
+}
+
+// mfaMethods returns the second factors enabled by the user, the sign in
+// with the first factor requires one of them if there are any.
+func (c signInCompletion) mfaMethods(context context.Context, user entities.User) ([]string, error) {
+	var methods []string
+
+	totp, err := c.mfaRepo.SelectTOTP(context, user.Id)
+	if err != nil && !errors.Is(err, repositories.ErrEntityNotFound) {
+		return nil, fmt.Errorf("failed to select totp: %w", err)
+	}
+	if err == nil && totp.IsConfirmed() {
+		methods = append(methods, entities.MFAMethodTOTP)
+	}
+
+	credentials, err := c.webAuthnRepo.SelectCredentials(context, user.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select webauthn credentials: %w", err)
+	}
+	if len(credentials) > 0 {
+		methods = append(methods, entities.MFAMethodWebAuthn)
+	}
+
+	if user.PhoneVerified() {
+		methods = append(methods, entities.MFAMethodSMS)
+	}
+
+	return methods, nil
+}
+
+// mfaChallenge returns the token for /auth/mfa/verify instead of the session,
+// the token carries the first factor.
+func (c signInCompletion) mfaChallenge(user entities.User, methods []string, authentication entities.Authentication) (responses.SignIn, error) {
+	token, expiresAt, err := c.sessionManager.CreateRestrictedToken(user, entities.ScopeMFA, authentication)
+	if err != nil {
+		return responses.SignIn{}, fmt.Errorf("%w: couldn't create mfa token", err)
+	}
+	return responses.NewMFARequired(user.Id, methods, responses.RestrictedToken{
+		Token:     token,
+		Scope:     entities.ScopeMFA,
+		ExpiresAt: expiresAt,
+	}), nil
+}
+
+// signInAuditEvent records the sign in attempt with the address it came from,
+// the sign in that stopped at the second factor or at the expired password is
+// marked with the reason.
+func signInAuditEvent(eventType, userId, userAgent, ip string, response responses.SignIn, err error) entities.AuditEvent {
+	event := newAuditEvent(eventType, userId, err)
+	event.IP = ip
+	event.UserAgent = userAgent
+	if err != nil {
+		return event
+	}
+
+	// The failed attempt is not attributed to the owner of the account.
+	event.ActorId = userId
+	switch {
+	case response.MFARequired:
+		event.Reason = ErrMFARequired.Error()
+	case response.PasswordChangeRequired:
+		event.Reason = ErrPasswordChangeRequired.Error()
+	}
+	return event
+}
+
+// mfaTokenUser returns the user of the challenge token issued at sign in and
+// the first factor the user has passed.
+func mfaTokenUser(context context.Context, sessionManager VerifyMFASessionService, userRepo VerifyMFAUserRepository, token string) (entities.User, entities.Authentication, error) {
+	claims, err := sessionManager.ParseToken(token)
+	if err != nil || claims.Scope() != entities.ScopeMFA {
+		return entities.User{}, entities.Authentication{}, ErrNotAValidAccessToken
+	}
+
+	user, err := userRepo.SelectByUserId(context, claims.AccountId())
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return entities.User{}, entities.Authentication{}, ErrNotAValidAccessToken
+		}
+		return entities.User{}, entities.Authentication{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	return user, claims.Authentication(), nil
+}
+
+// isTrustedDevice tells whether the sign in comes from a device the user has
+// trusted. Tokens that are invalid, belong to another user or whose device
+// has expired or been revoked are ignored, the second factor is required
+// then.
+func isTrustedDevice(
+	context context.Context,
+	trustedDeviceRepo SignInTrustedDeviceRepository,
+	sessionManager SignInSessionService,
+	trustedDeviceTTL time.Duration,
+	user entities.User,
+	token string,
+) (bool, error) {
+	if token == "" || trustedDeviceTTL <= 0 {
+		return false, nil
+	}
+
+	claims, err := sessionManager.ParseToken(token)
+	if err != nil || claims.Scope() != entities.ScopeTrustedDevice || claims.AccountId() != user.Id {
+		return false, nil
+	}
+
+	device, err := trustedDeviceRepo.Select(context, user.Id, claims.DeviceId())
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to select trusted device: %w", err)
+	}
+
+	err = trustedDeviceRepo.UpdateUsage(context, device.Id)
+	if err != nil && !errors.Is(err, repositories.ErrEntityNotFound) {
+		return false, fmt.Errorf("failed to update trusted device: %w", err)
+	}
+	return true, nil
+}
+
+// trustDevice remembers the device the user has passed the second factor on,
+// the cookie keeps the signed token with the id of the device.
+func trustDevice(
+	context context.Context,
+	writer http.ResponseWriter,
+	trustedDeviceRepo VerifyMFATrustedDeviceRepository,
+	sessionManager VerifyMFASessionService,
+	cookieService VerifyMFACookieService,
+	trustedDeviceTTL time.Duration,
+	user entities.User,
+	userAgent, ip string,
+) error {
+	if trustedDeviceTTL <= 0 {
+		return nil
+	}
+
+	device := entities.TrustedDevice{
+		UserId:    user.Id,
+		UserAgent: userAgent,
+		IP:        ip,
+		ExpiresAt: time.Now().Add(trustedDeviceTTL),
+	}
+	id, err := trustedDeviceRepo.Insert(context, device)
+	if err != nil {
+		return fmt.Errorf("failed to insert trusted device: %w", err)
+	}
+
+	token, err := sessionManager.CreateDeviceToken(user, id, device.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("%w: couldn't create device token", err)
+	}
+
+	cookieService.Set(writer, TrustedDeviceCookie, token, device.ExpiresAt)
+	return nil
+}
+
+// checkAttempts rejects the sign in while the account or the IP address is
+// locked out or has to wait after the previous failures.
+func checkAttempts(context context.Context, loginAttemptRepo SignInLoginAttemptRepository, policy entities.LockoutPolicy, email entities.Email, ip string) error {
+	now := time.Now()
+
+	account, err := loginAttemptRepo.Select(context, entities.AccountAttemptsKey(email))
+	if err != nil {
+		return fmt.Errorf("failed to select login attempts: %w", err)
+	}
+	retryAfter, locked := account.RetryAfter(policy, now)
+	if locked {
+		return &RetryAfterError{Err: ErrAccountTemporarilyLocked, RetryAfter: retryAfter}
+	}
+	if retryAfter > 0 {
+		return &RetryAfterError{Err: ErrTooManyAttempts, RetryAfter: retryAfter}
+	}
+
+	address, err := loginAttemptRepo.Select(context, entities.IPAttemptsKey(ip))
+	if err != nil {
+		return fmt.Errorf("failed to select login attempts: %w", err)
+	}
+	retryAfter, _ = address.RetryAfter(policy, now)
+	if retryAfter > 0 {
+		return &RetryAfterError{Err: ErrTooManyAttempts, RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// registerFailure counts the failed attempt for the account and the IP
+// address and locks them out once the threshold is reached.
+func registerFailure(context context.Context, loginAttemptRepo SignInLoginAttemptRepository, policy entities.LockoutPolicy, email entities.Email, ip string) error {
+	err := registerKeyFailure(context, loginAttemptRepo, policy, entities.AccountAttemptsKey(email), policy.AccountMaxFailures)
+	if err != nil {
+		return err
+	}
+	return registerKeyFailure(context, loginAttemptRepo, policy, entities.IPAttemptsKey(ip), policy.IPMaxFailures)
+}
+
+func registerKeyFailure(context context.Context, loginAttemptRepo SignInLoginAttemptRepository, policy entities.LockoutPolicy, key string, maxFailures int) error {
+	attempts, err := loginAttemptRepo.RegisterFailure(context, key, policy.Window)
+	if err != nil {
+		return fmt.Errorf("failed to register login failure: %w", err)
+	}
+	if !attempts.ShouldLock(maxFailures) {
+		return nil
+	}
+
+	err = loginAttemptRepo.Lock(context, key, time.Now().Add(policy.LockoutDuration))
+	if err != nil {
+		return fmt.Errorf("failed to lock login attempts: %w", err)
+	}
+	return nil
+}
+
+// missingNames returns the names that are absent from found, preserving the
+// order in which they were requested.
+func missingNames(requested []string, found []string) []string {
+	known := make(map[string]struct{}, len(found))
+	for _, name := range found {
+		known[name] = struct{}{}
+	}
+
+	var missing []string
+	for _, name := range requested {
+		if _, ok := known[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// sameNames reports whether both lists hold the same names, the order and the
+// duplicates don't matter.
+func sameNames(a []string, b []string) bool {
+	return len(missingNames(a, b)) == 0 && len(missingNames(b, a)) == 0
+}
+
+// CheckUserStatus returns the error matching the user's status or nil when
+// the user is allowed to authenticate.
+func CheckUserStatus(user entities.User) error {
+	if user.IsActive() {
+		return nil
+	}
+
+	switch user.Status {
+	case entities.UserStatusLocked:
+		return fmt.Errorf("%w until %s", ErrUserLocked, user.LockedUntil.UTC().Format(time.RFC3339))
+	case entities.UserStatusBanned:
+		if user.StatusReason != "" {
+			return fmt.Errorf("%w: %s", ErrUserBanned, user.StatusReason)
+		}
+		return ErrUserBanned
+	}
+	return ErrUserDisabled
+}
+
+// auditReasons are the errors whose message is recorded as the reason of the
+// failed audit event, the others are recorded as the internal error so that
+// the log doesn't keep the details of the storage failures.
+var auditReasons = []error{
+	ErrInvalidCredentials,
+	ErrWrongPassword,
+	ErrInvalidMFACode,
+	ErrInvalidSMSCode,
+	ErrInvalidWebAuthnCredential,
+	ErrInvalidPasswordlessCode,
+	ErrPasswordlessDisabled,
+	ErrUserDisabled,
+	ErrUserLocked,
+	ErrUserBanned,
+	ErrTooManyAttempts,
+	ErrAccountTemporarilyLocked,
+	ErrTooManySMS,
+	ErrAccessTokenExpired,
+	ErrRefreshTokenExpired,
+	ErrNotAValidAccessToken,
+	ErrNotAValidRefreshToken,
+	ErrInvalidUserAgent,
+	ErrSessionNotFound,
+	ErrSuspiciousLogin,
+	ErrSuspiciousSession,
+	ErrOrganizationAccessDenied,
+	ErrInvalidInvitation,
+	ErrInviteOnly,
+	ErrInvalidEntity,
+	ErrInvalidInput,
+	ErrEntityNotFound,
+	ErrEntityAlreadyExists,
+	ErrLastAdmin,
+	ErrWebhookReplayed,
+}
+
+const auditInternalError = "internal error"
+
+// newAuditEvent describes the outcome of the use case, the actor, the IP
+// address and the user agent are filled in by the audit logger.
+func newAuditEvent(eventType, subjectId string, err error) entities.AuditEvent {
+	event := entities.AuditEvent{
+		Type:      eventType,
+		SubjectId: subjectId,
+		Outcome:   entities.AuditOutcomeSuccess,
+	}
+	if err == nil {
+		return event
+	}
+
+	event.Outcome = entities.AuditOutcomeFailure
+	event.Reason = auditInternalError
+	for _, reason := range auditReasons {
+		if errors.Is(err, reason) {
+			event.Reason = reason.Error()
+			break
+		}
+	}
+	return event
 }
@@ -0,0 +1,230 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type verifyMFAUseCase struct {
+	userRepo          VerifyMFAUserRepository
+	loginAttemptRepo  VerifyMFALoginAttemptRepository
+	mfaRepo           VerifyMFAMFARepository
+	trustedDeviceRepo VerifyMFATrustedDeviceRepository
+	smsCodeRepo       VerifyMFASMSCodeRepository
+	rateLimitRepo     VerifyMFARateLimitRepository
+	hashProvider      VerifyMFAHashService
+	sessionManager    VerifyMFASessionService
+	cookieService     VerifyMFACookieService
+	mfaService        VerifyMFAMFAService
+	encryptionService VerifyMFAEncryptionService
+	randomService     VerifyMFARandomService
+	smsSender         VerifyMFASMSSender
+	lockoutPolicy     entities.LockoutPolicy
+	trustedDeviceTTL  time.Duration
+	smsPolicy         entities.SMSPolicy
+	auditLogger       VerifyMFAAuditLogger
+	completion        signInCompletion
+}
+
+type VerifyMFAUseCase interface {
+	VerifyMFA(context context.Context, writer http.ResponseWriter, request *requests.VerifyMFA, userAgent, ip string) (responses.SignIn, error)
+	SendMFASMS(context context.Context, request *requests.SendMFASMS) (responses.SMSCodeSent, error)
+}
+
+func NewVerifyMFAUseCase(
+	userRepo VerifyMFAUserRepository,
+	sessionRepo VerifyMFASessionRepository,
+	organizationRepo VerifyMFAOrganizationRepository,
+	loginAttemptRepo VerifyMFALoginAttemptRepository,
+	mfaRepo VerifyMFAMFARepository,
+	webAuthnRepo VerifyMFAWebAuthnRepository,
+	trustedDeviceRepo VerifyMFATrustedDeviceRepository,
+	smsCodeRepo VerifyMFASMSCodeRepository,
+	rateLimitRepo VerifyMFARateLimitRepository,
+	hashProvider VerifyMFAHashService,
+	sessionManager VerifyMFASessionService,
+	cookieService VerifyMFACookieService,
+	mfaService VerifyMFAMFAService,
+	encryptionService VerifyMFAEncryptionService,
+	randomService VerifyMFARandomService,
+	smsSender VerifyMFASMSSender,
+	passwordMaxAge time.Duration,
+	lockoutPolicy entities.LockoutPolicy,
+	trustedDeviceTTL time.Duration,
+	smsPolicy entities.SMSPolicy,
+	riskEngine VerifyMFARiskEngine,
+	auditLogger VerifyMFAAuditLogger,
+) VerifyMFAUseCase {
+	return &verifyMFAUseCase{
+		userRepo:          userRepo,
+		loginAttemptRepo:  loginAttemptRepo,
+		mfaRepo:           mfaRepo,
+		trustedDeviceRepo: trustedDeviceRepo,
+		smsCodeRepo:       smsCodeRepo,
+		rateLimitRepo:     rateLimitRepo,
+		hashProvider:      hashProvider,
+		sessionManager:    sessionManager,
+		cookieService:     cookieService,
+		mfaService:        mfaService,
+		encryptionService: encryptionService,
+		randomService:     randomService,
+		smsSender:         smsSender,
+		lockoutPolicy:     lockoutPolicy,
+		trustedDeviceTTL:  trustedDeviceTTL,
+		smsPolicy:         smsPolicy,
+		auditLogger:       auditLogger,
+		completion: signInCompletion{
+			sessionRepo:      sessionRepo,
+			organizationRepo: organizationRepo,
+			loginAttemptRepo: loginAttemptRepo,
+			mfaRepo:          mfaRepo,
+			webAuthnRepo:     webAuthnRepo,
+			hashProvider:     hashProvider,
+			sessionManager:   sessionManager,
+			cookieService:    cookieService,
+			riskEngine:       riskEngine,
+			auditLogger:      auditLogger,
+			passwordMaxAge:   passwordMaxAge,
+		},
+	}
+}
+
+// VerifyMFA finishes the sign in started with the password by checking the
+// TOTP code, a recovery code or the code sent by SMS against the challenge
+// token. On request the device is trusted and later sign ins from it skip the
+// second factor.
+func (u *verifyMFAUseCase) VerifyMFA(context context.Context, writer http.ResponseWriter, request *requests.VerifyMFA, userAgent, ip string) (responses.SignIn, error) {
+	response, userId, err := u.verifyMFA(context, writer, request, userAgent, ip)
+	u.auditLogger.Log(context, signInAuditEvent(entities.AuditMFAVerify, userId, userAgent, ip, response, err))
+	return response, err
+}
+
+func (u *verifyMFAUseCase) verifyMFA(context context.Context, writer http.ResponseWriter, request *requests.VerifyMFA, userAgent, ip string) (responses.SignIn, string, error) {
+	user, authentication, err := mfaTokenUser(context, u.sessionManager, u.userRepo, request.MFAToken)
+	if err != nil {
+		return responses.SignIn{}, "", err
+	}
+
+	err = checkAttempts(context, u.loginAttemptRepo, u.lockoutPolicy, user.Email, ip)
+	if err != nil {
+		return responses.SignIn{}, user.Id, err
+	}
+
+	err = CheckUserStatus(user)
+	if err != nil {
+		return responses.SignIn{}, user.Id, fmt.Errorf("user can't sign in: %w", err)
+	}
+
+	err = u.verifySecondFactor(context, user.Id, request)
+	if errors.Is(err, ErrInvalidMFACode) {
+		if err := registerFailure(context, u.loginAttemptRepo, u.lockoutPolicy, user.Email, ip); err != nil {
+			return responses.SignIn{}, user.Id, err
+		}
+	}
+	if err != nil {
+		return responses.SignIn{}, user.Id, err
+	}
+
+	// A recovery code is a one-time password as well.
+	method := entities.AuthMethodOTP
+	if request.SMSCode != "" {
+		method = entities.AuthMethodSMS
+	}
+	response, err := u.completion.completeSignIn(context, writer, user, request.OrganizationId, userAgent, ip, authentication.With(method))
+	if err != nil || !request.RememberDevice {
+		return response, user.Id, err
+	}
+	err = trustDevice(context, writer, u.trustedDeviceRepo, u.sessionManager, u.cookieService, u.trustedDeviceTTL, user, userAgent, ip)
+	return response, user.Id, err
+}
+
+// SendMFASMS sends the code for /auth/mfa/verify to the verified phone of the
+// user of the challenge token.
+func (u *verifyMFAUseCase) SendMFASMS(context context.Context, request *requests.SendMFASMS) (responses.SMSCodeSent, error) {
+	response, userId, err := u.sendMFASMS(context, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditMFASMSSend, userId, err))
+	return response, err
+}
+
+func (u *verifyMFAUseCase) sendMFASMS(context context.Context, request *requests.SendMFASMS) (responses.SMSCodeSent, string, error) {
+	user, _, err := mfaTokenUser(context, u.sessionManager, u.userRepo, request.MFAToken)
+	if err != nil {
+		return responses.SMSCodeSent{}, "", err
+	}
+	if !user.PhoneVerified() {
+		return responses.SMSCodeSent{}, user.Id, fmt.Errorf("verified phone not found: %w", ErrEntityNotFound)
+	}
+
+	response, err := sendSMSCode(
+		context,
+		u.smsCodeRepo,
+		u.rateLimitRepo,
+		u.hashProvider,
+		u.randomService,
+		u.smsSender,
+		u.smsPolicy,
+		user.Id,
+		user.Phone,
+		entities.SMSPurposeMFA,
+	)
+	return response, user.Id, err
+}
+
+// verifySecondFactor accepts either the code sent by SMS, an unused recovery
+// code or a TOTP code of a time step later than the last accepted one.
+func (u *verifyMFAUseCase) verifySecondFactor(context context.Context, userId string, request *requests.VerifyMFA) error {
+	if request.SMSCode != "" {
+		_, err := checkSMSCode(context, u.smsCodeRepo, u.hashProvider, u.smsPolicy, userId, entities.SMSPurposeMFA, request.SMSCode)
+		if errors.Is(err, ErrInvalidSMSCode) {
+			return fmt.Errorf("%w: %w", ErrInvalidMFACode, err)
+		}
+		return err
+	}
+
+	if request.RecoveryCode != "" {
+		err := u.mfaRepo.UseRecoveryCode(context, userId, u.mfaService.HashRecoveryCode(request.RecoveryCode))
+		if err != nil {
+			if errors.Is(err, repositories.ErrEntityNotFound) {
+				return ErrInvalidMFACode
+			}
+			return fmt.Errorf("failed to use recovery code: %w", err)
+		}
+		return nil
+	}
+
+	totp, err := u.mfaRepo.SelectTOTP(context, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return ErrInvalidMFACode
+		}
+		return fmt.Errorf("failed to select totp: %w", err)
+	}
+	if !totp.IsConfirmed() {
+		return ErrInvalidMFACode
+	}
+
+	secret, err := u.encryptionService.Decrypt(totp.Secret)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt totp secret: %w", err)
+	}
+	step, ok := u.mfaService.Validate(secret, request.Code, totp.LastUsedStep)
+	if !ok {
+		return ErrInvalidMFACode
+	}
+
+	err = u.mfaRepo.UseTOTPStep(context, userId, step)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return ErrInvalidMFACode
+		}
+		return fmt.Errorf("failed to save totp step: %w", err)
+	}
+	return nil
+}
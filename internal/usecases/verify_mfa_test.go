@@ -0,0 +1,379 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockVerifyMFAUserRepo       *MockVerifyMFAUserRepository
+	mockVerifyMFASessionRepo    *MockVerifyMFASessionRepository
+	mockVerifyMFAOrgRepo        *MockVerifyMFAOrganizationRepository
+	mockVerifyMFAAttemptRepo    *MockVerifyMFALoginAttemptRepository
+	mockVerifyMFAMFARepo        *MockVerifyMFAMFARepository
+	mockVerifyMFAWebAuthnRepo   *MockVerifyMFAWebAuthnRepository
+	mockVerifyMFATrustedDevices *MockVerifyMFATrustedDeviceRepository
+	mockVerifyMFASMSCodes       *MockVerifyMFASMSCodeRepository
+	mockVerifyMFARateLimits     *MockVerifyMFARateLimitRepository
+	mockVerifyMFAHashService    *MockVerifyMFAHashService
+	mockVerifyMFASessionService *MockVerifyMFASessionService
+	mockVerifyMFACookieService  *MockVerifyMFACookieService
+	mockVerifyMFAMFAService     *MockVerifyMFAMFAService
+	mockVerifyMFAEncryption     *MockVerifyMFAEncryptionService
+	mockVerifyMFARandomService  *MockVerifyMFARandomService
+	mockVerifyMFASMSSender      *MockVerifyMFASMSSender
+	mockVerifyMFARiskEngine     *MockVerifyMFARiskEngine
+	mockVerifyMFAAuditLogger    *MockVerifyMFAAuditLogger
+)
+
+var verifyMFASMSPolicy = entities.SMSPolicy{
+	CodeTTL:     5 * time.Minute,
+	MaxAttempts: 3,
+	NumberLimit: entities.RateLimit{Limit: 5, Period: time.Hour},
+}
+
+func initVerifyMFAMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockVerifyMFAUserRepo = NewMockVerifyMFAUserRepository(ctrl)
+	mockVerifyMFASessionRepo = NewMockVerifyMFASessionRepository(ctrl)
+	mockVerifyMFAOrgRepo = NewMockVerifyMFAOrganizationRepository(ctrl)
+	mockVerifyMFAAttemptRepo = NewMockVerifyMFALoginAttemptRepository(ctrl)
+	mockVerifyMFAMFARepo = NewMockVerifyMFAMFARepository(ctrl)
+	mockVerifyMFAWebAuthnRepo = NewMockVerifyMFAWebAuthnRepository(ctrl)
+	mockVerifyMFATrustedDevices = NewMockVerifyMFATrustedDeviceRepository(ctrl)
+	mockVerifyMFASMSCodes = NewMockVerifyMFASMSCodeRepository(ctrl)
+	mockVerifyMFARateLimits = NewMockVerifyMFARateLimitRepository(ctrl)
+	mockVerifyMFAHashService = NewMockVerifyMFAHashService(ctrl)
+	mockVerifyMFASessionService = NewMockVerifyMFASessionService(ctrl)
+	mockVerifyMFACookieService = NewMockVerifyMFACookieService(ctrl)
+	mockVerifyMFAMFAService = NewMockVerifyMFAMFAService(ctrl)
+	mockVerifyMFAEncryption = NewMockVerifyMFAEncryptionService(ctrl)
+	mockVerifyMFARandomService = NewMockVerifyMFARandomService(ctrl)
+	mockVerifyMFASMSSender = NewMockVerifyMFASMSSender(ctrl)
+	mockVerifyMFARiskEngine = NewMockVerifyMFARiskEngine(ctrl)
+	mockVerifyMFARiskEngine.EXPECT().Assess(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(entities.RiskAssessment{}, nil).AnyTimes()
+	mockVerifyMFARiskEngine.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockVerifyMFAAuditLogger = NewMockVerifyMFAAuditLogger(ctrl)
+	mockVerifyMFAAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func newVerifyMFAUseCase() VerifyMFAUseCase {
+	return NewVerifyMFAUseCase(
+		mockVerifyMFAUserRepo,
+		mockVerifyMFASessionRepo,
+		mockVerifyMFAOrgRepo,
+		mockVerifyMFAAttemptRepo,
+		mockVerifyMFAMFARepo,
+		mockVerifyMFAWebAuthnRepo,
+		mockVerifyMFATrustedDevices,
+		mockVerifyMFASMSCodes,
+		mockVerifyMFARateLimits,
+		mockVerifyMFAHashService,
+		mockVerifyMFASessionService,
+		mockVerifyMFACookieService,
+		mockVerifyMFAMFAService,
+		mockVerifyMFAEncryption,
+		mockVerifyMFARandomService,
+		mockVerifyMFASMSSender,
+		0,
+		signInLockoutPolicy,
+		signInTrustedDeviceTTL,
+		verifyMFASMSPolicy,
+		mockVerifyMFARiskEngine,
+		mockVerifyMFAAuditLogger)
+}
+
+func expectVerifyMFAAttempts(ctx context.Context, email, ip string) {
+	mockVerifyMFAAttemptRepo.EXPECT().Select(ctx, entities.AccountAttemptsKey(entities.Email(email))).
+		Return(entities.LoginAttempts{}, nil)
+	mockVerifyMFAAttemptRepo.EXPECT().Select(ctx, entities.IPAttemptsKey(ip)).
+		Return(entities.LoginAttempts{}, nil)
+}
+
+func expectVerifyMFARisk(t *testing.T, ctx context.Context, assessment entities.RiskAssessment) {
+	mockVerifyMFARiskEngine = NewMockVerifyMFARiskEngine(gomock.NewController(t))
+	mockVerifyMFARiskEngine.EXPECT().Assess(ctx, "user-id", "", "10.0.0.1").Return(assessment, nil)
+}
+
+func expectVerifyMFAUser(ctx context.Context, user entities.User) {
+	mockVerifyMFASessionService.EXPECT().ParseToken("mfa-token").Return(entities.AccessTokenClaims{
+		entities.UserIdClaimName:      user.Id,
+		entities.ScopeClaimName:       entities.ScopeMFA,
+		entities.AuthMethodsClaimName: []any{entities.AuthMethodPassword},
+		entities.AuthTimeClaimName:    float64(time.Now().Unix()),
+	}, nil)
+	mockVerifyMFAUserRepo.EXPECT().SelectByUserId(ctx, user.Id).Return(user, nil)
+	expectVerifyMFAAttempts(ctx, string(user.Email), "10.0.0.1")
+}
+
+func TestVerifyMFAUseCase_VerifyMFA_Success(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	session := entities.Session{AccessToken: "access-token", RefreshToken: "refresh-token", UserId: "user-id"}
+	expectVerifyMFAUser(ctx, user)
+
+	mockVerifyMFAMFARepo.EXPECT().SelectTOTP(ctx, "user-id").
+		Return(entities.TOTP{UserId: "user-id", Secret: "encrypted", ConfirmedAt: time.Now(), LastUsedStep: 100}, nil)
+	mockVerifyMFAEncryption.EXPECT().Decrypt("encrypted").Return("secret", nil)
+	mockVerifyMFAMFAService.EXPECT().Validate("secret", "123456", int64(100)).Return(int64(101), true)
+	mockVerifyMFAMFARepo.EXPECT().UseTOTPStep(ctx, "user-id", int64(101)).Return(nil)
+	mockVerifyMFAAttemptRepo.EXPECT().Reset(ctx, "account:test@mail.ru").Return(nil)
+	mockVerifyMFASessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	mockVerifyMFASessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodPassword, entities.AuthMethodOTP)).Return(session, nil)
+	mockVerifyMFAHashService.EXPECT().GenerateHash("refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockVerifyMFASessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockVerifyMFACookieService.EXPECT().Set(nil, "access_token", "access-token", session.AccessExpiresAt)
+
+	response, err := newVerifyMFAUseCase().VerifyMFA(ctx, nil, &requests.VerifyMFA{MFAToken: "mfa-token", Code: "123456"}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access-token", response.Session.AccessToken)
+}
+
+func TestVerifyMFAUseCase_VerifyMFA_RecoveryCode(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	expectVerifyMFAUser(ctx, user)
+
+	mockVerifyMFAMFAService.EXPECT().HashRecoveryCode("abcde-fghij").Return("code-hash")
+	mockVerifyMFAMFARepo.EXPECT().UseRecoveryCode(ctx, "user-id", "code-hash").Return(nil)
+	mockVerifyMFAAttemptRepo.EXPECT().Reset(ctx, "account:test@mail.ru").Return(nil)
+	mockVerifyMFASessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(fmt.Errorf("db error"))
+
+	_, err := newVerifyMFAUseCase().VerifyMFA(ctx, nil, &requests.VerifyMFA{MFAToken: "mfa-token", RecoveryCode: "abcde-fghij"}, "", "10.0.0.1")
+
+	assert.ErrorContains(t, err, "db error")
+}
+
+func TestVerifyMFAUseCase_VerifyMFA_WrongCode(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	expectVerifyMFAUser(ctx, user)
+
+	mockVerifyMFAMFARepo.EXPECT().SelectTOTP(ctx, "user-id").
+		Return(entities.TOTP{UserId: "user-id", Secret: "encrypted", ConfirmedAt: time.Now()}, nil)
+	mockVerifyMFAEncryption.EXPECT().Decrypt("encrypted").Return("secret", nil)
+	mockVerifyMFAMFAService.EXPECT().Validate("secret", "000000", int64(0)).Return(int64(0), false)
+	mockVerifyMFAAttemptRepo.EXPECT().RegisterFailure(ctx, "account:test@mail.ru", signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 1}, nil)
+	mockVerifyMFAAttemptRepo.EXPECT().RegisterFailure(ctx, "ip:10.0.0.1", signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 1}, nil)
+
+	_, err := newVerifyMFAUseCase().VerifyMFA(ctx, nil, &requests.VerifyMFA{MFAToken: "mfa-token", Code: "000000"}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrInvalidMFACode)
+}
+
+func TestVerifyMFAUseCase_VerifyMFA_NotAnMFAToken(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	mockVerifyMFASessionService.EXPECT().ParseToken("access-token").Return(entities.AccessTokenClaims{
+		entities.UserIdClaimName: "user-id",
+	}, nil)
+
+	_, err := newVerifyMFAUseCase().VerifyMFA(ctx, nil, &requests.VerifyMFA{MFAToken: "access-token", Code: "123456"}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrNotAValidAccessToken)
+}
+
+func expectMFATokenUser(user entities.User) {
+	mockVerifyMFASessionService.EXPECT().ParseToken("mfa-token").Return(entities.AccessTokenClaims{
+		entities.UserIdClaimName:      user.Id,
+		entities.ScopeClaimName:       entities.ScopeMFA,
+		entities.AuthMethodsClaimName: []any{entities.AuthMethodPassword},
+	}, nil)
+	mockVerifyMFAUserRepo.EXPECT().SelectByUserId(gomock.Any(), user.Id).Return(user, nil)
+}
+
+func TestVerifyMFAUseCase_SendMFASMS_Success(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Phone: "+79991234567", PhoneVerifiedAt: time.Now()}
+	expectMFATokenUser(user)
+
+	mockVerifyMFARateLimits.EXPECT().Take(ctx, "sms:+79991234567", verifyMFASMSPolicy.NumberLimit).
+		Return(entities.RateLimitResult{Allowed: true}, nil)
+	mockVerifyMFARandomService.EXPECT().GenerateCode(6).Return("654321", nil)
+	mockVerifyMFAHashService.EXPECT().GenerateHash("654321").Return([]byte("code-hash"), nil)
+	mockVerifyMFASMSCodes.EXPECT().SaveCode(ctx, gomock.AssignableToTypeOf(entities.SMSCode{})).DoAndReturn(
+		func(_ context.Context, code entities.SMSCode) error {
+			assert.Equal(t, "user-id", code.UserId)
+			assert.Equal(t, entities.Phone("+79991234567"), code.Phone)
+			assert.Equal(t, entities.SMSPurposeMFA, code.Purpose)
+			assert.Equal(t, "code-hash", code.CodeHash)
+			assert.WithinDuration(t, time.Now().Add(verifyMFASMSPolicy.CodeTTL), code.ExpiresAt, time.Minute)
+			return nil
+		})
+	mockVerifyMFASMSSender.EXPECT().Send("+79991234567", gomock.Any()).DoAndReturn(func(_, message string) error {
+		assert.Contains(t, message, "654321")
+		return nil
+	})
+
+	response, err := newVerifyMFAUseCase().SendMFASMS(ctx, &requests.SendMFASMS{MFAToken: "mfa-token"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "+*******4567", response.Phone)
+}
+
+func TestVerifyMFAUseCase_SendMFASMS_PhoneNotVerified(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	expectMFATokenUser(entities.User{Id: "user-id", Email: "test@mail.ru", Phone: "+79991234567"})
+
+	_, err := newVerifyMFAUseCase().SendMFASMS(ctx, &requests.SendMFASMS{MFAToken: "mfa-token"})
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestVerifyMFAUseCase_SendMFASMS_NumberRateLimited(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	expectMFATokenUser(entities.User{Id: "user-id", Email: "test@mail.ru", Phone: "+79991234567", PhoneVerifiedAt: time.Now()})
+	mockVerifyMFARateLimits.EXPECT().Take(ctx, "sms:+79991234567", verifyMFASMSPolicy.NumberLimit).
+		Return(entities.RateLimitResult{RetryAfter: 10 * time.Minute}, nil)
+
+	_, err := newVerifyMFAUseCase().SendMFASMS(ctx, &requests.SendMFASMS{MFAToken: "mfa-token"})
+
+	var retryErr *RetryAfterError
+	assert.ErrorIs(t, err, ErrTooManySMS)
+	assert.ErrorAs(t, err, &retryErr)
+	assert.Equal(t, 10*time.Minute, retryErr.RetryAfter)
+}
+
+func TestVerifyMFAUseCase_VerifyMFA_SMSCode(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password", Phone: "+79991234567", PhoneVerifiedAt: time.Now()}
+	session := entities.Session{AccessToken: "access-token", RefreshToken: "refresh-token", UserId: "user-id"}
+	expectVerifyMFAUser(ctx, user)
+
+	mockVerifyMFASMSCodes.EXPECT().SelectCode(ctx, "user-id", entities.SMSPurposeMFA).
+		Return(entities.SMSCode{Id: "code-id", UserId: "user-id", Phone: user.Phone, CodeHash: "code-hash"}, nil)
+	mockVerifyMFAHashService.EXPECT().CompareStringAndHash("654321", "code-hash").Return(true)
+	mockVerifyMFASMSCodes.EXPECT().DeleteCode(ctx, "code-id").Return(nil)
+	mockVerifyMFAAttemptRepo.EXPECT().Reset(ctx, "account:test@mail.ru").Return(nil)
+	mockVerifyMFASessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	mockVerifyMFASessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodPassword, entities.AuthMethodSMS)).Return(session, nil)
+	mockVerifyMFAHashService.EXPECT().GenerateHash("refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockVerifyMFASessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockVerifyMFACookieService.EXPECT().Set(nil, "access_token", "access-token", session.AccessExpiresAt)
+
+	response, err := newVerifyMFAUseCase().VerifyMFA(ctx, nil, &requests.VerifyMFA{MFAToken: "mfa-token", SMSCode: "654321"}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access-token", response.Session.AccessToken)
+}
+
+func TestVerifyMFAUseCase_VerifyMFA_WrongSMSCodeDropsCodeAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password", Phone: "+79991234567", PhoneVerifiedAt: time.Now()}
+	expectVerifyMFAUser(ctx, user)
+
+	mockVerifyMFASMSCodes.EXPECT().SelectCode(ctx, "user-id", entities.SMSPurposeMFA).
+		Return(entities.SMSCode{Id: "code-id", UserId: "user-id", CodeHash: "code-hash", Attempts: 2}, nil)
+	mockVerifyMFAHashService.EXPECT().CompareStringAndHash("000000", "code-hash").Return(false)
+	mockVerifyMFASMSCodes.EXPECT().RegisterCodeFailure(ctx, "code-id").Return(verifyMFASMSPolicy.MaxAttempts, nil)
+	mockVerifyMFASMSCodes.EXPECT().DeleteCode(ctx, "code-id").Return(nil)
+	mockVerifyMFAAttemptRepo.EXPECT().RegisterFailure(ctx, "account:test@mail.ru", signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 1}, nil)
+	mockVerifyMFAAttemptRepo.EXPECT().RegisterFailure(ctx, "ip:10.0.0.1", signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 1}, nil)
+
+	_, err := newVerifyMFAUseCase().VerifyMFA(ctx, nil, &requests.VerifyMFA{MFAToken: "mfa-token", SMSCode: "000000"}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrInvalidMFACode)
+}
+
+func TestVerifyMFAUseCase_VerifyMFA_RememberDevice(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	session := entities.Session{AccessToken: "access-token", RefreshToken: "refresh-token", UserId: "user-id"}
+	expectVerifyMFAUser(ctx, user)
+
+	mockVerifyMFAMFAService.EXPECT().HashRecoveryCode("abcde-fghij").Return("code-hash")
+	mockVerifyMFAMFARepo.EXPECT().UseRecoveryCode(ctx, "user-id", "code-hash").Return(nil)
+	mockVerifyMFAAttemptRepo.EXPECT().Reset(ctx, "account:test@mail.ru").Return(nil)
+	mockVerifyMFASessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	mockVerifyMFASessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodPassword, entities.AuthMethodOTP)).Return(session, nil)
+	mockVerifyMFAHashService.EXPECT().GenerateHash("refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockVerifyMFASessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockVerifyMFACookieService.EXPECT().Set(nil, "access_token", "access-token", session.AccessExpiresAt)
+
+	var expiresAt time.Time
+	mockVerifyMFATrustedDevices.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.TrustedDevice{})).
+		DoAndReturn(func(_ context.Context, device entities.TrustedDevice) (string, error) {
+			assert.Equal(t, "user-id", device.UserId)
+			assert.Equal(t, "test-agent", device.UserAgent)
+			assert.Equal(t, "10.0.0.1", device.IP)
+			assert.WithinDuration(t, time.Now().Add(signInTrustedDeviceTTL), device.ExpiresAt, time.Minute)
+			expiresAt = device.ExpiresAt
+			return "device-id", nil
+		})
+	mockVerifyMFASessionService.EXPECT().CreateDeviceToken(user, "device-id", gomock.Any()).Return("device-token", nil)
+	mockVerifyMFACookieService.EXPECT().Set(nil, TrustedDeviceCookie, "device-token", gomock.Any()).
+		Do(func(_ http.ResponseWriter, _, _ string, expires time.Time) {
+			assert.Equal(t, expiresAt, expires)
+		})
+
+	response, err := newVerifyMFAUseCase().VerifyMFA(ctx, nil, &requests.VerifyMFA{
+		MFAToken:       "mfa-token",
+		RecoveryCode:   "abcde-fghij",
+		RememberDevice: true,
+	}, "test-agent", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access-token", response.Session.AccessToken)
+}
+
+func TestVerifyMFAUseCase_VerifyMFA_RiskChallengeIsPassed(t *testing.T) {
+	ctx := context.Background()
+	initVerifyMFAMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	session := entities.Session{AccessToken: "access-token", RefreshToken: "refresh-token", UserId: "user-id"}
+	assessment := entities.RiskAssessment{Score: 40, Signals: []string{entities.RiskNewCountry}, Notify: true, Challenge: true}
+	expectVerifyMFAUser(ctx, user)
+	expectVerifyMFARisk(t, ctx, assessment)
+	mockVerifyMFARiskEngine.EXPECT().Record(ctx, user, assessment).Return(nil)
+
+	mockVerifyMFAMFARepo.EXPECT().SelectTOTP(ctx, "user-id").
+		Return(entities.TOTP{UserId: "user-id", Secret: "encrypted", ConfirmedAt: time.Now(), LastUsedStep: 100}, nil)
+	mockVerifyMFAEncryption.EXPECT().Decrypt("encrypted").Return("secret", nil)
+	mockVerifyMFAMFAService.EXPECT().Validate("secret", "123456", int64(100)).Return(int64(101), true)
+	mockVerifyMFAMFARepo.EXPECT().UseTOTPStep(ctx, "user-id", int64(101)).Return(nil)
+	mockVerifyMFAAttemptRepo.EXPECT().Reset(ctx, "account:test@mail.ru").Return(nil)
+	mockVerifyMFASessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	mockVerifyMFASessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodPassword, entities.AuthMethodOTP)).Return(session, nil)
+	mockVerifyMFAHashService.EXPECT().GenerateHash("refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockVerifyMFASessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockVerifyMFACookieService.EXPECT().Set(nil, "access_token", "access-token", session.AccessExpiresAt)
+
+	response, err := newVerifyMFAUseCase().VerifyMFA(ctx, nil, &requests.VerifyMFA{MFAToken: "mfa-token", Code: "123456"}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access-token", response.Session.AccessToken)
+}
@@ -0,0 +1,110 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type verifyPhoneUseCase struct {
+	userRepo      VerifyPhoneUserRepository
+	codeRepo      VerifyPhoneSMSCodeRepository
+	rateLimitRepo VerifyPhoneRateLimitRepository
+	hashService   VerifyPhoneHashService
+	randomService VerifyPhoneRandomService
+	smsSender     VerifyPhoneSMSSender
+	smsPolicy     entities.SMSPolicy
+	auditLogger   VerifyPhoneAuditLogger
+}
+
+// VerifyPhoneUseCase sets the phone of the user, the phone is saved only
+// after the code sent to it has been entered.
+type VerifyPhoneUseCase interface {
+	Start(context context.Context, userId string, request requests.StartPhoneVerification) (responses.SMSCodeSent, error)
+	Confirm(context context.Context, userId string, request requests.ConfirmPhone) error
+}
+
+func NewVerifyPhoneUseCase(
+	userRepo VerifyPhoneUserRepository,
+	codeRepo VerifyPhoneSMSCodeRepository,
+	rateLimitRepo VerifyPhoneRateLimitRepository,
+	hashService VerifyPhoneHashService,
+	randomService VerifyPhoneRandomService,
+	smsSender VerifyPhoneSMSSender,
+	smsPolicy entities.SMSPolicy,
+	auditLogger VerifyPhoneAuditLogger,
+) VerifyPhoneUseCase {
+	return &verifyPhoneUseCase{
+		userRepo:      userRepo,
+		codeRepo:      codeRepo,
+		rateLimitRepo: rateLimitRepo,
+		hashService:   hashService,
+		randomService: randomService,
+		smsSender:     smsSender,
+		smsPolicy:     smsPolicy,
+		auditLogger:   auditLogger,
+	}
+}
+
+func (u *verifyPhoneUseCase) Start(context context.Context, userId string, request requests.StartPhoneVerification) (responses.SMSCodeSent, error) {
+	response, err := u.start(context, userId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditPhoneVerificationStart, userId, err))
+	return response, err
+}
+
+func (u *verifyPhoneUseCase) start(context context.Context, userId string, request requests.StartPhoneVerification) (responses.SMSCodeSent, error) {
+	phone := entities.NewPhone(request.Phone)
+	err := phone.Validate()
+	if err != nil {
+		return responses.SMSCodeSent{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	_, err = u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.SMSCodeSent{}, fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+		}
+		return responses.SMSCodeSent{}, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	return sendSMSCode(
+		context,
+		u.codeRepo,
+		u.rateLimitRepo,
+		u.hashService,
+		u.randomService,
+		u.smsSender,
+		u.smsPolicy,
+		userId,
+		phone,
+		entities.SMSPurposePhoneVerification,
+	)
+}
+
+// Confirm saves the phone the code has been sent to as the verified phone of
+// the user, it replaces the previous one.
+func (u *verifyPhoneUseCase) Confirm(context context.Context, userId string, request requests.ConfirmPhone) error {
+	err := u.confirm(context, userId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditPhoneVerify, userId, err))
+	return err
+}
+
+func (u *verifyPhoneUseCase) confirm(context context.Context, userId string, request requests.ConfirmPhone) error {
+	code, err := checkSMSCode(context, u.codeRepo, u.hashService, u.smsPolicy, userId, entities.SMSPurposePhoneVerification, request.Code)
+	if err != nil {
+		return err
+	}
+
+	err = u.userRepo.UpdatePhone(context, userId, code.Phone)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to find user: %w", ErrEntityNotFound)
+		}
+		return fmt.Errorf("failed to update phone: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockVerifyPhoneUserRepo      *MockVerifyPhoneUserRepository
+	mockVerifyPhoneCodeRepo      *MockVerifyPhoneSMSCodeRepository
+	mockVerifyPhoneRateLimitRepo *MockVerifyPhoneRateLimitRepository
+	mockVerifyPhoneHashService   *MockVerifyPhoneHashService
+	mockVerifyPhoneRandomService *MockVerifyPhoneRandomService
+	mockVerifyPhoneSMSSender     *MockVerifyPhoneSMSSender
+	mockVerifyPhoneAuditLogger   *MockVerifyPhoneAuditLogger
+)
+
+var verifyPhoneSMSPolicy = entities.SMSPolicy{
+	CodeTTL:     5 * time.Minute,
+	MaxAttempts: 3,
+	NumberLimit: entities.RateLimit{Limit: 5, Period: time.Hour},
+}
+
+func newVerifyPhoneUseCase(t *testing.T) VerifyPhoneUseCase {
+	ctrl := gomock.NewController(t)
+	mockVerifyPhoneUserRepo = NewMockVerifyPhoneUserRepository(ctrl)
+	mockVerifyPhoneCodeRepo = NewMockVerifyPhoneSMSCodeRepository(ctrl)
+	mockVerifyPhoneRateLimitRepo = NewMockVerifyPhoneRateLimitRepository(ctrl)
+	mockVerifyPhoneHashService = NewMockVerifyPhoneHashService(ctrl)
+	mockVerifyPhoneRandomService = NewMockVerifyPhoneRandomService(ctrl)
+	mockVerifyPhoneSMSSender = NewMockVerifyPhoneSMSSender(ctrl)
+	mockVerifyPhoneAuditLogger = NewMockVerifyPhoneAuditLogger(ctrl)
+	mockVerifyPhoneAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return NewVerifyPhoneUseCase(
+		mockVerifyPhoneUserRepo,
+		mockVerifyPhoneCodeRepo,
+		mockVerifyPhoneRateLimitRepo,
+		mockVerifyPhoneHashService,
+		mockVerifyPhoneRandomService,
+		mockVerifyPhoneSMSSender,
+		verifyPhoneSMSPolicy,
+		mockVerifyPhoneAuditLogger,
+	)
+}
+
+func TestVerifyPhoneUseCase_Start_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := newVerifyPhoneUseCase(t)
+
+	mockVerifyPhoneUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{Id: "user-id"}, nil)
+	mockVerifyPhoneRateLimitRepo.EXPECT().Take(ctx, "sms:+79991234567", verifyPhoneSMSPolicy.NumberLimit).
+		Return(entities.RateLimitResult{Allowed: true}, nil)
+	mockVerifyPhoneRandomService.EXPECT().GenerateCode(6).Return("654321", nil)
+	mockVerifyPhoneHashService.EXPECT().GenerateHash("654321").Return([]byte("code-hash"), nil)
+	mockVerifyPhoneCodeRepo.EXPECT().SaveCode(ctx, gomock.AssignableToTypeOf(entities.SMSCode{})).DoAndReturn(
+		func(_ context.Context, code entities.SMSCode) error {
+			assert.Equal(t, entities.Phone("+79991234567"), code.Phone)
+			assert.Equal(t, entities.SMSPurposePhoneVerification, code.Purpose)
+			assert.Equal(t, "code-hash", code.CodeHash)
+			return nil
+		})
+	mockVerifyPhoneSMSSender.EXPECT().Send("+79991234567", gomock.Any()).Return(nil)
+
+	response, err := useCase.Start(ctx, "user-id", requests.StartPhoneVerification{Phone: "+7 (999) 123-45-67"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "+*******4567", response.Phone)
+	assert.WithinDuration(t, time.Now().Add(verifyPhoneSMSPolicy.CodeTTL), response.ExpiresAt, time.Minute)
+}
+
+func TestVerifyPhoneUseCase_Start_InvalidPhone(t *testing.T) {
+	ctx := context.Background()
+	useCase := newVerifyPhoneUseCase(t)
+
+	_, err := useCase.Start(ctx, "user-id", requests.StartPhoneVerification{Phone: "89991234567"})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestVerifyPhoneUseCase_Start_SendError(t *testing.T) {
+	ctx := context.Background()
+	useCase := newVerifyPhoneUseCase(t)
+
+	mockVerifyPhoneUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(entities.User{Id: "user-id"}, nil)
+	mockVerifyPhoneRateLimitRepo.EXPECT().Take(ctx, "sms:+79991234567", verifyPhoneSMSPolicy.NumberLimit).
+		Return(entities.RateLimitResult{Allowed: true}, nil)
+	mockVerifyPhoneRandomService.EXPECT().GenerateCode(6).Return("654321", nil)
+	mockVerifyPhoneHashService.EXPECT().GenerateHash("654321").Return([]byte("code-hash"), nil)
+	mockVerifyPhoneCodeRepo.EXPECT().SaveCode(ctx, gomock.Any()).Return(nil)
+	mockVerifyPhoneSMSSender.EXPECT().Send("+79991234567", gomock.Any()).Return(fmt.Errorf("provider error"))
+
+	_, err := useCase.Start(ctx, "user-id", requests.StartPhoneVerification{Phone: "+79991234567"})
+
+	assert.ErrorContains(t, err, "provider error")
+}
+
+func TestVerifyPhoneUseCase_Confirm_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := newVerifyPhoneUseCase(t)
+
+	mockVerifyPhoneCodeRepo.EXPECT().SelectCode(ctx, "user-id", entities.SMSPurposePhoneVerification).
+		Return(entities.SMSCode{Id: "code-id", UserId: "user-id", Phone: "+79991234567", CodeHash: "code-hash"}, nil)
+	mockVerifyPhoneHashService.EXPECT().CompareStringAndHash("654321", "code-hash").Return(true)
+	mockVerifyPhoneCodeRepo.EXPECT().DeleteCode(ctx, "code-id").Return(nil)
+	mockVerifyPhoneUserRepo.EXPECT().UpdatePhone(ctx, "user-id", entities.Phone("+79991234567")).Return(nil)
+
+	err := useCase.Confirm(ctx, "user-id", requests.ConfirmPhone{Code: " 654321 "})
+
+	assert.NoError(t, err)
+}
+
+func TestVerifyPhoneUseCase_Confirm_WrongCode(t *testing.T) {
+	ctx := context.Background()
+	useCase := newVerifyPhoneUseCase(t)
+
+	mockVerifyPhoneCodeRepo.EXPECT().SelectCode(ctx, "user-id", entities.SMSPurposePhoneVerification).
+		Return(entities.SMSCode{Id: "code-id", UserId: "user-id", Phone: "+79991234567", CodeHash: "code-hash"}, nil)
+	mockVerifyPhoneHashService.EXPECT().CompareStringAndHash("000000", "code-hash").Return(false)
+	mockVerifyPhoneCodeRepo.EXPECT().RegisterCodeFailure(ctx, "code-id").Return(1, nil)
+
+	err := useCase.Confirm(ctx, "user-id", requests.ConfirmPhone{Code: "000000"})
+
+	assert.ErrorIs(t, err, ErrInvalidSMSCode)
+}
+
+func TestVerifyPhoneUseCase_Confirm_ExpiredCode(t *testing.T) {
+	ctx := context.Background()
+	useCase := newVerifyPhoneUseCase(t)
+
+	mockVerifyPhoneCodeRepo.EXPECT().SelectCode(ctx, "user-id", entities.SMSPurposePhoneVerification).
+		Return(entities.SMSCode{}, repositories.ErrEntityNotFound)
+
+	err := useCase.Confirm(ctx, "user-id", requests.ConfirmPhone{Code: "654321"})
+
+	assert.ErrorIs(t, err, ErrInvalidSMSCode)
+}
@@ -0,0 +1,203 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type webAuthnLoginUseCase struct {
+	userRepo          WebAuthnLoginUserRepository
+	loginAttemptRepo  WebAuthnLoginLoginAttemptRepository
+	webAuthnRepo      WebAuthnLoginWebAuthnRepository
+	trustedDeviceRepo WebAuthnLoginTrustedDeviceRepository
+	sessionManager    WebAuthnLoginSessionService
+	cookieService     WebAuthnLoginCookieService
+	webAuthnService   WebAuthnLoginWebAuthnService
+	lockoutPolicy     entities.LockoutPolicy
+	passwordless      bool
+	trustedDeviceTTL  time.Duration
+	auditLogger       WebAuthnLoginAuditLogger
+	completion        signInCompletion
+}
+
+type WebAuthnLoginUseCase interface {
+	BeginWebAuthnLogin(context context.Context, request *requests.BeginWebAuthnLogin) (responses.WebAuthnOptions, error)
+	FinishWebAuthnLogin(context context.Context, writer http.ResponseWriter, request *requests.FinishWebAuthnLogin, userAgent, ip string) (responses.SignIn, error)
+}
+
+func NewWebAuthnLoginUseCase(
+	userRepo WebAuthnLoginUserRepository,
+	sessionRepo WebAuthnLoginSessionRepository,
+	organizationRepo WebAuthnLoginOrganizationRepository,
+	loginAttemptRepo WebAuthnLoginLoginAttemptRepository,
+	mfaRepo WebAuthnLoginMFARepository,
+	webAuthnRepo WebAuthnLoginWebAuthnRepository,
+	trustedDeviceRepo WebAuthnLoginTrustedDeviceRepository,
+	hashProvider WebAuthnLoginHashService,
+	sessionManager WebAuthnLoginSessionService,
+	cookieService WebAuthnLoginCookieService,
+	webAuthnService WebAuthnLoginWebAuthnService,
+	passwordMaxAge time.Duration,
+	lockoutPolicy entities.LockoutPolicy,
+	passwordless bool,
+	trustedDeviceTTL time.Duration,
+	riskEngine WebAuthnLoginRiskEngine,
+	auditLogger WebAuthnLoginAuditLogger,
+) WebAuthnLoginUseCase {
+	return &webAuthnLoginUseCase{
+		userRepo:          userRepo,
+		loginAttemptRepo:  loginAttemptRepo,
+		webAuthnRepo:      webAuthnRepo,
+		trustedDeviceRepo: trustedDeviceRepo,
+		sessionManager:    sessionManager,
+		cookieService:     cookieService,
+		webAuthnService:   webAuthnService,
+		lockoutPolicy:     lockoutPolicy,
+		passwordless:      passwordless,
+		trustedDeviceTTL:  trustedDeviceTTL,
+		auditLogger:       auditLogger,
+		completion: signInCompletion{
+			sessionRepo:      sessionRepo,
+			organizationRepo: organizationRepo,
+			loginAttemptRepo: loginAttemptRepo,
+			mfaRepo:          mfaRepo,
+			webAuthnRepo:     webAuthnRepo,
+			hashProvider:     hashProvider,
+			sessionManager:   sessionManager,
+			cookieService:    cookieService,
+			riskEngine:       riskEngine,
+			auditLogger:      auditLogger,
+			passwordMaxAge:   passwordMaxAge,
+		},
+	}
+}
+
+// BeginWebAuthnLogin starts the assertion ceremony. With the MFA token it is
+// the second factor of the user, without it it is the passwordless login and
+// the user is identified by the passkey.
+func (u *webAuthnLoginUseCase) BeginWebAuthnLogin(context context.Context, request *requests.BeginWebAuthnLogin) (responses.WebAuthnOptions, error) {
+	var options []byte
+	var session entities.WebAuthnSession
+	if request.MFAToken != "" {
+		user, authentication, err := mfaTokenUser(context, u.sessionManager, u.userRepo, request.MFAToken)
+		if err != nil {
+			return responses.WebAuthnOptions{}, err
+		}
+
+		credentials, err := u.webAuthnRepo.SelectCredentials(context, user.Id)
+		if err != nil {
+			return responses.WebAuthnOptions{}, fmt.Errorf("failed to select webauthn credentials: %w", err)
+		}
+		if len(credentials) == 0 {
+			return responses.WebAuthnOptions{}, fmt.Errorf("webauthn credentials not found: %w", ErrEntityNotFound)
+		}
+
+		options, session, err = u.webAuthnService.BeginLogin(user, credentials)
+		if err != nil {
+			return responses.WebAuthnOptions{}, fmt.Errorf("failed to begin webauthn login: %w", err)
+		}
+		session.UserId = user.Id
+		session.Purpose = entities.WebAuthnMFA
+		session.AuthMethods = authentication.Methods
+	} else {
+		if !u.passwordless {
+			return responses.WebAuthnOptions{}, ErrPasswordlessDisabled
+		}
+
+		var err error
+		options, session, err = u.webAuthnService.BeginDiscoverableLogin()
+		if err != nil {
+			return responses.WebAuthnOptions{}, fmt.Errorf("failed to begin webauthn login: %w", err)
+		}
+		session.Purpose = entities.WebAuthnLogin
+	}
+
+	id, err := u.webAuthnRepo.InsertSession(context, session)
+	if err != nil {
+		return responses.WebAuthnOptions{}, fmt.Errorf("failed to save webauthn session: %w", err)
+	}
+
+	return responses.WebAuthnOptions{SessionId: id, Options: options}, nil
+}
+
+// FinishWebAuthnLogin verifies the assertion and issues the same session as
+// the sign in with the password. Failed assertions count as failed sign in
+// attempts of the account.
+func (u *webAuthnLoginUseCase) FinishWebAuthnLogin(context context.Context, writer http.ResponseWriter, request *requests.FinishWebAuthnLogin, userAgent, ip string) (responses.SignIn, error) {
+	response, userId, err := u.finishWebAuthnLogin(context, writer, request, userAgent, ip)
+	u.auditLogger.Log(context, signInAuditEvent(entities.AuditWebAuthnLogin, userId, userAgent, ip, response, err))
+	return response, err
+}
+
+func (u *webAuthnLoginUseCase) finishWebAuthnLogin(context context.Context, writer http.ResponseWriter, request *requests.FinishWebAuthnLogin, userAgent, ip string) (responses.SignIn, string, error) {
+	session, err := u.webAuthnRepo.TakeSession(context, request.SessionId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.SignIn{}, "", fmt.Errorf("%w: the session has expired", ErrInvalidWebAuthnCredential)
+		}
+		return responses.SignIn{}, "", fmt.Errorf("failed to take webauthn session: %w", err)
+	}
+
+	userId := session.UserId
+	switch session.Purpose {
+	case entities.WebAuthnMFA:
+	case entities.WebAuthnLogin:
+		userId, err = u.webAuthnService.CredentialOwner(request.Credential)
+		if err != nil {
+			return responses.SignIn{}, "", fmt.Errorf("%w: %s", ErrInvalidWebAuthnCredential, err)
+		}
+	default:
+		return responses.SignIn{}, "", fmt.Errorf("%w: not a login session", ErrInvalidWebAuthnCredential)
+	}
+
+	user, err := u.userRepo.SelectByUserId(context, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.SignIn{}, "", ErrInvalidWebAuthnCredential
+		}
+		return responses.SignIn{}, "", fmt.Errorf("failed to find user: %w", err)
+	}
+
+	err = checkAttempts(context, u.loginAttemptRepo, u.lockoutPolicy, user.Email, ip)
+	if err != nil {
+		return responses.SignIn{}, user.Id, err
+	}
+
+	err = CheckUserStatus(user)
+	if err != nil {
+		return responses.SignIn{}, user.Id, fmt.Errorf("user can't sign in: %w", err)
+	}
+
+	credentials, err := u.webAuthnRepo.SelectCredentials(context, user.Id)
+	if err != nil {
+		return responses.SignIn{}, user.Id, fmt.Errorf("failed to select webauthn credentials: %w", err)
+	}
+
+	credential, err := u.webAuthnService.FinishLogin(user, credentials, session, request.Credential)
+	if err != nil {
+		if err := registerFailure(context, u.loginAttemptRepo, u.lockoutPolicy, user.Email, ip); err != nil {
+			return responses.SignIn{}, user.Id, err
+		}
+		return responses.SignIn{}, user.Id, fmt.Errorf("%w: %s", ErrInvalidWebAuthnCredential, err)
+	}
+
+	err = u.webAuthnRepo.UpdateCredentialUsage(context, credential)
+	if err != nil {
+		return responses.SignIn{}, user.Id, fmt.Errorf("failed to update webauthn credential: %w", err)
+	}
+
+	authentication := entities.Authentication{Methods: session.AuthMethods}.With(entities.AuthMethodWebAuthn)
+	response, err := u.completion.completeSignIn(context, writer, user, request.OrganizationId, userAgent, ip, authentication)
+	if err != nil || !request.RememberDevice || session.Purpose != entities.WebAuthnMFA {
+		return response, user.Id, err
+	}
+	err = trustDevice(context, writer, u.trustedDeviceRepo, u.sessionManager, u.cookieService, u.trustedDeviceTTL, user, userAgent, ip)
+	return response, user.Id, err
+}
@@ -0,0 +1,206 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockWebAuthnLoginUserRepo       *MockWebAuthnLoginUserRepository
+	mockWebAuthnLoginSessionRepo    *MockWebAuthnLoginSessionRepository
+	mockWebAuthnLoginOrgRepo        *MockWebAuthnLoginOrganizationRepository
+	mockWebAuthnLoginAttemptRepo    *MockWebAuthnLoginLoginAttemptRepository
+	mockWebAuthnLoginMFARepo        *MockWebAuthnLoginMFARepository
+	mockWebAuthnLoginWebAuthnRepo   *MockWebAuthnLoginWebAuthnRepository
+	mockWebAuthnLoginTrustedDevices *MockWebAuthnLoginTrustedDeviceRepository
+	mockWebAuthnLoginHashService    *MockWebAuthnLoginHashService
+	mockWebAuthnLoginSessionService *MockWebAuthnLoginSessionService
+	mockWebAuthnLoginCookieService  *MockWebAuthnLoginCookieService
+	mockWebAuthnLoginWebAuthn       *MockWebAuthnLoginWebAuthnService
+	mockWebAuthnLoginRiskEngine     *MockWebAuthnLoginRiskEngine
+	mockWebAuthnLoginAuditLogger    *MockWebAuthnLoginAuditLogger
+)
+
+func initWebAuthnLoginMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWebAuthnLoginUserRepo = NewMockWebAuthnLoginUserRepository(ctrl)
+	mockWebAuthnLoginSessionRepo = NewMockWebAuthnLoginSessionRepository(ctrl)
+	mockWebAuthnLoginOrgRepo = NewMockWebAuthnLoginOrganizationRepository(ctrl)
+	mockWebAuthnLoginAttemptRepo = NewMockWebAuthnLoginLoginAttemptRepository(ctrl)
+	mockWebAuthnLoginMFARepo = NewMockWebAuthnLoginMFARepository(ctrl)
+	mockWebAuthnLoginWebAuthnRepo = NewMockWebAuthnLoginWebAuthnRepository(ctrl)
+	mockWebAuthnLoginTrustedDevices = NewMockWebAuthnLoginTrustedDeviceRepository(ctrl)
+	mockWebAuthnLoginHashService = NewMockWebAuthnLoginHashService(ctrl)
+	mockWebAuthnLoginSessionService = NewMockWebAuthnLoginSessionService(ctrl)
+	mockWebAuthnLoginCookieService = NewMockWebAuthnLoginCookieService(ctrl)
+	mockWebAuthnLoginWebAuthn = NewMockWebAuthnLoginWebAuthnService(ctrl)
+	mockWebAuthnLoginRiskEngine = NewMockWebAuthnLoginRiskEngine(ctrl)
+	mockWebAuthnLoginRiskEngine.EXPECT().Assess(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(entities.RiskAssessment{}, nil).AnyTimes()
+	mockWebAuthnLoginRiskEngine.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockWebAuthnLoginAuditLogger = NewMockWebAuthnLoginAuditLogger(ctrl)
+	mockWebAuthnLoginAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func newWebAuthnLoginUseCase() WebAuthnLoginUseCase {
+	return NewWebAuthnLoginUseCase(
+		mockWebAuthnLoginUserRepo,
+		mockWebAuthnLoginSessionRepo,
+		mockWebAuthnLoginOrgRepo,
+		mockWebAuthnLoginAttemptRepo,
+		mockWebAuthnLoginMFARepo,
+		mockWebAuthnLoginWebAuthnRepo,
+		mockWebAuthnLoginTrustedDevices,
+		mockWebAuthnLoginHashService,
+		mockWebAuthnLoginSessionService,
+		mockWebAuthnLoginCookieService,
+		mockWebAuthnLoginWebAuthn,
+		0,
+		signInLockoutPolicy,
+		true,
+		signInTrustedDeviceTTL,
+		mockWebAuthnLoginRiskEngine,
+		mockWebAuthnLoginAuditLogger)
+}
+
+func expectWebAuthnLoginAttempts(ctx context.Context, email, ip string) {
+	mockWebAuthnLoginAttemptRepo.EXPECT().Select(ctx, entities.AccountAttemptsKey(entities.Email(email))).
+		Return(entities.LoginAttempts{}, nil)
+	mockWebAuthnLoginAttemptRepo.EXPECT().Select(ctx, entities.IPAttemptsKey(ip)).
+		Return(entities.LoginAttempts{}, nil)
+}
+
+func expectWebAuthnLoginFailure(ctx context.Context, email, ip string) {
+	expectWebAuthnLoginAttempts(ctx, email, ip)
+	mockWebAuthnLoginAttemptRepo.EXPECT().RegisterFailure(ctx, entities.AccountAttemptsKey(entities.Email(email)), signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 1}, nil)
+	mockWebAuthnLoginAttemptRepo.EXPECT().RegisterFailure(ctx, entities.IPAttemptsKey(ip), signInLockoutPolicy.Window).
+		Return(entities.LoginAttempts{Failures: 1}, nil)
+}
+
+func TestWebAuthnLoginUseCase_BeginWebAuthnLogin_Passwordless(t *testing.T) {
+	ctx := context.Background()
+	initWebAuthnLoginMocks(t)
+
+	session := entities.WebAuthnSession{Data: []byte("session"), ExpiresAt: time.Now().Add(time.Minute)}
+	stored := session
+	stored.Purpose = entities.WebAuthnLogin
+
+	mockWebAuthnLoginWebAuthn.EXPECT().BeginDiscoverableLogin().Return([]byte(`{"publicKey":{}}`), session, nil)
+	mockWebAuthnLoginWebAuthnRepo.EXPECT().InsertSession(ctx, stored).Return("session-id", nil)
+
+	response, err := newWebAuthnLoginUseCase().BeginWebAuthnLogin(ctx, &requests.BeginWebAuthnLogin{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "session-id", response.SessionId)
+	assert.JSONEq(t, `{"publicKey":{}}`, string(response.Options))
+}
+
+func TestWebAuthnLoginUseCase_BeginWebAuthnLogin_PasswordlessDisabled(t *testing.T) {
+	ctx := context.Background()
+	initWebAuthnLoginMocks(t)
+
+	useCase := webAuthnLoginUseCase{webAuthnRepo: mockWebAuthnLoginWebAuthnRepo, webAuthnService: mockWebAuthnLoginWebAuthn}
+
+	_, err := useCase.BeginWebAuthnLogin(ctx, &requests.BeginWebAuthnLogin{})
+
+	assert.ErrorIs(t, err, ErrPasswordlessDisabled)
+}
+
+func TestWebAuthnLoginUseCase_BeginWebAuthnLogin_SecondFactor(t *testing.T) {
+	ctx := context.Background()
+	initWebAuthnLoginMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru"}
+	credentials := []entities.WebAuthnCredential{{Id: []byte("credential-id"), UserId: "user-id"}}
+
+	mockWebAuthnLoginSessionService.EXPECT().ParseToken("mfa-token").Return(entities.AccessTokenClaims{
+		entities.UserIdClaimName: "user-id",
+		entities.ScopeClaimName:  entities.ScopeMFA,
+	}, nil)
+	mockWebAuthnLoginUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	mockWebAuthnLoginWebAuthnRepo.EXPECT().SelectCredentials(ctx, "user-id").Return(credentials, nil)
+	mockWebAuthnLoginWebAuthn.EXPECT().BeginLogin(user, credentials).Return([]byte(`{}`), entities.WebAuthnSession{Data: []byte("session")}, nil)
+	mockWebAuthnLoginWebAuthnRepo.EXPECT().InsertSession(ctx, entities.WebAuthnSession{
+		UserId:  "user-id",
+		Purpose: entities.WebAuthnMFA,
+		Data:    []byte("session"),
+	}).Return("session-id", nil)
+
+	response, err := newWebAuthnLoginUseCase().BeginWebAuthnLogin(ctx, &requests.BeginWebAuthnLogin{MFAToken: "mfa-token"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "session-id", response.SessionId)
+}
+
+func TestWebAuthnLoginUseCase_FinishWebAuthnLogin_Passwordless(t *testing.T) {
+	ctx := context.Background()
+	initWebAuthnLoginMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru", Password: "hashed-password"}
+	credentials := []entities.WebAuthnCredential{{Id: []byte("credential-id"), UserId: "user-id", SignCount: 1}}
+	used := entities.WebAuthnCredential{Id: []byte("credential-id"), UserId: "user-id", SignCount: 2}
+	webAuthnSession := entities.WebAuthnSession{Id: "session-id", Purpose: entities.WebAuthnLogin, Data: []byte("session")}
+	session := entities.Session{AccessToken: "access-token", RefreshToken: "refresh-token", UserId: "user-id"}
+	response := []byte(`{"id":"credential-id"}`)
+
+	mockWebAuthnLoginWebAuthnRepo.EXPECT().TakeSession(ctx, "session-id").Return(webAuthnSession, nil)
+	mockWebAuthnLoginWebAuthn.EXPECT().CredentialOwner(gomock.Any()).Return("user-id", nil)
+	mockWebAuthnLoginUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	expectWebAuthnLoginAttempts(ctx, "test@mail.ru", "10.0.0.1")
+	mockWebAuthnLoginWebAuthnRepo.EXPECT().SelectCredentials(ctx, "user-id").Return(credentials, nil)
+	mockWebAuthnLoginWebAuthn.EXPECT().FinishLogin(user, credentials, webAuthnSession, gomock.Any()).Return(used, nil)
+	mockWebAuthnLoginWebAuthnRepo.EXPECT().UpdateCredentialUsage(ctx, used).Return(nil)
+	mockWebAuthnLoginAttemptRepo.EXPECT().Reset(ctx, "account:test@mail.ru").Return(nil)
+	mockWebAuthnLoginSessionRepo.EXPECT().DeleteByUserId(ctx, "user-id").Return(nil)
+	mockWebAuthnLoginSessionService.EXPECT().CreateSession(user, entities.Membership{}, authenticatedWith(entities.AuthMethodWebAuthn)).Return(session, nil)
+	mockWebAuthnLoginHashService.EXPECT().GenerateHash("refresh-token").Return([]byte("hashed-refresh-token"), nil)
+	mockWebAuthnLoginSessionRepo.EXPECT().Insert(ctx, gomock.AssignableToTypeOf(entities.Session{})).Return(nil)
+	mockWebAuthnLoginCookieService.EXPECT().Set(nil, "access_token", "access-token", session.AccessExpiresAt)
+
+	result, err := newWebAuthnLoginUseCase().FinishWebAuthnLogin(ctx, nil, &requests.FinishWebAuthnLogin{
+		SessionId:  "session-id",
+		Credential: response,
+	}, "", "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access-token", result.Session.AccessToken)
+}
+
+func TestWebAuthnLoginUseCase_FinishWebAuthnLogin_InvalidAssertion(t *testing.T) {
+	ctx := context.Background()
+	initWebAuthnLoginMocks(t)
+
+	user := entities.User{Id: "user-id", Email: "test@mail.ru"}
+	webAuthnSession := entities.WebAuthnSession{Id: "session-id", UserId: "user-id", Purpose: entities.WebAuthnMFA}
+
+	mockWebAuthnLoginWebAuthnRepo.EXPECT().TakeSession(ctx, "session-id").Return(webAuthnSession, nil)
+	mockWebAuthnLoginUserRepo.EXPECT().SelectByUserId(ctx, "user-id").Return(user, nil)
+	expectWebAuthnLoginFailure(ctx, "test@mail.ru", "10.0.0.1")
+	mockWebAuthnLoginWebAuthnRepo.EXPECT().SelectCredentials(ctx, "user-id").Return(nil, nil)
+	mockWebAuthnLoginWebAuthn.EXPECT().FinishLogin(user, nil, webAuthnSession, gomock.Any()).
+		Return(entities.WebAuthnCredential{}, fmt.Errorf("signature mismatch"))
+
+	_, err := newWebAuthnLoginUseCase().FinishWebAuthnLogin(ctx, nil, &requests.FinishWebAuthnLogin{SessionId: "session-id"}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrInvalidWebAuthnCredential)
+}
+
+func TestWebAuthnLoginUseCase_FinishWebAuthnLogin_RegistrationSession(t *testing.T) {
+	ctx := context.Background()
+	initWebAuthnLoginMocks(t)
+
+	mockWebAuthnLoginWebAuthnRepo.EXPECT().TakeSession(ctx, "session-id").
+		Return(entities.WebAuthnSession{Id: "session-id", UserId: "user-id", Purpose: entities.WebAuthnRegistration}, nil)
+
+	_, err := newWebAuthnLoginUseCase().FinishWebAuthnLogin(ctx, nil, &requests.FinishWebAuthnLogin{SessionId: "session-id"}, "", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrInvalidWebAuthnCredential)
+}
@@ -0,0 +1,183 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type webhookAlertsUseCase struct {
+	alertRepo        WebhookAlertsAlertRepository
+	nonceRepo        WebhookAlertsNonceRepository
+	signatureService WebhookAlertsSignatureService
+	policy           entities.InboundWebhookPolicy
+	auditLogger      WebhookAlertsAuditLogger
+}
+
+// WebhookAlertsUseCase receives the signed events on the inbound webhook and
+// lets the administrator query them.
+type WebhookAlertsUseCase interface {
+	Receive(context context.Context, request requests.ReceiveWebhook) error
+	List(context context.Context, request requests.ListWebhookAlerts) (responses.WebhookAlertList, error)
+}
+
+func NewWebhookAlertsUseCase(
+	alertRepo WebhookAlertsAlertRepository,
+	nonceRepo WebhookAlertsNonceRepository,
+	signatureService WebhookAlertsSignatureService,
+	policy entities.InboundWebhookPolicy,
+	auditLogger WebhookAlertsAuditLogger,
+) WebhookAlertsUseCase {
+	return &webhookAlertsUseCase{
+		alertRepo:        alertRepo,
+		nonceRepo:        nonceRepo,
+		signatureService: signatureService,
+		policy:           policy,
+		auditLogger:      auditLogger,
+	}
+}
+
+// Receive stores the alert once its signature and timestamp are verified.
+// The body is parsed only after that, so an unsigned request never reaches
+// the storage or the audit log, anyone can send one. The event id and the
+// timestamp are both signed, together they are the nonce rejecting the
+// replayed requests.
+func (u *webhookAlertsUseCase) Receive(context context.Context, request requests.ReceiveWebhook) error {
+	userId, err := u.receive(context, request)
+	if !errors.Is(err, ErrInboundWebhookDisabled) && !errors.Is(err, ErrInvalidWebhookSignature) {
+		u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookReceive, userId, err))
+	}
+	return err
+}
+
+func (u *webhookAlertsUseCase) receive(context context.Context, request requests.ReceiveWebhook) (string, error) {
+	if !u.policy.Enabled() {
+		return "", ErrInboundWebhookDisabled
+	}
+
+	seconds, err := strconv.ParseInt(request.Timestamp, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid timestamp", ErrInvalidWebhookSignature)
+	}
+	signedAt := time.Unix(seconds, 0)
+	if age := time.Since(signedAt); age > u.policy.Tolerance || age < -u.policy.Tolerance {
+		return "", fmt.Errorf("%w: the timestamp is outside of the tolerance", ErrInvalidWebhookSignature)
+	}
+
+	if !u.signatureService.Verify(u.policy.Secret, request.Timestamp, request.Body, request.Signature) {
+		return "", ErrInvalidWebhookSignature
+	}
+
+	payload, err := decodeWebhookAlert(request.Body)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	alert := entities.WebhookAlert{
+		EventId:    payload.Id,
+		Type:       payload.Type,
+		UserId:     payload.Data.UserId,
+		IP:         payload.Data.IP,
+		PreviousIP: payload.Data.PreviousIP,
+		UserAgent:  payload.Data.UserAgent,
+		OccurredAt: payload.CreatedAt,
+	}
+	err = alert.Validate()
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	err = u.nonceRepo.Insert(context, alert.EventId+"."+request.Timestamp, signedAt.Add(u.policy.Tolerance))
+	if errors.Is(err, repositories.ErrEntityAlreadyExists) {
+		return alert.UserId, ErrWebhookReplayed
+	}
+	if err != nil {
+		return alert.UserId, fmt.Errorf("failed to store webhook nonce: %w", err)
+	}
+
+	err = u.alertRepo.Insert(context, alert)
+	if err != nil {
+		return alert.UserId, fmt.Errorf("failed to store webhook alert: %w", err)
+	}
+
+	return alert.UserId, nil
+}
+
+// decodeWebhookAlert parses the body against the schema, the unknown fields
+// and anything after the object are rejected.
+func decodeWebhookAlert(body []byte) (requests.WebhookAlert, error) {
+	var payload requests.WebhookAlert
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return requests.WebhookAlert{}, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return requests.WebhookAlert{}, errors.New("invalid webhook payload: unexpected data after the object")
+	}
+	return payload, nil
+}
+
+// List returns the page of the received alerts, the newest first.
+func (u *webhookAlertsUseCase) List(context context.Context, request requests.ListWebhookAlerts) (responses.WebhookAlertList, error) {
+	response, err := u.list(context, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookAlertList, request.UserId, err))
+	return response, err
+}
+
+func (u *webhookAlertsUseCase) list(context context.Context, request requests.ListWebhookAlerts) (responses.WebhookAlertList, error) {
+	if !request.From.IsZero() && !request.To.IsZero() && request.To.Before(request.From) {
+		return responses.WebhookAlertList{}, fmt.Errorf("%w: the end of the period is before its start", ErrInvalidEntity)
+	}
+
+	page, limit := request.Page, request.Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultAuditPageLimit
+	}
+	if limit > maxAuditPageLimit {
+		limit = maxAuditPageLimit
+	}
+	filter := repositories.WebhookAlertFilter{
+		UserId: request.UserId,
+		Type:   request.Type,
+		From:   request.From,
+		To:     request.To,
+		Offset: (page - 1) * limit,
+		Limit:  limit,
+	}
+
+	total, err := u.alertRepo.Count(context, filter)
+	if err != nil {
+		return responses.WebhookAlertList{}, fmt.Errorf("failed to count webhook alerts: %w", err)
+	}
+
+	alerts, err := u.alertRepo.Select(context, filter)
+	if err != nil {
+		return responses.WebhookAlertList{}, fmt.Errorf("failed to select webhook alerts: %w", err)
+	}
+
+	result := responses.WebhookAlertList{
+		Alerts: make([]responses.WebhookAlert, 0, len(alerts)),
+		Total:  total,
+		Page:   page,
+		Limit:  limit,
+	}
+	for _, alert := range alerts {
+		result.Alerts = append(result.Alerts, responses.NewWebhookAlert(alert))
+	}
+
+	return result, nil
+}
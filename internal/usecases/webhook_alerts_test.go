@@ -0,0 +1,215 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockWebhookAlertRepo         *MockWebhookAlertsAlertRepository
+	mockWebhookNonceRepo         *MockWebhookAlertsNonceRepository
+	mockWebhookSignatureService  *MockWebhookAlertsSignatureService
+	mockWebhookAlertsAuditLogger *MockWebhookAlertsAuditLogger
+)
+
+var testInboundWebhookPolicy = entities.InboundWebhookPolicy{
+	Secret:    "inbound-secret",
+	Tolerance: 5 * time.Minute,
+}
+
+const testWebhookAlertBody = `{"id":"event-id","type":"session.ip_changed","createdAt":"2025-01-01T00:00:00Z",` +
+	`"data":{"userId":"user-id","ip":"203.0.113.7","previousIp":"198.51.100.4","userAgent":"test-agent"}}`
+
+func initWebhookAlertsMocks(t *testing.T, policy entities.InboundWebhookPolicy) WebhookAlertsUseCase {
+	ctrl := gomock.NewController(t)
+	mockWebhookAlertRepo = NewMockWebhookAlertsAlertRepository(ctrl)
+	mockWebhookNonceRepo = NewMockWebhookAlertsNonceRepository(ctrl)
+	mockWebhookSignatureService = NewMockWebhookAlertsSignatureService(ctrl)
+	mockWebhookAlertsAuditLogger = NewMockWebhookAlertsAuditLogger(ctrl)
+	mockWebhookAlertsAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+	return NewWebhookAlertsUseCase(mockWebhookAlertRepo, mockWebhookNonceRepo, mockWebhookSignatureService, policy, mockWebhookAlertsAuditLogger)
+}
+
+func newReceiveWebhook(signedAt time.Time, body string) requests.ReceiveWebhook {
+	return requests.ReceiveWebhook{
+		Timestamp: strconv.FormatInt(signedAt.Unix(), 10),
+		Signature: "v1=signature",
+		Body:      []byte(body),
+	}
+}
+
+func TestWebhookAlertsUseCase_Receive_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := initWebhookAlertsMocks(t, testInboundWebhookPolicy)
+
+	signedAt := time.Now()
+	request := newReceiveWebhook(signedAt, testWebhookAlertBody)
+	alert := entities.WebhookAlert{
+		EventId:    "event-id",
+		Type:       entities.WebhookSessionIPChanged,
+		UserId:     "user-id",
+		IP:         "203.0.113.7",
+		PreviousIP: "198.51.100.4",
+		UserAgent:  "test-agent",
+		OccurredAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockWebhookSignatureService.EXPECT().Verify("inbound-secret", request.Timestamp, request.Body, "v1=signature").Return(true)
+	mockWebhookNonceRepo.EXPECT().Insert(ctx, "event-id."+request.Timestamp, time.Unix(signedAt.Unix(), 0).Add(5*time.Minute)).Return(nil)
+	mockWebhookAlertRepo.EXPECT().Insert(ctx, alert).Return(nil)
+
+	err := useCase.Receive(ctx, request)
+
+	assert.NoError(t, err)
+}
+
+func TestWebhookAlertsUseCase_Receive_Disabled(t *testing.T) {
+	ctx := context.Background()
+	useCase := initWebhookAlertsMocks(t, entities.InboundWebhookPolicy{Tolerance: 5 * time.Minute})
+
+	err := useCase.Receive(ctx, newReceiveWebhook(time.Now(), testWebhookAlertBody))
+
+	assert.ErrorIs(t, err, ErrInboundWebhookDisabled)
+}
+
+func TestWebhookAlertsUseCase_Receive_InvalidSignature(t *testing.T) {
+	ctx := context.Background()
+	useCase := initWebhookAlertsMocks(t, testInboundWebhookPolicy)
+
+	request := newReceiveWebhook(time.Now(), testWebhookAlertBody)
+	mockWebhookSignatureService.EXPECT().Verify("inbound-secret", request.Timestamp, request.Body, "v1=signature").Return(false)
+
+	err := useCase.Receive(ctx, request)
+
+	assert.ErrorIs(t, err, ErrInvalidWebhookSignature)
+}
+
+func TestWebhookAlertsUseCase_Receive_StaleTimestamp(t *testing.T) {
+	ctx := context.Background()
+	useCase := initWebhookAlertsMocks(t, testInboundWebhookPolicy)
+
+	for _, signedAt := range []time.Time{time.Now().Add(-10 * time.Minute), time.Now().Add(10 * time.Minute)} {
+		err := useCase.Receive(ctx, newReceiveWebhook(signedAt, testWebhookAlertBody))
+
+		assert.ErrorIs(t, err, ErrInvalidWebhookSignature)
+	}
+
+	err := useCase.Receive(ctx, requests.ReceiveWebhook{Timestamp: "yesterday", Body: []byte(testWebhookAlertBody)})
+
+	assert.ErrorIs(t, err, ErrInvalidWebhookSignature)
+}
+
+func TestWebhookAlertsUseCase_Receive_Replayed(t *testing.T) {
+	ctx := context.Background()
+	useCase := initWebhookAlertsMocks(t, testInboundWebhookPolicy)
+
+	request := newReceiveWebhook(time.Now(), testWebhookAlertBody)
+	mockWebhookSignatureService.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true)
+	mockWebhookNonceRepo.EXPECT().Insert(ctx, "event-id."+request.Timestamp, gomock.Any()).Return(repositories.ErrEntityAlreadyExists)
+
+	err := useCase.Receive(ctx, request)
+
+	assert.ErrorIs(t, err, ErrWebhookReplayed)
+}
+
+func TestWebhookAlertsUseCase_Receive_InvalidPayload(t *testing.T) {
+	ctx := context.Background()
+	useCase := initWebhookAlertsMocks(t, testInboundWebhookPolicy)
+
+	bodies := []string{
+		`{"id":"event-id","type":"session.ip_changed","createdAt":"2025-01-01T00:00:00Z","data":{"userId":"user-id"},"extra":1}`,
+		`{"id":"event-id","type":"user.hacked","createdAt":"2025-01-01T00:00:00Z","data":{"userId":"user-id"}}`,
+		`{"id":"event-id","type":"user.deleted","createdAt":"2025-01-01T00:00:00Z","data":{"userId":"user-id","ip":"<script>"}}`,
+		`{"id":"event-id","type":"user.deleted","createdAt":"2025-01-01T00:00:00Z","data":{"userId":"user-id"}} {}`,
+		`not json`,
+	}
+	mockWebhookSignatureService.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true).Times(len(bodies))
+
+	for _, body := range bodies {
+		err := useCase.Receive(ctx, newReceiveWebhook(time.Now(), body))
+
+		assert.ErrorIs(t, err, ErrInvalidEntity, body)
+	}
+}
+
+func TestWebhookAlertsUseCase_Receive_StoreError(t *testing.T) {
+	ctx := context.Background()
+	useCase := initWebhookAlertsMocks(t, testInboundWebhookPolicy)
+
+	mockWebhookSignatureService.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true)
+	mockWebhookNonceRepo.EXPECT().Insert(ctx, gomock.Any(), gomock.Any()).Return(nil)
+	mockWebhookAlertRepo.EXPECT().Insert(ctx, gomock.Any()).Return(errors.New("db down"))
+
+	err := useCase.Receive(ctx, newReceiveWebhook(time.Now(), testWebhookAlertBody))
+
+	assert.Error(t, err)
+}
+
+func TestWebhookAlertsUseCase_List_Success(t *testing.T) {
+	ctx := context.Background()
+	useCase := initWebhookAlertsMocks(t, testInboundWebhookPolicy)
+
+	request := requests.ListWebhookAlerts{Page: 3, Limit: 500, UserId: "user-id", Type: entities.WebhookUserDeleted}
+	filter := repositories.WebhookAlertFilter{
+		UserId: "user-id",
+		Type:   entities.WebhookUserDeleted,
+		Offset: 2 * maxAuditPageLimit,
+		Limit:  maxAuditPageLimit,
+	}
+	alerts := []entities.WebhookAlert{{Id: "alert-id", EventId: "event-id", Type: entities.WebhookUserDeleted, UserId: "user-id"}}
+
+	mockWebhookAlertRepo.EXPECT().Count(ctx, filter).Return(201, nil)
+	mockWebhookAlertRepo.EXPECT().Select(ctx, filter).Return(alerts, nil)
+
+	result, err := useCase.List(ctx, request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 201, result.Total)
+	assert.Equal(t, 3, result.Page)
+	assert.Equal(t, maxAuditPageLimit, result.Limit)
+	assert.Len(t, result.Alerts, 1)
+	assert.Equal(t, "event-id", result.Alerts[0].EventId)
+}
+
+func TestWebhookAlertsUseCase_List_InvalidPeriod(t *testing.T) {
+	ctx := context.Background()
+	useCase := initWebhookAlertsMocks(t, testInboundWebhookPolicy)
+
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	_, err := useCase.List(ctx, requests.ListWebhookAlerts{From: from, To: from.Add(-time.Hour)})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestWebhookAlertsUseCase_Receive_RecordsSignedRequestsOnly(t *testing.T) {
+	ctx := context.Background()
+	initWebhookAlertsMocks(t, testInboundWebhookPolicy)
+	auditLogger := NewMockWebhookAlertsAuditLogger(gomock.NewController(t))
+	useCase := NewWebhookAlertsUseCase(mockWebhookAlertRepo, mockWebhookNonceRepo, mockWebhookSignatureService, testInboundWebhookPolicy, auditLogger)
+
+	request := newReceiveWebhook(time.Now(), testWebhookAlertBody)
+	gomock.InOrder(
+		mockWebhookSignatureService.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false),
+		mockWebhookSignatureService.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true),
+	)
+	mockWebhookNonceRepo.EXPECT().Insert(ctx, "event-id."+request.Timestamp, gomock.Any()).Return(repositories.ErrEntityAlreadyExists)
+	// The request with a wrong signature is anonymous and isn't recorded.
+	auditLogger.EXPECT().Log(ctx, entities.AuditEvent{
+		Type:      entities.AuditWebhookReceive,
+		SubjectId: "user-id",
+		Outcome:   entities.AuditOutcomeFailure,
+		Reason:    ErrWebhookReplayed.Error(),
+	})
+
+	assert.ErrorIs(t, useCase.Receive(ctx, request), ErrInvalidWebhookSignature)
+	assert.ErrorIs(t, useCase.Receive(ctx, request), ErrWebhookReplayed)
+}
@@ -0,0 +1,289 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/controllers/responses"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	defaultWebhookDeliveriesLimit = 20
+	maxWebhookDeliveriesLimit     = 100
+)
+
+type webhookEndpointsUseCase struct {
+	webhookRepo       WebhookEndpointsWebhookRepository
+	encryptionService WebhookEndpointsEncryptionService
+	randomService     WebhookEndpointsRandomService
+	auditLogger       WebhookEndpointsAuditLogger
+}
+
+// WebhookEndpointsUseCase lets the administrator manage the webhook
+// endpoints and look into their deliveries. The signing secret is generated
+// by the service and shown only when it is created or rotated.
+type WebhookEndpointsUseCase interface {
+	List(context context.Context) ([]responses.WebhookEndpoint, error)
+	Create(context context.Context, request requests.CreateWebhookEndpoint) (responses.WebhookEndpoint, error)
+	Update(context context.Context, endpointId string, request requests.UpdateWebhookEndpoint) (responses.WebhookEndpoint, error)
+	SetActive(context context.Context, endpointId string, active bool) (responses.WebhookEndpoint, error)
+	Delete(context context.Context, endpointId string) error
+	RotateSecret(context context.Context, endpointId string) (responses.WebhookEndpoint, error)
+	ListDeliveries(context context.Context, endpointId string, request requests.ListWebhookDeliveries) ([]responses.WebhookDelivery, error)
+	Replay(context context.Context, deliveryId string) (responses.WebhookDelivery, error)
+}
+
+func NewWebhookEndpointsUseCase(
+	webhookRepo WebhookEndpointsWebhookRepository,
+	encryptionService WebhookEndpointsEncryptionService,
+	randomService WebhookEndpointsRandomService,
+	auditLogger WebhookEndpointsAuditLogger,
+) WebhookEndpointsUseCase {
+	return &webhookEndpointsUseCase{
+		webhookRepo:       webhookRepo,
+		encryptionService: encryptionService,
+		randomService:     randomService,
+		auditLogger:       auditLogger,
+	}
+}
+
+func (u *webhookEndpointsUseCase) List(context context.Context) ([]responses.WebhookEndpoint, error) {
+	response, err := u.list(context)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookList, "", err))
+	return response, err
+}
+
+func (u *webhookEndpointsUseCase) list(context context.Context) ([]responses.WebhookEndpoint, error) {
+	endpoints, err := u.webhookRepo.SelectEndpoints(context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select webhook endpoints: %w", err)
+	}
+
+	result := make([]responses.WebhookEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		result = append(result, responses.NewWebhookEndpoint(endpoint))
+	}
+	return result, nil
+}
+
+func (u *webhookEndpointsUseCase) Create(context context.Context, request requests.CreateWebhookEndpoint) (responses.WebhookEndpoint, error) {
+	response, err := u.create(context, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookCreate, response.Id, err))
+	return response, err
+}
+
+func (u *webhookEndpointsUseCase) create(context context.Context, request requests.CreateWebhookEndpoint) (responses.WebhookEndpoint, error) {
+	secret, err := u.randomService.GenerateToken()
+	if err != nil {
+		return responses.WebhookEndpoint{}, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	endpoint := entities.WebhookEndpoint{
+		URL:        request.URL,
+		Secret:     secret,
+		EventTypes: request.Events,
+		Active:     true,
+	}
+	err = endpoint.Validate()
+	if err != nil {
+		return responses.WebhookEndpoint{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	endpoint.Secret, err = u.encryptionService.Encrypt(secret)
+	if err != nil {
+		return responses.WebhookEndpoint{}, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+
+	endpoint, err = u.webhookRepo.InsertEndpoint(context, endpoint)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityAlreadyExists) {
+			return responses.WebhookEndpoint{}, fmt.Errorf("%w: webhook endpoint %s already exists", ErrEntityAlreadyExists, request.URL)
+		}
+		return responses.WebhookEndpoint{}, fmt.Errorf("failed to insert webhook endpoint: %w", err)
+	}
+
+	response := responses.NewWebhookEndpoint(endpoint)
+	response.Secret = secret
+	return response, nil
+}
+
+func (u *webhookEndpointsUseCase) Update(context context.Context, endpointId string, request requests.UpdateWebhookEndpoint) (responses.WebhookEndpoint, error) {
+	response, err := u.update(context, endpointId, func(endpoint *entities.WebhookEndpoint) {
+		if request.URL != nil {
+			endpoint.URL = *request.URL
+		}
+		if request.Events != nil {
+			endpoint.EventTypes = *request.Events
+		}
+	})
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookUpdate, endpointId, err))
+	return response, err
+}
+
+// SetActive pauses or resumes the endpoint. The events keep being queued for
+// the paused endpoint and are sent once it is resumed.
+func (u *webhookEndpointsUseCase) SetActive(context context.Context, endpointId string, active bool) (responses.WebhookEndpoint, error) {
+	response, err := u.update(context, endpointId, func(endpoint *entities.WebhookEndpoint) {
+		endpoint.Active = active
+	})
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookUpdate, endpointId, err))
+	return response, err
+}
+
+func (u *webhookEndpointsUseCase) update(context context.Context, endpointId string, change func(endpoint *entities.WebhookEndpoint)) (responses.WebhookEndpoint, error) {
+	endpoint, err := u.selectEndpoint(context, endpointId)
+	if err != nil {
+		return responses.WebhookEndpoint{}, err
+	}
+
+	change(&endpoint)
+	err = endpoint.Validate()
+	if err != nil {
+		return responses.WebhookEndpoint{}, fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	err = u.saveEndpoint(context, endpoint)
+	if err != nil {
+		return responses.WebhookEndpoint{}, err
+	}
+	return responses.NewWebhookEndpoint(endpoint), nil
+}
+
+func (u *webhookEndpointsUseCase) Delete(context context.Context, endpointId string) error {
+	err := u.delete(context, endpointId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookDelete, endpointId, err))
+	return err
+}
+
+func (u *webhookEndpointsUseCase) delete(context context.Context, endpointId string) error {
+	err := u.webhookRepo.DeleteEndpoint(context, endpointId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to find webhook endpoint: %w", ErrEntityNotFound)
+		}
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// RotateSecret replaces the signing secret at once, the pending deliveries
+// are signed with the new one.
+func (u *webhookEndpointsUseCase) RotateSecret(context context.Context, endpointId string) (responses.WebhookEndpoint, error) {
+	response, err := u.rotateSecret(context, endpointId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookSecretRotate, endpointId, err))
+	return response, err
+}
+
+func (u *webhookEndpointsUseCase) rotateSecret(context context.Context, endpointId string) (responses.WebhookEndpoint, error) {
+	endpoint, err := u.selectEndpoint(context, endpointId)
+	if err != nil {
+		return responses.WebhookEndpoint{}, err
+	}
+
+	secret, err := u.randomService.GenerateToken()
+	if err != nil {
+		return responses.WebhookEndpoint{}, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	endpoint.Secret, err = u.encryptionService.Encrypt(secret)
+	if err != nil {
+		return responses.WebhookEndpoint{}, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+
+	err = u.saveEndpoint(context, endpoint)
+	if err != nil {
+		return responses.WebhookEndpoint{}, err
+	}
+
+	response := responses.NewWebhookEndpoint(endpoint)
+	response.Secret = secret
+	return response, nil
+}
+
+// ListDeliveries returns the latest deliveries of the endpoint, optionally
+// only the ones with the status.
+func (u *webhookEndpointsUseCase) ListDeliveries(context context.Context, endpointId string, request requests.ListWebhookDeliveries) ([]responses.WebhookDelivery, error) {
+	response, err := u.listDeliveries(context, endpointId, request)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookDeliveryList, endpointId, err))
+	return response, err
+}
+
+func (u *webhookEndpointsUseCase) listDeliveries(context context.Context, endpointId string, request requests.ListWebhookDeliveries) ([]responses.WebhookDelivery, error) {
+	if request.Status != "" && !entities.IsWebhookDeliveryStatus(request.Status) {
+		return nil, fmt.Errorf("%w: unknown delivery status %q", ErrInvalidEntity, request.Status)
+	}
+
+	_, err := u.selectEndpoint(context, endpointId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := request.Limit
+	if limit < 1 {
+		limit = defaultWebhookDeliveriesLimit
+	}
+	if limit > maxWebhookDeliveriesLimit {
+		limit = maxWebhookDeliveriesLimit
+	}
+
+	deliveries, err := u.webhookRepo.SelectDeliveries(context, repositories.WebhookDeliveryFilter{
+		EndpointId: endpointId,
+		Status:     request.Status,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to select webhook deliveries: %w", err)
+	}
+
+	result := make([]responses.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, responses.NewWebhookDelivery(delivery))
+	}
+	return result, nil
+}
+
+// Replay queues the event of the delivery to its endpoint once more, the
+// receiver sees the same Webhook-Id as in the original delivery.
+func (u *webhookEndpointsUseCase) Replay(context context.Context, deliveryId string) (responses.WebhookDelivery, error) {
+	response, err := u.replay(context, deliveryId)
+	u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookReplay, deliveryId, err))
+	return response, err
+}
+
+func (u *webhookEndpointsUseCase) replay(context context.Context, deliveryId string) (responses.WebhookDelivery, error) {
+	delivery, err := u.webhookRepo.ReplayDelivery(context, deliveryId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return responses.WebhookDelivery{}, fmt.Errorf("failed to find webhook delivery: %w", ErrEntityNotFound)
+		}
+		return responses.WebhookDelivery{}, fmt.Errorf("failed to replay webhook delivery: %w", err)
+	}
+	return responses.NewWebhookDelivery(delivery), nil
+}
+
+func (u *webhookEndpointsUseCase) selectEndpoint(context context.Context, endpointId string) (entities.WebhookEndpoint, error) {
+	endpoint, err := u.webhookRepo.SelectEndpoint(context, endpointId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return entities.WebhookEndpoint{}, fmt.Errorf("failed to find webhook endpoint: %w", ErrEntityNotFound)
+		}
+		return entities.WebhookEndpoint{}, fmt.Errorf("failed to select webhook endpoint: %w", err)
+	}
+	return endpoint, nil
+}
+
+func (u *webhookEndpointsUseCase) saveEndpoint(context context.Context, endpoint entities.WebhookEndpoint) error {
+	err := u.webhookRepo.UpdateEndpoint(context, endpoint)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityAlreadyExists) {
+			return fmt.Errorf("%w: webhook endpoint %s already exists", ErrEntityAlreadyExists, endpoint.URL)
+		}
+		if errors.Is(err, repositories.ErrEntityNotFound) {
+			return fmt.Errorf("failed to find webhook endpoint: %w", ErrEntityNotFound)
+		}
+		return fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package usecases
+
+import (
+	"auth/internal/controllers/requests"
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockWebhookEndpointsRepo        *MockWebhookEndpointsWebhookRepository
+	mockWebhookEndpointsEncryption  *MockWebhookEndpointsEncryptionService
+	mockWebhookEndpointsRandom      *MockWebhookEndpointsRandomService
+	mockWebhookEndpointsAuditLogger *MockWebhookEndpointsAuditLogger
+)
+
+func initWebhookEndpointsMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWebhookEndpointsRepo = NewMockWebhookEndpointsWebhookRepository(ctrl)
+	mockWebhookEndpointsEncryption = NewMockWebhookEndpointsEncryptionService(ctrl)
+	mockWebhookEndpointsRandom = NewMockWebhookEndpointsRandomService(ctrl)
+	mockWebhookEndpointsAuditLogger = NewMockWebhookEndpointsAuditLogger(ctrl)
+	mockWebhookEndpointsAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func newTestWebhookEndpointsUseCase() WebhookEndpointsUseCase {
+	return NewWebhookEndpointsUseCase(
+		mockWebhookEndpointsRepo,
+		mockWebhookEndpointsEncryption,
+		mockWebhookEndpointsRandom,
+		mockWebhookEndpointsAuditLogger,
+	)
+}
+
+func TestWebhookEndpointsUseCase_Create_ReturnsSecretOnce(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	mockWebhookEndpointsRandom.EXPECT().GenerateToken().Return("secret", nil)
+	mockWebhookEndpointsEncryption.EXPECT().Encrypt("secret").Return("encrypted", nil)
+	mockWebhookEndpointsRepo.EXPECT().InsertEndpoint(ctx, entities.WebhookEndpoint{
+		URL:        "https://example.com/hook",
+		Secret:     "encrypted",
+		EventTypes: []string{entities.WebhookUserDeleted},
+		Active:     true,
+	}).Return(entities.WebhookEndpoint{
+		Id:         "webhook-id",
+		URL:        "https://example.com/hook",
+		Secret:     "encrypted",
+		EventTypes: []string{entities.WebhookUserDeleted},
+		Active:     true,
+	}, nil)
+
+	response, err := newTestWebhookEndpointsUseCase().Create(ctx, requests.CreateWebhookEndpoint{
+		URL:    "https://example.com/hook",
+		Events: []string{entities.WebhookUserDeleted},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "webhook-id", response.Id)
+	assert.Equal(t, "secret", response.Secret)
+}
+
+func TestWebhookEndpointsUseCase_Create_InvalidURL(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	mockWebhookEndpointsRandom.EXPECT().GenerateToken().Return("secret", nil)
+
+	_, err := newTestWebhookEndpointsUseCase().Create(ctx, requests.CreateWebhookEndpoint{URL: "ftp://example.com"})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestWebhookEndpointsUseCase_Create_AlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	mockWebhookEndpointsRandom.EXPECT().GenerateToken().Return("secret", nil)
+	mockWebhookEndpointsEncryption.EXPECT().Encrypt("secret").Return("encrypted", nil)
+	mockWebhookEndpointsRepo.EXPECT().InsertEndpoint(ctx, gomock.Any()).
+		Return(entities.WebhookEndpoint{}, repositories.ErrEntityAlreadyExists)
+
+	_, err := newTestWebhookEndpointsUseCase().Create(ctx, requests.CreateWebhookEndpoint{URL: "https://example.com/hook"})
+
+	assert.ErrorIs(t, err, ErrEntityAlreadyExists)
+}
+
+func TestWebhookEndpointsUseCase_Update_KeepsOmittedFields(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	endpoint := entities.WebhookEndpoint{
+		Id:         "webhook-id",
+		URL:        "https://example.com/hook",
+		Secret:     "encrypted",
+		EventTypes: []string{entities.WebhookUserDeleted},
+		Active:     true,
+	}
+	events := []string{entities.WebhookSessionIPChanged}
+	updated := endpoint
+	updated.EventTypes = events
+
+	mockWebhookEndpointsRepo.EXPECT().SelectEndpoint(ctx, "webhook-id").Return(endpoint, nil)
+	mockWebhookEndpointsRepo.EXPECT().UpdateEndpoint(ctx, updated).Return(nil)
+
+	response, err := newTestWebhookEndpointsUseCase().Update(ctx, "webhook-id", requests.UpdateWebhookEndpoint{Events: &events})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/hook", response.URL)
+	assert.Equal(t, events, response.Events)
+	assert.Empty(t, response.Secret)
+}
+
+func TestWebhookEndpointsUseCase_SetActive_Pauses(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	endpoint := entities.WebhookEndpoint{Id: "webhook-id", URL: "https://example.com/hook", Secret: "encrypted", Active: true}
+	paused := endpoint
+	paused.Active = false
+
+	mockWebhookEndpointsRepo.EXPECT().SelectEndpoint(ctx, "webhook-id").Return(endpoint, nil)
+	mockWebhookEndpointsRepo.EXPECT().UpdateEndpoint(ctx, paused).Return(nil)
+
+	response, err := newTestWebhookEndpointsUseCase().SetActive(ctx, "webhook-id", false)
+
+	assert.NoError(t, err)
+	assert.False(t, response.Active)
+}
+
+func TestWebhookEndpointsUseCase_Delete_NotFound(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	mockWebhookEndpointsRepo.EXPECT().DeleteEndpoint(ctx, "webhook-id").Return(repositories.ErrEntityNotFound)
+
+	err := newTestWebhookEndpointsUseCase().Delete(ctx, "webhook-id")
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestWebhookEndpointsUseCase_RotateSecret(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	endpoint := entities.WebhookEndpoint{Id: "webhook-id", URL: "https://example.com/hook", Secret: "old-encrypted", Active: true}
+	rotated := endpoint
+	rotated.Secret = "new-encrypted"
+
+	mockWebhookEndpointsRepo.EXPECT().SelectEndpoint(ctx, "webhook-id").Return(endpoint, nil)
+	mockWebhookEndpointsRandom.EXPECT().GenerateToken().Return("new-secret", nil)
+	mockWebhookEndpointsEncryption.EXPECT().Encrypt("new-secret").Return("new-encrypted", nil)
+	mockWebhookEndpointsRepo.EXPECT().UpdateEndpoint(ctx, rotated).Return(nil)
+
+	response, err := newTestWebhookEndpointsUseCase().RotateSecret(ctx, "webhook-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new-secret", response.Secret)
+}
+
+func TestWebhookEndpointsUseCase_ListDeliveries(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	mockWebhookEndpointsRepo.EXPECT().SelectEndpoint(ctx, "webhook-id").Return(entities.WebhookEndpoint{Id: "webhook-id"}, nil)
+	mockWebhookEndpointsRepo.EXPECT().SelectDeliveries(ctx, repositories.WebhookDeliveryFilter{
+		EndpointId: "webhook-id",
+		Status:     entities.WebhookDeliveryDead,
+		Limit:      100,
+	}).Return([]entities.WebhookDelivery{{Id: "delivery-id", Status: entities.WebhookDeliveryDead, LastStatusCode: 503}}, nil)
+
+	response, err := newTestWebhookEndpointsUseCase().ListDeliveries(ctx, "webhook-id", requests.ListWebhookDeliveries{
+		Status: entities.WebhookDeliveryDead,
+		Limit:  500,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, response, 1)
+	assert.Equal(t, 503, response[0].LastStatusCode)
+}
+
+func TestWebhookEndpointsUseCase_ListDeliveries_UnknownStatus(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	_, err := newTestWebhookEndpointsUseCase().ListDeliveries(ctx, "webhook-id", requests.ListWebhookDeliveries{Status: "lost"})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestWebhookEndpointsUseCase_Replay(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	mockWebhookEndpointsRepo.EXPECT().ReplayDelivery(ctx, "delivery-id").
+		Return(entities.WebhookDelivery{Id: "new-delivery-id", EventId: "event-id", Status: entities.WebhookDeliveryPending}, nil)
+
+	response, err := newTestWebhookEndpointsUseCase().Replay(ctx, "delivery-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new-delivery-id", response.Id)
+	assert.Equal(t, "event-id", response.EventId)
+}
+
+func TestWebhookEndpointsUseCase_Replay_NotFound(t *testing.T) {
+	ctx := context.Background()
+	initWebhookEndpointsMocks(t)
+
+	mockWebhookEndpointsRepo.EXPECT().ReplayDelivery(ctx, "delivery-id").
+		Return(entities.WebhookDelivery{}, repositories.ErrEntityNotFound)
+
+	_, err := newTestWebhookEndpointsUseCase().Replay(ctx, "delivery-id")
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
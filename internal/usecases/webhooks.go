@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type webhooksUseCase struct {
+	webhookRepo       WebhooksWebhookRepository
+	encryptionService WebhooksEncryptionService
+	sender            WebhooksSender
+	policy            entities.WebhookPolicy
+	auditLogger       WebhooksAuditLogger
+}
+
+// WebhooksUseCase delivers the events queued in the outbox to the webhook
+// endpoints. A failed delivery is retried with the exponential backoff and
+// is marked dead once the attempts of the policy are exhausted.
+type WebhooksUseCase interface {
+	Configure(context context.Context, endpoints []entities.WebhookEndpoint) error
+	DeliverDue(context context.Context) (int, error)
+}
+
+func NewWebhooksUseCase(
+	webhookRepo WebhooksWebhookRepository,
+	encryptionService WebhooksEncryptionService,
+	sender WebhooksSender,
+	policy entities.WebhookPolicy,
+	auditLogger WebhooksAuditLogger,
+) WebhooksUseCase {
+	return &webhooksUseCase{
+		webhookRepo:       webhookRepo,
+		encryptionService: encryptionService,
+		sender:            sender,
+		policy:            policy,
+		auditLogger:       auditLogger,
+	}
+}
+
+// Configure creates the endpoints from the configuration, their secrets are
+// stored encrypted. The existing endpoints are left as they are, afterwards
+// they are managed by the administrators.
+func (u *webhooksUseCase) Configure(context context.Context, endpoints []entities.WebhookEndpoint) error {
+	for _, endpoint := range endpoints {
+		endpointId, err := u.configure(context, endpoint)
+		if errors.Is(err, repositories.ErrEntityAlreadyExists) {
+			continue
+		}
+		u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookCreate, endpointId, err))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *webhooksUseCase) configure(context context.Context, endpoint entities.WebhookEndpoint) (string, error) {
+	err := endpoint.Validate()
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidEntity, err)
+	}
+
+	endpoint.Secret, err = u.encryptionService.Encrypt(endpoint.Secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+
+	endpoint, err = u.webhookRepo.InsertEndpoint(context, endpoint)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEntityAlreadyExists) {
+			return "", err
+		}
+		return "", fmt.Errorf("failed to save webhook endpoint: %w", err)
+	}
+	return endpoint.Id, nil
+}
+
+// DeliverDue sends a batch of the due deliveries and returns how many of
+// them were attempted. Only the deliveries given up are recorded in the
+// audit log, the failed attempts before are kept in the delivery itself.
+func (u *webhooksUseCase) DeliverDue(context context.Context) (int, error) {
+	deliveries, err := u.webhookRepo.ClaimDeliveries(context, u.policy.BatchSize, u.policy.Lease)
+	if err != nil {
+		err = fmt.Errorf("failed to claim webhook deliveries: %w", err)
+		u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookDeliver, "", err))
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		delivery, err = u.deliver(delivery)
+		if delivery.Status == entities.WebhookDeliveryDead {
+			u.auditLogger.Log(context, newAuditEvent(entities.AuditWebhookDeliver, delivery.Id, err))
+		}
+		err = u.webhookRepo.UpdateDelivery(context, delivery)
+		if err != nil {
+			return 0, fmt.Errorf("failed to save webhook delivery: %w", err)
+		}
+	}
+	return len(deliveries), nil
+}
+
+// deliver sends the delivery once and returns it updated for the next
+// attempt along with the error of this one.
+func (u *webhooksUseCase) deliver(delivery entities.WebhookDelivery) (entities.WebhookDelivery, error) {
+	delivery.Attempts++
+
+	secret, err := u.encryptionService.Decrypt(delivery.Secret)
+	statusCode := 0
+	if err == nil {
+		statusCode, err = u.sender.Send(delivery.URL, secret, delivery)
+	} else {
+		err = fmt.Errorf("failed to decrypt webhook secret: %w", err)
+	}
+
+	now := time.Now().UTC()
+	delivery.LastStatusCode = statusCode
+	if err == nil {
+		delivery.Status = entities.WebhookDeliveryDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = now
+		return delivery, nil
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= u.policy.MaxAttempts {
+		delivery.Status = entities.WebhookDeliveryDead
+		return delivery, err
+	}
+	delivery.Status = entities.WebhookDeliveryPending
+	delivery.NextAttemptAt = now.Add(u.policy.Backoff(delivery.Attempts))
+	return delivery, err
+}
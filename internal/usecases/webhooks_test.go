@@ -0,0 +1,208 @@
+package usecases
+
+import (
+	"auth/internal/entities"
+	"auth/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockWebhooksRepo        *MockWebhooksWebhookRepository
+	mockWebhooksEncryption  *MockWebhooksEncryptionService
+	mockWebhooksSender      *MockWebhooksSender
+	mockWebhooksAuditLogger *MockWebhooksAuditLogger
+)
+
+var testWebhookPolicy = entities.WebhookPolicy{
+	BatchSize:   10,
+	MaxAttempts: 3,
+	BaseDelay:   time.Minute,
+	MaxDelay:    time.Hour,
+	Lease:       time.Minute,
+}
+
+func initWebhooksMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWebhooksRepo = NewMockWebhooksWebhookRepository(ctrl)
+	mockWebhooksEncryption = NewMockWebhooksEncryptionService(ctrl)
+	mockWebhooksSender = NewMockWebhooksSender(ctrl)
+	mockWebhooksAuditLogger = NewMockWebhooksAuditLogger(ctrl)
+	mockWebhooksAuditLogger.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func newTestWebhooksUseCase() WebhooksUseCase {
+	return NewWebhooksUseCase(mockWebhooksRepo, mockWebhooksEncryption, mockWebhooksSender, testWebhookPolicy, mockWebhooksAuditLogger)
+}
+
+// webhookEventMatcher compares the type and the data of the event, the id
+// and the time are generated.
+type webhookEventMatcher struct {
+	eventType string
+	data      map[string]string
+}
+
+func webhookEventOf(eventType string, data map[string]string) gomock.Matcher {
+	return webhookEventMatcher{eventType: eventType, data: data}
+}
+
+func (m webhookEventMatcher) Matches(x any) bool {
+	event, ok := x.(entities.WebhookEvent)
+	return ok && event.Type == m.eventType && maps.Equal(event.Data, m.data)
+}
+
+func (m webhookEventMatcher) String() string {
+	return fmt.Sprintf("is %s event with %v", m.eventType, m.data)
+}
+
+func TestWebhooksUseCase_Configure_EncryptsSecret(t *testing.T) {
+	ctx := context.Background()
+	initWebhooksMocks(t)
+
+	endpoint := entities.WebhookEndpoint{
+		URL:        "https://example.com/hook",
+		Secret:     "secret",
+		EventTypes: []string{entities.WebhookUserDeleted},
+		Active:     true,
+	}
+	mockWebhooksEncryption.EXPECT().Encrypt("secret").Return("encrypted", nil)
+	stored := endpoint
+	stored.Secret = "encrypted"
+	mockWebhooksRepo.EXPECT().InsertEndpoint(ctx, stored).Return(entities.WebhookEndpoint{}, nil)
+
+	err := newTestWebhooksUseCase().Configure(ctx, []entities.WebhookEndpoint{endpoint})
+
+	assert.NoError(t, err)
+}
+
+func TestWebhooksUseCase_Configure_UnknownEventType(t *testing.T) {
+	ctx := context.Background()
+	initWebhooksMocks(t)
+
+	endpoint := entities.WebhookEndpoint{
+		URL:        "https://example.com/hook",
+		Secret:     "secret",
+		EventTypes: []string{"user.unknown"},
+	}
+
+	err := newTestWebhooksUseCase().Configure(ctx, []entities.WebhookEndpoint{endpoint})
+
+	assert.ErrorIs(t, err, ErrInvalidEntity)
+}
+
+func TestWebhooksUseCase_DeliverDue_Delivered(t *testing.T) {
+	ctx := context.Background()
+	initWebhooksMocks(t)
+
+	delivery := entities.WebhookDelivery{Id: "delivery-id", URL: "https://example.com/hook", Secret: "encrypted"}
+	mockWebhooksRepo.EXPECT().ClaimDeliveries(ctx, 10, time.Minute).Return([]entities.WebhookDelivery{delivery}, nil)
+	mockWebhooksEncryption.EXPECT().Decrypt("encrypted").Return("secret", nil)
+	mockWebhooksSender.EXPECT().Send("https://example.com/hook", "secret", gomock.Any()).Return(204, nil)
+	mockWebhooksRepo.EXPECT().UpdateDelivery(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, delivery entities.WebhookDelivery) error {
+			assert.Equal(t, entities.WebhookDeliveryDelivered, delivery.Status)
+			assert.Equal(t, 1, delivery.Attempts)
+			assert.Equal(t, 204, delivery.LastStatusCode)
+			assert.False(t, delivery.DeliveredAt.IsZero())
+			return nil
+		})
+
+	count, err := newTestWebhooksUseCase().DeliverDue(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestWebhooksUseCase_DeliverDue_RetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	initWebhooksMocks(t)
+
+	delivery := entities.WebhookDelivery{Id: "delivery-id", URL: "https://example.com/hook", Secret: "encrypted", Attempts: 1}
+	mockWebhooksRepo.EXPECT().ClaimDeliveries(ctx, 10, time.Minute).Return([]entities.WebhookDelivery{delivery}, nil)
+	mockWebhooksEncryption.EXPECT().Decrypt("encrypted").Return("secret", nil)
+	mockWebhooksSender.EXPECT().Send("https://example.com/hook", "secret", gomock.Any()).
+		Return(500, fmt.Errorf("webhook endpoint responded with status 500"))
+	mockWebhooksRepo.EXPECT().UpdateDelivery(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, delivery entities.WebhookDelivery) error {
+			assert.Equal(t, entities.WebhookDeliveryPending, delivery.Status)
+			assert.Equal(t, 2, delivery.Attempts)
+			assert.Equal(t, 500, delivery.LastStatusCode)
+			assert.WithinDuration(t, time.Now().Add(2*time.Minute), delivery.NextAttemptAt, 5*time.Second)
+			return nil
+		})
+
+	_, err := newTestWebhooksUseCase().DeliverDue(ctx)
+
+	assert.NoError(t, err)
+}
+
+func TestWebhooksUseCase_DeliverDue_DeadAfterLastAttempt(t *testing.T) {
+	ctx := context.Background()
+	initWebhooksMocks(t)
+
+	delivery := entities.WebhookDelivery{Id: "delivery-id", URL: "https://example.com/hook", Secret: "encrypted", Attempts: 2}
+	mockWebhooksRepo.EXPECT().ClaimDeliveries(ctx, 10, time.Minute).Return([]entities.WebhookDelivery{delivery}, nil)
+	mockWebhooksEncryption.EXPECT().Decrypt("encrypted").Return("secret", nil)
+	mockWebhooksSender.EXPECT().Send("https://example.com/hook", "secret", gomock.Any()).
+		Return(0, fmt.Errorf("connection refused"))
+	mockWebhooksRepo.EXPECT().UpdateDelivery(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, delivery entities.WebhookDelivery) error {
+			assert.Equal(t, entities.WebhookDeliveryDead, delivery.Status)
+			assert.Equal(t, 3, delivery.Attempts)
+			assert.Equal(t, "connection refused", delivery.LastError)
+			return nil
+		})
+
+	_, err := newTestWebhooksUseCase().DeliverDue(ctx)
+
+	assert.NoError(t, err)
+}
+
+func TestWebhooksUseCase_Configure_KeepsExistingEndpoint(t *testing.T) {
+	ctx := context.Background()
+	initWebhooksMocks(t)
+
+	endpoint := entities.WebhookEndpoint{URL: "https://example.com/hook", Secret: "secret", Active: true}
+	mockWebhooksEncryption.EXPECT().Encrypt("secret").Return("encrypted", nil)
+	mockWebhooksRepo.EXPECT().InsertEndpoint(ctx, gomock.Any()).Return(entities.WebhookEndpoint{}, repositories.ErrEntityAlreadyExists)
+
+	err := newTestWebhooksUseCase().Configure(ctx, []entities.WebhookEndpoint{endpoint})
+
+	assert.NoError(t, err)
+}
+
+func TestWebhooksUseCase_DeliverDue_RecordsDeadDeliveriesOnly(t *testing.T) {
+	ctx := context.Background()
+	initWebhooksMocks(t)
+	auditLogger := NewMockWebhooksAuditLogger(gomock.NewController(t))
+
+	// The first delivery is retried later and isn't recorded, the second one
+	// has used up its attempts.
+	deliveries := []entities.WebhookDelivery{
+		{Id: "retried-id", URL: "https://example.com/hook", Secret: "encrypted", Attempts: 1},
+		{Id: "dead-id", URL: "https://example.com/hook", Secret: "encrypted", Attempts: 2},
+	}
+	mockWebhooksRepo.EXPECT().ClaimDeliveries(ctx, 10, time.Minute).Return(deliveries, nil)
+	mockWebhooksEncryption.EXPECT().Decrypt("encrypted").Return("secret", nil).Times(2)
+	mockWebhooksSender.EXPECT().Send("https://example.com/hook", "secret", gomock.Any()).Return(500, errors.New("unexpected status 500")).Times(2)
+	auditLogger.EXPECT().Log(ctx, entities.AuditEvent{
+		Type:      entities.AuditWebhookDeliver,
+		SubjectId: "dead-id",
+		Outcome:   entities.AuditOutcomeFailure,
+		Reason:    auditInternalError,
+	})
+	mockWebhooksRepo.EXPECT().UpdateDelivery(ctx, gomock.Any()).Return(nil).Times(2)
+
+	useCase := NewWebhooksUseCase(mockWebhooksRepo, mockWebhooksEncryption, mockWebhooksSender, testWebhookPolicy, auditLogger)
+	count, err := useCase.DeliverDue(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
@@ -0,0 +1,116 @@
+package pkg
+
+import (
+	"auth/config"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/argon2"
+	"strings"
+)
+
+const argon2idPrefix = "$" + HashAlgorithmArgon2id + "$"
+
+var errInvalidArgon2idHash = errors.New("invalid argon2id hash")
+
+type argon2idParams struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	saltLength  uint32
+	keyLength   uint32
+}
+
+type argon2idHashService struct {
+	params argon2idParams
+}
+
+func NewArgon2idHashService(cfg config.Argon2id) HashService {
+	return &argon2idHashService{
+		params: argon2idParams{
+			memory:      cfg.Memory,
+			iterations:  cfg.Iterations,
+			parallelism: cfg.Parallelism,
+			saltLength:  cfg.SaltLength,
+			keyLength:   cfg.KeyLength,
+		},
+	}
+}
+
+// GenerateHash returns the hash in the PHC string format:
+// $argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>.
+func (s *argon2idHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	salt := make([]byte, s.params.saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	key := argon2.IDKey([]byte(stringToHash), salt, s.params.iterations, s.params.memory, s.params.parallelism, s.params.keyLength)
+
+	return []byte(fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		s.params.memory,
+		s.params.iterations,
+		s.params.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)), nil
+}
+
+func (s *argon2idHashService) CompareStringAndHash(stringToCompare string, hashedString string) bool {
+	return compareStringAndHash(stringToCompare, hashedString)
+}
+
+func (s *argon2idHashService) NeedsRehash(hashedString string) bool {
+	params, _, _, err := decodeArgon2idHash(hashedString)
+	if err != nil {
+		return true
+	}
+	return params != s.params
+}
+
+func compareArgon2id(stringToCompare string, hashedString string) bool {
+	params, salt, key, err := decodeArgon2idHash(hashedString)
+	if err != nil {
+		return false
+	}
+
+	otherKey := argon2.IDKey([]byte(stringToCompare), salt, params.iterations, params.memory, params.parallelism, params.keyLength)
+	return subtle.ConstantTimeCompare(key, otherKey) == 1
+}
+
+func decodeArgon2idHash(hashedString string) (argon2idParams, []byte, []byte, error) {
+	parts := strings.Split(hashedString, "$")
+	if len(parts) != 6 || parts[1] != HashAlgorithmArgon2id {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	var version int
+	_, err := fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil || version != argon2.Version {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	var params argon2idParams
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism)
+	if err != nil {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	params.saltLength = uint32(len(salt))
+	params.keyLength = uint32(len(key))
+	return params, salt, key, nil
+}
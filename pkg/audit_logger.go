@@ -0,0 +1,50 @@
+package pkg
+
+import (
+	"auth/internal/entities"
+	"auth/pkg/logger"
+	"context"
+)
+
+// AuditLogger records the security events. The actor, the IP address and the
+// user agent missing from the event are taken from the request context. A
+// failure to store the event is logged and doesn't fail the request.
+type AuditLogger interface {
+	Log(context context.Context, event entities.AuditEvent)
+}
+
+// AuditEventWriter stores the audit events.
+type AuditEventWriter interface {
+	Insert(context context.Context, event entities.AuditEvent) error
+}
+
+type auditLogger struct {
+	writer AuditEventWriter
+	logger logger.Logger
+}
+
+func NewAuditLogger(writer AuditEventWriter, logger logger.Logger) AuditLogger {
+	return &auditLogger{writer: writer, logger: logger}
+}
+
+func (a *auditLogger) Log(context context.Context, event entities.AuditEvent) {
+	if event.ActorId == "" {
+		event.ActorId = contextString(context, entities.ContextUserIdKey)
+	}
+	if event.IP == "" {
+		event.IP = contextString(context, entities.ContextIPKey)
+	}
+	if event.UserAgent == "" {
+		event.UserAgent = contextString(context, entities.ContextUserAgentKey)
+	}
+
+	err := a.writer.Insert(context, event)
+	if err != nil {
+		a.logger.Error().Msgf("failed to record audit event %s of %s: %s", event.Type, event.SubjectId, err)
+	}
+}
+
+func contextString(context context.Context, key string) string {
+	value, _ := context.Value(key).(string)
+	return value
+}
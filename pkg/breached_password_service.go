@@ -0,0 +1,240 @@
+package pkg
+
+import (
+	"auth/config"
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	sha1Size              = sha1.Size
+	breachedBinaryFileExt = ".bin"
+)
+
+// Formats of the text corpus.
+const (
+	BreachedFormatPasswords = "passwords"
+	BreachedFormatSHA1      = "sha1"
+)
+
+// BreachedPasswordService checks passwords against a local corpus of breached
+// or common passwords, it never calls external services.
+type BreachedPasswordService interface {
+	IsBreached(password string) (bool, error)
+}
+
+// NewBreachedPasswordService loads the corpus from cfg.Path. Files with the
+// .bin extension are sorted SHA-1 digests searched on disk, any other file is
+// a text list in cfg.Format loaded into memory. An empty path disables the
+// check.
+func NewBreachedPasswordService(cfg config.BreachedPasswords) (BreachedPasswordService, error) {
+	if cfg.Path == "" {
+		return &disabledBreachedPasswordService{}, nil
+	}
+
+	if strings.HasSuffix(cfg.Path, breachedBinaryFileExt) {
+		return newFileBreachedPasswordService(cfg.Path)
+	}
+
+	file, err := os.Open(cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached passwords corpus: %w", err)
+	}
+	defer file.Close()
+
+	format := cfg.Format
+	if format == "" {
+		format = BreachedFormatPasswords
+	}
+	digests, err := ReadBreachedPasswords(file, format)
+	if err != nil {
+		return nil, err
+	}
+	return &memoryBreachedPasswordService{digests: digests}, nil
+}
+
+type disabledBreachedPasswordService struct{}
+
+func (s *disabledBreachedPasswordService) IsBreached(string) (bool, error) {
+	return false, nil
+}
+
+type memoryBreachedPasswordService struct {
+	digests [][sha1Size]byte
+}
+
+func (s *memoryBreachedPasswordService) IsBreached(password string) (bool, error) {
+	digest := sha1.Sum([]byte(password))
+	i := sort.Search(len(s.digests), func(i int) bool {
+		return bytes.Compare(s.digests[i][:], digest[:]) >= 0
+	})
+	return i < len(s.digests) && s.digests[i] == digest, nil
+}
+
+// fileBreachedPasswordService binary searches the file without loading it,
+// so even the full HIBP corpus takes no memory.
+type fileBreachedPasswordService struct {
+	file  *os.File
+	count int64
+}
+
+func newFileBreachedPasswordService(path string) (BreachedPasswordService, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached passwords corpus: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to stat breached passwords corpus: %w", err)
+	}
+	if info.Size()%sha1Size != 0 {
+		file.Close()
+		return nil, fmt.Errorf("breached passwords corpus size must be a multiple of %d bytes", sha1Size)
+	}
+
+	return &fileBreachedPasswordService{file: file, count: info.Size() / sha1Size}, nil
+}
+
+func (s *fileBreachedPasswordService) IsBreached(password string) (bool, error) {
+	digest := sha1.Sum([]byte(password))
+	record := make([]byte, sha1Size)
+
+	low, high := int64(0), s.count
+	for low < high {
+		middle := low + (high-low)/2
+		_, err := s.file.ReadAt(record, middle*sha1Size)
+		if err != nil {
+			return false, fmt.Errorf("failed to read breached passwords corpus: %w", err)
+		}
+
+		switch bytes.Compare(record, digest[:]) {
+		case 0:
+			return true, nil
+		case -1:
+			low = middle + 1
+		default:
+			high = middle
+		}
+	}
+	return false, nil
+}
+
+// ReadBreachedPasswords parses a text corpus into sorted unique SHA-1
+// digests. The lines of the BreachedFormatSHA1 corpus are HIBP records
+// "<SHA-1 hex>[:count]", the lines of the BreachedFormatPasswords one are
+// plain passwords. The whole corpus is held in memory, so it suits the common
+// passwords lists; the full HIBP dump is converted with ConvertBreachedDigests.
+func ReadBreachedPasswords(reader io.Reader, format string) ([][sha1Size]byte, error) {
+	if format != BreachedFormatPasswords && format != BreachedFormatSHA1 {
+		return nil, fmt.Errorf("unknown breached passwords format %q", format)
+	}
+
+	var digests [][sha1Size]byte
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if text == "" {
+			continue
+		}
+		if format == BreachedFormatPasswords {
+			digests = append(digests, sha1.Sum([]byte(text)))
+			continue
+		}
+
+		digest, err := parseBreachedDigest(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		digests = append(digests, digest)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read breached passwords corpus: %w", err)
+	}
+
+	sort.Slice(digests, func(i, j int) bool {
+		return bytes.Compare(digests[i][:], digests[j][:]) < 0
+	})
+
+	unique := digests[:0]
+	for i, digest := range digests {
+		if i == 0 || digest != digests[i-1] {
+			unique = append(unique, digest)
+		}
+	}
+	return unique, nil
+}
+
+// WriteBreachedPasswords stores the digests in the binary format read by the
+// .bin corpus: fixed size records in ascending order.
+func WriteBreachedPasswords(writer io.Writer, digests [][sha1Size]byte) error {
+	buffered := bufio.NewWriter(writer)
+	for _, digest := range digests {
+		if _, err := buffered.Write(digest[:]); err != nil {
+			return err
+		}
+	}
+	return buffered.Flush()
+}
+
+// ConvertBreachedDigests streams the HIBP SHA-1 dump ordered by hash into the
+// binary format record by record, so the dump of any size takes no memory.
+// It returns the number of the records written and fails on the first line
+// out of order.
+func ConvertBreachedDigests(reader io.Reader, writer io.Writer) (int, error) {
+	buffered := bufio.NewWriter(writer)
+	scanner := bufio.NewScanner(reader)
+
+	var previous [sha1Size]byte
+	count := 0
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if text == "" {
+			continue
+		}
+
+		digest, err := parseBreachedDigest(text)
+		if err != nil {
+			return count, fmt.Errorf("line %d: %w", line, err)
+		}
+		if count > 0 {
+			switch bytes.Compare(digest[:], previous[:]) {
+			case 0:
+				continue
+			case -1:
+				return count, fmt.Errorf("line %d: the dump must be ordered by hash", line)
+			}
+		}
+
+		if _, err = buffered.Write(digest[:]); err != nil {
+			return count, err
+		}
+		previous = digest
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		return count, fmt.Errorf("failed to read breached passwords corpus: %w", err)
+	}
+	return count, buffered.Flush()
+}
+
+func parseBreachedDigest(line string) ([sha1Size]byte, error) {
+	var digest [sha1Size]byte
+	hash, _, _ := strings.Cut(line, ":")
+	if len(hash) != hex.EncodedLen(sha1Size) {
+		return digest, fmt.Errorf("invalid SHA-1 hash %q", hash)
+	}
+	_, err := hex.Decode(digest[:], []byte(hash))
+	if err != nil {
+		return digest, fmt.Errorf("invalid SHA-1 hash %q", hash)
+	}
+	return digest, nil
+}
@@ -0,0 +1,200 @@
+package pkg
+
+import (
+	"auth/config"
+	"auth/internal/entities"
+	"auth/pkg/logger"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	defaultEventTimeout    = 10 * time.Second
+	defaultEventSource     = "auth"
+	defaultNotifyChannel   = "auth_events"
+	cloudEventsContentType = "application/cloudevents+json"
+)
+
+// EventPublisher sends the domain events to the message broker as CloudEvents
+// in the structured JSON mode. Publish returns once the broker has accepted
+// the event, the events of a user are published one after another, so the
+// broker keeps them in order.
+type EventPublisher interface {
+	Publish(context context.Context, event entities.DomainEvent) error
+	Close() error
+}
+
+// logEventPublisher writes the events to the debug log, it is used when no
+// broker is configured.
+type logEventPublisher struct {
+	source string
+	logger logger.Logger
+}
+
+// notifyEventPublisher sends the events with the Postgres NOTIFY to the
+// channel, the consumers LISTEN to it. A notification is only delivered to
+// the connected consumers, the payload is limited to 8000 bytes.
+type notifyEventPublisher struct {
+	source  string
+	channel string
+	timeout time.Duration
+	pool    *pgxpool.Pool
+}
+
+// natsEventPublisher publishes the events to the JetStream stream, the subject
+// is the configured prefix followed by the event type. The event id is the
+// message id, so the stream drops the duplicates within its window.
+type natsEventPublisher struct {
+	source    string
+	subject   string
+	timeout   time.Duration
+	conn      *nats.Conn
+	jetStream jetstream.JetStream
+}
+
+// kafkaEventPublisher writes the events to the topic keyed by the user, so
+// the events of a user land in one partition and keep their order.
+type kafkaEventPublisher struct {
+	source  string
+	timeout time.Duration
+	writer  *kafka.Writer
+}
+
+func NewEventPublisher(cfg config.Events, pool *pgxpool.Pool, logger logger.Logger) (EventPublisher, error) {
+	source := cfg.Source
+	if source == "" {
+		source = defaultEventSource
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultEventTimeout
+	}
+
+	switch cfg.Transport {
+	case "", "log":
+		return &logEventPublisher{source: source, logger: logger}, nil
+	case "notify":
+		channel := cfg.Notify.Channel
+		if channel == "" {
+			channel = defaultNotifyChannel
+		}
+		return &notifyEventPublisher{source: source, channel: channel, timeout: timeout, pool: pool}, nil
+	case "nats":
+		if cfg.NATS.URL == "" || cfg.NATS.Subject == "" {
+			return nil, fmt.Errorf("the nats url and subject must be set")
+		}
+		conn, err := nats.Connect(
+			cfg.NATS.URL,
+			nats.Name(source),
+			nats.Timeout(timeout),
+			nats.RetryOnFailedConnect(true),
+			nats.MaxReconnects(-1),
+		)
+		if err != nil {
+			return nil, err
+		}
+		jetStream, err := jetstream.New(conn)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return &natsEventPublisher{
+			source:    source,
+			subject:   cfg.NATS.Subject,
+			timeout:   timeout,
+			conn:      conn,
+			jetStream: jetStream,
+		}, nil
+	case "kafka":
+		if len(cfg.Kafka.Brokers) == 0 || cfg.Kafka.Topic == "" {
+			return nil, fmt.Errorf("the kafka brokers and topic must be set")
+		}
+		return &kafkaEventPublisher{
+			source:  source,
+			timeout: timeout,
+			writer: &kafka.Writer{
+				Addr:         kafka.TCP(cfg.Kafka.Brokers...),
+				Topic:        cfg.Kafka.Topic,
+				Balancer:     &kafka.Hash{},
+				RequiredAcks: kafka.RequireAll,
+				BatchSize:    1,
+				WriteTimeout: timeout,
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown event transport %q", cfg.Transport)
+}
+
+func (p *logEventPublisher) Publish(_ context.Context, event entities.DomainEvent) error {
+	body, err := event.CloudEvent(p.source)
+	if err != nil {
+		return err
+	}
+	p.logger.Debug().Msgf("domain event: %s", body)
+	return nil
+}
+
+func (p *logEventPublisher) Close() error {
+	return nil
+}
+
+func (p *notifyEventPublisher) Publish(ctx context.Context, event entities.DomainEvent) error {
+	body, err := event.CloudEvent(p.source)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	_, err = p.pool.Exec(ctx, "SELECT pg_notify($1, $2)", p.channel, string(body))
+	return err
+}
+
+func (p *notifyEventPublisher) Close() error {
+	return nil
+}
+
+func (p *natsEventPublisher) Publish(ctx context.Context, event entities.DomainEvent) error {
+	body, err := event.CloudEvent(p.source)
+	if err != nil {
+		return err
+	}
+
+	message := nats.NewMsg(p.subject + "." + event.Type)
+	message.Header.Set("Content-Type", cloudEventsContentType)
+	message.Data = body
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	_, err = p.jetStream.PublishMsg(ctx, message, jetstream.WithMsgID(event.Id))
+	return err
+}
+
+func (p *natsEventPublisher) Close() error {
+	return p.conn.Drain()
+}
+
+func (p *kafkaEventPublisher) Publish(ctx context.Context, event entities.DomainEvent) error {
+	body, err := event.CloudEvent(p.source)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Key:     []byte(event.UserId),
+		Value:   body,
+		Headers: []kafka.Header{{Key: "content-type", Value: []byte(cloudEventsContentType)}},
+	})
+}
+
+func (p *kafkaEventPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,78 @@
+package pkg
+
+import (
+	"auth/internal/entities"
+	"net"
+	"strings"
+
+	"github.com/oschwald/geoip2-golang"
+)
+
+// GeoIPService locates the IP addresses with the offline MaxMind database,
+// the GeoLite2 City database gives the coordinates, the Country one only the
+// country. An address missing from the database has an empty location.
+type GeoIPService interface {
+	Locate(ip string) entities.Location
+	Close() error
+}
+
+// noGeoIPService is used when no database is configured, it knows no
+// address, so only the device and the address signals are assessed.
+type noGeoIPService struct{}
+
+type geoIPService struct {
+	reader *geoip2.Reader
+	city   bool
+}
+
+func NewGeoIPService(database string) (GeoIPService, error) {
+	if database == "" {
+		return noGeoIPService{}, nil
+	}
+
+	reader, err := geoip2.Open(database)
+	if err != nil {
+		return nil, err
+	}
+	return &geoIPService{
+		reader: reader,
+		city:   strings.Contains(reader.Metadata().DatabaseType, "City"),
+	}, nil
+}
+
+func (noGeoIPService) Locate(string) entities.Location {
+	return entities.Location{}
+}
+
+func (noGeoIPService) Close() error {
+	return nil
+}
+
+func (s *geoIPService) Locate(ip string) entities.Location {
+	address := net.ParseIP(ip)
+	if address == nil {
+		return entities.Location{}
+	}
+
+	if !s.city {
+		record, err := s.reader.Country(address)
+		if err != nil {
+			return entities.Location{}
+		}
+		return entities.Location{Country: record.Country.IsoCode}
+	}
+
+	record, err := s.reader.City(address)
+	if err != nil {
+		return entities.Location{}
+	}
+	return entities.Location{
+		Country:   record.Country.IsoCode,
+		Latitude:  record.Location.Latitude,
+		Longitude: record.Location.Longitude,
+	}
+}
+
+func (s *geoIPService) Close() error {
+	return s.reader.Close()
+}
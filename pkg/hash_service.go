@@ -1,13 +1,20 @@
 package pkg
 
 import (
+	"auth/config"
 	"golang.org/x/crypto/bcrypt"
+	"strings"
 )
 
 const (
-	hashCost = 10
+	HashAlgorithmBcrypt   = "bcrypt"
+	HashAlgorithmArgon2id = "argon2id"
 )
 
+// BcryptMaxPasswordBytes is how much of the password bcrypt hashes, longer
+// passwords are refused by it.
+const BcryptMaxPasswordBytes = 72
+
 type bcryptHashService struct {
 	hashCost int
 }
@@ -15,9 +22,28 @@ type bcryptHashService struct {
 type HashService interface {
 	GenerateHash(stringToHash string) ([]byte, error)
 	CompareStringAndHash(stringToCompare string, hashedString string) bool
+	// NeedsRehash reports whether the hash was produced by another algorithm
+	// or with parameters that differ from the configured ones.
+	NeedsRehash(hashedString string) bool
+}
+
+// NewHashService returns the service for the configured algorithm with the
+// optional pepper. Every service verifies hashes of any supported algorithm,
+// so switching the algorithm doesn't invalidate the stored passwords.
+func NewHashService(cfg config.PasswordHashing) HashService {
+	var hashService HashService
+	if cfg.Algorithm == HashAlgorithmBcrypt {
+		hashService = NewBcryptHashService(cfg.BcryptCost)
+	} else {
+		hashService = NewArgon2idHashService(cfg.Argon2id)
+	}
+	return NewPepperedHashService(hashService, cfg.Pepper)
 }
 
-func NewBcryptHashService() HashService {
+func NewBcryptHashService(hashCost int) HashService {
+	if hashCost == 0 {
+		hashCost = bcrypt.DefaultCost
+	}
 	return &bcryptHashService{hashCost: hashCost}
 }
 
@@ -30,6 +56,24 @@ func (p *bcryptHashService) GenerateHash(stringToHash string) ([]byte, error) {
 }
 
 func (p *bcryptHashService) CompareStringAndHash(stringToCompare string, hashedString string) bool {
+	return compareStringAndHash(stringToCompare, hashedString)
+}
+
+func (p *bcryptHashService) NeedsRehash(hashedString string) bool {
+	cost, err := bcrypt.Cost([]byte(hashedString))
+	if err != nil {
+		return true
+	}
+	return cost != p.hashCost
+}
+
+// compareStringAndHash detects the algorithm from the format of the stored
+// hash: PHC strings for Argon2id and modular crypt strings for bcrypt.
+func compareStringAndHash(stringToCompare string, hashedString string) bool {
+	if strings.HasPrefix(hashedString, argon2idPrefix) {
+		return compareArgon2id(stringToCompare, hashedString)
+	}
+
 	passwordMatched := bcrypt.CompareHashAndPassword([]byte(hashedString), []byte(stringToCompare))
 	if passwordMatched != nil {
 		return false
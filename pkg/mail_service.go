@@ -0,0 +1,60 @@
+package pkg
+
+import (
+	"auth/config"
+	"auth/pkg/logger"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+// MailService sends plain text emails to users.
+type MailService interface {
+	Send(to, subject, body string) error
+}
+
+type smtpMailService struct {
+	address string
+	from    string
+	auth    smtp.Auth
+}
+
+// logMailService writes the emails to the log, it is used when no SMTP
+// server is configured, e.g. in development.
+type logMailService struct {
+	logger logger.Logger
+}
+
+func NewMailService(cfg config.Mail, logger logger.Logger) MailService {
+	if cfg.Host == "" {
+		return &logMailService{logger: logger}
+	}
+
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return &smtpMailService{
+		address: net.JoinHostPort(cfg.Host, cfg.Port),
+		from:    cfg.From,
+		auth:    auth,
+	}
+}
+
+func (s *smtpMailService) Send(to, subject, body string) error {
+	var message strings.Builder
+	fmt.Fprintf(&message, "From: %s\r\n", s.from)
+	fmt.Fprintf(&message, "To: %s\r\n", to)
+	fmt.Fprintf(&message, "Subject: %s\r\n", subject)
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	message.WriteString(body)
+
+	return smtp.SendMail(s.address, s.auth, s.from, []string{to}, []byte(message.String()))
+}
+
+func (s *logMailService) Send(to, subject, body string) error {
+	s.logger.Info().Msgf("mail to %s: %s\n%s", to, subject, body)
+	return nil
+}
@@ -0,0 +1,95 @@
+package pkg
+
+import (
+	"auth/config"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const pepperPrefix = "$pepper$k="
+
+type pepperedHashService struct {
+	hashService    HashService
+	keys           map[int][]byte
+	currentVersion int
+}
+
+// NewPepperedHashService applies HMAC-SHA256 with a server key to the
+// string before hashing it and stores the key version in front of the hash:
+// $pepper$k=2$argon2id$v=19$.... Hashes without the prefix are verified as
+// is, so the pepper can be introduced and rotated without a password reset.
+func NewPepperedHashService(hashService HashService, cfg config.Pepper) HashService {
+	return &pepperedHashService{
+		hashService:    hashService,
+		keys:           cfg.Keys,
+		currentVersion: cfg.CurrentVersion(),
+	}
+}
+
+func (p *pepperedHashService) GenerateHash(stringToHash string) ([]byte, error) {
+	if p.currentVersion == 0 {
+		return p.hashService.GenerateHash(stringToHash)
+	}
+
+	hash, err := p.hashService.GenerateHash(p.pepper(p.keys[p.currentVersion], stringToHash))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(fmt.Sprintf("%s%d%s", pepperPrefix, p.currentVersion, hash)), nil
+}
+
+func (p *pepperedHashService) CompareStringAndHash(stringToCompare string, hashedString string) bool {
+	version, hash, err := splitPepperedHash(hashedString)
+	if err != nil {
+		return false
+	}
+	if version == 0 {
+		return p.hashService.CompareStringAndHash(stringToCompare, hash)
+	}
+
+	key, ok := p.keys[version]
+	if !ok {
+		return false
+	}
+	return p.hashService.CompareStringAndHash(p.pepper(key, stringToCompare), hash)
+}
+
+func (p *pepperedHashService) NeedsRehash(hashedString string) bool {
+	version, hash, err := splitPepperedHash(hashedString)
+	if err != nil {
+		return true
+	}
+	return version != p.currentVersion || p.hashService.NeedsRehash(hash)
+}
+
+// pepper returns the base64 encoded HMAC, it is 44 bytes long and so it also
+// fits into the 72 bytes bcrypt takes into account.
+func (p *pepperedHashService) pepper(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// splitPepperedHash returns the pepper key version and the underlying hash,
+// the version is 0 for hashes made without a pepper.
+func splitPepperedHash(hashedString string) (int, string, error) {
+	if !strings.HasPrefix(hashedString, pepperPrefix) {
+		return 0, hashedString, nil
+	}
+
+	rest := strings.TrimPrefix(hashedString, pepperPrefix)
+	end := strings.Index(rest, "$")
+	if end <= 0 {
+		return 0, "", fmt.Errorf("invalid peppered hash")
+	}
+
+	version, err := strconv.Atoi(rest[:end])
+	if err != nil || version <= 0 {
+		return 0, "", fmt.Errorf("invalid pepper key version %q", rest[:end])
+	}
+	return version, rest[end:], nil
+}
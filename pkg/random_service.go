@@ -0,0 +1,51 @@
+package pkg
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+)
+
+const randomTokenLength = 32
+
+type randomService struct{}
+
+// RandomService generates opaque URL-safe secrets such as invitation tokens.
+type RandomService interface {
+	GenerateToken() (string, error)
+	// GenerateCode returns a uniformly distributed numeric code of the given
+	// number of digits, with leading zeros.
+	GenerateCode(digits int) (string, error)
+	// HashToken returns the stored form of a token. The tokens are random, so
+	// a plain SHA-256 is enough and allows looking them up.
+	HashToken(token string) string
+}
+
+func NewRandomService() RandomService {
+	return &randomService{}
+}
+
+func (r *randomService) GenerateToken() (string, error) {
+	rndBytes := make([]byte, randomTokenLength)
+	if _, err := rand.Read(rndBytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(rndBytes), nil
+}
+
+func (r *randomService) GenerateCode(digits int) (string, error) {
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	code, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", digits, code), nil
+}
+
+func (r *randomService) HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
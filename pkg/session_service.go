@@ -13,7 +13,9 @@ type sessionService struct {
 }
 
 type SessionService interface {
-	CreateSession(account entities.User) (entities.Session, error)
+	CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error)
+	CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error)
+	CreateDeviceToken(account entities.User, deviceId string, expiresAt time.Time) (string, error)
 	ParseToken(token string) (entities.AccessTokenClaims, error)
 }
 
@@ -24,11 +26,16 @@ func NewSessionService(
 	return &sessionService{config: config, access: access, refresh: refresh}
 }
 
-func (t *sessionService) CreateSession(account entities.User) (entities.Session, error) {
+// CreateSession issues tokens for the user. A non-empty membership makes its
+// organization the active one of the session, the authentication is reported
+// in the amr, auth_time and acr claims.
+func (t *sessionService) CreateSession(account entities.User, membership entities.Membership, authentication entities.Authentication) (entities.Session, error) {
 	accessExpiresAt := time.Now().Add(t.config.AccessTokenDuration)
 	refreshExpiresAt := time.Now().Add(t.config.RefreshTokenDuration)
 
-	accessClaims := entities.NewClaims(account.Id, accessExpiresAt)
+	accessClaims := entities.NewClaims(account.Id, account.Roles, account.Permissions, accessExpiresAt)
+	accessClaims.SetOrganization(membership)
+	accessClaims.SetAuthentication(authentication)
 	access, err := t.access.CreateAccessToken(accessClaims)
 	if err != nil {
 		return entities.Session{}, err
@@ -41,13 +48,36 @@ func (t *sessionService) CreateSession(account entities.User) (entities.Session,
 
 	return entities.Session{
 		UserId:          account.Id,
+		OrganizationId:  membership.OrganizationId,
 		AccessToken:     access,
 		AccessExpiresAt: accessExpiresAt,
 		RefreshToken:    refresh,
 		ExpiresAt:       refreshExpiresAt,
+		Authentication:  authentication,
 	}, nil
 }
 
+// CreateRestrictedToken issues a short-lived access token without a session
+// that only allows the action of the scope. The authentication passed so far
+// is carried along, e.g. the first factor in the MFA challenge.
+func (t *sessionService) CreateRestrictedToken(account entities.User, scope string, authentication entities.Authentication) (string, time.Time, error) {
+	expiresAt := time.Now().Add(t.config.RestrictedTokenDuration)
+
+	claims := entities.NewRestrictedClaims(account.Id, scope, expiresAt)
+	claims.SetAuthentication(authentication)
+	token, err := t.access.CreateAccessToken(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+// CreateDeviceToken signs the token of the trusted device cookie. The token
+// has the scope of its own, so it can't be used as an access token.
+func (t *sessionService) CreateDeviceToken(account entities.User, deviceId string, expiresAt time.Time) (string, error) {
+	return t.access.CreateAccessToken(entities.NewTrustedDeviceClaims(account.Id, deviceId, expiresAt))
+}
+
 func (t *sessionService) ParseToken(token string) (entities.AccessTokenClaims, error) {
 	claims, err := t.access.ParseAccessToken(token)
 	if err != nil {
@@ -0,0 +1,110 @@
+package pkg
+
+import (
+	"auth/config"
+	"auth/pkg/logger"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const defaultSMSTimeout = 10 * time.Second
+
+// SMSSender delivers text messages to phone numbers in the E.164 format.
+type SMSSender interface {
+	Send(to, message string) error
+}
+
+// logSMSSender writes the messages to the log and, when a file is
+// configured, appends them to it, it is used in development.
+type logSMSSender struct {
+	logger logger.Logger
+	file   string
+	mu     sync.Mutex
+}
+
+// httpSMSSender posts the messages as JSON to the provider gateway.
+type httpSMSSender struct {
+	url    string
+	token  string
+	from   string
+	client *http.Client
+}
+
+type httpSMSRequest struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+func NewSMSSender(cfg config.SMS, logger logger.Logger) (SMSSender, error) {
+	switch cfg.Provider {
+	case "", "log":
+		return &logSMSSender{logger: logger, file: cfg.File}, nil
+	case "http":
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("the url of the sms provider is not set")
+		}
+		timeout := cfg.Timeout
+		if timeout <= 0 {
+			timeout = defaultSMSTimeout
+		}
+		return &httpSMSSender{
+			url:    cfg.URL,
+			token:  cfg.Token,
+			from:   cfg.From,
+			client: &http.Client{Timeout: timeout},
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown sms provider %q", cfg.Provider)
+}
+
+func (s *logSMSSender) Send(to, message string) error {
+	s.logger.Info().Msgf("sms to %s: %s", to, message)
+	if s.file == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file, err := os.OpenFile(s.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = fmt.Fprintf(file, "%s\t%s\t%s\n", time.Now().UTC().Format(time.RFC3339), to, message)
+	return err
+}
+
+func (s *httpSMSSender) Send(to, message string) error {
+	body, err := json.Marshal(httpSMSRequest{From: s.from, To: to, Text: message})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		request.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("sms provider responded with status %d", response.StatusCode)
+	}
+	return nil
+}
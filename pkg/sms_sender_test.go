@@ -0,0 +1,94 @@
+package pkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"auth/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHTTPSMSSender(t *testing.T, url string, timeout time.Duration) SMSSender {
+	sender, err := NewSMSSender(config.SMS{
+		Provider: "http",
+		URL:      url,
+		Token:    "provider-token",
+		From:     "Auth",
+		Timeout:  timeout,
+	}, nil)
+	require.NoError(t, err)
+	return sender
+}
+
+func TestHTTPSMSSender_Send_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/messages", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer provider-token", r.Header.Get("Authorization"))
+
+		var request httpSMSRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, httpSMSRequest{From: "Auth", To: "+79990000000", Text: "code 123456"}, request)
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	err := newTestHTTPSMSSender(t, server.URL+"/messages", time.Second).Send("+79990000000", "code 123456")
+
+	assert.NoError(t, err)
+}
+
+func TestHTTPSMSSender_Send_WithoutToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender, err := NewSMSSender(config.SMS{Provider: "http", URL: server.URL}, nil)
+	require.NoError(t, err)
+
+	assert.NoError(t, sender.Send("+79990000000", "code 123456"))
+}
+
+func TestHTTPSMSSender_Send_ErrorStatus(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusTooManyRequests, http.StatusBadGateway} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"error":"rejected"}`))
+		}))
+
+		err := newTestHTTPSMSSender(t, server.URL, time.Second).Send("+79990000000", "code 123456")
+
+		assert.EqualError(t, err, fmt.Sprintf("sms provider responded with status %d", status))
+		server.Close()
+	}
+}
+
+func TestHTTPSMSSender_Send_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	started := time.Now()
+	err := newTestHTTPSMSSender(t, server.URL, 50*time.Millisecond).Send("+79990000000", "code 123456")
+
+	assert.Error(t, err)
+	assert.Less(t, time.Since(started), time.Second)
+}
+
+func TestNewSMSSender_HTTPWithoutURL(t *testing.T) {
+	_, err := NewSMSSender(config.SMS{Provider: "http"}, nil)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,245 @@
+package pkg
+
+import (
+	"auth/config"
+	"auth/internal/entities"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+)
+
+const defaultWebAuthnTimeout = 5 * time.Minute
+
+var ErrClonedAuthenticator = errors.New("the signature counter went backwards, the authenticator may be cloned")
+
+// WebAuthnService runs the registration and the assertion ceremonies. The
+// options are returned as the JSON expected by navigator.credentials, the
+// responses are taken as the JSON of the PublicKeyCredential built by the
+// browser.
+type WebAuthnService interface {
+	BeginRegistration(user entities.User, credentials []entities.WebAuthnCredential) ([]byte, entities.WebAuthnSession, error)
+	FinishRegistration(user entities.User, credentials []entities.WebAuthnCredential, session entities.WebAuthnSession, response []byte) (entities.WebAuthnCredential, error)
+	// BeginLogin is used for the second factor, only the credentials of the
+	// user are allowed.
+	BeginLogin(user entities.User, credentials []entities.WebAuthnCredential) ([]byte, entities.WebAuthnSession, error)
+	// BeginDiscoverableLogin is used for the passwordless login, the user is
+	// identified by the passkey and the user verification is required.
+	BeginDiscoverableLogin() ([]byte, entities.WebAuthnSession, error)
+	// CredentialOwner returns the user id stored in the passkey, it is not
+	// verified until FinishLogin.
+	CredentialOwner(response []byte) (string, error)
+	// FinishLogin verifies the assertion and returns the credential with the
+	// updated signature counter.
+	FinishLogin(user entities.User, credentials []entities.WebAuthnCredential, session entities.WebAuthnSession, response []byte) (entities.WebAuthnCredential, error)
+}
+
+type webAuthnService struct {
+	webAuthn *webauthn.WebAuthn
+}
+
+func NewWebAuthnService(cfg config.WebAuthn) (WebAuthnService, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultWebAuthnTimeout
+	}
+	ceremonyTimeout := webauthn.TimeoutConfig{Enforce: true, Timeout: timeout, TimeoutUVD: timeout}
+
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:          cfg.RPID,
+		RPDisplayName: cfg.RPDisplayName,
+		RPOrigins:     cfg.RPOrigins,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementPreferred,
+			UserVerification: protocol.VerificationPreferred,
+		},
+		Timeouts: webauthn.TimeoutsConfig{Login: ceremonyTimeout, Registration: ceremonyTimeout},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &webAuthnService{webAuthn: webAuthn}, nil
+}
+
+func (s *webAuthnService) BeginRegistration(user entities.User, credentials []entities.WebAuthnCredential) ([]byte, entities.WebAuthnSession, error) {
+	webAuthnUser := newWebAuthnUser(user, credentials)
+
+	exclusions := make([]protocol.CredentialDescriptor, 0, len(credentials))
+	for _, credential := range webAuthnUser.credentials {
+		exclusions = append(exclusions, credential.Descriptor())
+	}
+
+	creation, session, err := s.webAuthn.BeginRegistration(webAuthnUser, webauthn.WithExclusions(exclusions))
+	if err != nil {
+		return nil, entities.WebAuthnSession{}, err
+	}
+	return encodeCeremony(creation, session)
+}
+
+func (s *webAuthnService) FinishRegistration(user entities.User, credentials []entities.WebAuthnCredential, session entities.WebAuthnSession, response []byte) (entities.WebAuthnCredential, error) {
+	sessionData, err := decodeSessionData(session)
+	if err != nil {
+		return entities.WebAuthnCredential{}, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return entities.WebAuthnCredential{}, err
+	}
+
+	credential, err := s.webAuthn.CreateCredential(newWebAuthnUser(user, credentials), sessionData, parsed)
+	if err != nil {
+		return entities.WebAuthnCredential{}, err
+	}
+	return newWebAuthnCredentialEntity(user.Id, *credential), nil
+}
+
+func (s *webAuthnService) BeginLogin(user entities.User, credentials []entities.WebAuthnCredential) ([]byte, entities.WebAuthnSession, error) {
+	assertion, session, err := s.webAuthn.BeginLogin(newWebAuthnUser(user, credentials))
+	if err != nil {
+		return nil, entities.WebAuthnSession{}, err
+	}
+	return encodeCeremony(assertion, session)
+}
+
+func (s *webAuthnService) BeginDiscoverableLogin() ([]byte, entities.WebAuthnSession, error) {
+	assertion, session, err := s.webAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		return nil, entities.WebAuthnSession{}, err
+	}
+	return encodeCeremony(assertion, session)
+}
+
+func (s *webAuthnService) CredentialOwner(response []byte) (string, error) {
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return "", err
+	}
+	userId := string(parsed.Response.UserHandle)
+	if uuid.Validate(userId) != nil {
+		return "", errors.New("the user handle of the credential is not a user id")
+	}
+	return userId, nil
+}
+
+func (s *webAuthnService) FinishLogin(user entities.User, credentials []entities.WebAuthnCredential, session entities.WebAuthnSession, response []byte) (entities.WebAuthnCredential, error) {
+	sessionData, err := decodeSessionData(session)
+	if err != nil {
+		return entities.WebAuthnCredential{}, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return entities.WebAuthnCredential{}, err
+	}
+
+	webAuthnUser := newWebAuthnUser(user, credentials)
+	var credential *webauthn.Credential
+	if len(sessionData.UserID) == 0 {
+		credential, err = s.webAuthn.ValidateDiscoverableLogin(func(_, _ []byte) (webauthn.User, error) {
+			return webAuthnUser, nil
+		}, sessionData, parsed)
+	} else {
+		credential, err = s.webAuthn.ValidateLogin(webAuthnUser, sessionData, parsed)
+	}
+	if err != nil {
+		return entities.WebAuthnCredential{}, err
+	}
+	if credential.Authenticator.CloneWarning {
+		return entities.WebAuthnCredential{}, ErrClonedAuthenticator
+	}
+
+	result := newWebAuthnCredentialEntity(user.Id, *credential)
+	for _, stored := range credentials {
+		if string(stored.Id) == string(result.Id) {
+			result.Name = stored.Name
+			result.CreatedAt = stored.CreatedAt
+		}
+	}
+	return result, nil
+}
+
+// webAuthnUser adapts the user to the library, the user handle stored in the
+// passkeys is the user id.
+type webAuthnUser struct {
+	user        entities.User
+	credentials []webauthn.Credential
+}
+
+func newWebAuthnUser(user entities.User, credentials []entities.WebAuthnCredential) *webAuthnUser {
+	result := &webAuthnUser{user: user, credentials: make([]webauthn.Credential, 0, len(credentials))}
+	for _, credential := range credentials {
+		transports := make([]protocol.AuthenticatorTransport, 0, len(credential.Transports))
+		for _, transport := range credential.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(transport))
+		}
+
+		result.credentials = append(result.credentials, webauthn.Credential{
+			ID:              credential.Id,
+			PublicKey:       credential.PublicKey,
+			AttestationType: credential.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: credential.BackupEligible,
+				BackupState:    credential.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    credential.AAGUID,
+				SignCount: credential.SignCount,
+			},
+		})
+	}
+	return result
+}
+
+func (u *webAuthnUser) WebAuthnID() []byte { return []byte(u.user.Id) }
+
+func (u *webAuthnUser) WebAuthnName() string { return string(u.user.Email) }
+
+func (u *webAuthnUser) WebAuthnDisplayName() string { return string(u.user.Email) }
+
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential { return u.credentials }
+
+func newWebAuthnCredentialEntity(userId string, credential webauthn.Credential) entities.WebAuthnCredential {
+	transports := make([]string, 0, len(credential.Transport))
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	return entities.WebAuthnCredential{
+		Id:              credential.ID,
+		UserId:          userId,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		AAGUID:          credential.Authenticator.AAGUID,
+		SignCount:       credential.Authenticator.SignCount,
+		Transports:      transports,
+		BackupEligible:  credential.Flags.BackupEligible,
+		BackupState:     credential.Flags.BackupState,
+	}
+}
+
+func encodeCeremony(options any, session *webauthn.SessionData) ([]byte, entities.WebAuthnSession, error) {
+	encodedOptions, err := json.Marshal(options)
+	if err != nil {
+		return nil, entities.WebAuthnSession{}, fmt.Errorf("failed to encode options: %w", err)
+	}
+	data, err := json.Marshal(session)
+	if err != nil {
+		return nil, entities.WebAuthnSession{}, fmt.Errorf("failed to encode session: %w", err)
+	}
+	return encodedOptions, entities.WebAuthnSession{Data: data, ExpiresAt: session.Expires}, nil
+}
+
+func decodeSessionData(session entities.WebAuthnSession) (webauthn.SessionData, error) {
+	var sessionData webauthn.SessionData
+	err := json.Unmarshal(session.Data, &sessionData)
+	if err != nil {
+		return webauthn.SessionData{}, fmt.Errorf("failed to decode session: %w", err)
+	}
+	return sessionData, nil
+}
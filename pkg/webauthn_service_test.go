@@ -0,0 +1,245 @@
+package pkg
+
+import (
+	"auth/config"
+	"auth/internal/entities"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRPID   = "auth.example.com"
+	testOrigin = "https://auth.example.com"
+)
+
+const (
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttestedData = 0x40
+)
+
+// softwareAuthenticator is a P-256 authenticator with the "none" attestation,
+// it builds the responses the browser would send.
+type softwareAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialId []byte
+	signCount    uint32
+}
+
+func newSoftwareAuthenticator(t *testing.T) *softwareAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	credentialId := make([]byte, 16)
+	_, err = rand.Read(credentialId)
+	require.NoError(t, err)
+	return &softwareAuthenticator{key: key, credentialId: credentialId}
+}
+
+func (a *softwareAuthenticator) authenticatorData(flags byte) []byte {
+	rpIdHash := sha256.Sum256([]byte(testRPID))
+	data := append([]byte{}, rpIdHash[:]...)
+	data = append(data, flags|flagUserPresent|flagUserVerified)
+	return binary.BigEndian.AppendUint32(data, a.signCount)
+}
+
+func (a *softwareAuthenticator) clientData(t *testing.T, ceremony string, challenge []byte) []byte {
+	clientData, err := json.Marshal(map[string]string{
+		"type":      ceremony,
+		"challenge": base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":    testOrigin,
+	})
+	require.NoError(t, err)
+	return clientData
+}
+
+func (a *softwareAuthenticator) create(t *testing.T, options []byte) []byte {
+	var creation protocol.CredentialCreation
+	require.NoError(t, json.Unmarshal(options, &creation))
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.PublicKey.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(t, err)
+
+	authData := a.authenticatorData(flagAttestedData)
+	authData = append(authData, make([]byte, 16)...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialId)))
+	authData = append(authData, a.credentialId...)
+	authData = append(authData, publicKey...)
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	require.NoError(t, err)
+
+	return a.credential(t, map[string]string{
+		"clientDataJSON":    encode(a.clientData(t, "webauthn.create", creation.Response.Challenge)),
+		"attestationObject": encode(attestation),
+	})
+}
+
+func (a *softwareAuthenticator) get(t *testing.T, challenge []byte, userHandle string) []byte {
+	clientData := a.clientData(t, "webauthn.get", challenge)
+	authData := a.authenticatorData(0)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	return a.credential(t, map[string]string{
+		"clientDataJSON":    encode(clientData),
+		"authenticatorData": encode(authData),
+		"signature":         encode(signature),
+		"userHandle":        encode([]byte(userHandle)),
+	})
+}
+
+func (a *softwareAuthenticator) credential(t *testing.T, response map[string]string) []byte {
+	credential, err := json.Marshal(map[string]any{
+		"id":       encode(a.credentialId),
+		"rawId":    encode(a.credentialId),
+		"type":     "public-key",
+		"response": response,
+	})
+	require.NoError(t, err)
+	return credential
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func loginChallenge(t *testing.T, options []byte) []byte {
+	var assertion protocol.CredentialAssertion
+	require.NoError(t, json.Unmarshal(options, &assertion))
+	return assertion.Response.Challenge
+}
+
+func newTestWebAuthnService(t *testing.T) WebAuthnService {
+	service, err := NewWebAuthnService(config.WebAuthn{
+		RPID:          testRPID,
+		RPDisplayName: "Auth",
+		RPOrigins:     []string{testOrigin},
+	})
+	require.NoError(t, err)
+	return service
+}
+
+func registerSoftwareAuthenticator(t *testing.T, service WebAuthnService, user entities.User, authenticator *softwareAuthenticator) entities.WebAuthnCredential {
+	options, session, err := service.BeginRegistration(user, nil)
+	require.NoError(t, err)
+
+	credential, err := service.FinishRegistration(user, nil, session, authenticator.create(t, options))
+	require.NoError(t, err)
+	return credential
+}
+
+func TestWebAuthnService_Ceremonies(t *testing.T) {
+	service := newTestWebAuthnService(t)
+	user := entities.User{Id: uuid.NewString(), Email: "user@example.com"}
+	authenticator := newSoftwareAuthenticator(t)
+
+	credential := registerSoftwareAuthenticator(t, service, user, authenticator)
+	assert.Equal(t, authenticator.credentialId, credential.Id)
+	assert.Equal(t, user.Id, credential.UserId)
+
+	options, session, err := service.BeginLogin(user, []entities.WebAuthnCredential{credential})
+	require.NoError(t, err)
+	authenticator.signCount = 1
+
+	result, err := service.FinishLogin(user, []entities.WebAuthnCredential{credential}, session, authenticator.get(t, loginChallenge(t, options), user.Id))
+
+	require.NoError(t, err)
+	assert.Equal(t, credential.Id, result.Id)
+	assert.Equal(t, uint32(1), result.SignCount)
+}
+
+func TestWebAuthnService_DiscoverableLogin(t *testing.T) {
+	service := newTestWebAuthnService(t)
+	user := entities.User{Id: uuid.NewString(), Email: "user@example.com"}
+	authenticator := newSoftwareAuthenticator(t)
+	credential := registerSoftwareAuthenticator(t, service, user, authenticator)
+
+	options, session, err := service.BeginDiscoverableLogin()
+	require.NoError(t, err)
+	response := authenticator.get(t, loginChallenge(t, options), user.Id)
+
+	owner, err := service.CredentialOwner(response)
+	require.NoError(t, err)
+	assert.Equal(t, user.Id, owner)
+
+	_, err = service.FinishLogin(user, []entities.WebAuthnCredential{credential}, session, response)
+	assert.NoError(t, err)
+}
+
+func TestWebAuthnService_FinishRegistration_ChallengeMismatch(t *testing.T) {
+	service := newTestWebAuthnService(t)
+	user := entities.User{Id: uuid.NewString(), Email: "user@example.com"}
+	authenticator := newSoftwareAuthenticator(t)
+
+	options, _, err := service.BeginRegistration(user, nil)
+	require.NoError(t, err)
+	_, session, err := service.BeginRegistration(user, nil)
+	require.NoError(t, err)
+
+	_, err = service.FinishRegistration(user, nil, session, authenticator.create(t, options))
+
+	assert.ErrorContains(t, err, "challenge")
+}
+
+func TestWebAuthnService_FinishLogin_ChallengeMismatch(t *testing.T) {
+	service := newTestWebAuthnService(t)
+	user := entities.User{Id: uuid.NewString(), Email: "user@example.com"}
+	authenticator := newSoftwareAuthenticator(t)
+	credentials := []entities.WebAuthnCredential{registerSoftwareAuthenticator(t, service, user, authenticator)}
+
+	options, _, err := service.BeginLogin(user, credentials)
+	require.NoError(t, err)
+	_, session, err := service.BeginLogin(user, credentials)
+	require.NoError(t, err)
+	authenticator.signCount = 1
+
+	_, err = service.FinishLogin(user, credentials, session, authenticator.get(t, loginChallenge(t, options), user.Id))
+
+	assert.ErrorContains(t, err, "challenge")
+}
+
+func TestWebAuthnService_FinishLogin_SignCountRegression(t *testing.T) {
+	service := newTestWebAuthnService(t)
+	user := entities.User{Id: uuid.NewString(), Email: "user@example.com"}
+	authenticator := newSoftwareAuthenticator(t)
+	credential := registerSoftwareAuthenticator(t, service, user, authenticator)
+	credential.SignCount = 5
+
+	for _, signCount := range []uint32{3, 5} {
+		options, session, err := service.BeginLogin(user, []entities.WebAuthnCredential{credential})
+		require.NoError(t, err)
+		authenticator.signCount = signCount
+
+		_, err = service.FinishLogin(user, []entities.WebAuthnCredential{credential}, session, authenticator.get(t, loginChallenge(t, options), user.Id))
+
+		assert.ErrorIs(t, err, ErrClonedAuthenticator)
+	}
+}
@@ -0,0 +1,57 @@
+package pkg
+
+import (
+	"auth/config"
+	"auth/internal/entities"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// WebhookSender posts the deliveries to the endpoints. It returns the status
+// code of the response, a response outside of 2xx is an error.
+type WebhookSender interface {
+	Send(url, secret string, delivery entities.WebhookDelivery) (int, error)
+}
+
+type webhookSender struct {
+	client *http.Client
+}
+
+func NewWebhookSender(cfg config.Webhooks) WebhookSender {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	return &webhookSender{client: &http.Client{Timeout: timeout}}
+}
+
+func (s *webhookSender) Send(url, secret string, delivery entities.WebhookDelivery) (int, error) {
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookIdHeader, delivery.EventId)
+	request.Header.Set(WebhookTimestampHeader, timestamp)
+	request.Header.Set(WebhookSignatureHeader, SignWebhook(secret, timestamp, delivery.Payload))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("webhook endpoint responded with status %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
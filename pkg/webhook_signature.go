@@ -0,0 +1,52 @@
+package pkg
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Headers of the webhook requests. The signature is computed over the
+// timestamp and the body, so the receiver can reject the stale requests.
+const (
+	WebhookIdHeader        = "Webhook-Id"
+	WebhookTimestampHeader = "Webhook-Timestamp"
+	WebhookSignatureHeader = "Webhook-Signature"
+)
+
+const webhookSignatureVersion = "v1="
+
+// WebhookSignatureService checks the signatures of the inbound webhooks.
+type WebhookSignatureService interface {
+	// Verify reports whether one of the space separated signatures of the
+	// header matches the timestamp and the body, several signatures let the
+	// sender rotate the secret.
+	Verify(secret, timestamp string, body []byte, signature string) bool
+}
+
+type webhookSignatureService struct{}
+
+func NewWebhookSignatureService() WebhookSignatureService {
+	return &webhookSignatureService{}
+}
+
+// SignWebhook returns the signature header value, the hex encoded
+// HMAC-SHA256 of "timestamp.body" with the endpoint secret.
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return webhookSignatureVersion + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *webhookSignatureService) Verify(secret, timestamp string, body []byte, signature string) bool {
+	expected := []byte(SignWebhook(secret, timestamp, body))
+	for _, candidate := range strings.Fields(signature) {
+		if hmac.Equal([]byte(candidate), expected) {
+			return true
+		}
+	}
+	return false
+}